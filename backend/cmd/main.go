@@ -1,6 +1,12 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"os/signal"
+	"syscall"
+
 	"example.com/infrahandson/config"
 	"example.com/infrahandson/internal/infrastructure/server"
 )
@@ -9,8 +15,27 @@ func main() {
 	// 設定の読み込み
 	cfg := config.LoadConfig()
 
+	// SIGINT / SIGTERM を受け取ったら停止処理に移る
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	// サーバーの起動
-	e, db, _ := server.ServerStart(cfg) // Echoインスタンスを取得
-	defer db.Close()
-	e.Logger.Fatal(e.Start(":" + cfg.Port)) // サーバーを起動
+	srv := server.ServerStart(cfg) // Echoインスタンスを取得
+	e := srv.Echo
+	go func() {
+		if err := e.Start(":" + cfg.Port); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			e.Logger.Fatal(err) // サーバーを起動
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+	e.Logger.Info("shutting down server")
+
+	// 処理中のリクエストと送信待ちのメッセージを待ってから停止する
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		e.Logger.Error("failed to shut down gracefully: ", err)
+	}
 }
@@ -19,6 +19,28 @@ type Config struct {
 	MySQLDSN *string // MySQL用データベースのDSN
 	// Cache
 	MemcachedAddr *string // Memcachedのアドレス
+	// WebSocket
+	WsBackplaneAddr   *string       // 複数ノードで配信を共有する場合に接続するTCPブローカーのアドレス
+	WsBackplaneListen *string       // このプロセスでTCPブローカーを起動する場合の待ち受けアドレス
+	WsSendQueueSize   int           // コネクションごとの送信キューの長さ
+	WsWriteTimeout    time.Duration // 1回の書き込みのタイムアウト
+	WsOverflowPolicy  string        // 送信キューが溢れた場合の扱い（drop_oldest / disconnect）
+	WsPingInterval    time.Duration // サーバーから ping を送る間隔
+	WsPongWait        time.Duration // 応答がないクライアントを切断するまでの時間（WsPingInterval より長くする）
+	WsNodeHeartbeat   time.Duration // 複数ノードの場合に、ノードが稼働していることを他のノードに知らせる間隔
+	// Presence
+	PresenceAwayTimeout time.Duration // 操作がない場合に離席中として扱うまでの時間
+	// Message
+	MsgBurstLimit  int           // ユーザーが MsgBurstWindow の間に1つの部屋へ送信できるメッセージ数（0 で無制限）
+	MsgBurstWindow time.Duration // 連投を数える期間
+	ReadReceipts   bool          // 既読位置を部屋の他のメンバーに配信・公開するか
+	TypingTimeout  time.Duration // typing.start の送り直しがない場合に入力を終了したものとして扱うまでの時間
+	// Attachment
+	AttachmentMaxSize     int64  // 添付ファイル1つあたりの最大バイト数
+	LocalAttachmentDir    string // 添付ファイルのローカル保存先（静的配信しないディレクトリにする）
+	AttachmentStorePrefix string // S3 を使う場合の添付ファイルの保存先プレフィックス（バケットはアイコンと共通）
+	// Server
+	ShutdownTimeout time.Duration // 停止シグナルを受けてから処理中のリクエスト・送信待ちのメッセージを待つ時間
 	// IconStore
 	LocalIconDir       string  // ユーザーアイコンのローカル保存先
 	IconStoreEndpoint  *string // ユーザーアイコンの保存先エンドポイント
@@ -43,6 +65,28 @@ func LoadConfig() *Config {
 		MySQLDSN: parseStringPointer(getEnv("MYSQL_DSN", "")),
 		// Cache
 		MemcachedAddr: parseStringPointer(getEnv("MEMCACHED_ADDR", "")),
+		// WebSocket
+		WsBackplaneAddr:   parseStringPointer(getEnv("WS_BACKPLANE_ADDR", "")),
+		WsBackplaneListen: parseStringPointer(getEnv("WS_BACKPLANE_LISTEN", "")),
+		WsSendQueueSize:   parseInt(getEnv("WS_SEND_QUEUE_SIZE", "64")),
+		WsWriteTimeout:    paraseDuration(getEnv("WS_WRITE_TIMEOUT", "10s")),
+		WsOverflowPolicy:  getEnv("WS_OVERFLOW_POLICY", "drop_oldest"),
+		WsPingInterval:    paraseDuration(getEnv("WS_PING_INTERVAL", "30s")),
+		WsPongWait:        paraseDuration(getEnv("WS_PONG_WAIT", "60s")),
+		WsNodeHeartbeat:   paraseDuration(getEnv("WS_NODE_HEARTBEAT", "10s")),
+		// Presence
+		PresenceAwayTimeout: paraseDuration(getEnv("PRESENCE_AWAY_TIMEOUT", "5m")),
+		// Message
+		MsgBurstLimit:  parseInt(getEnv("MSG_BURST_LIMIT", "5")),
+		MsgBurstWindow: paraseDuration(getEnv("MSG_BURST_WINDOW", "10s")),
+		ReadReceipts:   parseBool(getEnv("READ_RECEIPTS", "true")),
+		TypingTimeout:  paraseDuration(getEnv("TYPING_TIMEOUT", "5s")),
+		// Attachment
+		AttachmentMaxSize:     int64(parseInt(getEnv("ATTACHMENT_MAX_SIZE", "10485760"))),
+		LocalAttachmentDir:    getEnv("LOCAL_ATTACHMENT_DIR", "./attachments"),
+		AttachmentStorePrefix: getEnv("ATTACHMENT_STORE_PREFIX", "attachments"),
+		// Server
+		ShutdownTimeout: paraseDuration(getEnv("SHUTDOWN_TIMEOUT", "10s")),
 		//IconStore
 		LocalIconDir:       getEnv("LOCAL_ICON_DIR", "./images/icons"),
 		IconStoreEndpoint:  parseStringPointer(getEnv("ICON_STORE_ENDPOINT", "")),
@@ -111,6 +155,15 @@ func paraseDuration(duration string) time.Duration {
 	return d
 }
 
+func parseBool(value string) bool {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
 func parseStringPointer(value string) *string {
 	if value == "" {
 		return nil
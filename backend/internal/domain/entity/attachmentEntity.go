@@ -0,0 +1,127 @@
+// メッセージの添付ファイルのエンティティ
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxMessageAttachments は1つのメッセージに添付できるファイルの最大数
+const MaxMessageAttachments = 10
+
+type Attachment struct {
+	id           AttachmentID
+	roomID       RoomID    // アップロードした部屋のID（この部屋のメッセージにのみ添付できる）
+	uploaderID   UserID    // アップロードしたユーザーのID（本人のメッセージにのみ添付できる）
+	messageID    MessageID // 添付したメッセージのID（まだ送信していない場合は空）
+	fileName     string    // アップロードされたときのファイル名
+	mimeType     string    // 内容から判定した MIME タイプ
+	size         int64     // バイト数
+	width        int       // 画像の幅（画像以外は 0）
+	height       int       // 画像の高さ（画像以外は 0）
+	hasThumbnail bool      // サムネイルを作成したか
+	createdAt    time.Time // アップロードした日時
+}
+
+type AttachmentParams struct {
+	ID           AttachmentID
+	RoomID       RoomID
+	UploaderID   UserID
+	MessageID    MessageID // 省略時はまだ送信していない
+	FileName     string
+	MimeType     string
+	Size         int64
+	Width        int
+	Height       int
+	HasThumbnail bool
+	CreatedAt    time.Time
+}
+
+func NewAttachment(params AttachmentParams) *Attachment {
+	return &Attachment{
+		id:           params.ID,
+		roomID:       params.RoomID,
+		uploaderID:   params.UploaderID,
+		messageID:    params.MessageID,
+		fileName:     params.FileName,
+		mimeType:     params.MimeType,
+		size:         params.Size,
+		width:        params.Width,
+		height:       params.Height,
+		hasThumbnail: params.HasThumbnail,
+		createdAt:    params.CreatedAt,
+	}
+}
+
+func (a *Attachment) GetID() AttachmentID {
+	return a.id
+}
+
+func (a *Attachment) GetRoomID() RoomID {
+	return a.roomID
+}
+
+func (a *Attachment) GetUploaderID() UserID {
+	return a.uploaderID
+}
+
+func (a *Attachment) GetMessageID() MessageID {
+	return a.messageID
+}
+
+// AttachTo はメッセージに添付済みにする
+func (a *Attachment) AttachTo(messageID MessageID) {
+	a.messageID = messageID
+}
+
+// IsAttached はメッセージに添付済みかどうかを返す
+func (a *Attachment) IsAttached() bool {
+	return a.messageID != ""
+}
+
+func (a *Attachment) GetFileName() string {
+	return a.fileName
+}
+
+func (a *Attachment) GetMimeType() string {
+	return a.mimeType
+}
+
+// IsImage は画像ファイルかどうかを返す
+func (a *Attachment) IsImage() bool {
+	return strings.HasPrefix(a.mimeType, "image/")
+}
+
+func (a *Attachment) GetSize() int64 {
+	return a.size
+}
+
+func (a *Attachment) GetWidth() int {
+	return a.width
+}
+
+func (a *Attachment) GetHeight() int {
+	return a.height
+}
+
+func (a *Attachment) HasThumbnail() bool {
+	return a.hasThumbnail
+}
+
+func (a *Attachment) GetCreatedAt() time.Time {
+	return a.createdAt
+}
+
+// GetDownloadPath は添付ファイルをダウンロードするAPIのパスを返す
+// ファイルは公開せず、部屋のメンバーであることを確認してからこのパスで返す
+func (a *Attachment) GetDownloadPath() string {
+	return "/api/room/" + string(a.roomID) + "/attachments/" + string(a.id)
+}
+
+// GetThumbnailPath はサムネイルをダウンロードするAPIのパスを返す（サムネイルがない場合は空）
+func (a *Attachment) GetThumbnailPath() string {
+	if !a.hasThumbnail {
+		return ""
+	}
+	return a.GetDownloadPath() + "/thumbnail"
+}
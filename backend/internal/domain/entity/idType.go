@@ -63,3 +63,17 @@ func (w *WsClientID) WsClientID2UUID() (uuid.UUID, error) {
 func (w *WsClientID) UUID2WsClientID(id uuid.UUID) {
 	*w = WsClientID(id.String())
 }
+
+type AttachmentID string
+// AttachmentID -> UUID変換メソッド
+func (a *AttachmentID) AttachmentID2UUID() (uuid.UUID, error) {
+	id, err := uuid.Parse(string(*a))
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+// UUID -> AttachmentID変換メソッド
+func (a *AttachmentID) UUID2AttachmentID(id uuid.UUID) {
+	*a = AttachmentID(id.String())
+}
@@ -0,0 +1,135 @@
+// メッセージ内のメンションのエンティティ
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxMentionNames は1つのメッセージで @ユーザー名 として解釈する名前の最大数（超えた分は無視する）
+const MaxMentionNames = 20
+
+// MentionKind はメンションの種類
+type MentionKind string
+
+const (
+	MentionKindUser MentionKind = "user" // @ユーザー名 で指定された
+	MentionKindHere MentionKind = "here" // @here で部屋に接続しているメンバーが対象になった
+	MentionKindRoom MentionKind = "room" // @room で部屋のメンバー全員が対象になった
+)
+
+// mentionTrailingCutset はメンションの直後に続いても名前に含めない記号
+const mentionTrailingCutset = ".,!?:;)]}\"'、。！？）」"
+
+type Mention struct {
+	messageID MessageID   // メンションを含むメッセージのID
+	roomID    RoomID      // メッセージが投稿された部屋のID
+	userID    UserID      // メンションされたユーザーのID
+	kind      MentionKind // 同じユーザーが複数の方法で対象になった場合は user > here > room の順に優先する
+	createdAt time.Time   // メッセージの送信日時
+}
+
+type MentionParams struct {
+	MessageID MessageID
+	RoomID    RoomID
+	UserID    UserID
+	Kind      MentionKind
+	CreatedAt time.Time
+}
+
+func NewMention(params MentionParams) *Mention {
+	return &Mention{
+		messageID: params.MessageID,
+		roomID:    params.RoomID,
+		userID:    params.UserID,
+		kind:      params.Kind,
+		createdAt: params.CreatedAt,
+	}
+}
+
+func (m *Mention) GetMessageID() MessageID {
+	return m.messageID
+}
+
+func (m *Mention) GetRoomID() RoomID {
+	return m.roomID
+}
+
+func (m *Mention) GetUserID() UserID {
+	return m.userID
+}
+
+func (m *Mention) GetKind() MentionKind {
+	return m.kind
+}
+
+func (m *Mention) GetCreatedAt() time.Time {
+	return m.createdAt
+}
+
+// MentionedMessage はメンションとメンションを含むメッセージの組
+type MentionedMessage struct {
+	mention *Mention
+	message *Message
+	read    bool // メンションされたユーザーの既読位置がメッセージまで進んでいるか
+}
+
+func NewMentionedMessage(mention *Mention, message *Message, read bool) *MentionedMessage {
+	return &MentionedMessage{mention: mention, message: message, read: read}
+}
+
+func (m *MentionedMessage) GetMention() *Mention {
+	return m.mention
+}
+
+func (m *MentionedMessage) GetMessage() *Message {
+	return m.message
+}
+
+func (m *MentionedMessage) IsRead() bool {
+	return m.read
+}
+
+// MentionTargets はメッセージの本文から読み取ったメンションの対象
+type MentionTargets struct {
+	Names []string // @ユーザー名 で指定された名前（重複を除いた出現順）
+	Here  bool     // @here を含む
+	Room  bool     // @room を含む
+}
+
+// IsEmpty はメンションを含まないかを返します。
+func (t MentionTargets) IsEmpty() bool {
+	return len(t.Names) == 0 && !t.Here && !t.Room
+}
+
+// ParseMentions はメッセージの本文からメンションを読み取ります。
+// 行頭または空白の直後の @ から次の空白までをメンションとして扱い、末尾の句読点・閉じ括弧は名前に含めません。
+// メールアドレスのように単語の途中にある @ はメンションになりません。
+// 空白を含む名前のユーザーは @ユーザー名 でメンションできません。
+func ParseMentions(content string) MentionTargets {
+	var targets MentionTargets
+	seen := make(map[string]struct{})
+	for _, field := range strings.Fields(content) {
+		name, ok := strings.CutPrefix(field, "@")
+		if !ok {
+			continue
+		}
+		name = strings.TrimRight(name, mentionTrailingCutset)
+		switch name {
+		case "":
+			continue
+		case "here":
+			targets.Here = true
+			continue
+		case "room":
+			targets.Room = true
+			continue
+		}
+		if _, dup := seen[name]; dup || len(targets.Names) >= MaxMentionNames {
+			continue
+		}
+		seen[name] = struct{}{}
+		targets.Names = append(targets.Names, name)
+	}
+	return targets
+}
@@ -4,30 +4,53 @@ package entity
 import "time"
 
 type Message struct {
-	id      MessageID // メッセージID
-	roomID  RoomID    // 所属するチャットルームのID
-	userID  UserID    // 投稿者のID（匿名なら名前など）
-	content string    // 本文
-	sentAt  time.Time // 送信日時
+	id        MessageID  // メッセージID
+	roomID    RoomID     // 所属するチャットルームのID
+	userID    UserID     // 投稿者のID（匿名なら名前など）
+	parentID  MessageID  // 返信先のメッセージID（スレッドの返信でない場合は空）
+	content   string     // 本文
+	sentAt    time.Time  // 送信日時
+	editedAt  *time.Time // 最終編集日時（未編集の場合は nil）
+	deletedAt *time.Time // 削除日時（削除されていない場合は nil）
+
+	// attachments は送信時に添付したファイル
+	// 送信したメッセージの配信にのみ使用する。DBから取得したメッセージでは空のため、履歴では AttachmentRepository から取得する
+	attachments []*Attachment
 }
 
 // メッセージ作成の時のパラメータ
 type MessageParams struct {
-	ID      MessageID
-	RoomID  RoomID
-	UserID  UserID
-	Content string
-	SentAt  time.Time
+	ID        MessageID
+	RoomID    RoomID
+	UserID    UserID
+	ParentID  MessageID // 省略時はスレッドの返信ではないメッセージ
+	Content   string
+	SentAt    time.Time
+	EditedAt  *time.Time
+	DeletedAt *time.Time
+	// Attachments は送信時に添付したファイル
+	Attachments []*Attachment
 }
 
 func NewMessage(params MessageParams) *Message {
-	return &Message{
-		id:      params.ID,
-		roomID:  params.RoomID,
-		userID:  params.UserID,
-		content: params.Content,
-		sentAt:  params.SentAt,
+	m := &Message{
+		id:        params.ID,
+		roomID:    params.RoomID,
+		userID:    params.UserID,
+		parentID:  params.ParentID,
+		content:   params.Content,
+		sentAt:    params.SentAt,
+		editedAt:  params.EditedAt,
+		deletedAt: params.DeletedAt,
+
+		attachments: params.Attachments,
+	}
+	// 削除済みのメッセージは本文・添付ファイルを公開しない
+	if m.deletedAt != nil {
+		m.content = ""
+		m.attachments = nil
 	}
+	return m
 }
 
 // Getters for Message fields
@@ -42,6 +65,15 @@ func (m *Message) GetUserID() UserID {
 	return m.userID
 }
 
+func (m *Message) GetParentID() MessageID {
+	return m.parentID
+}
+
+// IsReply はメッセージがスレッドの返信かどうかを返す
+func (m *Message) IsReply() bool {
+	return m.parentID != ""
+}
+
 func (m *Message) GetContent() string {
 	return m.content
 }
@@ -49,3 +81,99 @@ func (m *Message) GetContent() string {
 func (m *Message) GetSentAt() time.Time {
 	return m.sentAt
 }
+
+func (m *Message) GetEditedAt() *time.Time {
+	return m.editedAt
+}
+
+func (m *Message) GetDeletedAt() *time.Time {
+	return m.deletedAt
+}
+
+// GetAttachments は送信時に添付したファイルを返す
+func (m *Message) GetAttachments() []*Attachment {
+	return m.attachments
+}
+
+// IsDeleted はメッセージが削除済みかどうかを返す
+func (m *Message) IsDeleted() bool {
+	return m.deletedAt != nil
+}
+
+// Edit は本文を書き換え、書き換える前の本文を編集履歴として返す
+func (m *Message) Edit(content string, at time.Time) *MessageRevision {
+	rev := NewMessageRevision(MessageRevisionParams{
+		MessageID: m.id,
+		Content:   m.content,
+		EditedAt:  at,
+	})
+	m.content = content
+	m.editedAt = &at
+	return rev
+}
+
+// Delete はメッセージを削除済みにする（論理削除）
+func (m *Message) Delete(at time.Time) {
+	m.content = ""
+	m.attachments = nil
+	m.deletedAt = &at
+}
+
+// MessageRevision はメッセージの編集履歴（編集される前の本文）
+type MessageRevision struct {
+	messageID MessageID // 編集されたメッセージのID
+	content   string    // 編集される前の本文
+	editedAt  time.Time // 編集された日時
+}
+
+type MessageRevisionParams struct {
+	MessageID MessageID
+	Content   string
+	EditedAt  time.Time
+}
+
+func NewMessageRevision(params MessageRevisionParams) *MessageRevision {
+	return &MessageRevision{
+		messageID: params.MessageID,
+		content:   params.Content,
+		editedAt:  params.EditedAt,
+	}
+}
+
+func (r *MessageRevision) GetMessageID() MessageID {
+	return r.messageID
+}
+
+func (r *MessageRevision) GetContent() string {
+	return r.content
+}
+
+func (r *MessageRevision) GetEditedAt() time.Time {
+	return r.editedAt
+}
+
+// ThreadSummary はスレッドの親メッセージに付く返信の要約
+type ThreadSummary struct {
+	replyCount  int       // 返信数（削除された返信は含まない）
+	lastReplyAt time.Time // 最後の返信の送信日時
+}
+
+type ThreadSummaryParams struct {
+	ReplyCount  int
+	LastReplyAt time.Time
+}
+
+func NewThreadSummary(params ThreadSummaryParams) *ThreadSummary {
+	return &ThreadSummary{
+		replyCount:  params.ReplyCount,
+		lastReplyAt: params.LastReplyAt,
+	}
+}
+
+func (s *ThreadSummary) GetReplyCount() int {
+	return s.replyCount
+}
+
+func (s *ThreadSummary) GetLastReplyAt() time.Time {
+	return s.lastReplyAt
+}
@@ -0,0 +1,64 @@
+// 部屋にピン留めされたメッセージのエンティティ
+package entity
+
+import "time"
+
+// DefaultRoomPinLimit は部屋にピン留めできるメッセージ数の既定の上限
+const DefaultRoomPinLimit = 50
+
+type Pin struct {
+	roomID    RoomID    // ピン留めされた部屋のID
+	messageID MessageID // ピン留めされたメッセージのID
+	pinnedBy  UserID    // ピン留めしたユーザーのID
+	pinnedAt  time.Time // ピン留めした日時
+}
+
+type PinParams struct {
+	RoomID    RoomID
+	MessageID MessageID
+	PinnedBy  UserID
+	PinnedAt  time.Time
+}
+
+func NewPin(params PinParams) *Pin {
+	return &Pin{
+		roomID:    params.RoomID,
+		messageID: params.MessageID,
+		pinnedBy:  params.PinnedBy,
+		pinnedAt:  params.PinnedAt,
+	}
+}
+
+func (p *Pin) GetRoomID() RoomID {
+	return p.roomID
+}
+
+func (p *Pin) GetMessageID() MessageID {
+	return p.messageID
+}
+
+func (p *Pin) GetPinnedBy() UserID {
+	return p.pinnedBy
+}
+
+func (p *Pin) GetPinnedAt() time.Time {
+	return p.pinnedAt
+}
+
+// PinnedMessage はピン留めとピン留めされたメッセージの組
+type PinnedMessage struct {
+	pin     *Pin
+	message *Message
+}
+
+func NewPinnedMessage(pin *Pin, message *Message) *PinnedMessage {
+	return &PinnedMessage{pin: pin, message: message}
+}
+
+func (p *PinnedMessage) GetPin() *Pin {
+	return p.pin
+}
+
+func (p *PinnedMessage) GetMessage() *Message {
+	return p.message
+}
@@ -0,0 +1,76 @@
+// メッセージへのリアクション（絵文字）のエンティティ
+package entity
+
+import "time"
+
+type Reaction struct {
+	messageID MessageID // リアクションしたメッセージのID
+	userID    UserID    // リアクションしたユーザーのID
+	emoji     string    // 絵文字
+	createdAt time.Time // リアクションした日時
+}
+
+type ReactionParams struct {
+	MessageID MessageID
+	UserID    UserID
+	Emoji     string
+	CreatedAt time.Time
+}
+
+func NewReaction(params ReactionParams) *Reaction {
+	return &Reaction{
+		messageID: params.MessageID,
+		userID:    params.UserID,
+		emoji:     params.Emoji,
+		createdAt: params.CreatedAt,
+	}
+}
+
+func (r *Reaction) GetMessageID() MessageID {
+	return r.messageID
+}
+
+func (r *Reaction) GetUserID() UserID {
+	return r.userID
+}
+
+func (r *Reaction) GetEmoji() string {
+	return r.emoji
+}
+
+func (r *Reaction) GetCreatedAt() time.Time {
+	return r.createdAt
+}
+
+// ReactionSummary はメッセージに付いた絵文字ごとのリアクションの集計
+type ReactionSummary struct {
+	emoji   string // 絵文字
+	count   int    // リアクションしたユーザー数
+	reacted bool   // 閲覧しているユーザー自身がリアクションしているか
+}
+
+type ReactionSummaryParams struct {
+	Emoji   string
+	Count   int
+	Reacted bool
+}
+
+func NewReactionSummary(params ReactionSummaryParams) *ReactionSummary {
+	return &ReactionSummary{
+		emoji:   params.Emoji,
+		count:   params.Count,
+		reacted: params.Reacted,
+	}
+}
+
+func (s *ReactionSummary) GetEmoji() string {
+	return s.emoji
+}
+
+func (s *ReactionSummary) GetCount() int {
+	return s.count
+}
+
+func (s *ReactionSummary) HasReacted() bool {
+	return s.reacted
+}
@@ -1,26 +1,79 @@
 // チャットルームのエンティティ
 package entity
 
+import (
+	"errors"
+	"time"
+)
+
+// ErrRoomArchived はアーカイブされた部屋にメッセージを送信しようとした場合に返されます。
+var ErrRoomArchived = errors.New("room is archived")
+
 type Room struct {
-	id      RoomID
-	name    string
-	members []UserID
+	id          RoomID
+	name        string
+	kind        RoomKind
+	visibility  RoomVisibility
+	description string
+	pinLimit    int
+	slowMode    time.Duration
+	archivedAt  *time.Time
+	createdAt   time.Time
+	members     []UserID
 }
 
 type RoomParams struct {
-	ID      RoomID
-	Name    string
-	Members []UserID
+	ID          RoomID
+	Name        string
+	Kind        RoomKind       // 省略時は通常の部屋
+	Visibility  RoomVisibility // 省略時は公開
+	Description string         // 部屋の説明（トピック）
+	PinLimit    int            // ピン留めできるメッセージ数の上限。省略時は DefaultRoomPinLimit
+	SlowMode    time.Duration  // 同じユーザーがメッセージを送信できる間隔。0 の場合はスローモードなし
+	ArchivedAt  *time.Time     // アーカイブされていない場合は nil
+	CreatedAt   time.Time
+	Members     []UserID
 }
 
 func NewRoom(params RoomParams) *Room {
+	kind := params.Kind
+	if kind == "" {
+		kind = RoomKindGroup
+	}
+	visibility := params.Visibility
+	if visibility == "" {
+		visibility = RoomVisibilityPublic
+	}
+	pinLimit := params.PinLimit
+	if pinLimit == 0 {
+		pinLimit = DefaultRoomPinLimit
+	}
 	return &Room{
-		id:      params.ID,
-		name:    params.Name,
-		members: params.Members,
+		id:          params.ID,
+		name:        params.Name,
+		kind:        kind,
+		visibility:  visibility,
+		description: params.Description,
+		pinLimit:    pinLimit,
+		slowMode:    params.SlowMode,
+		archivedAt:  params.ArchivedAt,
+		createdAt:   params.CreatedAt,
+		members:     params.Members,
 	}
 }
 
+// NewDirectRoom は2人のユーザーのダイレクトメッセージ用の部屋を生成します。
+// ダイレクトメッセージは常に非公開で、名前を持ちません。
+func NewDirectRoom(id RoomID, userA, userB UserID, createdAt time.Time) *Room {
+	return NewRoom(RoomParams{
+		ID:         id,
+		Kind:       RoomKindDirect,
+		Visibility: RoomVisibilityPrivate,
+		CreatedAt:  createdAt,
+		Members:    []UserID{userA, userB},
+	})
+}
+
 func (r *Room) GetID() RoomID {
 	// 部屋のIDを取得
 	return r.id
@@ -31,7 +84,197 @@ func (r *Room) GetName() string {
 	return r.name
 }
 
+func (r *Room) GetKind() RoomKind {
+	// 部屋の種類を取得
+	return r.kind
+}
+
+func (r *Room) IsDirect() bool {
+	// ダイレクトメッセージの部屋か
+	return r.kind == RoomKindDirect
+}
+
+func (r *Room) GetVisibility() RoomVisibility {
+	// 部屋の公開設定を取得
+	return r.visibility
+}
+
+func (r *Room) IsPrivate() bool {
+	// 招待されたユーザーのみが参加できる部屋か
+	return r.visibility == RoomVisibilityPrivate
+}
+
+func (r *Room) GetDescription() string {
+	// 部屋の説明を取得
+	return r.description
+}
+
+func (r *Room) GetPinLimit() int {
+	// ピン留めできるメッセージ数の上限を取得
+	return r.pinLimit
+}
+
+func (r *Room) GetSlowMode() time.Duration {
+	// スローモードの間隔を取得（0 の場合は無効）
+	return r.slowMode
+}
+
+func (r *Room) GetArchivedAt() *time.Time {
+	// アーカイブされた日時を取得（アーカイブされていない場合は nil）
+	return r.archivedAt
+}
+
+func (r *Room) IsArchived() bool {
+	// アーカイブされた部屋か（履歴は閲覧できるが、メッセージは送信できない）
+	return r.archivedAt != nil
+}
+
+func (r *Room) GetCreatedAt() time.Time {
+	// 部屋の作成日時を取得
+	return r.createdAt
+}
+
 func (r *Room) GetMembers() []UserID {
 	// 部屋のメンバーを取得
 	return r.members
 }
+
+// RoomKind は部屋の種類
+type RoomKind string
+
+const (
+	RoomKindGroup  RoomKind = "group"  // 名前を付けて作成する通常の部屋
+	RoomKindDirect RoomKind = "direct" // 2人のユーザーのダイレクトメッセージ
+)
+
+// RoomVisibility は部屋の公開設定
+type RoomVisibility string
+
+const (
+	RoomVisibilityPublic  RoomVisibility = "public"  // 誰でも一覧から見つけて参加できる
+	RoomVisibilityPrivate RoomVisibility = "private" // メンバー以外には見えず、招待リンクまたは参加リクエストの承認によってのみ参加できる
+)
+
+// IsValid は定義済みの公開設定かを返します。
+func (v RoomVisibility) IsValid() bool {
+	return v == RoomVisibilityPublic || v == RoomVisibilityPrivate
+}
+
+// RoomRole は部屋のメンバーの役割
+type RoomRole string
+
+const (
+	RoomRoleOwner  RoomRole = "owner"  // 部屋の作成者。部屋の削除を含むすべての操作ができる
+	RoomRoleAdmin  RoomRole = "admin"  // 部屋の設定を変更できる
+	RoomRoleMember RoomRole = "member" // 一般のメンバー
+)
+
+// ErrInsufficientRoomRole は部屋での役割が操作に必要な権限に満たない場合に返されます。
+var ErrInsufficientRoomRole = errors.New("insufficient room role")
+
+// roomRoleRanks は役割ごとの権限の強さ
+var roomRoleRanks = map[RoomRole]int{
+	RoomRoleMember: 1,
+	RoomRoleAdmin:  2,
+	RoomRoleOwner:  3,
+}
+
+// IsValid は定義済みの役割かを返します。
+func (r RoomRole) IsValid() bool {
+	_, ok := roomRoleRanks[r]
+	return ok
+}
+
+// AtLeast は r が required 以上の権限を持つかを返します。
+func (r RoomRole) AtLeast(required RoomRole) bool {
+	return r.IsValid() && roomRoleRanks[r] >= roomRoleRanks[required]
+}
+
+// Outranks は役割が other より上位かを返します。
+// 管理者が同じ役割以上のメンバーをモデレーションできないようにするために使用します。
+func (r RoomRole) Outranks(other RoomRole) bool {
+	return r.IsValid() && roomRoleRanks[r] > roomRoleRanks[other]
+}
+
+// RoomSummary は部屋の一覧に表示する部屋の要約
+type RoomSummary struct {
+	room        *Room
+	memberCount int
+	lastMessage *Message // 部屋への最新の投稿（スレッドの返信・削除済みは含まない）。ない場合は nil
+	// 一覧を取得したユーザーの既読位置より後の、他のユーザーの投稿の件数（メンバーでない部屋は 0）
+	unreadCount  int
+	mentionCount int // unreadCount のうち、一覧を取得したユーザーがメンションされた件数
+}
+
+type RoomSummaryParams struct {
+	Room         *Room
+	MemberCount  int
+	LastMessage  *Message
+	UnreadCount  int
+	MentionCount int
+}
+
+func NewRoomSummary(params RoomSummaryParams) *RoomSummary {
+	return &RoomSummary{
+		room:         params.Room,
+		memberCount:  params.MemberCount,
+		lastMessage:  params.LastMessage,
+		unreadCount:  params.UnreadCount,
+		mentionCount: params.MentionCount,
+	}
+}
+
+func (s *RoomSummary) GetRoom() *Room {
+	return s.room
+}
+
+func (s *RoomSummary) GetMemberCount() int {
+	return s.memberCount
+}
+
+func (s *RoomSummary) GetLastMessage() *Message {
+	return s.lastMessage
+}
+
+func (s *RoomSummary) GetUnreadCount() int {
+	return s.unreadCount
+}
+
+func (s *RoomSummary) GetMentionCount() int {
+	return s.mentionCount
+}
+
+// GetLastActivityAt は最後に動きがあった日時を返します。
+// メッセージがない部屋は作成日時です。
+func (s *RoomSummary) GetLastActivityAt() time.Time {
+	if s.lastMessage != nil {
+		return s.lastMessage.GetSentAt()
+	}
+	return s.room.GetCreatedAt()
+}
+
+// RoomMember は部屋のメンバーと部屋での役割
+type RoomMember struct {
+	user *User
+	role RoomRole
+}
+
+type RoomMemberParams struct {
+	User *User
+	Role RoomRole
+}
+
+func NewRoomMember(params RoomMemberParams) *RoomMember {
+	return &RoomMember{
+		user: params.User,
+		role: params.Role,
+	}
+}
+
+func (m *RoomMember) GetUser() *User {
+	return m.user
+}
+
+func (m *RoomMember) GetRole() RoomRole {
+	return m.role
+}
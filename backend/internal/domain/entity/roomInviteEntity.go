@@ -0,0 +1,78 @@
+// 部屋への招待リンクのエンティティ
+package entity
+
+import "time"
+
+// InviteToken は招待リンクに含めるトークン
+// 推測されないよう、ランダムな値を使用する（生成は factory.InviteTokenFactory）
+type InviteToken string
+
+type RoomInvite struct {
+	token     InviteToken
+	roomID    RoomID
+	createdBy UserID
+	maxUses   int // 利用できる回数の上限
+	uses      int // 利用された回数
+	expiresAt time.Time
+	createdAt time.Time
+}
+
+type RoomInviteParams struct {
+	Token     InviteToken
+	RoomID    RoomID
+	CreatedBy UserID
+	MaxUses   int
+	Uses      int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func NewRoomInvite(params RoomInviteParams) *RoomInvite {
+	return &RoomInvite{
+		token:     params.Token,
+		roomID:    params.RoomID,
+		createdBy: params.CreatedBy,
+		maxUses:   params.MaxUses,
+		uses:      params.Uses,
+		expiresAt: params.ExpiresAt,
+		createdAt: params.CreatedAt,
+	}
+}
+
+func (i *RoomInvite) GetToken() InviteToken {
+	return i.token
+}
+
+func (i *RoomInvite) GetRoomID() RoomID {
+	return i.roomID
+}
+
+func (i *RoomInvite) GetCreatedBy() UserID {
+	return i.createdBy
+}
+
+func (i *RoomInvite) GetMaxUses() int {
+	return i.maxUses
+}
+
+func (i *RoomInvite) GetUses() int {
+	return i.uses
+}
+
+func (i *RoomInvite) GetExpiresAt() time.Time {
+	return i.expiresAt
+}
+
+func (i *RoomInvite) GetCreatedAt() time.Time {
+	return i.createdAt
+}
+
+// IsExpired は now の時点で有効期限が切れているかを返します。
+func (i *RoomInvite) IsExpired(now time.Time) bool {
+	return !now.Before(i.expiresAt)
+}
+
+// IsExhausted は利用回数の上限に達しているかを返します。
+func (i *RoomInvite) IsExhausted() bool {
+	return i.uses >= i.maxUses
+}
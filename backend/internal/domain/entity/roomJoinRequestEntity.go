@@ -0,0 +1,82 @@
+// 非公開の部屋への参加リクエストのエンティティ
+package entity
+
+import "time"
+
+// RoomJoinRequestStatus は参加リクエストの状態
+type RoomJoinRequestStatus string
+
+const (
+	RoomJoinRequestPending  RoomJoinRequestStatus = "pending"  // 管理者の判断待ち
+	RoomJoinRequestApproved RoomJoinRequestStatus = "approved" // 承認され、メンバーとして追加された
+	RoomJoinRequestDenied   RoomJoinRequestStatus = "denied"   // 却下された（再度リクエストできる）
+)
+
+type RoomJoinRequest struct {
+	roomID    RoomID
+	userID    UserID // リクエストしたユーザー
+	message   string // 管理者へのメッセージ（任意）
+	status    RoomJoinRequestStatus
+	createdAt time.Time
+	decidedBy *UserID    // 承認・却下した管理者（判断待ちの間は nil）
+	decidedAt *time.Time // 承認・却下した日時（判断待ちの間は nil）
+}
+
+type RoomJoinRequestParams struct {
+	RoomID    RoomID
+	UserID    UserID
+	Message   string
+	Status    RoomJoinRequestStatus
+	CreatedAt time.Time
+	DecidedBy *UserID
+	DecidedAt *time.Time
+}
+
+func NewRoomJoinRequest(params RoomJoinRequestParams) *RoomJoinRequest {
+	status := params.Status
+	if status == "" {
+		status = RoomJoinRequestPending
+	}
+	return &RoomJoinRequest{
+		roomID:    params.RoomID,
+		userID:    params.UserID,
+		message:   params.Message,
+		status:    status,
+		createdAt: params.CreatedAt,
+		decidedBy: params.DecidedBy,
+		decidedAt: params.DecidedAt,
+	}
+}
+
+func (r *RoomJoinRequest) GetRoomID() RoomID {
+	return r.roomID
+}
+
+func (r *RoomJoinRequest) GetUserID() UserID {
+	return r.userID
+}
+
+func (r *RoomJoinRequest) GetMessage() string {
+	return r.message
+}
+
+func (r *RoomJoinRequest) GetStatus() RoomJoinRequestStatus {
+	return r.status
+}
+
+func (r *RoomJoinRequest) GetCreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *RoomJoinRequest) GetDecidedBy() *UserID {
+	return r.decidedBy
+}
+
+func (r *RoomJoinRequest) GetDecidedAt() *time.Time {
+	return r.decidedAt
+}
+
+// IsPending は管理者の判断待ちかを返す
+func (r *RoomJoinRequest) IsPending() bool {
+	return r.status == RoomJoinRequestPending
+}
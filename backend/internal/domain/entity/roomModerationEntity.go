@@ -0,0 +1,92 @@
+// 部屋のモデレーション（キック・追放・発言禁止）のエンティティ
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrRoomBanned は部屋から追放されたユーザーが参加・接続しようとした場合に返されます。
+	ErrRoomBanned = errors.New("user is banned from the room")
+
+	// ErrUserMuted は発言を禁止されているユーザーがメッセージを送信しようとした場合に返されます。
+	ErrUserMuted = errors.New("user is muted in the room")
+)
+
+// RoomModerationActionType はモデレーションの種類
+type RoomModerationActionType string
+
+const (
+	RoomModerationKick   RoomModerationActionType = "kick"   // 部屋から退出させ、接続を切断する（再参加はできる）
+	RoomModerationBan    RoomModerationActionType = "ban"    // 部屋から退出させ、以降の参加・接続を拒否する
+	RoomModerationUnban  RoomModerationActionType = "unban"  // 追放を解除する
+	RoomModerationMute   RoomModerationActionType = "mute"   // 期限まで発言を禁止する（接続は維持する）
+	RoomModerationUnmute RoomModerationActionType = "unmute" // 発言の禁止を解除する
+)
+
+// RoomModerationAction は部屋で行われたモデレーションの記録
+type RoomModerationAction struct {
+	roomID    RoomID
+	actorID   UserID // 操作した管理者
+	targetID  UserID // 対象のユーザー
+	action    RoomModerationActionType
+	reason    string
+	expiresAt *time.Time // 発言禁止の期限（mute 以外は nil）
+	createdAt time.Time
+}
+
+type RoomModerationActionParams struct {
+	RoomID    RoomID
+	ActorID   UserID
+	TargetID  UserID
+	Action    RoomModerationActionType
+	Reason    string
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
+
+func NewRoomModerationAction(params RoomModerationActionParams) *RoomModerationAction {
+	return &RoomModerationAction{
+		roomID:    params.RoomID,
+		actorID:   params.ActorID,
+		targetID:  params.TargetID,
+		action:    params.Action,
+		reason:    params.Reason,
+		expiresAt: params.ExpiresAt,
+		createdAt: params.CreatedAt,
+	}
+}
+
+func (a *RoomModerationAction) GetRoomID() RoomID {
+	return a.roomID
+}
+
+func (a *RoomModerationAction) GetActorID() UserID {
+	return a.actorID
+}
+
+func (a *RoomModerationAction) GetTargetID() UserID {
+	return a.targetID
+}
+
+func (a *RoomModerationAction) GetAction() RoomModerationActionType {
+	return a.action
+}
+
+func (a *RoomModerationAction) GetReason() string {
+	return a.reason
+}
+
+func (a *RoomModerationAction) GetExpiresAt() *time.Time {
+	return a.expiresAt
+}
+
+func (a *RoomModerationAction) GetCreatedAt() time.Time {
+	return a.createdAt
+}
+
+// RemovesMember は対象のユーザーを部屋から退出させる操作かを返します。
+func (a *RoomModerationAction) RemovesMember() bool {
+	return a.action == RoomModerationKick || a.action == RoomModerationBan
+}
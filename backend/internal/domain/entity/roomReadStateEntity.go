@@ -0,0 +1,50 @@
+// ユーザーが部屋のどのメッセージまで読んだかを表すエンティティ
+package entity
+
+import "time"
+
+type RoomReadState struct {
+	roomID            RoomID
+	userID            UserID
+	lastReadMessageID MessageID // 最後に読んだメッセージ
+	lastReadAt        time.Time // 最後に読んだメッセージの送信日時（未読件数はこれより後のメッセージを数える）
+	updatedAt         time.Time // 既読にした日時
+}
+
+type RoomReadStateParams struct {
+	RoomID            RoomID
+	UserID            UserID
+	LastReadMessageID MessageID
+	LastReadAt        time.Time
+	UpdatedAt         time.Time
+}
+
+func NewRoomReadState(params RoomReadStateParams) *RoomReadState {
+	return &RoomReadState{
+		roomID:            params.RoomID,
+		userID:            params.UserID,
+		lastReadMessageID: params.LastReadMessageID,
+		lastReadAt:        params.LastReadAt,
+		updatedAt:         params.UpdatedAt,
+	}
+}
+
+func (s *RoomReadState) GetRoomID() RoomID {
+	return s.roomID
+}
+
+func (s *RoomReadState) GetUserID() UserID {
+	return s.userID
+}
+
+func (s *RoomReadState) GetLastReadMessageID() MessageID {
+	return s.lastReadMessageID
+}
+
+func (s *RoomReadState) GetLastReadAt() time.Time {
+	return s.lastReadAt
+}
+
+func (s *RoomReadState) GetUpdatedAt() time.Time {
+	return s.updatedAt
+}
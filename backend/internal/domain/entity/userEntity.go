@@ -12,6 +12,7 @@ type User struct {
 	passwdhash string
 	createdAt  time.Time
 	updatedAt  *time.Time
+	lastSeenAt *time.Time // 最後にオンラインだった日時（一度も接続していない場合は nil）
 }
 
 type UserParams struct {
@@ -21,6 +22,7 @@ type UserParams struct {
 	PasswdHash string
 	CreatedAt  time.Time
 	UpdatedAt  *time.Time
+	LastSeenAt *time.Time
 }
 
 func NewUser(p UserParams) *User {
@@ -31,6 +33,7 @@ func NewUser(p UserParams) *User {
 		passwdhash: p.PasswdHash,
 		createdAt:  p.CreatedAt,
 		updatedAt:  p.UpdatedAt,
+		lastSeenAt: p.LastSeenAt,
 	}
 }
 
@@ -53,3 +56,7 @@ func (u User) GetPasswdHash() string {
 func (u User) GetCreatedAt() time.Time {
 	return u.createdAt
 }
+
+func (u User) GetLastSeenAt() *time.Time {
+	return u.lastSeenAt
+}
@@ -0,0 +1,64 @@
+// ユーザーのオンライン状態を表すエンティティ
+package entity
+
+import "time"
+
+// PresenceStatus はユーザーのオンライン状態
+type PresenceStatus string
+
+const (
+	PresenceOnline  PresenceStatus = "online"  // 接続しており、操作している
+	PresenceAway    PresenceStatus = "away"    // 接続しているが、しばらく操作していない
+	PresenceOffline PresenceStatus = "offline" // 接続していない
+)
+
+// UserActivity はオンライン状態の判定に使う、ユーザーのコネクション数と最後に操作した日時
+// WebsocketManager が登録されたコネクションから求める
+type UserActivity struct {
+	Connections  int       // 接続中のコネクション数
+	LastActiveAt time.Time // 最後に操作した日時（コネクションがなくなった後も保持する）
+}
+
+// PresenceStatus は now の時点のオンライン状態を返す
+// 接続中で awayTimeout を過ぎても操作していない場合は離席中とする（接続した直後でまだ操作を記録していない場合は除く）
+func (a UserActivity) PresenceStatus(now time.Time, awayTimeout time.Duration) PresenceStatus {
+	if a.Connections == 0 {
+		return PresenceOffline
+	}
+	if !a.LastActiveAt.IsZero() && now.Sub(a.LastActiveAt) >= awayTimeout {
+		return PresenceAway
+	}
+	return PresenceOnline
+}
+
+type UserPresence struct {
+	userID     UserID
+	status     PresenceStatus
+	lastSeenAt *time.Time // 最後にオンラインだった日時（一度も接続していない場合は nil）
+}
+
+type UserPresenceParams struct {
+	UserID     UserID
+	Status     PresenceStatus
+	LastSeenAt *time.Time
+}
+
+func NewUserPresence(params UserPresenceParams) *UserPresence {
+	return &UserPresence{
+		userID:     params.UserID,
+		status:     params.Status,
+		lastSeenAt: params.LastSeenAt,
+	}
+}
+
+func (p *UserPresence) GetUserID() UserID {
+	return p.userID
+}
+
+func (p *UserPresence) GetStatus() PresenceStatus {
+	return p.status
+}
+
+func (p *UserPresence) GetLastSeenAt() *time.Time {
+	return p.lastSeenAt
+}
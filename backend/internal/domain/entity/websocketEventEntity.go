@@ -0,0 +1,391 @@
+// WebsocketEvent は WebSocket 上でやり取りされるイベントのエンベロープです。
+// type でイベントの種類を判別し、payload に種類ごとの内容を保持します。
+// 未知の type は受信側で無視できるため、既存のクライアントを壊さずにイベントを追加できます。
+// JSON表現は/infrastructure/serviceImpl/websocketConnectionImplで定義されます。
+package entity
+
+import "time"
+
+// WebsocketProtocolVersion はイベントプロトコルのバージョン
+// エンベロープの形を互換性なく変更する場合にインクリメントする
+const WebsocketProtocolVersion = 1
+
+type WebsocketEventType string
+
+const (
+	// クライアント → サーバー
+	WebsocketEventTypeMessageSend WebsocketEventType = "message.send" // メッセージ送信要求
+	WebsocketEventTypeMessageRead WebsocketEventType = "message.read" // 指定したメッセージまで既読にする要求
+	WebsocketEventTypeTypingStart WebsocketEventType = "typing.start" // 入力を開始した（入力中は定期的に送り直す。ack は返さない）
+	WebsocketEventTypeTypingStop  WebsocketEventType = "typing.stop"  // 入力を終了した（ack は返さない）
+	// 操作していることを通知する（ack は返さない）
+	// 他のイベントも操作として扱うため、それ以外のイベントを送っていない間に定期的に送る
+	WebsocketEventTypePresenceHeartbeat WebsocketEventType = "presence.heartbeat"
+
+	// サーバー → クライアント
+	WebsocketEventTypeMessageCreated     WebsocketEventType = "message.created"      // 部屋に新しいメッセージが投稿された
+	WebsocketEventTypeMessageUpdated     WebsocketEventType = "message.updated"      // メッセージが編集された
+	WebsocketEventTypeMessageDeleted     WebsocketEventType = "message.deleted"      // メッセージが削除された
+	WebsocketEventTypeReactionAdded      WebsocketEventType = "reaction.added"       // メッセージにリアクションが付いた
+	WebsocketEventTypeReactionRemoved    WebsocketEventType = "reaction.removed"     // メッセージのリアクションが取り消された
+	WebsocketEventTypeMessagePinned      WebsocketEventType = "message.pinned"       // メッセージがピン留めされた
+	WebsocketEventTypeMessageUnpinned    WebsocketEventType = "message.unpinned"     // メッセージのピン留めが外された
+	WebsocketEventTypeRoomClosed         WebsocketEventType = "room.closed"          // 部屋の削除などにより、サーバーがこの後コネクションを閉じる
+	WebsocketEventTypeRoomModeration     WebsocketEventType = "room.moderation"      // 部屋でキック・追放・発言禁止などが行われた
+	WebsocketEventTypeRoomRemoved        WebsocketEventType = "room.removed"         // キック・追放により、サーバーがこの後このユーザーのコネクションを閉じる
+	WebsocketEventTypeJoinRequestDecided WebsocketEventType = "join_request.decided" // 参加リクエストが承認・却下された（リクエストしたユーザーのコネクションに送る）
+	WebsocketEventTypeReadUpdated        WebsocketEventType = "read.updated"         // メンバーの既読位置が進んだ（既読の配信が有効な場合のみ）
+	WebsocketEventTypeTypingUpdated      WebsocketEventType = "typing.updated"       // メンバーが入力を開始・終了した
+	WebsocketEventTypePresenceUpdated    WebsocketEventType = "presence.updated"     // メンバーのオンライン状態が変わった
+	WebsocketEventTypeMentionCreated     WebsocketEventType = "mention.created"      // メッセージでメンションされた（接続している部屋を問わず、メンションされたユーザーのコネクションに送る）
+	WebsocketEventTypeAck                WebsocketEventType = "ack"                  // クライアントのイベントを受理した
+	WebsocketEventTypeError              WebsocketEventType = "error"                // クライアントのイベントを処理できなかった
+
+	// ノード間（バックプレーン）のみ。クライアントには送らない
+	WebsocketEventTypeUserActivity  WebsocketEventType = "user.activity"  // ノードでのユーザーのコネクション数・最後に操作した日時が変わった
+	WebsocketEventTypeNodeHeartbeat WebsocketEventType = "node.heartbeat" // ノードが稼働している（一定間隔で送る）
+)
+
+// WebsocketErrorCode は error イベントで返すエラーの種類
+type WebsocketErrorCode string
+
+const (
+	WebsocketErrorCodeInvalidEvent     WebsocketErrorCode = "invalid_event"     // イベントの形式が不正
+	WebsocketErrorCodeUnsupportedEvent WebsocketErrorCode = "unsupported_event" // 未対応の type またはバージョン
+	WebsocketErrorCodeForbidden        WebsocketErrorCode = "forbidden"         // 部屋のメンバーでないなど、操作する権限がない
+	WebsocketErrorCodeRoomArchived     WebsocketErrorCode = "room_archived"     // アーカイブされた部屋には送信できない
+	WebsocketErrorCodeMuted            WebsocketErrorCode = "muted"             // 発言を禁止されている（期限まで送信できない）
+	WebsocketErrorCodeRateLimited      WebsocketErrorCode = "rate_limited"      // スローモード・連投の制限により送信できない（RetryAfter 以降に再送できる）
+	WebsocketErrorCodeInternal         WebsocketErrorCode = "internal_error"    // サーバー内部のエラー
+)
+
+type WebsocketEvent struct {
+	eventType WebsocketEventType
+	id        string // クライアントが付与する相関ID（ack / error では応答先のID）
+	payload   any    // type ごとのペイロード
+}
+
+type WebsocketEventParams struct {
+	Type    WebsocketEventType
+	ID      string
+	Payload any
+}
+
+func NewWebsocketEvent(params WebsocketEventParams) *WebsocketEvent {
+	return &WebsocketEvent{
+		eventType: params.Type,
+		id:        params.ID,
+		payload:   params.Payload,
+	}
+}
+
+func (e *WebsocketEvent) GetType() WebsocketEventType {
+	return e.eventType
+}
+
+func (e *WebsocketEvent) GetID() string {
+	return e.id
+}
+
+func (e *WebsocketEvent) GetPayload() any {
+	return e.payload
+}
+
+// MessageSendPayload は message.send のペイロード
+type MessageSendPayload struct {
+	Content  string
+	ParentID MessageID // スレッドに返信する場合の返信先（省略時は部屋への投稿）
+	// AttachmentIDs はアップロード済みの添付ファイル（省略時は添付しない）
+	AttachmentIDs []AttachmentID
+}
+
+// MessageReadPayload は message.read のペイロード
+type MessageReadPayload struct {
+	MessageID MessageID // 最後に読んだメッセージ
+}
+
+// AckPayload は ack のペイロード
+// 応答先のイベントでメッセージが作成された場合は MessageID が入る
+type AckPayload struct {
+	MessageID MessageID
+}
+
+// ErrorPayload は error のペイロード
+type ErrorPayload struct {
+	Code    WebsocketErrorCode
+	Message string
+	// RetryAfter は再送できるようになる日時（再送しても成功しないエラーの場合は nil）
+	RetryAfter *time.Time
+}
+
+// NewMessageCreatedEvent はメッセージ投稿を通知するイベントを生成します。
+// ペイロードは *Message です。
+func NewMessageCreatedEvent(msg *Message) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeMessageCreated,
+		Payload: msg,
+	})
+}
+
+// NewMessageUpdatedEvent はメッセージの編集を通知するイベントを生成します。
+// ペイロードは編集後の *Message です。
+func NewMessageUpdatedEvent(msg *Message) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeMessageUpdated,
+		Payload: msg,
+	})
+}
+
+// NewMessageDeletedEvent はメッセージの削除を通知するイベントを生成します。
+// ペイロードは削除済み（本文が空）の *Message です。
+func NewMessageDeletedEvent(msg *Message) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeMessageDeleted,
+		Payload: msg,
+	})
+}
+
+// ReactionPayload は reaction.added / reaction.removed のペイロード
+// Count は変更後のその絵文字のリアクション数
+type ReactionPayload struct {
+	MessageID MessageID
+	UserID    UserID
+	Emoji     string
+	Count     int
+}
+
+// NewReactionAddedEvent はリアクションの追加を通知するイベントを生成します。
+func NewReactionAddedEvent(payload ReactionPayload) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeReactionAdded,
+		Payload: payload,
+	})
+}
+
+// NewReactionRemovedEvent はリアクションの取り消しを通知するイベントを生成します。
+func NewReactionRemovedEvent(payload ReactionPayload) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeReactionRemoved,
+		Payload: payload,
+	})
+}
+
+// MessagePinnedPayload は message.pinned のペイロード
+type MessagePinnedPayload struct {
+	Message  *Message // ピン留めされたメッセージ
+	PinnedBy UserID
+	PinnedAt time.Time
+}
+
+// NewMessagePinnedEvent はメッセージのピン留めを通知するイベントを生成します。
+func NewMessagePinnedEvent(pinned *PinnedMessage) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type: WebsocketEventTypeMessagePinned,
+		Payload: MessagePinnedPayload{
+			Message:  pinned.GetMessage(),
+			PinnedBy: pinned.GetPin().GetPinnedBy(),
+			PinnedAt: pinned.GetPin().GetPinnedAt(),
+		},
+	})
+}
+
+// MessageUnpinnedPayload は message.unpinned のペイロード
+type MessageUnpinnedPayload struct {
+	MessageID  MessageID
+	UnpinnedBy UserID
+}
+
+// NewMessageUnpinnedEvent はメッセージのピン留めが外されたことを通知するイベントを生成します。
+func NewMessageUnpinnedEvent(payload MessageUnpinnedPayload) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeMessageUnpinned,
+		Payload: payload,
+	})
+}
+
+// RoomClosedPayload は room.closed のペイロード
+type RoomClosedPayload struct {
+	Reason string // 切断理由（例: "room deleted"）
+}
+
+// NewRoomClosedEvent は部屋のコネクションをすべて閉じることを通知するイベントを生成します。
+func NewRoomClosedEvent(reason string) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeRoomClosed,
+		Payload: RoomClosedPayload{Reason: reason},
+	})
+}
+
+// RoomModerationPayload は room.moderation のペイロード
+type RoomModerationPayload struct {
+	Action       RoomModerationActionType
+	TargetUserID UserID
+	ActorUserID  UserID
+	Reason       string
+	ExpiresAt    *time.Time // 発言禁止の期限（mute 以外は nil）
+}
+
+// NewRoomModerationEvent は部屋で行われたモデレーションを通知するイベントを生成します。
+func NewRoomModerationEvent(action *RoomModerationAction) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type: WebsocketEventTypeRoomModeration,
+		Payload: RoomModerationPayload{
+			Action:       action.GetAction(),
+			TargetUserID: action.GetTargetID(),
+			ActorUserID:  action.GetActorID(),
+			Reason:       action.GetReason(),
+			ExpiresAt:    action.GetExpiresAt(),
+		},
+	})
+}
+
+// RoomRemovedPayload は room.removed のペイロード
+type RoomRemovedPayload struct {
+	UserID UserID // 部屋から退出させられたユーザー
+	Reason string // 切断理由（例: "kicked"）
+}
+
+// NewRoomRemovedEvent はユーザーのコネクションを閉じることを通知するイベントを生成します。
+func NewRoomRemovedEvent(userID UserID, reason string) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeRoomRemoved,
+		Payload: RoomRemovedPayload{UserID: userID, Reason: reason},
+	})
+}
+
+// JoinRequestDecidedPayload は join_request.decided のペイロード
+type JoinRequestDecidedPayload struct {
+	RoomID    RoomID                // 参加をリクエストした部屋
+	Status    RoomJoinRequestStatus // approved / denied
+	DecidedBy UserID                // 承認・却下した管理者
+}
+
+// NewJoinRequestDecidedEvent は参加リクエストの承認・却下を通知するイベントを生成します。
+func NewJoinRequestDecidedEvent(roomID RoomID, status RoomJoinRequestStatus, decidedBy UserID) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeJoinRequestDecided,
+		Payload: JoinRequestDecidedPayload{RoomID: roomID, Status: status, DecidedBy: decidedBy},
+	})
+}
+
+// ReadUpdatedPayload は read.updated のペイロード
+type ReadUpdatedPayload struct {
+	UserID    UserID    // 既読にしたメンバー
+	MessageID MessageID // 最後に読んだメッセージ
+	ReadAt    time.Time // 既読にした日時
+}
+
+// NewReadUpdatedEvent はメンバーの既読位置が進んだことを通知するイベントを生成します。
+func NewReadUpdatedEvent(state *RoomReadState) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type: WebsocketEventTypeReadUpdated,
+		Payload: ReadUpdatedPayload{
+			UserID:    state.GetUserID(),
+			MessageID: state.GetLastReadMessageID(),
+			ReadAt:    state.GetUpdatedAt(),
+		},
+	})
+}
+
+// TypingPayload は typing.updated のペイロード
+// 入力したユーザー自身のコネクションには配信しない（WebsocketManager が除外する）
+type TypingPayload struct {
+	UserID UserID // 入力を開始・終了したメンバー
+	Typing bool   // 入力中であれば true
+}
+
+// NewTypingUpdatedEvent はメンバーが入力を開始・終了したことを通知するイベントを生成します。
+func NewTypingUpdatedEvent(userID UserID, typing bool) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeTypingUpdated,
+		Payload: TypingPayload{UserID: userID, Typing: typing},
+	})
+}
+
+// PresenceUpdatedPayload は presence.updated のペイロード
+type PresenceUpdatedPayload struct {
+	UserID     UserID
+	Status     PresenceStatus
+	LastSeenAt *time.Time // 最後にオンラインだった日時
+}
+
+// NewPresenceUpdatedEvent はメンバーのオンライン状態が変わったことを通知するイベントを生成します。
+func NewPresenceUpdatedEvent(presence *UserPresence) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type: WebsocketEventTypePresenceUpdated,
+		Payload: PresenceUpdatedPayload{
+			UserID:     presence.GetUserID(),
+			Status:     presence.GetStatus(),
+			LastSeenAt: presence.GetLastSeenAt(),
+		},
+	})
+}
+
+// UserActivityPayload は user.activity のペイロード
+type UserActivityPayload struct {
+	NodeID   string // 送信したノード
+	UserID   UserID
+	Activity UserActivity // NodeID のノードでのユーザーのコネクション数と最後に操作した日時
+}
+
+// NewUserActivityEvent はノードでのユーザーのコネクション数・最後に操作した日時を他のノードに共有するイベントを生成します。
+func NewUserActivityEvent(nodeID string, userID UserID, activity UserActivity) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeUserActivity,
+		Payload: UserActivityPayload{NodeID: nodeID, UserID: userID, Activity: activity},
+	})
+}
+
+// NodeHeartbeatPayload は node.heartbeat のペイロード
+type NodeHeartbeatPayload struct {
+	NodeID string // 送信したノード
+}
+
+// NewNodeHeartbeatEvent はノードが稼働していることを他のノードに知らせるイベントを生成します。
+func NewNodeHeartbeatEvent(nodeID string) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeNodeHeartbeat,
+		Payload: NodeHeartbeatPayload{NodeID: nodeID},
+	})
+}
+
+// MentionCreatedPayload は mention.created のペイロード
+type MentionCreatedPayload struct {
+	Message *Message    // メンションを含むメッセージ
+	Kind    MentionKind // user / here / room
+}
+
+// NewMentionCreatedEvent はメッセージでメンションされたことを通知するイベントを生成します。
+func NewMentionCreatedEvent(mention *Mention, msg *Message) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeMentionCreated,
+		Payload: MentionCreatedPayload{Message: msg, Kind: mention.GetKind()},
+	})
+}
+
+// NewAckEvent は replyTo のイベントを受理したことを通知するイベントを生成します。
+func NewAckEvent(replyTo string, payload AckPayload) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeAck,
+		ID:      replyTo,
+		Payload: payload,
+	})
+}
+
+// NewErrorEvent は replyTo のイベントを処理できなかったことを通知するイベントを生成します。
+func NewErrorEvent(replyTo string, code WebsocketErrorCode, message string) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeError,
+		ID:      replyTo,
+		Payload: ErrorPayload{Code: code, Message: message},
+	})
+}
+
+// NewRetryableErrorEvent は retryAfter 以降に再送できるエラーを通知するイベントを生成します。
+func NewRetryableErrorEvent(replyTo string, code WebsocketErrorCode, message string, retryAfter time.Time) *WebsocketEvent {
+	return NewWebsocketEvent(WebsocketEventParams{
+		Type:    WebsocketEventTypeError,
+		ID:      replyTo,
+		Payload: ErrorPayload{Code: code, Message: message, RetryAfter: &retryAfter},
+	})
+}
@@ -0,0 +1,40 @@
+// メッセージの添付ファイルの情報の永続化のメソッドを先に定義
+// ファイル自体は service.AttachmentStoreService で保存する
+// infrastructure/repositoryImplで具体実装
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+var (
+	// ErrAttachmentNotFound は指定された添付ファイルが存在しない場合に返されます。
+	ErrAttachmentNotFound = errors.New("attachment not found")
+
+	// ErrAttachmentAlreadyAttached は添付ファイルがすでに別のメッセージに添付されている場合に返されます。
+	ErrAttachmentAlreadyAttached = errors.New("attachment is already attached to a message")
+)
+
+// 添付ファイルはメッセージと同じトランザクションで添付済みにするため、MessageRepository.CreateMessageWithMentions で添付します。
+type AttachmentRepository interface {
+	// CreateAttachment はアップロードされた添付ファイルの情報を保存します。
+	CreateAttachment(ctx context.Context, attachment *entity.Attachment) error
+
+	// GetAttachmentByID は添付ファイルの情報を取得します。
+	// 存在しない場合は ErrAttachmentNotFound を返します。
+	GetAttachmentByID(ctx context.Context, id entity.AttachmentID) (*entity.Attachment, error)
+
+	// GetAttachmentsByIDs は指定した添付ファイルの情報を取得します。
+	// 存在しないIDは結果に含まれません。
+	GetAttachmentsByIDs(ctx context.Context, ids []entity.AttachmentID) ([]*entity.Attachment, error)
+
+	// GetAttachmentIDsByRoomID は部屋にアップロードされたすべての添付ファイル（メッセージに添付していないものを含む）のIDを返します。
+	GetAttachmentIDsByRoomID(ctx context.Context, roomID entity.RoomID) ([]entity.AttachmentID, error)
+
+	// GetAttachmentsByMessageIDs はメッセージごとの添付ファイルを、アップロードした日時の順に返します。
+	// 添付ファイルがないメッセージはキーに含まれません。
+	GetAttachmentsByMessageIDs(ctx context.Context, messageIDs []entity.MessageID) (map[entity.MessageID][]*entity.Attachment, error)
+}
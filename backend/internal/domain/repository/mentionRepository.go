@@ -0,0 +1,28 @@
+// メッセージのメンションの永続化のメソッドを先に定義
+// infrastructure/repositoryImplで具体実装
+package repository
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// MentionListQuery はメンションされたメッセージの一覧の取得条件です。
+// メッセージの送信日時の新しい順に並びます。
+type MentionListQuery struct {
+	UserID       entity.UserID // メンションされたユーザー
+	UnreadOnly   bool          // true の場合は既読位置より後のメッセージのみ含める
+	BeforeSentAt time.Time     // この日時より前に送信されたメッセージを取得する
+	Limit        int           // 取得件数
+}
+
+// メンションはメッセージと同じトランザクションで保存するため、MessageRepository.CreateMessageWithMentions で保存します。
+type MentionRepository interface {
+	// ListMentions はユーザーへのメンションを含むメッセージを、既読かどうかと合わせて返します。
+	// 既読かどうかはユーザーの部屋の既読位置（room_read_states）から判定します。
+	// 削除済みのメッセージと、ユーザーがすでに参加していない部屋のメッセージは含まれません。
+	// hasNext は続きのメンションがあるかを返します。
+	ListMentions(ctx context.Context, query MentionListQuery) (mentions []*entity.MentionedMessage, hasNext bool, err error)
+}
@@ -4,18 +4,62 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 )
 
+// ErrMessageNotFound は指定されたメッセージが存在しない場合に返されます。
+var ErrMessageNotFound = errors.New("message not found")
+
 type MessageRepository interface {
 	// CreateMessage は指定された Message を永続化します。
 	// コンテキストがキャンセルされた場合や保存に失敗した場合はエラーを返します。
 	CreateMessage(ctx context.Context, msg *entity.Message) error
 
+	// CreateMessageWithMentions は Message と、その本文のメンションを1つのトランザクションで永続化します。
+	// Message の添付ファイルも同じトランザクションで添付済みにします。
+	// いずれかが添付済み（または存在しない）場合は ErrAttachmentAlreadyAttached を返します。
+	// いずれかの保存に失敗した場合はメッセージも保存されません。
+	// 同じユーザーへのメンションが重複している場合は1件だけ保存します。
+	CreateMessageWithMentions(ctx context.Context, msg *entity.Message, mentions []*entity.Mention) error
+
 	// GetMessageHistoryInRoom は指定された部屋IDのメッセージ履歴を、指定された時刻より前のものから取得します。
 	// 結果にはメッセージ配列、次ページ取得用の時刻、次ページが存在するかのフラグ、エラーを含みます。
+	// 削除済みのメッセージも、本文を空にした状態で含まれます。スレッドの返信は含まれません。
 	GetMessageHistoryInRoom(ctx context.Context, roomID entity.RoomID, limit int, beforeSentAt time.Time) (messages []*entity.Message, nextBeforeSentAt time.Time, hasNext bool, err error)
-}
 
+	// GetMessageByID は指定されたIDのメッセージを取得します（削除済みのものを含む）。
+	// 存在しない場合は ErrMessageNotFound を返します。
+	GetMessageByID(ctx context.Context, id entity.MessageID) (*entity.Message, error)
+
+	// UpdateMessage は編集後のメッセージを保存し、編集履歴を追加します。
+	// 両方の書き込みは1つのトランザクションで行われます。
+	UpdateMessage(ctx context.Context, msg *entity.Message, revision *entity.MessageRevision) error
+
+	// DeleteMessage は指定されたメッセージを削除済みにします（論理削除）。
+	// 存在しない場合は ErrMessageNotFound を返します。
+	DeleteMessage(ctx context.Context, id entity.MessageID, deletedAt time.Time) error
+
+	// GetMessageRevisions は指定されたメッセージの編集履歴を古い順に取得します。
+	GetMessageRevisions(ctx context.Context, id entity.MessageID) ([]*entity.MessageRevision, error)
+
+	// GetThread は指定された親メッセージへの返信を、指定された時刻より後のものから古い順に取得します。
+	// 結果には返信の配列、次ページ取得用の時刻、次ページが存在するかのフラグ、エラーを含みます。
+	GetThread(ctx context.Context, parentID entity.MessageID, limit int, afterSentAt time.Time) (replies []*entity.Message, nextAfterSentAt time.Time, hasNext bool, err error)
+
+	// GetThreadSummaries は指定された親メッセージごとの返信の要約を取得します。
+	// 返信がないメッセージは結果に含まれません。
+	GetThreadSummaries(ctx context.Context, parentIDs []entity.MessageID) (map[entity.MessageID]*entity.ThreadSummary, error)
+
+	// SearchMessages は userID のユーザーが参加している部屋のメッセージから、本文が query を含むものを新しい順に検索します。
+	// query は空白区切りの語句で、すべての語句を含むメッセージが対象です。roomID を指定した場合はその部屋のみを検索します。
+	// 指定された時刻より前のものから取得し、結果にはメッセージ配列、次ページ取得用の時刻、次ページが存在するかのフラグ、エラーを含みます。
+	// 削除済みのメッセージは含まれません。スレッドの返信は含まれます。
+	SearchMessages(ctx context.Context, userID entity.UserID, query string, roomID entity.RoomID, limit int, beforeSentAt time.Time) (messages []*entity.Message, nextBeforeSentAt time.Time, hasNext bool, err error)
+
+	// GetUserSentTimesSince は userID のユーザーが roomID の部屋に since 以降に送信したメッセージの送信日時を、新しい順に最大 limit 件取得します。
+	// 連投の制限に使用するため、スレッドの返信と削除済みのメッセージも含まれます。
+	GetUserSentTimesSince(ctx context.Context, roomID entity.RoomID, userID entity.UserID, since time.Time, limit int) ([]time.Time, error)
+}
@@ -0,0 +1,35 @@
+// 部屋のメッセージのピン留めの永続化のメソッドを先に定義
+// infrastructure/repositoryImplで具体実装
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+var (
+	// ErrAlreadyPinned はすでにピン留めされているメッセージをピン留めしようとした場合に返されます。
+	ErrAlreadyPinned = errors.New("message already pinned")
+
+	// ErrPinNotFound はピン留めされていないメッセージのピン留めを外そうとした場合に返されます。
+	ErrPinNotFound = errors.New("pin not found")
+)
+
+type PinRepository interface {
+	// SavePin はメッセージをピン留めします。
+	// すでにピン留めされている場合は ErrAlreadyPinned を返します。
+	SavePin(ctx context.Context, pin *entity.Pin) error
+
+	// DeletePin はメッセージのピン留めを外します。
+	// ピン留めされていない場合は ErrPinNotFound を返します。
+	DeletePin(ctx context.Context, roomID entity.RoomID, messageID entity.MessageID) error
+
+	// CountPins は部屋にピン留めされているメッセージ数を返します。削除済みのメッセージは数えません。
+	CountPins(ctx context.Context, roomID entity.RoomID) (int, error)
+
+	// ListPinnedMessages は部屋にピン留めされているメッセージを、ピン留めした日時の新しい順に返します。
+	// 削除済みのメッセージは含まれません。
+	ListPinnedMessages(ctx context.Context, roomID entity.RoomID) ([]*entity.PinnedMessage, error)
+}
@@ -0,0 +1,27 @@
+// メッセージへのリアクションの永続化のメソッドを先に定義
+// infrastructure/repositoryImplで具体実装
+package repository
+
+import (
+	"context"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+type ReactionRepository interface {
+	// AddReaction はリアクションを追加します。
+	// 同じユーザーが同じメッセージに同じ絵文字で既にリアクションしている場合は何もしません。
+	AddReaction(ctx context.Context, reaction *entity.Reaction) error
+
+	// RemoveReaction はリアクションを取り消します。
+	// リアクションしていない場合は何もしません。
+	RemoveReaction(ctx context.Context, messageID entity.MessageID, userID entity.UserID, emoji string) error
+
+	// CountReactions は指定されたメッセージに指定された絵文字でリアクションしたユーザー数を返します。
+	CountReactions(ctx context.Context, messageID entity.MessageID, emoji string) (int, error)
+
+	// GetReactionSummaries は指定されたメッセージごとに、絵文字ごとのリアクションの集計を返します。
+	// viewerID のユーザーがリアクションしているかも集計に含まれます。
+	// リアクションがないメッセージは結果に含まれません。絵文字は最初にリアクションされた順に並びます。
+	GetReactionSummaries(ctx context.Context, messageIDs []entity.MessageID, viewerID entity.UserID) (map[entity.MessageID][]*entity.ReactionSummary, error)
+}
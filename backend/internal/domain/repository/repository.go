@@ -3,8 +3,16 @@ package repository
 // Repository : repositoryのインターフェースをまとめた構造体
 // DI層での依存性注入のために使用される
 type Repository struct {
-	UserRepository     UserRepository
-	RoomRepository     RoomRepository
-	MessageRepository  MessageRepository
-	WsClientRepository WebsocketClientRepository
-}
\ No newline at end of file
+	UserRepository            UserRepository
+	RoomRepository            RoomRepository
+	RoomInviteRepository      RoomInviteRepository
+	RoomModerationRepository  RoomModerationRepository
+	RoomJoinRequestRepository RoomJoinRequestRepository
+	MessageRepository         MessageRepository
+	ReactionRepository        ReactionRepository
+	PinRepository             PinRepository
+	RoomReadStateRepository   RoomReadStateRepository
+	MentionRepository         MentionRepository
+	AttachmentRepository      AttachmentRepository
+	WsClientRepository        WebsocketClientRepository
+}
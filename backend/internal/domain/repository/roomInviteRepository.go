@@ -0,0 +1,35 @@
+// 部屋への招待リンクの永続化のメソッドを先に定義
+// infrastructure/repositoryImplで具体実装
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+var (
+	// ErrInviteNotFound は指定されたトークンの招待が存在しない場合に返されます。
+	ErrInviteNotFound = errors.New("invite not found")
+
+	// ErrInviteUnavailable は招待が期限切れ・利用回数の上限に達しているため利用できない場合に返されます。
+	ErrInviteUnavailable = errors.New("invite is no longer available")
+)
+
+type RoomInviteRepository interface {
+	// SaveInvite は招待を保存します。
+	SaveInvite(ctx context.Context, invite *entity.RoomInvite) error
+
+	// GetInviteByToken はトークンから招待を取得します。
+	// 存在しない場合は ErrInviteNotFound を返します。
+	GetInviteByToken(ctx context.Context, token entity.InviteToken) (*entity.RoomInvite, error)
+
+	// RedeemInvite は招待の利用回数を1つ増やし、userID を一般メンバーとして招待先の部屋に参加させます。
+	// 利用回数の加算と参加は1つのトランザクションで行い、参加できなかった場合は利用回数も増やしません。
+	// 同時に利用された場合でも上限を超えないよう、now の時点で有効な場合のみ増やし、
+	// 増やせなかった場合は ErrInviteUnavailable を返します。
+	// すでにメンバーの場合は利用回数を増やさずに成功とします。
+	RedeemInvite(ctx context.Context, token entity.InviteToken, userID entity.UserID, now time.Time) error
+}
@@ -0,0 +1,39 @@
+// 非公開の部屋への参加リクエストの永続化のメソッドを先に定義
+// infrastructure/repositoryImplで具体実装
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+var (
+	// ErrJoinRequestAlreadyExists は判断待ちの参加リクエストがある部屋に再度リクエストしようとした場合に返されます。
+	ErrJoinRequestAlreadyExists = errors.New("join request already exists")
+
+	// ErrJoinRequestNotFound は判断待ちの参加リクエストが見つからない場合に返されます。
+	ErrJoinRequestNotFound = errors.New("join request not found")
+)
+
+type RoomJoinRequestRepository interface {
+	// SaveJoinRequest は参加リクエストを保存します。
+	// 承認・却下済みのリクエストがある場合は新しいリクエストで上書きし、
+	// 判断待ちのリクエストがある場合は ErrJoinRequestAlreadyExists を返します。
+	SaveJoinRequest(ctx context.Context, req *entity.RoomJoinRequest) error
+
+	// ListPendingJoinRequests は部屋の判断待ちの参加リクエストを、リクエストした日時の古い順に返します。
+	ListPendingJoinRequests(ctx context.Context, roomID entity.RoomID) ([]*entity.RoomJoinRequest, error)
+
+	// DecideJoinRequest は判断待ちの参加リクエストを承認・却下します。
+	// メンバーへの追加は行わないため、承認した場合は RoomRepository.AddMemberToRoom で追加します。
+	// 判断待ちのリクエストがない場合は ErrJoinRequestNotFound を返します。
+	DecideJoinRequest(ctx context.Context, roomID entity.RoomID, userID entity.UserID, status entity.RoomJoinRequestStatus, decidedBy entity.UserID, decidedAt time.Time) error
+
+	// ReopenJoinRequest は承認した参加リクエストを判断待ちに戻します。
+	// 承認した後にメンバーへの追加に失敗した場合に、再度承認できるようにするために使います。
+	// 承認済みのリクエストがない場合は何もしません。
+	ReopenJoinRequest(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error
+}
@@ -0,0 +1,47 @@
+// 部屋のモデレーション（追放・発言禁止とその記録）の永続化のメソッドを先に定義
+// infrastructure/repositoryImplで具体実装
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+var (
+	// ErrBanNotFound は追放されていないユーザーの追放を解除しようとした場合に返されます。
+	ErrBanNotFound = errors.New("ban not found")
+
+	// ErrMuteNotFound は発言を禁止されていないユーザーの禁止を解除しようとした場合に返されます。
+	ErrMuteNotFound = errors.New("mute not found")
+)
+
+type RoomModerationRepository interface {
+	// SaveAction はモデレーションの記録を保存します。
+	SaveAction(ctx context.Context, action *entity.RoomModerationAction) error
+
+	// SaveBan はユーザーを部屋から追放し、メンバーであれば部屋から退出させます。
+	// 追放と退出は1つのトランザクションで行います。すでに追放されている場合も退出は行います。
+	SaveBan(ctx context.Context, roomID entity.RoomID, userID entity.UserID, bannedAt time.Time) error
+
+	// DeleteBan は追放を解除します。
+	// 追放されていない場合は ErrBanNotFound を返します。
+	DeleteBan(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error
+
+	// IsBanned はユーザーが部屋から追放されているかを返します。
+	IsBanned(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (bool, error)
+
+	// SaveMute は until までユーザーの発言を禁止します。
+	// すでに禁止されている場合は期限を上書きします。
+	SaveMute(ctx context.Context, roomID entity.RoomID, userID entity.UserID, until time.Time) error
+
+	// DeleteMute は発言の禁止を解除します。
+	// 禁止されていない場合は ErrMuteNotFound を返します。
+	DeleteMute(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error
+
+	// GetMutedUntil は発言禁止の期限を返します。
+	// 禁止されていない場合は nil を返します（期限切れの禁止は期限をそのまま返すため、呼び出し側で現在時刻と比較します）。
+	GetMutedUntil(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (*time.Time, error)
+}
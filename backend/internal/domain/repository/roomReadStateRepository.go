@@ -0,0 +1,18 @@
+// 部屋の既読位置の永続化のメソッドを先に定義
+// infrastructure/repositoryImplで具体実装
+package repository
+
+import (
+	"context"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+type RoomReadStateRepository interface {
+	// SaveReadState はユーザーの部屋での既読位置を保存し、既読位置が進んだかを返します。
+	// 保存済みの既読位置より前のメッセージ（送信日時、同じ場合はメッセージIDの順）を指定した場合は更新しません。
+	SaveReadState(ctx context.Context, state *entity.RoomReadState) (bool, error)
+
+	// ListReadStates は部屋のメンバーの既読位置を、既読にした日時の新しい順に返します。
+	ListReadStates(ctx context.Context, roomID entity.RoomID) ([]*entity.RoomReadState, error)
+}
@@ -4,37 +4,155 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 )
 
+var (
+	// ErrRoomNotFound は指定された部屋が存在しない場合に返されます。
+	ErrRoomNotFound = errors.New("room not found")
+
+	// ErrNotRoomMember は指定されたユーザーが部屋のメンバーでない場合に返されます。
+	ErrNotRoomMember = errors.New("user is not a member of the room")
+)
+
+// RoomSort は部屋の一覧の並び順です。
+type RoomSort string
+
+const (
+	RoomSortName         RoomSort = "name"          // 名前の昇順
+	RoomSortCreatedAt    RoomSort = "created_at"    // 作成日時の新しい順
+	RoomSortMemberCount  RoomSort = "member_count"  // メンバー数の多い順
+	RoomSortLastActivity RoomSort = "last_activity" // 最新のメッセージ（ない場合は作成日時）の新しい順
+)
+
+// IsValid は定義済みの並び順かを返します。
+func (s RoomSort) IsValid() bool {
+	switch s {
+	case RoomSortName, RoomSortCreatedAt, RoomSortMemberCount, RoomSortLastActivity:
+		return true
+	}
+	return false
+}
+
+// RoomListCursor は部屋の一覧の続きを取得するための位置です。
+// 前のページの最後の部屋の値を保持し、Sort に対応する値と RoomID を比較に使用します。
+type RoomListCursor struct {
+	RoomID         entity.RoomID
+	Name           string
+	CreatedAt      time.Time
+	MemberCount    int
+	LastActivityAt time.Time
+}
+
+// RoomListQuery は部屋の一覧の取得条件です。
+type RoomListQuery struct {
+	ViewerID entity.UserID   // 非公開の部屋はこのユーザーが参加しているもののみ含める
+	NameLike string          // 空でない場合は名前の部分一致で絞り込む
+	Sort     RoomSort        // 並び順。同じ値の部屋は RoomID で並べる
+	Limit    int             // 取得件数
+	After    *RoomListCursor // nil の場合は先頭から取得する
+}
+
+// RoomMemberCursor は部屋のメンバーの一覧の続きを取得するための位置です。
+// 前のページの最後のメンバーの名前と UserID を保持します。
+type RoomMemberCursor struct {
+	Name   string
+	UserID entity.UserID
+}
+
+// RoomMemberListQuery は部屋のメンバーの一覧の取得条件です。
+// メンバーは名前の昇順（同じ名前は UserID 順）に並びます。
+type RoomMemberListQuery struct {
+	RoomID   entity.RoomID
+	NameLike string            // 空でない場合は名前の部分一致で絞り込む
+	Limit    int               // 取得件数
+	After    *RoomMemberCursor // nil の場合は先頭から取得する
+}
+
 // RoomRepository defines the interface for managing chat rooms and their members.
 type RoomRepository interface {
 	// SaveRoom persists a new room and returns its ID.
 	SaveRoom(ctx context.Context, room *entity.Room) (entity.RoomID, error)
 
+	// SaveRoomWithOwner persists a new room together with ownerID's membership as RoomRoleOwner
+	// as a single atomic operation, and returns the room ID.
+	SaveRoomWithOwner(ctx context.Context, room *entity.Room, ownerID entity.UserID) (entity.RoomID, error)
+
 	// GetRoomByID retrieves a room by its unique ID.
 	GetRoomByID(ctx context.Context, id entity.RoomID) (*entity.Room, error)
 
-	// GetAllRooms returns a list of all rooms.
-	GetAllRooms(ctx context.Context) ([]*entity.Room, error)
+	// GetAllRooms returns all rooms visible to viewerID:
+	// public rooms and private rooms the viewer is a member of.
+	// Direct message rooms are never included.
+	GetAllRooms(ctx context.Context, viewerID entity.UserID) ([]*entity.Room, error)
+
+	// ListRoomSummaries returns a page of rooms visible to query.ViewerID (same rule as GetAllRooms)
+	// with their member count, latest message and the viewer's unread and mention counts, ordered by query.Sort.
+	// hasNext reports whether more rooms follow the returned page.
+	ListRoomSummaries(ctx context.Context, query RoomListQuery) (summaries []*entity.RoomSummary, hasNext bool, err error)
+
+	// ListRoomMembers returns a page of members of the room with their roles, ordered by user name.
+	// hasNext reports whether more members follow the returned page.
+	ListRoomMembers(ctx context.Context, query RoomMemberListQuery) (members []*entity.RoomMember, hasNext bool, err error)
 
 	// GetUsersInRoom retrieves all users who are members of the specified room.
 	GetUsersInRoom(ctx context.Context, roomID entity.RoomID) ([]*entity.User, error)
 
-	// AddMemberToRoom adds a user to the specified room.
-	AddMemberToRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error
+	// GetMemberIDs returns the IDs of all members of the specified room.
+	GetMemberIDs(ctx context.Context, roomID entity.RoomID) ([]entity.UserID, error)
+
+	// GetMemberIDsByNames returns the IDs of members of the specified room whose user name exactly matches one of names.
+	// User names are not unique, so several members may match the same name; names matching no member are ignored.
+	GetMemberIDsByNames(ctx context.Context, roomID entity.RoomID, names []string) ([]entity.UserID, error)
+
+	// AddMemberToRoom adds a user to the specified room with the given role.
+	// If the user is already a member, it does nothing and keeps the existing role.
+	AddMemberToRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID, role entity.RoomRole) error
+
+	// GetMemberRole returns the role of the user in the specified room.
+	// It returns ErrRoomNotFound if the room does not exist, and ErrNotRoomMember if the user is not a member.
+	GetMemberRole(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (entity.RoomRole, error)
 
 	// RemoveMemberFromRoom removes a user from the specified room.
 	RemoveMemberFromRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error
 
-	// GetRoomByNameLike performs a partial match search for room names.
-	GetRoomByNameLike(ctx context.Context, name string) ([]*entity.Room, error)
+	// SaveDirectRoom persists a direct message room between userA and userB together with both memberships.
+	// If a direct message room for the pair already exists, nothing is created and the existing room ID is returned.
+	SaveDirectRoom(ctx context.Context, room *entity.Room, userA, userB entity.UserID) (entity.RoomID, error)
+
+	// GetDirectRoom returns the direct message room between userA and userB.
+	// It returns ErrRoomNotFound if the pair has no direct message room.
+	GetDirectRoom(ctx context.Context, userA, userB entity.UserID) (*entity.Room, error)
+
+	// GetDirectRooms returns the direct message rooms userID is a member of, with both participants as members.
+	GetDirectRooms(ctx context.Context, userID entity.UserID) ([]*entity.Room, error)
+
+	// GetRoomIDsByMember returns the IDs of all rooms userID is a member of, including direct message rooms.
+	GetRoomIDsByMember(ctx context.Context, userID entity.UserID) ([]entity.RoomID, error)
 
 	// UpdateRoomName updates the name of the specified room.
 	UpdateRoomName(ctx context.Context, roomID entity.RoomID, name string) error
 
-	// DeleteRoom deletes the specified room and its associations.
+	// UpdateRoomDescription updates the description (topic) of the specified room.
+	UpdateRoomDescription(ctx context.Context, roomID entity.RoomID, description string) error
+
+	// UpdateRoomPinLimit updates the maximum number of messages that can be pinned in the specified room.
+	UpdateRoomPinLimit(ctx context.Context, roomID entity.RoomID, limit int) error
+
+	// UpdateRoomSlowMode updates the minimum interval between messages from the same user in the specified room.
+	// The interval is stored in whole seconds; zero disables slow mode.
+	UpdateRoomSlowMode(ctx context.Context, roomID entity.RoomID, interval time.Duration) error
+
+	// SetRoomArchived archives the specified room at archivedAt, or unarchives it when archivedAt is nil.
+	SetRoomArchived(ctx context.Context, roomID entity.RoomID, archivedAt *time.Time) error
+
+	// DeleteRoom deletes the specified room together with its members, messages (including revisions, reactions, pins, mentions and attachment records),
+	// invites, join requests, read states and moderation records (bans, mutes and the action log) as a single atomic operation. Returns ErrRoomNotFound if the room does not exist.
+	// Attachment files in the AttachmentStoreService are not removed; callers should list them with
+	// AttachmentRepository.GetAttachmentIDsByRoomID beforehand and delete them after the room is deleted.
 	DeleteRoom(ctx context.Context, roomID entity.RoomID) error
 }
 
@@ -4,17 +4,31 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 )
 
+// ErrUserNotFound は指定されたユーザーが存在しない場合に返されます。
+var ErrUserNotFound = errors.New("user not found")
+
 type UserRepository interface {
 	// SaveUserはユーザー情報を保存し、保存後のユーザーを返します。
 	SaveUser(ctx context.Context, user *entity.User) (*entity.User, error)
 
 	// GetUserByIDは指定したユーザーIDに対応するユーザー情報を取得します。
+	// 存在しない場合は ErrUserNotFound を返します。
 	GetUserByID(ctx context.Context, id entity.UserID) (*entity.User, error)
 
 	// GetUserByEmailは指定したメールアドレスに対応するユーザー情報を取得します。
 	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
+
+	// GetUsersByIDsは指定したユーザーIDに対応するユーザー情報をまとめて取得します。
+	// 存在しないユーザーは結果に含めません（順序は保証しません）。
+	GetUsersByIDs(ctx context.Context, ids []entity.UserID) ([]*entity.User, error)
+
+	// UpdateLastSeenは最後にオンラインだった日時を更新します。
+	// 存在しない場合は ErrUserNotFound を返します。
+	UpdateLastSeen(ctx context.Context, id entity.UserID, lastSeenAt time.Time) error
 }
@@ -22,7 +22,8 @@ type WebsocketClientRepository interface {
 	// GetClientsByRoomIDは指定された部屋IDに接続しているすべてのWebSocketクライアントを取得します。
 	GetClientsByRoomID(ctx context.Context, roomID entity.RoomID) ([]*entity.WebsocketClient, error)
 
-	// GetClientsByUserIDは指定されたユーザーIDに紐づくWebSocketクライアントを取得します。
-	GetClientsByUserID(ctx context.Context, userID entity.UserID) (*entity.WebsocketClient, error)
+	// GetClientsByUserIDは指定されたユーザーIDに紐づくすべてのWebSocketクライアントを取得します。
+	// 1人のユーザーは複数のタブ・端末・部屋から同時に接続できます。
+	GetClientsByUserID(ctx context.Context, userID entity.UserID) ([]*entity.WebsocketClient, error)
 }
 
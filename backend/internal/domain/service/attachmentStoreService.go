@@ -0,0 +1,88 @@
+// メッセージの添付ファイルを保存するロジックのインターフェース
+// 具体実装は/infrastructure/serviceImpl/attachmentStoreServiceImpl
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+var (
+	// ErrAttachmentTooLarge は添付ファイルのサイズが上限を超える場合に返されます。
+	ErrAttachmentTooLarge = errors.New("attachment is too large")
+
+	// ErrInvalidAttachmentType は添付ファイルの内容が許可されていない種類の場合に返されます。
+	ErrInvalidAttachmentType = errors.New("attachment type is not allowed")
+
+	// ErrAttachmentFileNotFound は保存先に添付ファイル（またはサムネイル）が存在しない場合に返されます。
+	ErrAttachmentFileNotFound = errors.New("attachment file not found")
+)
+
+// allowedAttachmentTypes は添付できるファイルの MIME タイプ（内容から判定したもの）
+// HTML など、ブラウザで開いたときにスクリプトを実行できる種類は含めない
+// 判定できない形式（Office 文書など）は application/octet-stream として扱う
+var allowedAttachmentTypes = map[string]struct{}{
+	"image/jpeg":               {},
+	"image/png":                {},
+	"image/gif":                {},
+	"image/webp":               {},
+	"application/pdf":          {},
+	"text/plain":               {},
+	"application/zip":          {},
+	"application/x-gzip":       {},
+	"audio/mpeg":               {},
+	"audio/wave":               {},
+	"video/mp4":                {},
+	"video/webm":               {},
+	"application/octet-stream": {},
+}
+
+// AttachmentData は添付ファイルのやり取りのための構造体（メソッドの入力）
+type AttachmentData struct {
+	Data     []byte
+	FileName string
+	MimeType string // DetectAttachmentType で内容から判定したもの
+}
+
+// StoredAttachment は保存した添付ファイルについて、保存時に分かった情報です。
+type StoredAttachment struct {
+	Width        int  // 画像の幅（画像として読み込めなかった場合は 0）
+	Height       int  // 画像の高さ（画像として読み込めなかった場合は 0）
+	HasThumbnail bool // サムネイルを作成したか
+}
+
+type AttachmentStoreService interface {
+	// SaveAttachment は添付ファイルを保存する
+	// 画像の場合はサムネイルも作成して保存する。画像として読み込めない場合はサムネイルを作成せずに保存する
+	SaveAttachment(ctx context.Context, id entity.AttachmentID, data *AttachmentData) (*StoredAttachment, error)
+
+	// OpenAttachment は保存した添付ファイル（thumbnail が true の場合はサムネイル）を読み出す
+	// 存在しない場合は ErrAttachmentFileNotFound を返す。読み終えたら Close すること
+	OpenAttachment(ctx context.Context, id entity.AttachmentID, thumbnail bool) (io.ReadCloser, error)
+
+	// DeleteAttachment は添付ファイルとサムネイルを削除する（存在しない場合も成功とする）
+	DeleteAttachment(ctx context.Context, id entity.AttachmentID) error
+}
+
+// DetectAttachmentType は添付ファイルの内容から MIME タイプを判定する
+// クライアントが申告した Content-Type は信用しない
+func DetectAttachmentType(data []byte) string {
+	mimeType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	return mimeType
+}
+
+// ValidateAttachmentData は添付ファイルのサイズと MIME タイプを検証する
+func ValidateAttachmentData(data *AttachmentData, maxSize int64) error {
+	if int64(len(data.Data)) > maxSize {
+		return ErrAttachmentTooLarge
+	}
+	if _, ok := allowedAttachmentTypes[data.MimeType]; !ok {
+		return ErrInvalidAttachmentType
+	}
+	return nil
+}
@@ -4,10 +4,19 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"example.com/infrahandson/internal/domain/entity"
 )
 
+var (
+	// ErrIconTooLarge はアイコンのサイズが MAX_ICON_SIZE を超える場合に返されます。
+	ErrIconTooLarge = errors.New("file size is too large")
+
+	// ErrInvalidIconType はアイコンが JPEG / PNG / WebP 以外の場合に返されます。
+	ErrInvalidIconType = errors.New("invalid mime type")
+)
+
 // MAX_ICON_SIZE はアイコンサイズでバリデーションするための定数
 const MAX_ICON_SIZE = 5 * 1024 * 1024 // 5MB
 
@@ -33,6 +42,23 @@ type IconStoreService interface {
 	// GetIconPath はUserIDからアイコンにアクセスするためのパス（URL）を返す
 	// 環境変数＋返り値pathにリダイレクトすることで画像を返す機構を想定
 	GetIconPath(ctx context.Context, userID entity.UserID) (path string, err error)
+
+	// SaveRoomIcon は部屋IDとアイコンデータ構造体を受け取り、ユーザーのアイコンと同じ形式で保存する
+	SaveRoomIcon(ctx context.Context, iconData *IconData, roomID entity.RoomID) error
+
+	// GetRoomIconPath は部屋IDからアイコンにアクセスするためのパス（URL）を返す
+	GetRoomIconPath(ctx context.Context, roomID entity.RoomID) (path string, err error)
+}
+
+// ValidateIconData はアイコンのサイズと MIME タイプを検証する
+func ValidateIconData(iconData *IconData) error {
+	if iconData.Size > GetMaxIconSize() {
+		return ErrIconTooLarge
+	}
+	if iconData.MimeType != "image/jpeg" && iconData.MimeType != "image/png" && iconData.MimeType != "image/webp" {
+		return ErrInvalidIconType
+	}
+	return nil
 }
 
 // GetMaxIconSize は定数を直接参照させないための関数
@@ -20,6 +20,10 @@ type MessageCacheService interface {
 
 	// AddMessage はメッセージをキャッシュに追加（RECENT_MESSAGE_LIMIT件を超えた場合は古いものから削除）
 	AddMessage(ctx context.Context, roomID entity.RoomID, message *entity.Message) error
+
+	// InvalidateRoom は指定したルームのキャッシュを破棄（メッセージの編集・削除時に使用）
+	// 次の GetRecentMessages でリポジトリから取得し直す
+	InvalidateRoom(ctx context.Context, roomID entity.RoomID) error
 }
 
 // DefaultRecentMessageLimit はデフォルトの最近のメッセージLimit数を取得
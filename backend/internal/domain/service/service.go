@@ -5,6 +5,9 @@ type Service struct {
 	// IconStoreService はアイコンのストレージサービスです。
 	IconStoreService IconStoreService
 
+	// AttachmentStoreService はメッセージの添付ファイルのストレージサービスです。
+	AttachmentStoreService AttachmentStoreService
+
 	// MessageCacheService はメッセージのキャッシュサービスです。
 	MessageCacheService MessageCacheService
 
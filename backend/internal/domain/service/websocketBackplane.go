@@ -0,0 +1,23 @@
+// 複数ノード間で部屋へのブロードキャストを中継するバックプレーンのインターフェース
+package service
+
+import (
+	"context"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// WebsocketBackplaneHandler はバックプレーンから配信されたイベントを受け取る関数
+type WebsocketBackplaneHandler func(roomID entity.RoomID, event *entity.WebsocketEvent)
+
+// WebsocketBackplane はノード間の pub/sub を抽象化する
+// 各ノードは自身が保持するコネクションにだけイベントを書き込み、
+// 他ノードへの配信はバックプレーンに任せる
+type WebsocketBackplane interface {
+	// Publish は部屋へのイベントを全ノード（自ノードを含む）に配信する
+	// 自ノードのハンドラは Publish の中で同期的に呼び出される
+	Publish(ctx context.Context, roomID entity.RoomID, event *entity.WebsocketEvent) error
+	// Subscribe は配信されたイベントを受け取るハンドラを登録する
+	Subscribe(handler WebsocketBackplaneHandler)
+	Close() error
+}
@@ -3,24 +3,92 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 )
 
+var (
+	// ErrInvalidEvent は受信したイベントの形式が不正な場合に ReadEvent が返すエラー
+	// コネクション自体は引き続き利用できる
+	ErrInvalidEvent = errors.New("invalid websocket event")
+
+	// ErrUnsupportedEvent は受信したイベントの type またはバージョンが未対応の場合に ReadEvent が返すエラー
+	// コネクション自体は引き続き利用できる
+	ErrUnsupportedEvent = errors.New("unsupported websocket event")
+
+	// ErrConnectionNotFound は指定したコネクションが登録されていない場合に WebsocketManager が返すエラー
+	// 送信に失敗したコネクションは自動的に登録解除されるため、切断処理ではこのエラーを許容する
+	ErrConnectionNotFound = errors.New("websocket connection not found")
+
+	// ErrManagerShutdown は Shutdown 後に Register が呼ばれた場合に返すエラー
+	ErrManagerShutdown = errors.New("websocket manager is shut down")
+)
+
+// WebsocketCloseCode はコネクションを閉じる際にクライアントへ通知するコード（RFC 6455）
+type WebsocketCloseCode int
+
+const (
+	WebsocketCloseNormal          WebsocketCloseCode = 1000 // 通常の切断
+	WebsocketCloseGoingAway       WebsocketCloseCode = 1001 // サーバーの停止
+	WebsocketClosePolicyViolation WebsocketCloseCode = 1008 // 利用規約違反などによる切断
+)
+
 // コネクションの抽象化
 type WebSocketConnection interface {
-	ReadMessage() (*entity.Message, error)
-	WriteMessage(*entity.Message) error
+	// ReadEvent は次のイベントを読み取る
+	// 形式不正・未対応のイベントの場合は ErrInvalidEvent / ErrUnsupportedEvent をラップしたエラーを返す
+	ReadEvent() (*entity.WebsocketEvent, error)
+	// WriteEvent はイベントを書き込む（複数のゴルーチンから呼び出してよい）
+	WriteEvent(*entity.WebsocketEvent) error
+	// CloseWithReason はクライアントに切断理由を通知してからコネクションを閉じる
+	CloseWithReason(code WebsocketCloseCode, reason string) error
 	Close() error
 }
 
+// コネクションはクライアントID（entity.WsClientID）ごとに管理する
+// 1人のユーザーが複数のタブ・端末・部屋から同時に接続できる
 type WebsocketManager interface {
 	// コネクションの登録・削除
-	Register(ctx context.Context, conn WebSocketConnection, userID entity.UserID, roomID entity.RoomID) error
-	Unregister(ctx context.Context, conn WebSocketConnection) error
+	Register(ctx context.Context, client *entity.WebsocketClient, conn WebSocketConnection) error
+	Unregister(ctx context.Context, clientID entity.WsClientID) error
 	// コネクションの取得
-	GetConnectionByUserID(ctx context.Context, userID entity.UserID) (WebSocketConnection, error)
+	GetConnectionByClientID(ctx context.Context, clientID entity.WsClientID) (WebSocketConnection, error)
+	// GetConnectionsByUserID はユーザーのすべてのコネクションを返す（ない場合は空）
+	GetConnectionsByUserID(ctx context.Context, userID entity.UserID) ([]WebSocketConnection, error)
+
+	// 指定した部屋にいるユーザーにイベントをブロードキャスト
+	// 各コネクションへの書き込みは非同期に行われ、個々のコネクションの失敗はエラーとして返さない
+	// 部屋にコネクションがない場合は何もしない
+	BroadcastToRoom(ctx context.Context, roomID entity.RoomID, event *entity.WebsocketEvent) error
+
+	// BroadcastToRoomExcept は BroadcastToRoom と同様に配信するが、exclude のユーザーのコネクション（他のタブ・端末を含む）には配信しない
+	// typing.updated など、操作したユーザー自身には送らないイベントに使用する
+	BroadcastToRoomExcept(ctx context.Context, roomID entity.RoomID, exclude entity.UserID, event *entity.WebsocketEvent) error
+
+	// SendToUser はユーザーのすべてのコネクション（接続している部屋を問わない）にイベントを送信する
+	// 書き込みは BroadcastToRoom と同様に非同期に行われ、コネクションがない場合は何もしない
+	SendToUser(ctx context.Context, userID entity.UserID, event *entity.WebsocketEvent) error
+
+	// CloseRoom は部屋のすべてのコネクションに room.closed イベントを送り、切断理由を通知して閉じる
+	// 部屋にコネクションがない場合は何もしない
+	CloseRoom(ctx context.Context, roomID entity.RoomID, reason string) error
+
+	// DisconnectUser は部屋にいるユーザーのコネクションに room.removed イベントを送り、切断理由を通知して閉じる
+	// キック・追放に使用し、部屋の他のコネクションや同じユーザーの他の部屋のコネクションには影響しない
+	DisconnectUser(ctx context.Context, roomID entity.RoomID, userID entity.UserID, reason string) error
+
+	// TouchUser はユーザーが操作した日時を記録する（オンライン状態の判定に使用する）
+	// 複数ノードの場合は、ユーザーのコネクション数とあわせて他のノードにも共有する
+	TouchUser(ctx context.Context, userID entity.UserID, at time.Time) error
+
+	// GetUserActivity はユーザーのコネクション数と最後に操作した日時を返す
+	// 複数ノードの場合は、共有されたすべてのノードの分を合計する
+	GetUserActivity(ctx context.Context, userID entity.UserID) (entity.UserActivity, error)
 
-	// 指定した部屋にいるユーザーにブロードキャスト
-	BroadcastToRoom(ctx context.Context, roomID entity.RoomID, msg *entity.Message) error
+	// Shutdown は新しい登録を受け付けないようにし、送信待ちのイベントを書き出してから
+	// すべてのコネクションに切断理由を通知して閉じる
+	// ctx の期限を過ぎた場合は書き出しを待たずに閉じる
+	Shutdown(ctx context.Context, reason string) error
 }
@@ -1,6 +1,8 @@
 package gorillaconn
 
 import (
+	"time"
+
 	"github.com/gorilla/websocket"
 )
 
@@ -30,6 +32,26 @@ func (g *GorillaConnAdapter) WriteJSON(message any) error {
 	return g.conn.WriteJSON(message)
 }
 
+// SetWriteDeadline は WebSocketへの書き込み期限を設定する
+func (g *GorillaConnAdapter) SetWriteDeadline(t time.Time) error {
+	return g.conn.SetWriteDeadline(t)
+}
+
+// SetReadDeadline は WebSocketからの読み取り期限を設定する
+func (g *GorillaConnAdapter) SetReadDeadline(t time.Time) error {
+	return g.conn.SetReadDeadline(t)
+}
+
+// SetPongHandler は pong 受信時の処理を設定する
+func (g *GorillaConnAdapter) SetPongHandler(h func(appData string) error) {
+	g.conn.SetPongHandler(h)
+}
+
+// WriteControl は WebSocketへ制御メッセージを書き込む
+func (g *GorillaConnAdapter) WriteControl(messageType int, data []byte, deadline time.Time) error {
+	return g.conn.WriteControl(messageType, data, deadline)
+}
+
 // CloseFunc は WebSocket接続を閉じる
 func (g *GorillaConnAdapter) CloseFunc() error {
 	return g.conn.Close()
@@ -2,8 +2,10 @@ package di
 
 import (
 	"example.com/infrahandson/config"
+	"example.com/infrahandson/internal/domain/service"
 	mysqlgatewayimpl "example.com/infrahandson/internal/infrastructure/gatewayImpl/db/mysql"
 	sqlitegatewayimpl "example.com/infrahandson/internal/infrastructure/gatewayImpl/db/sqlite"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketBackplaneImpl/tcpbackplane"
 	"example.com/infrahandson/internal/interface/gateway"
 	"example.com/infrahandson/internal/interface/handler"
 	"github.com/bradfitz/gomemcache/memcache"
@@ -11,9 +13,11 @@ import (
 )
 
 type Dependencies struct {
-	DB      *sqlx.DB
-	Cache   *memcache.Client
-	Handler *handler.Handler
+	DB        *sqlx.DB
+	Cache     *memcache.Client
+	WsManager service.WebsocketManager
+	WsBroker  *tcpbackplane.TCPBroker // このプロセスでブローカーを起動していない場合は nil
+	Handler   *handler.Handler
 }
 
 func InitializeDependencies(cfg *config.Config) *Dependencies {
@@ -24,7 +28,7 @@ func InitializeDependencies(cfg *config.Config) *Dependencies {
 
 	// Factoryの初期化
 	// 詳細は internal/infrastructure/di/factory.go を参照
-	factorys := InitializeFactory()
+	factorys := InitializeFactory(cfg)
 
 	// DBの初期化
 	var initializer gateway.DBInitializer
@@ -62,11 +66,12 @@ func InitializeDependencies(cfg *config.Config) *Dependencies {
 
 	// Serviceの初期化
 	// 詳細は internal/infrastructure/di/service.go を参照
-	services, cacheClient := ServiceInitialize(cfg, repositories)
+	services, cacheClient, broker := ServiceInitialize(cfg, repositories)
 
 	// UseCaseの初期化
 // 詳細は internal/infrastructure/di/usecase.go を参照
 	usecases := UseCaseInitialize(&UseCaseDependency{
+		Cfg:     cfg,
 		Adapter: adapters,
 		Factory: factorys,
 		Repo:    repositories,
@@ -82,8 +87,10 @@ func InitializeDependencies(cfg *config.Config) *Dependencies {
 	})
 
 	return &Dependencies{
-		DB:      db,
-		Cache:   cacheClient,
-		Handler: handlers,
+		DB:        db,
+		Cache:     cacheClient,
+		WsManager: services.WebsocketManager,
+		WsBroker:  broker,
+		Handler:   handlers,
 	}
 }
@@ -1,25 +1,35 @@
 package di
 
 import (
+	"example.com/infrahandson/config"
 	"example.com/infrahandson/internal/infrastructure/factoryImpl"
 	"example.com/infrahandson/internal/interface/factory"
 )
 
 // InitializeFactory はファクトリーの初期化を行います。
 // 返り値 factory.Factory はファクトリー層をまとめた構造体（詳細：internal/interface/factory/factory.go）です。
-func InitializeFactory() *factory.Factory {
+// cfg でアプリケーション設定を受け取ります。
+func InitializeFactory(cfg *config.Config) *factory.Factory {
 	// Factoryの初期化
 	userIDFactory := factoryimpl.NewUserIDFactory()
 	roomIDFactory := factoryimpl.NewRoomIDFactory()
 	MsgIDFactory := factoryimpl.NewMessageIDFactory()
+	attachmentIDFactory := factoryimpl.NewAttachmentIDFactory()
 	clientDFactory := factoryimpl.NewWsClientIDFactory()
-	wsConnFactory := factoryimpl.NewWebSocketConnectionFactoryImpl()
+	inviteTokenFactory := factoryimpl.NewInviteTokenFactory()
+	wsConnFactory := factoryimpl.NewWebSocketConnectionFactoryImpl(&factoryimpl.NewWebSocketConnectionFactoryImplParams{
+		WriteTimeout: cfg.WsWriteTimeout,
+		PingInterval: cfg.WsPingInterval,
+		PongWait:     cfg.WsPongWait,
+	})
 
 	return &factory.Factory{
-		UserIDFactory:     userIDFactory,
-		RoomIDFactory:     roomIDFactory,
-		MessageIDFactory:  MsgIDFactory,
-		WsClientIDFactory: clientDFactory,
-		WsConnFactory:     wsConnFactory,
+		UserIDFactory:       userIDFactory,
+		RoomIDFactory:       roomIDFactory,
+		MessageIDFactory:    MsgIDFactory,
+		AttachmentIDFactory: attachmentIDFactory,
+		WsClientIDFactory:   clientDFactory,
+		InviteTokenFactory:  inviteTokenFactory,
+		WsConnFactory:       wsConnFactory,
 	}
 }
@@ -23,6 +23,7 @@ func HandlerInitialize(
 	return &handler.Handler{
 		UserHandler: userhandler.NewUserHandler(userhandler.NewUserHandlerParams{
 			UserUseCase:   params.UseCase.UserUseCase,
+			PresenceUseCase: params.UseCase.PresenceUseCase,
 			UserIDFactory: params.Factory.UserIDFactory,
 			Logger:        params.Adapter.LoggerAdapter,
 		}),
@@ -34,6 +35,8 @@ func HandlerInitialize(
 		}),
 		WsHandler: websockethandler.NewWebSocketHandler(websockethandler.NewWebSocketHandlerParams{
 			WsUseCase:   params.UseCase.WebsocketUseCase,
+			MsgUseCase:  params.UseCase.MessageUseCase,
+			PresenceUseCase: params.UseCase.PresenceUseCase,
 			WsUpgrader:  params.Adapter.Upgrader,
 			WsConnFactory: params.Factory.WsConnFactory,
 			UserIDFactory: params.Factory.UserIDFactory,
@@ -2,8 +2,25 @@ package di
 
 import (
 	"example.com/infrahandson/internal/domain/repository"
+	sqlitegatewayimpl "example.com/infrahandson/internal/infrastructure/gatewayImpl/db/sqlite"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/attachmentRepositoryImpl/mysqlattachmentrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/attachmentRepositoryImpl/sqliteattachmentrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/mentionRepositoryImpl/mysqlmentionrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/mentionRepositoryImpl/sqlitementionrepo"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/messageRepositoryImpl/mysqlmsgrepo"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/messageRepositoryImpl/sqlitemsgrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/pinRepositoryImpl/mysqlpinrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/pinRepositoryImpl/sqlitepinrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/reactionRepositoryImpl/mysqlreactionrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/reactionRepositoryImpl/sqlitereactionrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomInviteRepositoryImpl/mysqlinviterepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomInviteRepositoryImpl/sqliteinviterepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomJoinRequestRepositoryImpl/mysqljoinrequestrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomJoinRequestRepositoryImpl/sqlitejoinrequestrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomModerationRepositoryImpl/mysqlmoderationrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomModerationRepositoryImpl/sqlitemoderationrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomReadStateRepositoryImpl/mysqlreadstaterepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomReadStateRepositoryImpl/sqlitereadstaterepo"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomRepositoryImpl/mysqlroomrepo"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomRepositoryImpl/sqliteroomrepo"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/userRepositoryImpl/mysqluserrepo"
@@ -34,6 +51,14 @@ func RepositoryInitialize(
 	var userRepository repository.UserRepository
 	var roomRepository repository.RoomRepository
 	var msgRepository repository.MessageRepository
+	var reactionRepository repository.ReactionRepository
+	var pinRepository repository.PinRepository
+	var roomInviteRepository repository.RoomInviteRepository
+	var roomModerationRepository repository.RoomModerationRepository
+	var roomJoinRequestRepository repository.RoomJoinRequestRepository
+	var roomReadStateRepository repository.RoomReadStateRepository
+	var mentionRepository repository.MentionRepository
+	var attachmentRepository repository.AttachmentRepository
 
 	// Repositoryの初期化
 	// dbType に応じて適した種類のDBにリポジトリを初期化
@@ -42,18 +67,45 @@ func RepositoryInitialize(
 		userRepository = mysqluserrepo.NewUserRepositoryImpl(&mysqluserrepo.NewUserRepositoryImplParams{DB: db})
 		roomRepository = mysqlroomrepo.NewRoomRepositoryImpl(&mysqlroomrepo.NewRoomRepositoryImplParams{DB: db})
 		msgRepository = mysqlmsgrepo.NewMessageRepositoryImpl(&mysqlmsgrepo.NewMessageRepositoryImplParams{DB: db})
+		reactionRepository = mysqlreactionrepo.NewReactionRepositoryImpl(&mysqlreactionrepo.NewReactionRepositoryImplParams{DB: db})
+		pinRepository = mysqlpinrepo.NewPinRepositoryImpl(&mysqlpinrepo.NewPinRepositoryImplParams{DB: db})
+		roomInviteRepository = mysqlinviterepo.NewRoomInviteRepositoryImpl(&mysqlinviterepo.NewRoomInviteRepositoryImplParams{DB: db})
+		roomModerationRepository = mysqlmoderationrepo.NewRoomModerationRepositoryImpl(&mysqlmoderationrepo.NewRoomModerationRepositoryImplParams{DB: db})
+		roomJoinRequestRepository = mysqljoinrequestrepo.NewRoomJoinRequestRepositoryImpl(&mysqljoinrequestrepo.NewRoomJoinRequestRepositoryImplParams{DB: db})
+		roomReadStateRepository = mysqlreadstaterepo.NewRoomReadStateRepositoryImpl(&mysqlreadstaterepo.NewRoomReadStateRepositoryImplParams{DB: db})
+		mentionRepository = mysqlmentionrepo.NewMentionRepositoryImpl(&mysqlmentionrepo.NewMentionRepositoryImplParams{DB: db})
+		attachmentRepository = mysqlattachmentrepo.NewAttachmentRepositoryImpl(&mysqlattachmentrepo.NewAttachmentRepositoryImplParams{DB: db})
 	case DBTypeSQLite:
 		userRepository = sqliteuserrepo.NewUserRepositoryImpl(&sqliteuserrepo.NewUserRepositoryImplParams{DB: db})
 		roomRepository = sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
-		msgRepository = sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{DB: db})
+		msgRepository = sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{
+			DB:             db,
+			FullTextSearch: sqlitegatewayimpl.MessageSearchEnabled,
+		})
+		reactionRepository = sqlitereactionrepo.NewReactionRepositoryImpl(&sqlitereactionrepo.NewReactionRepositoryImplParams{DB: db})
+		pinRepository = sqlitepinrepo.NewPinRepositoryImpl(&sqlitepinrepo.NewPinRepositoryImplParams{DB: db})
+		roomInviteRepository = sqliteinviterepo.NewRoomInviteRepositoryImpl(&sqliteinviterepo.NewRoomInviteRepositoryImplParams{DB: db})
+		roomModerationRepository = sqlitemoderationrepo.NewRoomModerationRepositoryImpl(&sqlitemoderationrepo.NewRoomModerationRepositoryImplParams{DB: db})
+		roomJoinRequestRepository = sqlitejoinrequestrepo.NewRoomJoinRequestRepositoryImpl(&sqlitejoinrequestrepo.NewRoomJoinRequestRepositoryImplParams{DB: db})
+		roomReadStateRepository = sqlitereadstaterepo.NewRoomReadStateRepositoryImpl(&sqlitereadstaterepo.NewRoomReadStateRepositoryImplParams{DB: db})
+		mentionRepository = sqlitementionrepo.NewMentionRepositoryImpl(&sqlitementionrepo.NewMentionRepositoryImplParams{DB: db})
+		attachmentRepository = sqliteattachmentrepo.NewAttachmentRepositoryImpl(&sqliteattachmentrepo.NewAttachmentRepositoryImplParams{DB: db})
 	}
 
 	wsClientRepository := memwsclientrepo.NewInMemoryWebsocketClientRepository(memwsclientrepo.NewInMemoryWebsocketClientRepositoryParams{})
 
 	return &repository.Repository{
-		UserRepository:     userRepository,
-		RoomRepository:     roomRepository,
-		MessageRepository:  msgRepository,
-		WsClientRepository: wsClientRepository,
+		UserRepository:            userRepository,
+		RoomRepository:            roomRepository,
+		RoomInviteRepository:      roomInviteRepository,
+		RoomModerationRepository:  roomModerationRepository,
+		RoomJoinRequestRepository: roomJoinRequestRepository,
+		MessageRepository:         msgRepository,
+		ReactionRepository:        reactionRepository,
+		PinRepository:             pinRepository,
+		RoomReadStateRepository:   roomReadStateRepository,
+		MentionRepository:         mentionRepository,
+		AttachmentRepository:      attachmentRepository,
+		WsClientRepository:        wsClientRepository,
 	}
 }
@@ -8,11 +8,15 @@ import (
 	"example.com/infrahandson/internal/domain/service"
 	"example.com/infrahandson/internal/infrastructure/gatewayImpl/cache"
 	"example.com/infrahandson/internal/infrastructure/gatewayImpl/s3client"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/attachmentStoreServiceImpl/localattachmentsvc"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/attachmentStoreServiceImpl/s3attachmentsvc"
 	"example.com/infrahandson/internal/infrastructure/serviceImpl/iconStoreServiceImpl/localiconsvc"
 	"example.com/infrahandson/internal/infrastructure/serviceImpl/iconStoreServiceImpl/s3iconsvc"
 	"example.com/infrahandson/internal/infrastructure/serviceImpl/messageCacheImpl/memcachedmsg"
 	"example.com/infrahandson/internal/infrastructure/serviceImpl/messageCacheImpl/memmsgcache"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketBackplaneImpl/tcpbackplane"
 	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketManagerImpl/memwsmanager"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketManagerImpl/pubsubwsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/bradfitz/gomemcache/memcache"
 )
@@ -20,15 +24,46 @@ import (
 // ServiceInitialize はサービスの初期化を行います。
 // cfg でアプリケーション設定を受け取り、
 // repo でリポジトリを受け取ります。
-// 返り値は service.Service と *memcache.Client です。
+// 返り値は service.Service と *memcache.Client と *tcpbackplane.TCPBroker です。
 // service.Service はサービス層をまとめた構造体（詳細：internal/domain/service/service.go）で、
-// *memcache.Client は Memcached クライアントです。
+// *memcache.Client は Memcached クライアント、
+// *tcpbackplane.TCPBroker はこのプロセスで起動したバックプレーンのブローカーです。
+// 後者2つは使用しない場合 nil になり、停止時に閉じる必要があります。
 func ServiceInitialize(
 	cfg *config.Config,
 	repo *repository.Repository,
-) (*service.Service, *memcache.Client) {
+) (*service.Service, *memcache.Client, *tcpbackplane.TCPBroker) {
 	// Serviceの初期化
-	wsManager := memwsmanager.NewInMemoryWebSocketManager()
+	var wsManager service.WebsocketManager
+	localWsManager := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{
+		QueueSize:      cfg.WsSendQueueSize,
+		OverflowPolicy: memwsmanager.OverflowPolicy(cfg.WsOverflowPolicy),
+	})
+	var broker *tcpbackplane.TCPBroker
+	if cfg.WsBackplaneAddr != nil || cfg.WsBackplaneListen != nil {
+		// 複数ノードで配信を共有する場合はTCPブローカーを介したバックプレーンを使う
+		addr := ""
+		if cfg.WsBackplaneListen != nil {
+			// このプロセスでブローカーを起動する
+			broker = tcpbackplane.NewTCPBroker(&tcpbackplane.NewTCPBrokerParams{Addr: *cfg.WsBackplaneListen})
+			if err := broker.Start(); err != nil {
+				panic("failed to start websocket backplane broker: " + err.Error())
+			}
+			fmt.Println("WebSocket backplane broker listening on", broker.Addr())
+			addr = broker.Addr()
+		}
+		if cfg.WsBackplaneAddr != nil {
+			addr = *cfg.WsBackplaneAddr
+		}
+		fmt.Println("WebSocket backplane address:", addr)
+		wsManager = pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+			Local:             localWsManager,
+			Backplane:         tcpbackplane.NewTCPBackplane(&tcpbackplane.NewTCPBackplaneParams{Addr: addr}),
+			HeartbeatInterval: cfg.WsNodeHeartbeat,
+		})
+	} else {
+		wsManager = localWsManager
+	}
 	var msgCache service.MessageCacheService
 	var cacheClient *memcache.Client
 	if cfg.MemcachedAddr != nil {
@@ -36,7 +71,8 @@ func ServiceInitialize(
 		fmt.Println("Memcached address:", *cfg.MemcachedAddr)
 		cacheInitializer := cache.NewCacheInitializer(&cache.NewCacheInitializerParams{Cfg: cfg})
 
-		cacheClient, err := cacheInitializer.Init()
+		var err error
+		cacheClient, err = cacheInitializer.Init()
 		if err != nil {
 			panic("failed to initialize memcached: " + err.Error())
 		}
@@ -50,6 +86,7 @@ func ServiceInitialize(
 	}
 
 	var iconSvc service.IconStoreService
+	var attachmentSvc service.AttachmentStoreService
 	isS3, Type, errs := cfg.IsS3()
 	if isS3 {
 		var StorageClient *s3.Client
@@ -71,6 +108,12 @@ func ServiceInitialize(
 			Bucket:  *cfg.IconStoreBucket,
 			Prefix:  *cfg.IconStorePrefix,
 		})
+		// 添付ファイルはアイコンと同じバケットの別のプレフィックスに保存する
+		attachmentSvc = s3attachmentsvc.NewS3AttachmentStoreImpl(s3attachmentsvc.NewS3AttachmentStoreImplParams{
+			Client: StorageClient,
+			Bucket: *cfg.IconStoreBucket,
+			Prefix: cfg.AttachmentStorePrefix,
+		})
 	} else {
 		// S3関連のすべてがnilではない場合は、不足している旨を表示
 		if len(errs) != 5 {
@@ -82,11 +125,15 @@ func ServiceInitialize(
 		iconSvc = localiconsvc.NewLocalIconStoreImpl(&localiconsvc.NewLocalIconStoreImplParams{
 			DirPath: cfg.LocalIconDir,
 		})
+		attachmentSvc = localattachmentsvc.NewLocalAttachmentStoreImpl(&localattachmentsvc.NewLocalAttachmentStoreImplParams{
+			DirPath: cfg.LocalAttachmentDir,
+		})
 	}
 
 	return &service.Service{
 		IconStoreService: iconSvc,
+		AttachmentStoreService: attachmentSvc,
 		MessageCacheService: msgCache,
 		WebsocketManager: wsManager,
-	}, cacheClient
+	}, cacheClient, broker
 }
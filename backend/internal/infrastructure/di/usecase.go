@@ -1,18 +1,21 @@
 package di
 
 import (
+	"example.com/infrahandson/config"
 	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/domain/service"
 	"example.com/infrahandson/internal/interface/adapter"
 	"example.com/infrahandson/internal/interface/factory"
 	"example.com/infrahandson/internal/usecase"
 	"example.com/infrahandson/internal/usecase/messagecase"
+	"example.com/infrahandson/internal/usecase/presencecase"
 	"example.com/infrahandson/internal/usecase/roomcase"
 	"example.com/infrahandson/internal/usecase/usercase"
 	"example.com/infrahandson/internal/usecase/websocketcase"
 )
 
 type UseCaseDependency struct {
+	Cfg     *config.Config
 	Adapter *adapter.Adapter
 	Factory *factory.Factory
 	Repo    *repository.Repository
@@ -33,25 +36,59 @@ func UseCaseInitialize(
 			UserIDFactory: dep.Factory.UserIDFactory,
 		}),
 		RoomUseCase: roomcase.NewRoomUseCase(roomcase.NewRoomUseCaseParams{
-			RoomRepo:      dep.Repo.RoomRepository,
-			RoomIDFactory: dep.Factory.RoomIDFactory,
-			UserRepo:      dep.Repo.UserRepository,
+			RoomRepo:           dep.Repo.RoomRepository,
+			RoomIDFactory:      dep.Factory.RoomIDFactory,
+			UserRepo:           dep.Repo.UserRepository,
+			InviteRepo:         dep.Repo.RoomInviteRepository,
+			ModerationRepo:     dep.Repo.RoomModerationRepository,
+			JoinRequestRepo:    dep.Repo.RoomJoinRequestRepository,
+			InviteTokenFactory: dep.Factory.InviteTokenFactory,
+			IconSvc:            dep.Svc.IconStoreService,
+			MsgCache:           dep.Svc.MessageCacheService,
+			WsManager:          dep.Svc.WebsocketManager,
+			AttachmentRepo:     dep.Repo.AttachmentRepository,
+			AttachmentStore:    dep.Svc.AttachmentStoreService,
+			Logger:             dep.Adapter.LoggerAdapter,
+			AwayTimeout:        dep.Cfg.PresenceAwayTimeout,
 		}),
 		WebsocketUseCase: websocketcase.NewWebsocketUseCase(websocketcase.NewWebsocketUseCaseParams{
 			UserRepo:         dep.Repo.UserRepository,
 			RoomRepo:         dep.Repo.RoomRepository,
+			ModerationRepo:   dep.Repo.RoomModerationRepository,
 			MsgRepo:          dep.Repo.MessageRepository,
+			AttachmentRepo:   dep.Repo.AttachmentRepository,
 			MsgCache:         dep.Svc.MessageCacheService,
 			WsClientRepo:     dep.Repo.WsClientRepository,
 			WebsocketManager: dep.Svc.WebsocketManager,
 			MsgIDFactory:     dep.Factory.MessageIDFactory,
 			ClientIDFactory:  dep.Factory.WsClientIDFactory,
+			BurstLimit:       dep.Cfg.MsgBurstLimit,
+			BurstWindow:      dep.Cfg.MsgBurstWindow,
+			TypingTimeout:    dep.Cfg.TypingTimeout,
 		}),
 		MessageUseCase: messagecase.NewMessageUseCase(messagecase.NewMessageUseCaseParams{
-			MsgRepo:  dep.Repo.MessageRepository,
-			MsgCache: dep.Svc.MessageCacheService,
-			RoomRepo: dep.Repo.RoomRepository,
-			UserRepo: dep.Repo.UserRepository,
+			MsgRepo:       dep.Repo.MessageRepository,
+			MsgCache:      dep.Svc.MessageCacheService,
+			RoomRepo:      dep.Repo.RoomRepository,
+			UserRepo:      dep.Repo.UserRepository,
+			ReactionRepo:  dep.Repo.ReactionRepository,
+			PinRepo:       dep.Repo.PinRepository,
+			ReadStateRepo: dep.Repo.RoomReadStateRepository,
+			MentionRepo:   dep.Repo.MentionRepository,
+			WsManager:     dep.Svc.WebsocketManager,
+			Logger:        dep.Adapter.LoggerAdapter,
+			ReadReceipts:  dep.Cfg.ReadReceipts,
+
+			AttachmentRepo:      dep.Repo.AttachmentRepository,
+			AttachmentStore:     dep.Svc.AttachmentStoreService,
+			AttachmentIDFactory: dep.Factory.AttachmentIDFactory,
+			MaxAttachmentSize:   dep.Cfg.AttachmentMaxSize,
+		}),
+		PresenceUseCase: presencecase.NewPresenceUseCase(presencecase.NewPresenceUseCaseParams{
+			UserRepo:         dep.Repo.UserRepository,
+			RoomRepo:         dep.Repo.RoomRepository,
+			WebsocketManager: dep.Svc.WebsocketManager,
+			AwayTimeout:      dep.Cfg.PresenceAwayTimeout,
 		}),
 	}
 }
@@ -1,6 +1,9 @@
 package factoryimpl
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/interface/factory"
 	"github.com/google/uuid"
@@ -36,6 +39,16 @@ func (f *MessageIDFactoryImpl) NewMessageID() (entity.MessageID, error) {
 	return entity.MessageID(uuid.New().String()), nil
 }
 
+type AttachmentIDFactoryImpl struct{}
+
+func NewAttachmentIDFactory() factory.AttachmentIDFactory {
+	return &AttachmentIDFactoryImpl{}
+}
+
+func (f *AttachmentIDFactoryImpl) NewAttachmentID() (entity.AttachmentID, error) {
+	return entity.AttachmentID(uuid.New().String()), nil
+}
+
 type WsClientIDFactoryImpl struct{}
 
 func NewWsClientIDFactory() factory.WsClientIDFactory {
@@ -45,3 +58,20 @@ func NewWsClientIDFactory() factory.WsClientIDFactory {
 func (f *WsClientIDFactoryImpl) NewWsClientID() (entity.WsClientID, error) {
 	return entity.WsClientID(uuid.New().String()), nil
 }
+
+// inviteTokenBytes は招待トークンのランダムなバイト数（base64url で32文字になる）
+const inviteTokenBytes = 24
+
+type InviteTokenFactoryImpl struct{}
+
+func NewInviteTokenFactory() factory.InviteTokenFactory {
+	return &InviteTokenFactoryImpl{}
+}
+
+func (f *InviteTokenFactoryImpl) NewInviteToken() (entity.InviteToken, error) {
+	b := make([]byte, inviteTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return entity.InviteToken(""), err
+	}
+	return entity.InviteToken(base64.RawURLEncoding.EncodeToString(b)), nil
+}
@@ -1,20 +1,56 @@
 package factoryimpl
 
 import (
+	"errors"
+	"time"
+
 	"example.com/infrahandson/internal/domain/service"
 	gorillawsconnectionimpl "example.com/infrahandson/internal/infrastructure/serviceImpl/websocketConnectionImpl/gorillawebsocket"
 	"example.com/infrahandson/internal/interface/adapter"
 	"example.com/infrahandson/internal/interface/factory"
 )
 
-type WebSocketConnectionFactoryImpl struct{}
+type WebSocketConnectionFactoryImpl struct {
+	writeTimeout time.Duration
+	pingInterval time.Duration
+	pongWait     time.Duration
+}
+
+type NewWebSocketConnectionFactoryImplParams struct {
+	WriteTimeout time.Duration // 1回の書き込みの期限（0の場合は既定値）
+	PingInterval time.Duration // ping を送る間隔（0の場合は送らない）
+	PongWait     time.Duration // 応答がない相手を切断するまでの時間（0の場合は切断しない）
+}
+
+func (p *NewWebSocketConnectionFactoryImplParams) Validate() error {
+	if p.WriteTimeout < 0 {
+		return errors.New("write timeout must not be negative")
+	}
+	if p.PingInterval < 0 || p.PongWait < 0 {
+		return errors.New("heartbeat intervals must not be negative")
+	}
+	if p.PingInterval > 0 && p.PongWait > 0 && p.PingInterval >= p.PongWait {
+		return errors.New("ping interval must be shorter than pong wait")
+	}
+	return nil
+}
 
-func NewWebSocketConnectionFactoryImpl() factory.WebSocketConnectionFactory {
-	return &WebSocketConnectionFactoryImpl{}
+func NewWebSocketConnectionFactoryImpl(p *NewWebSocketConnectionFactoryImplParams) factory.WebSocketConnectionFactory {
+	if err := p.Validate(); err != nil {
+		panic(err)
+	}
+	return &WebSocketConnectionFactoryImpl{
+		writeTimeout: p.WriteTimeout,
+		pingInterval: p.PingInterval,
+		pongWait:     p.PongWait,
+	}
 }
 
 func (f *WebSocketConnectionFactoryImpl) CreateWebSocketConnection(conn adapter.ConnAdapter) (service.WebSocketConnection, error) {
 	return gorillawsconnectionimpl.NewGorillaWebSocketConnection(&gorillawsconnectionimpl.NewGorillaWebSocketConnectionParams{
-		Conn: conn, // Use exported field
+		Conn:         conn, // Use exported field
+		WriteTimeout: f.writeTimeout,
+		PingInterval: f.pingInterval,
+		PongWait:     f.pongWait,
 	}), nil
 }
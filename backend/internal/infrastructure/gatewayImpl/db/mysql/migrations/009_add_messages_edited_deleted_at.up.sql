@@ -0,0 +1,3 @@
+ALTER TABLE messages
+    ADD COLUMN edited_at DATETIME NULL,
+    ADD COLUMN deleted_at DATETIME NULL;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS message_revisions (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    message_id BINARY(16) NOT NULL,
+    content TEXT NOT NULL,
+    edited_at DATETIME NOT NULL,
+    INDEX idx_message_revisions_message_id (message_id, edited_at),
+    FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE
+);
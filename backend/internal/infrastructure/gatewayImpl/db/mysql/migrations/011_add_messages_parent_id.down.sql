@@ -0,0 +1,4 @@
+ALTER TABLE messages
+    DROP FOREIGN KEY fk_messages_parent_id,
+    DROP INDEX idx_messages_parent_id_sent_at,
+    DROP COLUMN parent_id;
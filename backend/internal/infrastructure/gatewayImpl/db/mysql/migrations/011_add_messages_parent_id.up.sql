@@ -0,0 +1,4 @@
+ALTER TABLE messages
+    ADD COLUMN parent_id BINARY(16) NULL,
+    ADD INDEX idx_messages_parent_id_sent_at (parent_id, sent_at),
+    ADD CONSTRAINT fk_messages_parent_id FOREIGN KEY (parent_id) REFERENCES messages(id) ON DELETE CASCADE;
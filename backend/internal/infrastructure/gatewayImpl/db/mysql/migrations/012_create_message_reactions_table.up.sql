@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS message_reactions (
+    message_id BINARY(16) NOT NULL,
+    user_id BINARY(16) NOT NULL,
+    -- 見た目の似た絵文字を同一視しないよう、バイナリ照合順序で比較する
+    emoji VARCHAR(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (message_id, user_id, emoji),
+    FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1 @@
+ALTER TABLE messages DROP INDEX ft_idx_messages_content;
@@ -0,0 +1 @@
+ALTER TABLE messages ADD FULLTEXT INDEX ft_idx_messages_content (content) WITH PARSER ngram;
@@ -0,0 +1,3 @@
+ALTER TABLE room_members
+    ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'member',
+    ADD INDEX idx_room_members_room_id_user_id (room_id, user_id);
@@ -0,0 +1,2 @@
+-- 既存の部屋はこれまで通り誰でも参加できるよう、公開として扱う
+ALTER TABLE rooms ADD COLUMN visibility VARCHAR(16) NOT NULL DEFAULT 'public';
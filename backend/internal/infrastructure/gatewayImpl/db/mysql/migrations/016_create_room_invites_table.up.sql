@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS room_invites (
+    token VARCHAR(64) CHARACTER SET ascii COLLATE ascii_bin NOT NULL PRIMARY KEY,
+    room_id BINARY(16) NOT NULL,
+    created_by BINARY(16) NOT NULL,
+    max_uses INT NOT NULL,
+    uses INT NOT NULL DEFAULT 0,
+    expires_at DATETIME NOT NULL,
+    created_at DATETIME NOT NULL,
+    INDEX idx_room_invites_room_id (room_id),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+ALTER TABLE rooms
+    DROP INDEX idx_rooms_dm_key,
+    DROP COLUMN dm_key,
+    DROP COLUMN kind;
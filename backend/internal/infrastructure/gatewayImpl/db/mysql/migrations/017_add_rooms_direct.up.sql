@@ -0,0 +1,6 @@
+-- 既存の部屋はすべて通常の部屋として扱う
+-- dm_key はダイレクトメッセージの2人の組み合わせ（ソートして連結したユーザーID）。通常の部屋は NULL
+ALTER TABLE rooms
+    ADD COLUMN kind VARCHAR(16) NOT NULL DEFAULT 'group',
+    ADD COLUMN dm_key VARCHAR(73) CHARACTER SET ascii COLLATE ascii_bin NULL,
+    ADD UNIQUE INDEX idx_rooms_dm_key (dm_key);
@@ -0,0 +1,4 @@
+-- archived_at はアーカイブされていない部屋は NULL
+ALTER TABLE rooms
+    ADD COLUMN description VARCHAR(1000) NOT NULL DEFAULT '',
+    ADD COLUMN archived_at DATETIME NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE rooms
+    DROP INDEX idx_rooms_created_at,
+    DROP COLUMN created_at;
@@ -0,0 +1,4 @@
+-- 既存の部屋は移行時刻を作成日時とする
+ALTER TABLE rooms
+    ADD COLUMN created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD INDEX idx_rooms_created_at (created_at, id);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS room_bans (
+    room_id BINARY(16) NOT NULL,
+    user_id BINARY(16) NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (room_id, user_id),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
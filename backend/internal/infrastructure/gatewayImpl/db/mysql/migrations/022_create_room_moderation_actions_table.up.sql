@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS room_moderation_actions (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    room_id BINARY(16) NOT NULL,
+    actor_id BINARY(16) NOT NULL,
+    target_id BINARY(16) NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    reason VARCHAR(500) NOT NULL DEFAULT '',
+    expires_at DATETIME,
+    created_at DATETIME NOT NULL,
+    INDEX idx_room_moderation_actions_room_id (room_id, created_at),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE
+);
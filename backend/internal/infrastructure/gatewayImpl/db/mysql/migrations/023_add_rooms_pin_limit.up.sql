@@ -0,0 +1,2 @@
+-- 部屋ごとのピン留めできるメッセージ数の上限
+ALTER TABLE rooms ADD COLUMN pin_limit INT NOT NULL DEFAULT 50;
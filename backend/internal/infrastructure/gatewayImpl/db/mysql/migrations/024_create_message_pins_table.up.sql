@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS message_pins (
+    message_id BINARY(16) NOT NULL PRIMARY KEY,
+    room_id BINARY(16) NOT NULL,
+    pinned_by BINARY(16) NOT NULL,
+    pinned_at DATETIME NOT NULL,
+    INDEX idx_message_pins_room_id (room_id, pinned_at),
+    FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE,
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS room_join_requests (
+    room_id BINARY(16) NOT NULL,
+    user_id BINARY(16) NOT NULL,
+    message VARCHAR(500) NOT NULL DEFAULT '',
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    created_at DATETIME NOT NULL,
+    decided_by BINARY(16),
+    decided_at DATETIME,
+    PRIMARY KEY (room_id, user_id),
+    INDEX idx_room_join_requests_status (room_id, status, created_at),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
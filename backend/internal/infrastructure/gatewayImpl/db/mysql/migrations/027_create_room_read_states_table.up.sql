@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS room_read_states (
+    room_id BINARY(16) NOT NULL,
+    user_id BINARY(16) NOT NULL,
+    last_read_message_id BINARY(16) NOT NULL,
+    last_read_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    PRIMARY KEY (room_id, user_id),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
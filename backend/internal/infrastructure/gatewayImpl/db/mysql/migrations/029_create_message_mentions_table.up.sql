@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS message_mentions (
+    message_id BINARY(16) NOT NULL,
+    user_id BINARY(16) NOT NULL,
+    room_id BINARY(16) NOT NULL,
+    kind VARCHAR(16) NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (message_id, user_id),
+    INDEX idx_message_mentions_user_id (user_id, created_at),
+    FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE,
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
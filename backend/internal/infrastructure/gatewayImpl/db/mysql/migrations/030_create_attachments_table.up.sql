@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS attachments (
+    id BINARY(16) PRIMARY KEY,
+    room_id BINARY(16) NOT NULL,
+    uploader_id BINARY(16) NOT NULL,
+    message_id BINARY(16) NULL,
+    file_name VARCHAR(255) NOT NULL,
+    mime_type VARCHAR(127) NOT NULL,
+    size BIGINT NOT NULL,
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    has_thumbnail BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL,
+    INDEX idx_attachments_message_id (message_id),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (uploader_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- 取り除いた重複は戻さない
+SELECT 1;
@@ -0,0 +1,3 @@
+-- 032 で (room_id, user_id) を一意にする前に、重複した参加を取り除く
+DELETE rm1 FROM room_members rm1
+JOIN room_members rm2 ON rm1.room_id = rm2.room_id AND rm1.user_id = rm2.user_id AND rm1.id > rm2.id;
@@ -0,0 +1,3 @@
+ALTER TABLE room_members
+    DROP INDEX idx_room_members_room_id_user_id,
+    ADD INDEX idx_room_members_room_id_user_id (room_id, user_id);
@@ -0,0 +1,17 @@
+//go:build sqlite_fts5 || fts5
+
+package sqlitegatewayimpl
+
+import (
+	"io/fs"
+	"os"
+)
+
+// MessageSearchEnabled はメッセージの全文検索用インデックス（FTS5）を使うかどうかです。
+// mattn/go-sqlite3 は `-tags sqlite_fts5` を付けてビルドした場合のみ FTS5 が使えます。
+const MessageSearchEnabled = true
+
+// migrationsFS はマイグレーションのディレクトリをそのまま返します。
+func migrationsFS(dir string) (fs.FS, error) {
+	return os.DirFS(dir), nil
+}
@@ -0,0 +1,27 @@
+//go:build !(sqlite_fts5 || fts5)
+
+package sqlitegatewayimpl
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+)
+
+// MessageSearchEnabled はメッセージの全文検索用インデックス（FTS5）を使うかどうかです。
+// mattn/go-sqlite3 は `-tags sqlite_fts5` を付けてビルドした場合のみ FTS5 が使えます。
+// FTS5 なしでマイグレーションしたデータベースにはインデックスがないため、検索は messages への LIKE で行います。
+const MessageSearchEnabled = false
+
+//go:embed migrations_nofts5/*.sql
+var noFTS5Migrations embed.FS
+
+// migrationsFS はマイグレーションのディレクトリを返します。
+// 全文検索用のインデックスを作成するマイグレーションは、migrations_nofts5 の何もしないものに差し替えます。
+func migrationsFS(dir string) (fs.FS, error) {
+	override, err := fs.Sub(noFTS5Migrations, "migrations_nofts5")
+	if err != nil {
+		return nil, err
+	}
+	return overlayFS{base: os.DirFS(dir), override: override}, nil
+}
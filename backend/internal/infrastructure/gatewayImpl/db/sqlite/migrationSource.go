@@ -0,0 +1,22 @@
+package sqlitegatewayimpl
+
+import "io/fs"
+
+// overlayFS はマイグレーションのディレクトリのうち、override にあるファイルだけを差し替えます。
+type overlayFS struct {
+	base     fs.FS
+	override fs.FS
+}
+
+func (f overlayFS) Open(name string) (fs.File, error) {
+	if name != "." {
+		if file, err := f.override.Open(name); err == nil {
+			return file, nil
+		}
+	}
+	return f.base.Open(name)
+}
+
+func (f overlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return fs.ReadDir(f.base, name)
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_messages_parent_id_sent_at;
+
+ALTER TABLE messages DROP COLUMN parent_id;
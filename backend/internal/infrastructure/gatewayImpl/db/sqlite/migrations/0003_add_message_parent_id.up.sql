@@ -0,0 +1,3 @@
+ALTER TABLE messages ADD COLUMN parent_id TEXT REFERENCES messages(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_messages_parent_id_sent_at ON messages(parent_id, sent_at);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS messages_fts_after_update;
+DROP TRIGGER IF EXISTS messages_fts_after_delete;
+DROP TRIGGER IF EXISTS messages_fts_after_insert;
+DROP TABLE IF EXISTS messages_fts;
+DROP VIEW IF EXISTS message_search_contents;
+DROP TABLE IF EXISTS message_search_keys;
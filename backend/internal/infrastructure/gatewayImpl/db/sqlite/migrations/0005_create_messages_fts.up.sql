@@ -0,0 +1,43 @@
+-- メッセージ本文の全文検索用インデックス（FTS5）
+-- trigram トークナイザで部分一致検索にし、単語を空白で区切らない日本語も検索できるようにする
+-- messages の rowid は VACUUM で変わることがあるため、インデックスの行は
+-- message_search_keys の明示的な INTEGER PRIMARY KEY でメッセージと対応させる
+-- FTS5 なしでビルドした場合は、このファイルの代わりに migrations_nofts5 の同名のファイルが使われる
+CREATE TABLE message_search_keys (
+    id         INTEGER PRIMARY KEY,
+    message_id TEXT NOT NULL UNIQUE
+);
+
+CREATE VIEW message_search_contents AS
+    SELECT k.id, m.content FROM message_search_keys k JOIN messages m ON m.id = k.message_id;
+
+CREATE VIRTUAL TABLE messages_fts USING fts5(
+    content,
+    content='message_search_contents',
+    content_rowid='id',
+    tokenize='trigram'
+);
+
+-- messages への書き込みに合わせてインデックスを更新する
+CREATE TRIGGER messages_fts_after_insert AFTER INSERT ON messages BEGIN
+    INSERT INTO message_search_keys (message_id) VALUES (new.id);
+    INSERT INTO messages_fts (rowid, content)
+        SELECT id, new.content FROM message_search_keys WHERE message_id = new.id;
+END;
+
+CREATE TRIGGER messages_fts_after_delete AFTER DELETE ON messages BEGIN
+    INSERT INTO messages_fts (messages_fts, rowid, content)
+        SELECT 'delete', id, old.content FROM message_search_keys WHERE message_id = old.id;
+    DELETE FROM message_search_keys WHERE message_id = old.id;
+END;
+
+CREATE TRIGGER messages_fts_after_update AFTER UPDATE OF content ON messages BEGIN
+    INSERT INTO messages_fts (messages_fts, rowid, content)
+        SELECT 'delete', id, old.content FROM message_search_keys WHERE message_id = old.id;
+    INSERT INTO messages_fts (rowid, content)
+        SELECT id, new.content FROM message_search_keys WHERE message_id = new.id;
+END;
+
+-- 作成する前のメッセージを取り込む
+INSERT INTO message_search_keys (message_id) SELECT id FROM messages;
+INSERT INTO messages_fts (messages_fts) VALUES ('rebuild');
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_room_members_room_id_user_id;
+
+ALTER TABLE room_members DROP COLUMN role;
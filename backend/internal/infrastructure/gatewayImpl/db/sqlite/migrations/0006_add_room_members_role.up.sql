@@ -0,0 +1,4 @@
+-- 既存のメンバーは作成者が分からないため、一般のメンバーとして扱う
+ALTER TABLE room_members ADD COLUMN role TEXT NOT NULL DEFAULT 'member';
+
+CREATE INDEX IF NOT EXISTS idx_room_members_room_id_user_id ON room_members(room_id, user_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_room_invites_room_id;
+
+DROP TABLE IF EXISTS room_invites;
+
+ALTER TABLE rooms DROP COLUMN visibility;
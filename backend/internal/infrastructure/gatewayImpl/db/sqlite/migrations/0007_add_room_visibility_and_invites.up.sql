@@ -0,0 +1,16 @@
+-- 既存の部屋はこれまで通り誰でも参加できるよう、公開として扱う
+ALTER TABLE rooms ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public';
+
+CREATE TABLE IF NOT EXISTS room_invites (
+    token      TEXT NOT NULL PRIMARY KEY,
+    room_id    TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    max_uses   INTEGER NOT NULL,
+    uses       INTEGER NOT NULL DEFAULT 0,
+    expires_at DATETIME NOT NULL,
+    created_at DATETIME NOT NULL,
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_room_invites_room_id ON room_invites(room_id);
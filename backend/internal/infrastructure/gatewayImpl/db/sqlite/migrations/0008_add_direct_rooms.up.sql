@@ -0,0 +1,7 @@
+-- 既存の部屋はすべて通常の部屋として扱う
+ALTER TABLE rooms ADD COLUMN kind TEXT NOT NULL DEFAULT 'group';
+
+-- ダイレクトメッセージの2人の組み合わせ（ソートして連結したユーザーID）。通常の部屋は NULL
+ALTER TABLE rooms ADD COLUMN dm_key TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rooms_dm_key ON rooms(dm_key);
@@ -0,0 +1,3 @@
+ALTER TABLE rooms DROP COLUMN archived_at;
+
+ALTER TABLE rooms DROP COLUMN description;
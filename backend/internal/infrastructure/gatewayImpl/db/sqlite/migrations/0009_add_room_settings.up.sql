@@ -0,0 +1,4 @@
+ALTER TABLE rooms ADD COLUMN description TEXT NOT NULL DEFAULT '';
+
+-- アーカイブされていない部屋は NULL
+ALTER TABLE rooms ADD COLUMN archived_at DATETIME;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_rooms_created_at;
+ALTER TABLE rooms DROP COLUMN created_at;
@@ -0,0 +1,6 @@
+-- SQLite の ALTER TABLE では CURRENT_TIMESTAMP を既定値にできないため、既存の部屋は移行時刻で埋める
+ALTER TABLE rooms ADD COLUMN created_at DATETIME;
+-- アプリケーションが書き込む形式（go-sqlite3 の既定、UTC）に合わせ、一覧のカーソルと比較できるようにする
+UPDATE rooms SET created_at = strftime('%Y-%m-%d %H:%M:%S+00:00', 'now') WHERE created_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_rooms_created_at ON rooms(created_at, id);
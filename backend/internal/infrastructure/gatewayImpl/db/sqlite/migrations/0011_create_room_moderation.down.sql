@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_room_moderation_actions_room_id;
+DROP TABLE IF EXISTS room_moderation_actions;
+DROP TABLE IF EXISTS room_mutes;
+DROP TABLE IF EXISTS room_bans;
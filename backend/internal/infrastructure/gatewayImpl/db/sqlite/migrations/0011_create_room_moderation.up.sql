@@ -0,0 +1,34 @@
+-- 部屋からの追放（参加・接続を拒否する）
+CREATE TABLE IF NOT EXISTS room_bans (
+    room_id    TEXT NOT NULL,
+    user_id    TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (room_id, user_id),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- 期限付きの発言禁止（接続は維持し、送信のみ拒否する）
+CREATE TABLE IF NOT EXISTS room_mutes (
+    room_id     TEXT NOT NULL,
+    user_id     TEXT NOT NULL,
+    muted_until DATETIME NOT NULL,
+    PRIMARY KEY (room_id, user_id),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- モデレーションの記録
+CREATE TABLE IF NOT EXISTS room_moderation_actions (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    room_id    TEXT NOT NULL,
+    actor_id   TEXT NOT NULL,
+    target_id  TEXT NOT NULL,
+    action     TEXT NOT NULL,
+    reason     TEXT NOT NULL DEFAULT '',
+    expires_at DATETIME,
+    created_at DATETIME NOT NULL,
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_room_moderation_actions_room_id ON room_moderation_actions(room_id, created_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_message_pins_room_id;
+DROP TABLE IF EXISTS message_pins;
+ALTER TABLE rooms DROP COLUMN pin_limit;
@@ -0,0 +1,14 @@
+-- 部屋ごとのピン留めできるメッセージ数の上限
+ALTER TABLE rooms ADD COLUMN pin_limit INTEGER NOT NULL DEFAULT 50;
+
+-- ピン留めされたメッセージ
+CREATE TABLE IF NOT EXISTS message_pins (
+    message_id TEXT NOT NULL PRIMARY KEY,
+    room_id    TEXT NOT NULL,
+    pinned_by  TEXT NOT NULL,
+    pinned_at  DATETIME NOT NULL,
+    FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE,
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_pins_room_id ON message_pins(room_id, pinned_at);
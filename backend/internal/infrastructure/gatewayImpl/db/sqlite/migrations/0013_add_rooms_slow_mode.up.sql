@@ -0,0 +1,2 @@
+-- 部屋ごとのスローモードの間隔（秒）。0 の場合は無効
+ALTER TABLE rooms ADD COLUMN slow_mode_seconds INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_room_join_requests_status;
+DROP TABLE IF EXISTS room_join_requests;
@@ -0,0 +1,16 @@
+-- 非公開の部屋への参加リクエスト
+-- ユーザーごとに部屋あたり1件のみ保持し、承認・却下後に再度リクエストした場合は上書きする
+CREATE TABLE IF NOT EXISTS room_join_requests (
+    room_id    TEXT NOT NULL,
+    user_id    TEXT NOT NULL,
+    message    TEXT NOT NULL DEFAULT '',
+    status     TEXT NOT NULL DEFAULT 'pending',
+    created_at DATETIME NOT NULL,
+    decided_by TEXT,
+    decided_at DATETIME,
+    PRIMARY KEY (room_id, user_id),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_room_join_requests_status ON room_join_requests(room_id, status, created_at);
@@ -0,0 +1,12 @@
+-- ユーザーごとに部屋のどのメッセージまで読んだかを保持する
+-- last_read_at には既読にしたメッセージの送信日時を入れ、未読件数はそれより後のメッセージを数える
+CREATE TABLE IF NOT EXISTS room_read_states (
+    room_id              TEXT NOT NULL,
+    user_id              TEXT NOT NULL,
+    last_read_message_id TEXT NOT NULL,
+    last_read_at         DATETIME NOT NULL,
+    updated_at           DATETIME NOT NULL,
+    PRIMARY KEY (room_id, user_id),
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
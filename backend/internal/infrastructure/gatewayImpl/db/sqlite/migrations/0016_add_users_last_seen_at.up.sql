@@ -0,0 +1,2 @@
+-- 最後にオンラインだった日時。一度も接続していない場合は NULL
+ALTER TABLE users ADD COLUMN last_seen_at DATETIME;
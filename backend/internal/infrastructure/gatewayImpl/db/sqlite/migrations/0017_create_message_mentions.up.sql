@@ -0,0 +1,14 @@
+-- メッセージでメンションされたユーザー
+-- 既読かどうかは持たず、メンションされたユーザーの部屋の既読位置（room_read_states）から判定する
+CREATE TABLE IF NOT EXISTS message_mentions (
+    message_id TEXT NOT NULL,
+    user_id    TEXT NOT NULL,
+    room_id    TEXT NOT NULL,
+    kind       TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (message_id, user_id),
+    FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE,
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_mentions_user_id ON message_mentions(user_id, created_at);
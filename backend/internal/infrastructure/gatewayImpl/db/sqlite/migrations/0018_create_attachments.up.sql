@@ -0,0 +1,19 @@
+-- メッセージの添付ファイルの情報（ファイル自体は AttachmentStoreService の保存先にある）
+-- message_id はアップロード後、メッセージを送信するまで NULL
+CREATE TABLE IF NOT EXISTS attachments (
+    id            TEXT PRIMARY KEY,
+    room_id       TEXT NOT NULL,
+    uploader_id   TEXT NOT NULL,
+    message_id    TEXT,
+    file_name     TEXT NOT NULL,
+    mime_type     TEXT NOT NULL,
+    size          INTEGER NOT NULL,
+    width         INTEGER NOT NULL DEFAULT 0,
+    height        INTEGER NOT NULL DEFAULT 0,
+    has_thumbnail BOOLEAN NOT NULL DEFAULT 0,
+    created_at    DATETIME NOT NULL,
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_message_id ON attachments(message_id);
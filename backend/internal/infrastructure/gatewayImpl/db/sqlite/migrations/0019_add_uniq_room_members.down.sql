@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_room_members_room_id_user_id;
+CREATE INDEX IF NOT EXISTS idx_room_members_room_id_user_id ON room_members(room_id, user_id);
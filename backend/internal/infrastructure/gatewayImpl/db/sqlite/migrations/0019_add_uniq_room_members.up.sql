@@ -0,0 +1,6 @@
+-- 同じ部屋に同じユーザーが重複して参加しないよう、重複を取り除いてから一意にする
+DELETE FROM room_members
+WHERE rowid NOT IN (SELECT MIN(rowid) FROM room_members GROUP BY room_id, user_id);
+
+DROP INDEX IF EXISTS idx_room_members_room_id_user_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_room_members_room_id_user_id ON room_members(room_id, user_id);
@@ -0,0 +1,2 @@
+-- UTC に揃えた日時は元のオフセットに戻さない
+SELECT 1;
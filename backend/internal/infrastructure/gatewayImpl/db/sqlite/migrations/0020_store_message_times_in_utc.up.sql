@@ -0,0 +1,25 @@
+-- SQLite は日時を文字列として比較するため、ローカル時刻で保存されていたメッセージの日時を UTC に揃える
+-- "2006-01-02 15:04:05.999999999-07:00" の形式のうち、UTC 以外のオフセットのものだけを変換する（秒未満はそのまま残す）
+UPDATE messages SET sent_at =
+    strftime('%Y-%m-%d %H:%M:%S', sent_at)
+    || CASE WHEN substr(sent_at, 20, 1) = '.' THEN substr(sent_at, 20, length(sent_at) - 25) ELSE '' END
+    || '+00:00'
+WHERE sent_at GLOB '*[+-][0-9][0-9]:[0-9][0-9]' AND substr(sent_at, -6) <> '+00:00';
+
+UPDATE messages SET edited_at =
+    strftime('%Y-%m-%d %H:%M:%S', edited_at)
+    || CASE WHEN substr(edited_at, 20, 1) = '.' THEN substr(edited_at, 20, length(edited_at) - 25) ELSE '' END
+    || '+00:00'
+WHERE edited_at GLOB '*[+-][0-9][0-9]:[0-9][0-9]' AND substr(edited_at, -6) <> '+00:00';
+
+UPDATE messages SET deleted_at =
+    strftime('%Y-%m-%d %H:%M:%S', deleted_at)
+    || CASE WHEN substr(deleted_at, 20, 1) = '.' THEN substr(deleted_at, 20, length(deleted_at) - 25) ELSE '' END
+    || '+00:00'
+WHERE deleted_at GLOB '*[+-][0-9][0-9]:[0-9][0-9]' AND substr(deleted_at, -6) <> '+00:00';
+
+UPDATE message_revisions SET edited_at =
+    strftime('%Y-%m-%d %H:%M:%S', edited_at)
+    || CASE WHEN substr(edited_at, 20, 1) = '.' THEN substr(edited_at, 20, length(edited_at) - 25) ELSE '' END
+    || '+00:00'
+WHERE edited_at GLOB '*[+-][0-9][0-9]:[0-9][0-9]' AND substr(edited_at, -6) <> '+00:00';
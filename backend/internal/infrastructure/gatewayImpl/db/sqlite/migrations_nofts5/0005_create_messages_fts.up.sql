@@ -0,0 +1,4 @@
+-- FTS5 なしでビルドした場合に migrations/0005_create_messages_fts.up.sql の代わりに使うマイグレーション
+-- mattn/go-sqlite3 は `-tags sqlite_fts5` を付けてビルドした場合のみ FTS5 が有効になるため、
+-- 全文検索用のインデックスは作成せず、検索は messages への LIKE で行う
+SELECT 1;
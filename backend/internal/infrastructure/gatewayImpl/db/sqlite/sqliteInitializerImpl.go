@@ -7,7 +7,7 @@ import (
 	"example.com/infrahandson/internal/interface/gateway"
 	"github.com/golang-migrate/migrate/v4"
 	sqlite3 "github.com/golang-migrate/migrate/v4/database/sqlite3"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3" // SQLite driver
 )
@@ -62,10 +62,19 @@ func (i *SQLiteInitializerImpl) InitSchema(db *sqlx.DB) error {
 		return err
 	}
 
-	path := "file://" + i.migrationsPath
+	// 全文検索用のインデックスを作成するマイグレーションは、FTS5 を使うかどうかで差し替える
+	migrations, err := migrationsFS(i.migrationsPath)
+	if err != nil {
+		return err
+	}
+	source, err := iofs.New(migrations, ".")
+	if err != nil {
+		return err
+	}
 
-	m, err := migrate.NewWithDatabaseInstance(
-		path,
+	m, err := migrate.NewWithInstance(
+		"iofs",
+		source,
 		"sqlite3",
 		driver,
 	)
@@ -78,5 +87,8 @@ func (i *SQLiteInitializerImpl) InitSchema(db *sqlx.DB) error {
 		return err
 	}
 
+	if !MessageSearchEnabled {
+		log.Printf("SQLite FTS5 is not available; message search falls back to LIKE (build with -tags sqlite_fts5 to enable it)\n")
+	}
 	return nil
 }
@@ -1,6 +1,8 @@
 package routes
 
 import (
+	"strconv"
+
 	"example.com/infrahandson/config"
 	"example.com/infrahandson/internal/interface/handler"
 	"example.com/infrahandson/internal/interface/handler/messagehandler"
@@ -8,6 +10,7 @@ import (
 	"example.com/infrahandson/internal/interface/handler/userhandler"
 	"example.com/infrahandson/internal/interface/handler/websockethandler"
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
 )
 
 func SetupRoutes(
@@ -18,8 +21,12 @@ func SetupRoutes(
 ) {
 	userGroup := e.Group("/api/user")
 	RegisterUserRoutes(userGroup, handler.UserHandler, AuthMiddleware)
+	RegisterMentionRoutes(userGroup, handler.MsgHandler, AuthMiddleware)
 	roomGroup := e.Group("/api/room", AuthMiddleware)
 	RegisterRoomRoutes(roomGroup, handler.RoomHandler)
+	RegisterPinRoutes(roomGroup, handler.MsgHandler)
+	RegisterReadRoutes(roomGroup, handler.MsgHandler)
+	RegisterAttachmentRoutes(roomGroup, handler.MsgHandler, cfg.AttachmentMaxSize)
 	wsGroup := e.Group("/api/ws", AuthMiddleware)
 	RegisterWsRoutes(wsGroup, handler.WsHandler)
 	msgGroup := e.Group("/api/message", AuthMiddleware)
@@ -34,14 +41,61 @@ func RegisterUserRoutes(g *echo.Group, h userhandler.UserHandlerInterface, authM
 	g.POST("/icon", h.SaveUserIcon, authMiddleware)
 	g.GET("/me", h.GetMe, authMiddleware)
 	g.GET("/icon/:user_id", h.GetUserIcon)
+	g.GET("/presence", h.GetPresences, authMiddleware)
+	g.GET("/:user_id/presence", h.GetPresence, authMiddleware)
+}
+
+// RegisterMentionRoutes は自分宛てのメンションのルートを登録する
+func RegisterMentionRoutes(g *echo.Group, h messagehandler.MessageHandlerInterface, authMiddleware echo.MiddlewareFunc) {
+	g.GET("/me/mentions", h.GetMentions, authMiddleware)
 }
 
 func RegisterRoomRoutes(g *echo.Group, h roomhandler.RoomHandlerInterface) {
 	g.POST("", h.CreateRoom)
 	g.POST("/:room_id/join", h.JoinRoom)
+	g.POST("/join/:token", h.RedeemInvite)
+	g.POST("/:room_id/invites", h.CreateInvite)
+	g.POST("/:room_id/join-requests", h.RequestToJoin)
+	g.GET("/:room_id/join-requests", h.GetJoinRequests)
+	g.POST("/:room_id/join-requests/:user_id/approve", h.ApproveJoinRequest)
+	g.POST("/:room_id/join-requests/:user_id/deny", h.DenyJoinRequest)
 	g.POST("/:room_id/leave", h.LeaveRoom)
 	g.GET("/:room_id", h.GetRoomByID)
+	g.PATCH("/:room_id", h.UpdateRoom)
+	g.DELETE("/:room_id", h.DeleteRoom)
+	g.POST("/:room_id/archive", h.ArchiveRoom)
+	g.DELETE("/:room_id/archive", h.UnarchiveRoom)
+	g.GET("/:room_id/icon", h.GetRoomIcon)
+	g.GET("/:room_id/members", h.GetRoomMembers)
+	g.POST("/:room_id/members/:user_id/kick", h.KickMember)
+	g.POST("/:room_id/members/:user_id/ban", h.BanMember)
+	g.DELETE("/:room_id/members/:user_id/ban", h.UnbanMember)
+	g.POST("/:room_id/members/:user_id/mute", h.MuteMember)
+	g.DELETE("/:room_id/members/:user_id/mute", h.UnmuteMember)
 	g.GET("", h.GetRooms)
+	g.GET("/dm", h.GetDirectRooms)
+	g.POST("/dm/:user_id", h.OpenDirectRoom)
+}
+
+// RegisterPinRoutes は部屋のピン留めのルートを登録する
+func RegisterPinRoutes(g *echo.Group, h messagehandler.MessageHandlerInterface) {
+	g.GET("/:room_id/pins", h.GetPinnedMessages)
+	g.POST("/:room_id/pins/:message_id", h.PinMessage)
+	g.DELETE("/:room_id/pins/:message_id", h.UnpinMessage)
+}
+
+// RegisterReadRoutes は部屋の既読のルートを登録する
+func RegisterReadRoutes(g *echo.Group, h messagehandler.MessageHandlerInterface) {
+	g.PUT("/:room_id/read", h.MarkAsRead)
+	g.GET("/:room_id/read-receipts", h.GetReadReceipts)
+}
+
+// RegisterAttachmentRoutes はメッセージの添付ファイルのルートを登録する
+// アップロードは添付ファイルの上限に multipart の区切り等の分を加えたサイズまで受け付ける
+func RegisterAttachmentRoutes(g *echo.Group, h messagehandler.MessageHandlerInterface, maxSize int64) {
+	g.POST("/:room_id/attachments", h.UploadAttachment, middleware.BodyLimit(strconv.FormatInt(maxSize+1<<20, 10)))
+	g.GET("/:room_id/attachments/:attachment_id", h.GetAttachment)
+	g.GET("/:room_id/attachments/:attachment_id/thumbnail", h.GetAttachmentThumbnail)
 }
 
 func RegisterWsRoutes(g *echo.Group, h websockethandler.WebSocketHandlerInterface) {
@@ -50,4 +104,11 @@ func RegisterWsRoutes(g *echo.Group, h websockethandler.WebSocketHandlerInterfac
 
 func RegisterMsgRoutes(g *echo.Group, h messagehandler.MessageHandlerInterface) {
 	g.GET("/:room_id", h.GetRoomMessage)
+	g.GET("/search", h.SearchMessages)
+	g.PATCH("/:room_id/:message_id", h.EditMessage)
+	g.DELETE("/:room_id/:message_id", h.DeleteMessage)
+	g.GET("/:room_id/:message_id/revisions", h.GetMessageRevisions)
+	g.GET("/:room_id/:message_id/thread", h.GetThread)
+	g.PUT("/:room_id/:message_id/reactions/:emoji", h.AddReaction)
+	g.DELETE("/:room_id/:message_id/reactions/:emoji", h.RemoveReaction)
 }
@@ -0,0 +1,167 @@
+package mysqlattachmentrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+const attachmentColumns = `
+	BIN_TO_UUID(id) AS id,
+	BIN_TO_UUID(room_id) AS room_id,
+	BIN_TO_UUID(uploader_id) AS uploader_id,
+	BIN_TO_UUID(message_id) AS message_id,
+	file_name, mime_type, size, width, height, has_thumbnail, created_at`
+
+type AttachmentRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewAttachmentRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewAttachmentRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewAttachmentRepositoryImpl(params *NewAttachmentRepositoryImplParams) repository.AttachmentRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &AttachmentRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *AttachmentRepositoryImpl) CreateAttachment(ctx context.Context, attachment *entity.Attachment) error {
+	if attachment == nil {
+		return errors.New("attachment cannot be nil")
+	}
+	var m model.AttachmentModel
+	if err := m.FromEntity(attachment); err != nil {
+		return err
+	}
+	var messageID any
+	if m.MessageID.Valid {
+		messageID = m.MessageID.UUID
+	}
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO attachments (id, room_id, uploader_id, message_id, file_name, mime_type, size, width, height, has_thumbnail, created_at)
+		VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), UUID_TO_BIN(?), UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?)`,
+		m.ID, m.RoomID, m.UploaderID, messageID,
+		m.FileName, m.MimeType, m.Size, m.Width, m.Height, m.HasThumbnail, m.CreatedAt)
+	return err
+}
+
+func (r *AttachmentRepositoryImpl) GetAttachmentByID(ctx context.Context, id entity.AttachmentID) (*entity.Attachment, error) {
+	idUUID, err := id.AttachmentID2UUID()
+	if err != nil {
+		return nil, err
+	}
+	var m model.AttachmentModel
+	err = r.db.GetContext(ctx, &m, "SELECT "+attachmentColumns+" FROM attachments WHERE id = UUID_TO_BIN(?)", idUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.ToEntity(), nil
+}
+
+func (r *AttachmentRepositoryImpl) GetAttachmentsByIDs(ctx context.Context, ids []entity.AttachmentID) ([]*entity.Attachment, error) {
+	if len(ids) == 0 {
+		return []*entity.Attachment{}, nil
+	}
+	placeholders, args, err := attachmentIDArgs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []model.AttachmentModel
+	err = r.db.SelectContext(ctx, &models, "SELECT "+attachmentColumns+" FROM attachments WHERE id IN ("+placeholders+")", args...)
+	if err != nil {
+		return nil, err
+	}
+	attachments := make([]*entity.Attachment, len(models))
+	for i := range models {
+		attachments[i] = models[i].ToEntity()
+	}
+	return attachments, nil
+}
+
+func (r *AttachmentRepositoryImpl) GetAttachmentIDsByRoomID(ctx context.Context, roomID entity.RoomID) ([]entity.AttachmentID, error) {
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	err = r.db.SelectContext(ctx, &ids, "SELECT BIN_TO_UUID(id) FROM attachments WHERE room_id = UUID_TO_BIN(?)", roomIDUUID)
+	if err != nil {
+		return nil, err
+	}
+	attachmentIDs := make([]entity.AttachmentID, len(ids))
+	for i, id := range ids {
+		attachmentIDs[i] = entity.AttachmentID(id)
+	}
+	return attachmentIDs, nil
+}
+
+func (r *AttachmentRepositoryImpl) GetAttachmentsByMessageIDs(
+	ctx context.Context,
+	messageIDs []entity.MessageID,
+) (map[entity.MessageID][]*entity.Attachment, error) {
+	attachments := make(map[entity.MessageID][]*entity.Attachment)
+	if len(messageIDs) == 0 {
+		return attachments, nil
+	}
+
+	// IN句の各要素を UUID_TO_BIN で変換する
+	placeholders := strings.TrimSuffix(strings.Repeat("UUID_TO_BIN(?),", len(messageIDs)), ",")
+	args := make([]any, len(messageIDs))
+	for i := range messageIDs {
+		id, err := messageIDs[i].MessageID2UUID()
+		if err != nil {
+			return nil, err
+		}
+		args[i] = id
+	}
+
+	var models []model.AttachmentModel
+	err := r.db.SelectContext(ctx, &models, `
+		SELECT `+attachmentColumns+` FROM attachments
+		WHERE message_id IN (`+placeholders+`)
+		ORDER BY created_at ASC, id ASC`, args...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range models {
+		attachment := models[i].ToEntity()
+		attachments[attachment.GetMessageID()] = append(attachments[attachment.GetMessageID()], attachment)
+	}
+	return attachments, nil
+}
+
+// attachmentIDArgs は IN句の各要素を UUID_TO_BIN で変換するプレースホルダーと引数を返す
+func attachmentIDArgs(ids []entity.AttachmentID) (string, []any, error) {
+	placeholders := strings.TrimSuffix(strings.Repeat("UUID_TO_BIN(?),", len(ids)), ",")
+	args := make([]any, len(ids))
+	for i := range ids {
+		id, err := ids[i].AttachmentID2UUID()
+		if err != nil {
+			return "", nil, err
+		}
+		args[i] = id
+	}
+	return placeholders, args, nil
+}
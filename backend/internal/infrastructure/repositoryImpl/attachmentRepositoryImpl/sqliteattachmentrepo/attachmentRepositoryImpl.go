@@ -0,0 +1,133 @@
+package sqliteattachmentrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+const attachmentColumns = "id, room_id, uploader_id, message_id, file_name, mime_type, size, width, height, has_thumbnail, created_at"
+
+type AttachmentRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewAttachmentRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewAttachmentRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewAttachmentRepositoryImpl(params *NewAttachmentRepositoryImplParams) repository.AttachmentRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &AttachmentRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *AttachmentRepositoryImpl) CreateAttachment(ctx context.Context, attachment *entity.Attachment) error {
+	if attachment == nil {
+		return errors.New("attachment cannot be nil")
+	}
+	var m model.AttachmentModel
+	if err := m.FromEntity(attachment); err != nil {
+		return err
+	}
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO attachments (`+attachmentColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		m.ID.String(), m.RoomID.String(), m.UploaderID.String(), m.MessageID,
+		m.FileName, m.MimeType, m.Size, m.Width, m.Height, m.HasThumbnail, m.CreatedAt)
+	return err
+}
+
+func (r *AttachmentRepositoryImpl) GetAttachmentByID(ctx context.Context, id entity.AttachmentID) (*entity.Attachment, error) {
+	var m model.AttachmentModel
+	err := r.db.GetContext(ctx, &m, "SELECT "+attachmentColumns+" FROM attachments WHERE id = ?", string(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.ToEntity(), nil
+}
+
+func (r *AttachmentRepositoryImpl) GetAttachmentsByIDs(ctx context.Context, ids []entity.AttachmentID) ([]*entity.Attachment, error) {
+	if len(ids) == 0 {
+		return []*entity.Attachment{}, nil
+	}
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = string(id)
+	}
+	query, args, err := sqlx.In("SELECT "+attachmentColumns+" FROM attachments WHERE id IN (?)", idStrs)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []model.AttachmentModel
+	if err := r.db.SelectContext(ctx, &models, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	attachments := make([]*entity.Attachment, len(models))
+	for i := range models {
+		attachments[i] = models[i].ToEntity()
+	}
+	return attachments, nil
+}
+
+func (r *AttachmentRepositoryImpl) GetAttachmentIDsByRoomID(ctx context.Context, roomID entity.RoomID) ([]entity.AttachmentID, error) {
+	var ids []string
+	if err := r.db.SelectContext(ctx, &ids, "SELECT id FROM attachments WHERE room_id = ?", string(roomID)); err != nil {
+		return nil, err
+	}
+	attachmentIDs := make([]entity.AttachmentID, len(ids))
+	for i, id := range ids {
+		attachmentIDs[i] = entity.AttachmentID(id)
+	}
+	return attachmentIDs, nil
+}
+
+func (r *AttachmentRepositoryImpl) GetAttachmentsByMessageIDs(
+	ctx context.Context,
+	messageIDs []entity.MessageID,
+) (map[entity.MessageID][]*entity.Attachment, error) {
+	attachments := make(map[entity.MessageID][]*entity.Attachment)
+	if len(messageIDs) == 0 {
+		return attachments, nil
+	}
+	ids := make([]string, len(messageIDs))
+	for i, id := range messageIDs {
+		ids[i] = string(id)
+	}
+	query, args, err := sqlx.In(`
+		SELECT `+attachmentColumns+` FROM attachments
+		WHERE message_id IN (?)
+		ORDER BY created_at ASC, id ASC`, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []model.AttachmentModel
+	if err := r.db.SelectContext(ctx, &models, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	for i := range models {
+		attachment := models[i].ToEntity()
+		attachments[attachment.GetMessageID()] = append(attachments[attachment.GetMessageID()], attachment)
+	}
+	return attachments, nil
+}
@@ -0,0 +1,139 @@
+package sqliteattachmentrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/attachmentRepositoryImpl/sqliteattachmentrepo"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // SQLiteドライバ
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoomID      = "0f8e7d6c-5b4a-4392-8170-6f5e4d3c2b1a"
+	testUserID      = "c4d2e1f0-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+	testMessageID   = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	testMessageID2  = "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+	testImageID     = "5e6f7a8b-9c0d-4e1f-8a2b-4c5d6e7f8091"
+	testTextID      = "6f7a8b9c-0d1e-4f2a-9b3c-5d6e7f809102"
+	testOtherID     = "7a8b9c0d-1e2f-4a3b-8c4d-6e7f80910213"
+	testNotExistsID = "8b9c0d1e-2f3a-4b4c-9d5e-7f8091021324"
+	testOtherRoomID = "9c0d1e2f-3a4b-4c5d-8e6f-809102132435"
+	testOtherFileID = "0d1e2f3a-4b5c-4d6e-9f70-910213243546"
+)
+
+var base = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+func setupTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+CREATE TABLE attachments (
+	id            TEXT PRIMARY KEY,
+	room_id       TEXT NOT NULL,
+	uploader_id   TEXT NOT NULL,
+	message_id    TEXT,
+	file_name     TEXT NOT NULL,
+	mime_type     TEXT NOT NULL,
+	size          INTEGER NOT NULL,
+	width         INTEGER NOT NULL DEFAULT 0,
+	height        INTEGER NOT NULL DEFAULT 0,
+	has_thumbnail BOOLEAN NOT NULL DEFAULT 0,
+	created_at    DATETIME NOT NULL
+);`)
+	require.NoError(t, err)
+	return db
+}
+
+func newAttachment(id string, fileName string, createdAt time.Time) *entity.Attachment {
+	params := entity.AttachmentParams{
+		ID:         entity.AttachmentID(id),
+		RoomID:     testRoomID,
+		UploaderID: testUserID,
+		FileName:   fileName,
+		MimeType:   "text/plain",
+		Size:       5,
+		CreatedAt:  createdAt,
+	}
+	if fileName == "photo.png" {
+		params.MimeType = "image/png"
+		params.Width, params.Height, params.HasThumbnail = 640, 480, true
+	}
+	return entity.NewAttachment(params)
+}
+
+func TestAttachmentRepositoryImpl(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqliteattachmentrepo.NewAttachmentRepositoryImpl(&sqliteattachmentrepo.NewAttachmentRepositoryImplParams{DB: db})
+
+	// 添付する順とアップロードした順を逆にする
+	require.NoError(t, repo.CreateAttachment(ctx, newAttachment(testTextID, "memo.txt", base.Add(time.Minute))))
+	require.NoError(t, repo.CreateAttachment(ctx, newAttachment(testImageID, "photo.png", base)))
+	require.NoError(t, repo.CreateAttachment(ctx, newAttachment(testOtherID, "other.txt", base)))
+
+	t.Run("GetAttachmentByID", func(t *testing.T) {
+		got, err := repo.GetAttachmentByID(ctx, testImageID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.RoomID(testRoomID), got.GetRoomID())
+		assert.Equal(t, entity.UserID(testUserID), got.GetUploaderID())
+		assert.False(t, got.IsAttached())
+		assert.Equal(t, "photo.png", got.GetFileName())
+		assert.Equal(t, "image/png", got.GetMimeType())
+		assert.Equal(t, 640, got.GetWidth())
+		assert.Equal(t, 480, got.GetHeight())
+		assert.True(t, got.HasThumbnail())
+		assert.True(t, base.Equal(got.GetCreatedAt()))
+
+		_, err = repo.GetAttachmentByID(ctx, testNotExistsID)
+		assert.ErrorIs(t, err, repository.ErrAttachmentNotFound)
+	})
+
+	t.Run("GetAttachmentsByIDs は存在しないIDを含めない", func(t *testing.T) {
+		got, err := repo.GetAttachmentsByIDs(ctx, []entity.AttachmentID{testImageID, testNotExistsID, testTextID})
+		require.NoError(t, err)
+		ids := make([]entity.AttachmentID, len(got))
+		for i, a := range got {
+			ids[i] = a.GetID()
+		}
+		assert.ElementsMatch(t, []entity.AttachmentID{testImageID, testTextID}, ids)
+	})
+
+	t.Run("GetAttachmentsByMessageIDs はアップロードした順に返す", func(t *testing.T) {
+		// 添付は MessageRepository.CreateMessageWithMentions で行うため、直接添付済みにする
+		_, err := db.Exec(`UPDATE attachments SET message_id = ? WHERE id IN (?, ?)`, testMessageID, testTextID, testImageID)
+		require.NoError(t, err)
+
+		got, err := repo.GetAttachmentsByMessageIDs(ctx, []entity.MessageID{testMessageID, testMessageID2})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Len(t, got[testMessageID], 2)
+		assert.Equal(t, entity.AttachmentID(testImageID), got[testMessageID][0].GetID())
+		assert.Equal(t, entity.AttachmentID(testTextID), got[testMessageID][1].GetID())
+	})
+
+	t.Run("GetAttachmentIDsByRoomID はメッセージに添付していないファイルも含める", func(t *testing.T) {
+		// 別の部屋のファイルは含めない
+		other := entity.NewAttachment(entity.AttachmentParams{
+			ID:         testOtherFileID,
+			RoomID:     testOtherRoomID,
+			UploaderID: testUserID,
+			FileName:   "other-room.txt",
+			MimeType:   "text/plain",
+			Size:       5,
+			CreatedAt:  base,
+		})
+		require.NoError(t, repo.CreateAttachment(ctx, other))
+
+		ids, err := repo.GetAttachmentIDsByRoomID(ctx, testRoomID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []entity.AttachmentID{testImageID, testTextID, testOtherID}, ids)
+	})
+}
@@ -0,0 +1,76 @@
+package sqlitementionrepo
+
+import (
+	"context"
+	"errors"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type MentionRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewMentionRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewMentionRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewMentionRepositoryImpl(params *NewMentionRepositoryImplParams) repository.MentionRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &MentionRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *MentionRepositoryImpl) ListMentions(ctx context.Context, q repository.MentionListQuery) ([]*entity.MentionedMessage, bool, error) {
+	// 既読位置は送信日時（同じ場合はメッセージID）の順で比較する
+	// 送信日時と既読位置はどちらも UTC の文字列で保存されているため、比較する日時も UTC で渡す
+	query := `
+		SELECT m.id, m.room_id, m.user_id, m.parent_id, m.content, m.sent_at, m.edited_at, m.deleted_at,
+			mm.user_id AS mentioned_user_id, mm.kind, mm.created_at,
+			CASE WHEN rs.last_read_at IS NOT NULL
+			      AND (m.sent_at < rs.last_read_at OR (m.sent_at = rs.last_read_at AND m.id <= rs.last_read_message_id))
+			     THEN 1 ELSE 0 END AS is_read
+		FROM message_mentions mm
+		JOIN messages m ON m.id = mm.message_id
+		JOIN (SELECT DISTINCT room_id FROM room_members WHERE user_id = ?) rm ON rm.room_id = mm.room_id
+		LEFT JOIN room_read_states rs ON rs.room_id = mm.room_id AND rs.user_id = mm.user_id
+		WHERE mm.user_id = ? AND m.deleted_at IS NULL AND m.sent_at < ?`
+	if q.UnreadOnly {
+		query += `
+		  AND (rs.last_read_at IS NULL OR m.sent_at > rs.last_read_at
+		       OR (m.sent_at = rs.last_read_at AND m.id > rs.last_read_message_id))`
+	}
+	// 次のページの有無を判定するため1件多く取得する
+	query += `
+		ORDER BY m.sent_at DESC, m.id DESC
+		LIMIT ?`
+
+	var rows []model.MentionedMessageModel
+	err := r.db.SelectContext(ctx, &rows, query, q.UserID, q.UserID, q.BeforeSentAt.UTC(), q.Limit+1)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasNext := len(rows) > q.Limit
+	if hasNext {
+		rows = rows[:q.Limit]
+	}
+	mentions := make([]*entity.MentionedMessage, len(rows))
+	for i := range rows {
+		mentions[i] = rows[i].ToEntity()
+	}
+	return mentions, hasNext, nil
+}
@@ -0,0 +1,245 @@
+package sqlitementionrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/mentionRepositoryImpl/sqlitementionrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/messageRepositoryImpl/sqlitemsgrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomReadStateRepositoryImpl/sqlitereadstaterepo"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // SQLiteドライバ
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoomID     = "0f8e7d6c-5b4a-4392-8170-6f5e4d3c2b1a"
+	testLeftRoomID = "7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d"
+	testUserID     = "c4d2e1f0-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+	testAuthorID   = "e6f4a3b2-7c8d-4e9f-8a0b-2c3d4e5f6a71"
+	testMessageID  = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	testMessageID2 = "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+	testDeletedID  = "3c4d5e6f-7a8b-4c9d-8e1f-2a3b4c5d6e7f"
+	testLeftMsgID  = "4d5e6f7a-8b9c-4d0e-9f1a-3b4c5d6e7f80"
+)
+
+var base = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+func setupTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+CREATE TABLE room_members (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL
+);
+CREATE TABLE messages (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	parent_id TEXT,
+	content TEXT NOT NULL,
+	sent_at DATETIME NOT NULL,
+	edited_at DATETIME,
+	deleted_at DATETIME
+);
+CREATE TABLE room_read_states (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	last_read_message_id TEXT NOT NULL,
+	last_read_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	PRIMARY KEY (room_id, user_id)
+);
+CREATE TABLE message_mentions (
+	message_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	room_id TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	PRIMARY KEY (message_id, user_id)
+);`)
+	require.NoError(t, err)
+
+	// testUserID は testLeftRoomID から退出済み
+	_, err = db.Exec(`INSERT INTO room_members (id, room_id, user_id) VALUES ('m1', ?, ?), ('m2', ?, ?), ('m3', ?, ?)`,
+		testRoomID, testUserID, testRoomID, testAuthorID, testLeftRoomID, testAuthorID)
+	require.NoError(t, err)
+
+	messages := []struct {
+		id, roomID string
+		sentAt     time.Time
+		deleted    bool
+	}{
+		{testMessageID, testRoomID, base, false},
+		{testMessageID2, testRoomID, base.Add(time.Minute), false},
+		{testDeletedID, testRoomID, base.Add(2 * time.Minute), true},
+		{testLeftMsgID, testLeftRoomID, base.Add(3 * time.Minute), false},
+	}
+	for _, m := range messages {
+		var deletedAt *time.Time
+		if m.deleted {
+			deletedAt = &m.sentAt
+		}
+		_, err = db.Exec(`INSERT INTO messages (id, room_id, user_id, content, sent_at, deleted_at) VALUES (?, ?, ?, ?, ?, ?)`,
+			m.id, m.roomID, testAuthorID, "@user hi", m.sentAt, deletedAt)
+		require.NoError(t, err)
+	}
+
+	// 最初のメッセージまで既読
+	_, err = db.Exec(`INSERT INTO room_read_states (room_id, user_id, last_read_message_id, last_read_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		testRoomID, testUserID, testMessageID, base, base)
+	require.NoError(t, err)
+
+	return db
+}
+
+func newMention(messageID, roomID string, kind entity.MentionKind, createdAt time.Time) *entity.Mention {
+	return entity.NewMention(entity.MentionParams{
+		MessageID: entity.MessageID(messageID),
+		RoomID:    entity.RoomID(roomID),
+		UserID:    testUserID,
+		Kind:      kind,
+		CreatedAt: createdAt,
+	})
+}
+
+// insertMentions はメンションを直接保存する（メッセージと一緒の保存は sqlitemsgrepo で確認する）
+func insertMentions(t *testing.T, db *sqlx.DB, mentions ...*entity.Mention) {
+	for _, mention := range mentions {
+		_, err := db.Exec(`INSERT INTO message_mentions (message_id, user_id, room_id, kind, created_at) VALUES (?, ?, ?, ?, ?)`,
+			string(mention.GetMessageID()), string(mention.GetUserID()), string(mention.GetRoomID()), string(mention.GetKind()), mention.GetCreatedAt().UTC())
+		require.NoError(t, err)
+	}
+}
+
+func TestMentionRepositoryImpl_ListMentions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitementionrepo.NewMentionRepositoryImpl(&sqlitementionrepo.NewMentionRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	insertMentions(t, db,
+		newMention(testMessageID, testRoomID, entity.MentionKindUser, base),
+		newMention(testMessageID2, testRoomID, entity.MentionKindRoom, base.Add(time.Minute)),
+		newMention(testDeletedID, testRoomID, entity.MentionKindUser, base.Add(2*time.Minute)),
+		newMention(testLeftMsgID, testLeftRoomID, entity.MentionKindHere, base.Add(3*time.Minute)),
+	)
+
+	// 削除されたメッセージと退出した部屋のメンションは含まない
+	mentions, hasNext, err := repo.ListMentions(ctx, repository.MentionListQuery{
+		UserID:       testUserID,
+		BeforeSentAt: base.Add(time.Hour),
+		Limit:        10,
+	})
+	require.NoError(t, err)
+	assert.False(t, hasNext)
+	require.Len(t, mentions, 2)
+	assert.Equal(t, entity.MessageID(testMessageID2), mentions[0].GetMessage().GetID())
+	assert.Equal(t, entity.MentionKindRoom, mentions[0].GetMention().GetKind())
+	assert.False(t, mentions[0].IsRead())
+	assert.Equal(t, entity.MessageID(testMessageID), mentions[1].GetMessage().GetID())
+	assert.Equal(t, entity.MentionKindUser, mentions[1].GetMention().GetKind())
+	assert.Equal(t, entity.UserID(testUserID), mentions[1].GetMention().GetUserID())
+	assert.True(t, mentions[1].IsRead())
+
+	// 未読のみ
+	mentions, _, err = repo.ListMentions(ctx, repository.MentionListQuery{
+		UserID:       testUserID,
+		UnreadOnly:   true,
+		BeforeSentAt: base.Add(time.Hour),
+		Limit:        10,
+	})
+	require.NoError(t, err)
+	require.Len(t, mentions, 1)
+	assert.Equal(t, entity.MessageID(testMessageID2), mentions[0].GetMessage().GetID())
+
+	// ページング
+	mentions, hasNext, err = repo.ListMentions(ctx, repository.MentionListQuery{
+		UserID:       testUserID,
+		BeforeSentAt: base.Add(time.Hour),
+		Limit:        1,
+	})
+	require.NoError(t, err)
+	assert.True(t, hasNext)
+	require.Len(t, mentions, 1)
+	mentions, hasNext, err = repo.ListMentions(ctx, repository.MentionListQuery{
+		UserID:       testUserID,
+		BeforeSentAt: mentions[0].GetMessage().GetSentAt(),
+		Limit:        1,
+	})
+	require.NoError(t, err)
+	assert.False(t, hasNext)
+	require.Len(t, mentions, 1)
+	assert.Equal(t, entity.MessageID(testMessageID), mentions[0].GetMessage().GetID())
+}
+
+func TestMentionRepositoryImpl_ListMentions_LocalTime(t *testing.T) {
+	// 送信日時・既読位置・取得する範囲はローカル時刻（time.Now()）で渡されるため、UTC 以外のタイムゾーンで確認する
+	local := time.Local
+	time.Local = time.FixedZone("JST", 9*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitementionrepo.NewMentionRepositoryImpl(&sqlitementionrepo.NewMentionRepositoryImplParams{DB: db})
+	msgRepo := sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{DB: db})
+	readStateRepo := sqlitereadstaterepo.NewRoomReadStateRepositoryImpl(&sqlitereadstaterepo.NewRoomReadStateRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	now := time.Now()
+
+	// 3件のメンションのうち、2件目まで既読にする
+	var messages []*entity.Message
+	for i, id := range []entity.MessageID{
+		"00000000-0000-4000-8000-000000000001",
+		"00000000-0000-4000-8000-000000000002",
+		"00000000-0000-4000-8000-000000000003",
+	} {
+		msg := entity.NewMessage(entity.MessageParams{
+			ID:      id,
+			RoomID:  testRoomID,
+			UserID:  testAuthorID,
+			Content: "@user hi",
+			SentAt:  now.Add(time.Duration(i-3) * time.Minute),
+		})
+		require.NoError(t, msgRepo.CreateMessageWithMentions(ctx, msg, []*entity.Mention{
+			newMention(string(id), testRoomID, entity.MentionKindUser, msg.GetSentAt()),
+		}))
+		messages = append(messages, msg)
+	}
+	_, err := readStateRepo.SaveReadState(ctx, entity.NewRoomReadState(entity.RoomReadStateParams{
+		RoomID:            testRoomID,
+		UserID:            testUserID,
+		LastReadMessageID: messages[1].GetID(),
+		LastReadAt:        messages[1].GetSentAt(),
+		UpdatedAt:         now,
+	}))
+	require.NoError(t, err)
+
+	mentions, _, err := repo.ListMentions(ctx, repository.MentionListQuery{
+		UserID:       testUserID,
+		UnreadOnly:   true,
+		BeforeSentAt: now,
+		Limit:        10,
+	})
+	require.NoError(t, err)
+	require.Len(t, mentions, 1)
+	assert.Equal(t, messages[2].GetID(), mentions[0].GetMessage().GetID())
+
+	// 2件目より前に送信されたメンションのみ
+	mentions, _, err = repo.ListMentions(ctx, repository.MentionListQuery{
+		UserID:       testUserID,
+		BeforeSentAt: messages[1].GetSentAt(),
+		Limit:        10,
+	})
+	require.NoError(t, err)
+	require.Len(t, mentions, 1)
+	assert.Equal(t, messages[0].GetID(), mentions[0].GetMessage().GetID())
+	assert.True(t, mentions[0].IsRead())
+}
@@ -2,12 +2,15 @@ package mysqlmsgrepo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"strings"
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -36,6 +39,82 @@ func NewMessageRepositoryImpl(params *NewMessageRepositoryImplParams) repository
 }
 
 func (r *MessageRepositoryImpl) CreateMessage(ctx context.Context, message *entity.Message) error {
+	return insertMessage(ctx, r.db, message)
+}
+
+func (r *MessageRepositoryImpl) CreateMessageWithMentions(ctx context.Context, message *entity.Message, mentions []*entity.Mention) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertMessage(ctx, tx, message); err != nil {
+		return err
+	}
+	if err := attachToMessage(ctx, tx, message); err != nil {
+		return err
+	}
+	for _, mention := range mentions {
+		if mention == nil {
+			return errors.New("mention cannot be nil")
+		}
+		var m model.MentionModel
+		if err := m.FromEntity(mention); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO message_mentions (message_id, user_id, room_id, kind, created_at)
+			VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), UUID_TO_BIN(?), ?, ?)`,
+			m.MessageID, m.UserID, m.RoomID, m.Kind, m.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// attachToMessage はメッセージの添付ファイルを添付済みにする
+// 1件でも添付済み（または存在しない）場合は repository.ErrAttachmentAlreadyAttached を返す
+func attachToMessage(ctx context.Context, tx *sqlx.Tx, message *entity.Message) error {
+	attachments := message.GetAttachments()
+	if len(attachments) == 0 {
+		return nil
+	}
+	messageID := message.GetID()
+	messageIDUUID, err := messageID.MessageID2UUID()
+	if err != nil {
+		return err
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("UUID_TO_BIN(?),", len(attachments)), ",")
+	args := []any{messageIDUUID}
+	for _, attachment := range attachments {
+		id := attachment.GetID()
+		idUUID, err := id.AttachmentID2UUID()
+		if err != nil {
+			return err
+		}
+		args = append(args, idUUID)
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE attachments SET message_id = UUID_TO_BIN(?)
+		WHERE id IN (`+placeholders+`) AND message_id IS NULL`, args...)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n != int64(len(attachments)) {
+		return repository.ErrAttachmentAlreadyAttached
+	}
+	return nil
+}
+
+// insertMessage は messages に1件保存する（トランザクションの中でも使う）
+func insertMessage(ctx context.Context, db sqlx.ExecerContext, message *entity.Message) error {
 	var msg model.MessageModel
 	err := msg.FromEntity(message)
 	if err != nil {
@@ -43,10 +122,10 @@ func (r *MessageRepositoryImpl) CreateMessage(ctx context.Context, message *enti
 	}
 
 	// UUIDを文字列で扱い、DB側でUUID_TO_BINに変換
-	_, err = r.db.ExecContext(ctx, `
-		INSERT INTO messages (id, room_id, user_id, content, sent_at)
-		VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), UUID_TO_BIN(?), ?, ?)`,
-		msg.ID, msg.RoomID, msg.UserID, msg.Content, msg.SentAt)
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO messages (id, room_id, user_id, parent_id, content, sent_at)
+		VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), UUID_TO_BIN(?), UUID_TO_BIN(?), ?, ?)`,
+		msg.ID, msg.RoomID, msg.UserID, msg.ParentID, msg.Content, msg.SentAt)
 
 	return err
 }
@@ -64,10 +143,13 @@ func (r *MessageRepositoryImpl) GetMessageHistoryInRoom(
 			BIN_TO_UUID(id) AS id,
 			BIN_TO_UUID(room_id) AS room_id,
 			BIN_TO_UUID(user_id) AS user_id,
+			BIN_TO_UUID(parent_id) AS parent_id,
 			content,
-			sent_at
+			sent_at,
+			edited_at,
+			deleted_at
 		FROM messages
-		WHERE room_id = UUID_TO_BIN(?) AND sent_at < ?
+		WHERE room_id = UUID_TO_BIN(?) AND parent_id IS NULL AND sent_at < ?
 		ORDER BY sent_at DESC
 		LIMIT ?`
 
@@ -95,3 +177,307 @@ func (r *MessageRepositoryImpl) GetMessageHistoryInRoom(
 
 	return messages, nextBeforeSentAt, hasNext, nil
 }
+
+func (r *MessageRepositoryImpl) GetMessageByID(ctx context.Context, id entity.MessageID) (*entity.Message, error) {
+	idUUID, err := id.MessageID2UUID()
+	if err != nil {
+		return nil, err
+	}
+
+	var msgModel model.MessageModel
+	err = r.db.GetContext(ctx, &msgModel, `
+		SELECT
+			BIN_TO_UUID(id) AS id,
+			BIN_TO_UUID(room_id) AS room_id,
+			BIN_TO_UUID(user_id) AS user_id,
+			BIN_TO_UUID(parent_id) AS parent_id,
+			content,
+			sent_at,
+			edited_at,
+			deleted_at
+		FROM messages
+		WHERE id = UUID_TO_BIN(?)`, idUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return msgModel.ToEntity(), nil
+}
+
+func (r *MessageRepositoryImpl) UpdateMessage(ctx context.Context, message *entity.Message, revision *entity.MessageRevision) error {
+	var msg model.MessageModel
+	if err := msg.FromEntity(message); err != nil {
+		return err
+	}
+	var rev model.MessageRevisionModel
+	if err := rev.FromEntity(revision); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE messages SET content = ?, edited_at = ?
+		WHERE id = UUID_TO_BIN(?) AND deleted_at IS NULL`,
+		msg.Content, msg.EditedAt, msg.ID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repository.ErrMessageNotFound
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO message_revisions (message_id, content, edited_at)
+		VALUES (UUID_TO_BIN(?), ?, ?)`,
+		rev.MessageID, rev.Content, rev.EditedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *MessageRepositoryImpl) DeleteMessage(ctx context.Context, id entity.MessageID, deletedAt time.Time) error {
+	idUUID, err := id.MessageID2UUID()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE messages SET deleted_at = ?
+		WHERE id = UUID_TO_BIN(?) AND deleted_at IS NULL`,
+		deletedAt, idUUID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrMessageNotFound
+	}
+	return nil
+}
+
+func (r *MessageRepositoryImpl) GetMessageRevisions(ctx context.Context, id entity.MessageID) ([]*entity.MessageRevision, error) {
+	idUUID, err := id.MessageID2UUID()
+	if err != nil {
+		return nil, err
+	}
+
+	var revModels []model.MessageRevisionModel
+	err = r.db.SelectContext(ctx, &revModels, `
+		SELECT
+			BIN_TO_UUID(message_id) AS message_id,
+			content,
+			edited_at
+		FROM message_revisions
+		WHERE message_id = UUID_TO_BIN(?)
+		ORDER BY edited_at ASC, id ASC`, idUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*entity.MessageRevision, len(revModels))
+	for i := range revModels {
+		revisions[i] = revModels[i].ToEntity()
+	}
+	return revisions, nil
+}
+
+func (r *MessageRepositoryImpl) GetThread(
+	ctx context.Context,
+	parentID entity.MessageID,
+	limit int,
+	afterSentAt time.Time,
+) (replies []*entity.Message, nextAfterSentAt time.Time, hasNext bool, err error) {
+	parentIDUUID, err := parentID.MessageID2UUID()
+	if err != nil {
+		return nil, afterSentAt, false, err
+	}
+
+	var msgModels []model.MessageModel
+	err = r.db.SelectContext(ctx, &msgModels, `
+		SELECT
+			BIN_TO_UUID(id) AS id,
+			BIN_TO_UUID(room_id) AS room_id,
+			BIN_TO_UUID(user_id) AS user_id,
+			BIN_TO_UUID(parent_id) AS parent_id,
+			content,
+			sent_at,
+			edited_at,
+			deleted_at
+		FROM messages
+		WHERE parent_id = UUID_TO_BIN(?) AND sent_at > ?
+		ORDER BY sent_at ASC
+		LIMIT ?`, parentIDUUID, afterSentAt, limit)
+	if err != nil {
+		return nil, afterSentAt, false, err
+	}
+
+	if len(msgModels) == 0 {
+		return nil, afterSentAt, false, nil
+	}
+
+	replies = make([]*entity.Message, len(msgModels))
+	for i := range msgModels {
+		replies[i] = msgModels[i].ToEntity()
+	}
+
+	nextAfterSentAt = msgModels[len(msgModels)-1].SentAt
+	hasNext = len(msgModels) == limit
+
+	return replies, nextAfterSentAt, hasNext, nil
+}
+
+func (r *MessageRepositoryImpl) GetThreadSummaries(ctx context.Context, parentIDs []entity.MessageID) (map[entity.MessageID]*entity.ThreadSummary, error) {
+	summaries := make(map[entity.MessageID]*entity.ThreadSummary)
+	if len(parentIDs) == 0 {
+		return summaries, nil
+	}
+
+	ids := make([]uuid.UUID, len(parentIDs))
+	for i := range parentIDs {
+		id, err := parentIDs[i].MessageID2UUID()
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+
+	// IN句の各要素を UUID_TO_BIN で変換する
+	placeholders := strings.TrimSuffix(strings.Repeat("UUID_TO_BIN(?),", len(ids)), ",")
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	var summaryModels []model.ThreadSummaryModel
+	err := r.db.SelectContext(ctx, &summaryModels, `
+		SELECT
+			BIN_TO_UUID(parent_id) AS parent_id,
+			COUNT(*) AS reply_count,
+			MAX(sent_at) AS last_reply_at
+		FROM messages
+		WHERE parent_id IN (`+placeholders+`) AND deleted_at IS NULL
+		GROUP BY parent_id`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range summaryModels {
+		summaries[entity.MessageID(summaryModels[i].ParentID.String())] = summaryModels[i].ToEntity()
+	}
+	return summaries, nil
+}
+
+func (r *MessageRepositoryImpl) SearchMessages(
+	ctx context.Context,
+	userID entity.UserID,
+	query string,
+	roomID entity.RoomID,
+	limit int,
+	beforeSentAt time.Time,
+) (messages []*entity.Message, nextBeforeSentAt time.Time, hasNext bool, err error) {
+	terms := strings.Fields(query)
+	if len(terms) == 0 {
+		return nil, beforeSentAt, false, nil
+	}
+
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return nil, beforeSentAt, false, err
+	}
+
+	// NOTE: FULLTEXT INDEX（ngram）が前提
+	// 各語句をフレーズとして必須にし、演算子として解釈されないようにする
+	against := make([]string, len(terms))
+	for i, term := range terms {
+		against[i] = `+"` + strings.ReplaceAll(term, `"`, ``) + `"`
+	}
+
+	where := `
+		WHERE MATCH(content) AGAINST(? IN BOOLEAN MODE)
+			AND deleted_at IS NULL
+			AND room_id IN (SELECT room_id FROM room_members WHERE user_id = UUID_TO_BIN(?))
+			AND sent_at < ?`
+	args := []any{strings.Join(against, " "), userIDUUID, beforeSentAt}
+	if roomID != "" {
+		roomIDUUID, err := roomID.RoomID2UUID()
+		if err != nil {
+			return nil, beforeSentAt, false, err
+		}
+		where += ` AND room_id = UUID_TO_BIN(?)`
+		args = append(args, roomIDUUID)
+	}
+	args = append(args, limit)
+
+	var msgModels []model.MessageModel
+	err = r.db.SelectContext(ctx, &msgModels, `
+		SELECT
+			BIN_TO_UUID(id) AS id,
+			BIN_TO_UUID(room_id) AS room_id,
+			BIN_TO_UUID(user_id) AS user_id,
+			BIN_TO_UUID(parent_id) AS parent_id,
+			content,
+			sent_at,
+			edited_at,
+			deleted_at
+		FROM messages`+where+`
+		ORDER BY sent_at DESC
+		LIMIT ?`, args...)
+	if err != nil {
+		return nil, beforeSentAt, false, err
+	}
+
+	if len(msgModels) == 0 {
+		return nil, beforeSentAt, false, nil
+	}
+
+	messages = make([]*entity.Message, len(msgModels))
+	for i := range msgModels {
+		messages[i] = msgModels[i].ToEntity()
+	}
+
+	nextBeforeSentAt = msgModels[len(msgModels)-1].SentAt
+	hasNext = len(messages) == limit
+	return messages, nextBeforeSentAt, hasNext, nil
+}
+
+func (r *MessageRepositoryImpl) GetUserSentTimesSince(
+	ctx context.Context,
+	roomID entity.RoomID,
+	userID entity.UserID,
+	since time.Time,
+	limit int,
+) ([]time.Time, error) {
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return nil, err
+	}
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return nil, err
+	}
+
+	var sentTimes []time.Time
+	err = r.db.SelectContext(ctx, &sentTimes, `
+		SELECT sent_at FROM messages
+		WHERE room_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?) AND sent_at >= ?
+		ORDER BY sent_at DESC
+		LIMIT ?`, roomIDUUID, userIDUUID, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	return sentTimes, nil
+}
@@ -2,20 +2,31 @@ package sqlitemsgrepo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
 	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
 )
 
+// messageColumns は MessageModel に対応するカラム
+const messageColumns = "id, room_id, user_id, parent_id, content, sent_at, edited_at, deleted_at"
+
 type MessageRepositoryImpl struct {
-	DB *sqlx.DB
+	DB             *sqlx.DB
+	FullTextSearch bool
 }
 type NewMessageRepositoryImplParams struct {
 	DB *sqlx.DB
+	// FullTextSearch は検索に全文検索用のインデックス（FTS5）を使うかどうか
+	// インデックスはマイグレーションで作成され、FTS5 なしでビルドした場合は作成されない
+	FullTextSearch bool
 }
 
 func (p *NewMessageRepositoryImplParams) Validate() error {
@@ -30,28 +41,98 @@ func NewMessageRepositoryImpl(params *NewMessageRepositoryImplParams) repository
 		panic(err)
 	}
 	return &MessageRepositoryImpl{
-		DB: params.DB,
+		DB:             params.DB,
+		FullTextSearch: params.FullTextSearch,
 	}
 }
 
 func (r *MessageRepositoryImpl) CreateMessage(ctx context.Context, message *entity.Message) error {
+	return insertMessage(ctx, r.DB, message)
+}
+
+func (r *MessageRepositoryImpl) CreateMessageWithMentions(ctx context.Context, message *entity.Message, mentions []*entity.Mention) error {
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertMessage(ctx, tx, message); err != nil {
+		return err
+	}
+	if err := attachToMessage(ctx, tx, message); err != nil {
+		return err
+	}
+	for _, mention := range mentions {
+		if mention == nil {
+			return errors.New("mention cannot be nil")
+		}
+		var m model.MentionModel
+		if err := m.FromEntity(mention); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT OR IGNORE INTO message_mentions (message_id, user_id, room_id, kind, created_at) VALUES (?, ?, ?, ?, ?)`,
+			m.MessageID.String(), m.UserID.String(), m.RoomID.String(), m.Kind, m.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// attachToMessage はメッセージの添付ファイルを添付済みにする
+// 1件でも添付済み（または存在しない）場合は repository.ErrAttachmentAlreadyAttached を返す
+func attachToMessage(ctx context.Context, tx *sqlx.Tx, message *entity.Message) error {
+	attachments := message.GetAttachments()
+	if len(attachments) == 0 {
+		return nil
+	}
+	ids := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		ids[i] = string(attachment.GetID())
+	}
+	query, args, err := sqlx.In(
+		"UPDATE attachments SET message_id = ? WHERE id IN (?) AND message_id IS NULL", string(message.GetID()), ids)
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx, tx.Rebind(query), args...)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n != int64(len(ids)) {
+		return repository.ErrAttachmentAlreadyAttached
+	}
+	return nil
+}
+
+// insertMessage は messages に1件保存する（トランザクションの中でも使う）
+func insertMessage(ctx context.Context, db sqlx.ExecerContext, message *entity.Message) error {
 	if message == nil {
 		return errors.New("message cannot be nil")
 	}
 
-	_, err := r.DB.ExecContext(ctx, "INSERT INTO messages (id, room_id, user_id, content, sent_at) VALUES (?, ?, ?, ?, ?)",
+	// スレッドの返信でない場合は parent_id を NULL にする
+	var parentID any
+	if message.IsReply() {
+		parentID = string(message.GetParentID())
+	}
+
+	_, err := db.ExecContext(ctx, "INSERT INTO messages (id, room_id, user_id, parent_id, content, sent_at) VALUES (?, ?, ?, ?, ?, ?)",
 		string(message.GetID()),
 		string(message.GetRoomID()),
 		string(message.GetUserID()),
+		parentID,
 		message.GetContent(),
-		message.GetSentAt(),
+		message.GetSentAt().UTC(),
 	)
-
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return err
 }
 
 func (r *MessageRepositoryImpl) GetMessageHistoryInRoom(
@@ -61,8 +142,8 @@ func (r *MessageRepositoryImpl) GetMessageHistoryInRoom(
 	beforeSentAt time.Time,
 ) (messages []*entity.Message, nextBeforeSentAt time.Time, hasNext bool, err error) {
 	var MessageModels []model.MessageModel
-	query := "SELECT * FROM messages WHERE room_id = ? AND sent_at < ? ORDER BY sent_at DESC LIMIT ?"
-	err = r.DB.SelectContext(ctx, &MessageModels, query, roomID, beforeSentAt, limit)
+	query := "SELECT " + messageColumns + " FROM messages WHERE room_id = ? AND parent_id IS NULL AND sent_at < ? ORDER BY sent_at DESC LIMIT ?"
+	err = r.DB.SelectContext(ctx, &MessageModels, query, roomID, beforeSentAt.UTC(), limit)
 	if err != nil {
 		return nil, time.Now(), false, err
 	}
@@ -80,3 +161,259 @@ func (r *MessageRepositoryImpl) GetMessageHistoryInRoom(
 	hasNext = len(messages) == limit
 	return messages, nextBeforeSentAt, hasNext, nil
 }
+
+func (r *MessageRepositoryImpl) GetMessageByID(ctx context.Context, id entity.MessageID) (*entity.Message, error) {
+	var msgModel model.MessageModel
+	err := r.DB.GetContext(ctx, &msgModel, "SELECT "+messageColumns+" FROM messages WHERE id = ?", string(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return msgModel.ToEntity(), nil
+}
+
+func (r *MessageRepositoryImpl) UpdateMessage(ctx context.Context, message *entity.Message, revision *entity.MessageRevision) error {
+	if message == nil || revision == nil {
+		return errors.New("message and revision cannot be nil")
+	}
+
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "UPDATE messages SET content = ?, edited_at = ? WHERE id = ? AND deleted_at IS NULL",
+		message.GetContent(),
+		utcOrNil(message.GetEditedAt()),
+		string(message.GetID()),
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repository.ErrMessageNotFound
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO message_revisions (message_id, content, edited_at) VALUES (?, ?, ?)",
+		string(revision.GetMessageID()),
+		revision.GetContent(),
+		revision.GetEditedAt().UTC(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *MessageRepositoryImpl) DeleteMessage(ctx context.Context, id entity.MessageID, deletedAt time.Time) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE messages SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL", deletedAt.UTC(), string(id))
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrMessageNotFound
+	}
+	return nil
+}
+
+func (r *MessageRepositoryImpl) GetMessageRevisions(ctx context.Context, id entity.MessageID) ([]*entity.MessageRevision, error) {
+	var revModels []model.MessageRevisionModel
+	err := r.DB.SelectContext(ctx, &revModels,
+		"SELECT message_id, content, edited_at FROM message_revisions WHERE message_id = ? ORDER BY edited_at ASC, id ASC",
+		string(id),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*entity.MessageRevision, len(revModels))
+	for i := range revModels {
+		revisions[i] = revModels[i].ToEntity()
+	}
+	return revisions, nil
+}
+
+func (r *MessageRepositoryImpl) GetThread(
+	ctx context.Context,
+	parentID entity.MessageID,
+	limit int,
+	afterSentAt time.Time,
+) (replies []*entity.Message, nextAfterSentAt time.Time, hasNext bool, err error) {
+	var msgModels []model.MessageModel
+	query := "SELECT " + messageColumns + " FROM messages WHERE parent_id = ? AND sent_at > ? ORDER BY sent_at ASC LIMIT ?"
+	err = r.DB.SelectContext(ctx, &msgModels, query, string(parentID), afterSentAt.UTC(), limit)
+	if err != nil {
+		return nil, afterSentAt, false, err
+	}
+
+	if len(msgModels) == 0 {
+		return nil, afterSentAt, false, nil
+	}
+
+	replies = make([]*entity.Message, len(msgModels))
+	for i := range msgModels {
+		replies[i] = msgModels[i].ToEntity()
+	}
+
+	nextAfterSentAt = msgModels[len(msgModels)-1].SentAt
+	hasNext = len(replies) == limit
+	return replies, nextAfterSentAt, hasNext, nil
+}
+
+func (r *MessageRepositoryImpl) GetThreadSummaries(ctx context.Context, parentIDs []entity.MessageID) (map[entity.MessageID]*entity.ThreadSummary, error) {
+	summaries := make(map[entity.MessageID]*entity.ThreadSummary)
+	if len(parentIDs) == 0 {
+		return summaries, nil
+	}
+
+	ids := make([]string, len(parentIDs))
+	for i, id := range parentIDs {
+		ids[i] = string(id)
+	}
+	query, args, err := sqlx.In(`
+		SELECT parent_id, COUNT(*) AS reply_count, MAX(sent_at) AS last_reply_at
+		FROM messages
+		WHERE parent_id IN (?) AND deleted_at IS NULL
+		GROUP BY parent_id`, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite は集計結果の型を保持しないため、MAX(sent_at) は文字列として受け取る
+	var rows []struct {
+		ParentID    string `db:"parent_id"`
+		ReplyCount  int    `db:"reply_count"`
+		LastReplyAt string `db:"last_reply_at"`
+	}
+	if err := r.DB.SelectContext(ctx, &rows, r.DB.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		lastReplyAt, err := parseTimestamp(row.LastReplyAt)
+		if err != nil {
+			return nil, err
+		}
+		summaries[entity.MessageID(row.ParentID)] = entity.NewThreadSummary(entity.ThreadSummaryParams{
+			ReplyCount:  row.ReplyCount,
+			LastReplyAt: lastReplyAt,
+		})
+	}
+	return summaries, nil
+}
+
+// utcOrNil は日時を UTC に揃えて返す
+// SQLite は日時を文字列として比較するため、メッセージの日時はすべて UTC で保存する
+func utcOrNil(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+// parseTimestamp は SQLite ドライバが保存した日時の文字列を time.Time に変換する
+func parseTimestamp(s string) (time.Time, error) {
+	for _, layout := range sqlite3.SQLiteTimestampFormats {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unsupported timestamp format %q", s)
+}
+
+// likeEscaper は LIKE のワイルドカードを通常の文字として扱うためのエスケープ
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *MessageRepositoryImpl) SearchMessages(
+	ctx context.Context,
+	userID entity.UserID,
+	query string,
+	roomID entity.RoomID,
+	limit int,
+	beforeSentAt time.Time,
+) (messages []*entity.Message, nextBeforeSentAt time.Time, hasNext bool, err error) {
+	terms := strings.Fields(query)
+	if len(terms) == 0 {
+		return nil, beforeSentAt, false, nil
+	}
+
+	// 全文検索用のインデックスがない場合（FTS5 なしでビルドした場合）は messages を直接検索する
+	from := "messages m"
+	contentColumn := "m.content"
+	if r.FullTextSearch {
+		// messages_fts は trigram トークナイザのため、LIKE による部分一致にもインデックスが使われる
+		from = `messages_fts f
+		JOIN message_search_keys k ON k.id = f.rowid
+		JOIN messages m ON m.id = k.message_id`
+		contentColumn = "f.content"
+	}
+
+	var where []string
+	var args []any
+	for _, term := range terms {
+		where = append(where, contentColumn+` LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+likeEscaper.Replace(term)+"%")
+	}
+	where = append(where,
+		"m.deleted_at IS NULL",
+		"m.room_id IN (SELECT room_id FROM room_members WHERE user_id = ?)",
+		"m.sent_at < ?",
+	)
+	args = append(args, string(userID), beforeSentAt.UTC())
+	if roomID != "" {
+		where = append(where, "m.room_id = ?")
+		args = append(args, string(roomID))
+	}
+	args = append(args, limit)
+
+	var msgModels []model.MessageModel
+	err = r.DB.SelectContext(ctx, &msgModels, `
+		SELECT m.id, m.room_id, m.user_id, m.parent_id, m.content, m.sent_at, m.edited_at, m.deleted_at
+		FROM `+from+`
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY m.sent_at DESC
+		LIMIT ?`, args...)
+	if err != nil {
+		return nil, beforeSentAt, false, err
+	}
+
+	if len(msgModels) == 0 {
+		return nil, beforeSentAt, false, nil
+	}
+
+	messages = make([]*entity.Message, len(msgModels))
+	for i := range msgModels {
+		messages[i] = msgModels[i].ToEntity()
+	}
+
+	nextBeforeSentAt = msgModels[len(msgModels)-1].SentAt
+	hasNext = len(messages) == limit
+	return messages, nextBeforeSentAt, hasNext, nil
+}
+
+func (r *MessageRepositoryImpl) GetUserSentTimesSince(
+	ctx context.Context,
+	roomID entity.RoomID,
+	userID entity.UserID,
+	since time.Time,
+	limit int,
+) ([]time.Time, error) {
+	var sentTimes []time.Time
+	err := r.DB.SelectContext(ctx, &sentTimes,
+		"SELECT sent_at FROM messages WHERE room_id = ? AND user_id = ? AND sent_at >= ? ORDER BY sent_at DESC LIMIT ?",
+		string(roomID), string(userID), since.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return sentTimes, nil
+}
@@ -0,0 +1,108 @@
+// FTS5 は `-tags sqlite_fts5` を付けた場合のみ使えるため、付けない場合は LIKE による検索を検証する
+package sqlitemsgrepo_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	sqlitegatewayimpl "example.com/infrahandson/internal/infrastructure/gatewayImpl/db/sqlite"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/messageRepositoryImpl/sqlitemsgrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testOtherRoomID = "9e8d7c6b-5a49-4382-a1b0-c9d8e7f6a5b4"
+	testOtherUserID = "e6f4a3b2-7c8d-4e9f-a0b1-2c3d4e5f6a71"
+)
+
+func TestMessageRepositoryImpl_SearchMessages(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	_, err := db.Exec(`CREATE TABLE room_members (id TEXT PRIMARY KEY, room_id TEXT NOT NULL, user_id TEXT NOT NULL)`)
+	require.NoError(t, err)
+	// 本番と同じマイグレーションで全文検索用のインデックスを作成する（FTS5 が使える場合のみ）
+	if sqlitegatewayimpl.MessageSearchEnabled {
+		migration, err := os.ReadFile("../../../gatewayImpl/db/sqlite/migrations/0005_create_messages_fts.up.sql")
+		require.NoError(t, err)
+		_, err = db.Exec(string(migration))
+		require.NoError(t, err)
+	}
+	t.Logf("full-text index enabled: %v", sqlitegatewayimpl.MessageSearchEnabled)
+	_, err = db.Exec(`INSERT INTO room_members (id, room_id, user_id) VALUES ('m1', ?, ?), ('m2', ?, ?)`,
+		testRoomID, testUserID, testOtherRoomID, testOtherUserID)
+	require.NoError(t, err)
+
+	repo := sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{
+		DB:             db,
+		FullTextSearch: sqlitegatewayimpl.MessageSearchEnabled,
+	})
+	base := time.Now().UTC().Add(-time.Hour)
+	create := func(id, roomID, content string, offset time.Duration) *entity.Message {
+		msg := entity.NewMessage(entity.MessageParams{
+			ID:      entity.MessageID(id),
+			RoomID:  entity.RoomID(roomID),
+			UserID:  entity.UserID(testUserID),
+			Content: content,
+			SentAt:  base.Add(offset),
+		})
+		require.NoError(t, repo.CreateMessage(ctx, msg))
+		return msg
+	}
+	create("00000000-0000-0000-0000-000000000001", testRoomID, "明日の定例会議は10時から", 0)
+	edited := create("00000000-0000-0000-0000-000000000002", testRoomID, "ランチに行きましょう", time.Minute)
+	deleted := create("00000000-0000-0000-0000-000000000003", testRoomID, "定例会議の議事録です", 2*time.Minute)
+	create("00000000-0000-0000-0000-000000000004", testOtherRoomID, "別の部屋の定例会議", 3*time.Minute)
+	create("00000000-0000-0000-0000-000000000005", testRoomID, "Weekly Meeting notes 100%", 4*time.Minute)
+
+	require.NoError(t, repo.UpdateMessage(ctx, edited, edited.Edit("定例会議のあとでランチ", time.Now().UTC())))
+	require.NoError(t, repo.DeleteMessage(ctx, deleted.GetID(), time.Now().UTC()))
+
+	search := func(query string, roomID entity.RoomID, limit int, before time.Time) ([]string, time.Time, bool) {
+		messages, next, hasNext, err := repo.SearchMessages(ctx, testUserID, query, roomID, limit, before)
+		require.NoError(t, err)
+		contents := make([]string, len(messages))
+		for i, msg := range messages {
+			contents[i] = msg.GetContent()
+		}
+		return contents, next, hasNext
+	}
+
+	t.Run("参加している部屋の削除されていないメッセージを新しい順に返す", func(t *testing.T) {
+		got, _, hasNext := search("定例会議", "", 10, time.Now())
+		assert.Equal(t, []string{"定例会議のあとでランチ", "明日の定例会議は10時から"}, got)
+		assert.False(t, hasNext)
+	})
+
+	t.Run("すべての語句を含むものだけを返す", func(t *testing.T) {
+		got, _, _ := search("定例会議 ランチ", "", 10, time.Now())
+		assert.Equal(t, []string{"定例会議のあとでランチ"}, got)
+	})
+
+	t.Run("大文字小文字を区別せず、記号はそのまま検索する", func(t *testing.T) {
+		got, _, _ := search("meeting", "", 10, time.Now())
+		assert.Equal(t, []string{"Weekly Meeting notes 100%"}, got)
+		got, _, _ = search("0%", "", 10, time.Now())
+		assert.Equal(t, []string{"Weekly Meeting notes 100%"}, got)
+		got, _, _ = search("1_0", "", 10, time.Now())
+		assert.Empty(t, got)
+	})
+
+	t.Run("ページング", func(t *testing.T) {
+		got, next, hasNext := search("定例会議", "", 1, time.Now())
+		assert.Equal(t, []string{"定例会議のあとでランチ"}, got)
+		assert.True(t, hasNext)
+
+		got, _, _ = search("定例会議", "", 1, next)
+		assert.Equal(t, []string{"明日の定例会議は10時から"}, got)
+	})
+
+	t.Run("参加していない部屋を指定しても返さない", func(t *testing.T) {
+		got, _, _ := search("定例会議", testOtherRoomID, 10, time.Now())
+		assert.Empty(t, got)
+	})
+}
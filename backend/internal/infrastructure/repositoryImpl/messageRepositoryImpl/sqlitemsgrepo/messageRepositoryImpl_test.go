@@ -2,14 +2,23 @@ package sqlitemsgrepo_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/messageRepositoryImpl/sqlitemsgrepo"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3" // SQLiteドライバ
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMessageID = "7f1f3c1e-9b7a-4d3e-8a55-0c6f1d2b3a40"
+	testRoomID    = "2b0c8f6e-1d4a-4f6b-9e3c-7a8d5e4f3c21"
+	testUserID    = "c4d2e1f0-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
 )
 
 func setupTestDB(t *testing.T) *sqlx.DB {
@@ -21,11 +30,40 @@ func setupTestDB(t *testing.T) *sqlx.DB {
 	schema := `
 CREATE TABLE messages (
 	id TEXT PRIMARY KEY,
-	public_id TEXT NOT NULL,
 	room_id TEXT NOT NULL,
 	user_id TEXT NOT NULL,
+	parent_id TEXT,
+	content TEXT NOT NULL,
+	sent_at DATETIME NOT NULL,
+	edited_at DATETIME,
+	deleted_at DATETIME
+);
+CREATE TABLE message_revisions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	message_id TEXT NOT NULL,
 	content TEXT NOT NULL,
-	sent_at DATETIME NOT NULL
+	edited_at DATETIME NOT NULL
+);
+CREATE TABLE message_mentions (
+	message_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	room_id TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	PRIMARY KEY (message_id, user_id)
+);
+CREATE TABLE attachments (
+	id            TEXT PRIMARY KEY,
+	room_id       TEXT NOT NULL,
+	uploader_id   TEXT NOT NULL,
+	message_id    TEXT,
+	file_name     TEXT NOT NULL,
+	mime_type     TEXT NOT NULL,
+	size          INTEGER NOT NULL,
+	width         INTEGER NOT NULL DEFAULT 0,
+	height        INTEGER NOT NULL DEFAULT 0,
+	has_thumbnail BOOLEAN NOT NULL DEFAULT 0,
+	created_at    DATETIME NOT NULL
 );`
 	_, err = db.Exec(schema)
 	if err != nil {
@@ -42,9 +80,9 @@ func TestMessageRepositoryImpl_CreateAndGetMessageHistoryInRoom(t *testing.T) {
 	// メッセージを作成
 	now := time.Now().UTC()
 	message := entity.NewMessage(entity.MessageParams{
-		ID:      entity.MessageID("test-public-id"),
-		RoomID:  entity.RoomID("test-room-id"),
-		UserID:  entity.UserID("test-user-id"),
+		ID:      entity.MessageID(testMessageID),
+		RoomID:  entity.RoomID(testRoomID),
+		UserID:  entity.UserID(testUserID),
 		Content: "Hello, World!",
 		SentAt:  now,
 	})
@@ -55,7 +93,7 @@ func TestMessageRepositoryImpl_CreateAndGetMessageHistoryInRoom(t *testing.T) {
 	// メッセージ履歴を取得
 	messages, nextBeforeSentAt, hasNext, err := repo.GetMessageHistoryInRoom(
 		context.Background(),
-		entity.RoomID("test-room-id"),
+		entity.RoomID(testRoomID),
 		10,
 		time.Now().Add(1*time.Hour), // 未来時間を指定しているので、登録したメッセージが対象になる
 	)
@@ -68,3 +106,285 @@ func TestMessageRepositoryImpl_CreateAndGetMessageHistoryInRoom(t *testing.T) {
 	assert.Equal(t, now, nextBeforeSentAt)
 	assert.False(t, hasNext)
 }
+
+func TestMessageRepositoryImpl_CreateMessageWithMentions(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	const mentionedID = "e6f4a3b2-7c8d-4e9f-8a0b-2c3d4e5f6a71"
+	newMention := func(messageID entity.MessageID, userID entity.UserID, kind entity.MentionKind, createdAt time.Time) *entity.Mention {
+		return entity.NewMention(entity.MentionParams{
+			MessageID: messageID,
+			RoomID:    testRoomID,
+			UserID:    userID,
+			Kind:      kind,
+			CreatedAt: createdAt,
+		})
+	}
+
+	// 同じユーザーへのメンションは1件だけ保存する
+	message := entity.NewMessage(entity.MessageParams{
+		ID:      testMessageID,
+		RoomID:  testRoomID,
+		UserID:  testUserID,
+		Content: "@room @alice",
+		SentAt:  time.Now().UTC(),
+	})
+	require.NoError(t, repo.CreateMessageWithMentions(ctx, message, []*entity.Mention{
+		newMention(message.GetID(), mentionedID, entity.MentionKindUser, message.GetSentAt()),
+		newMention(message.GetID(), mentionedID, entity.MentionKindRoom, message.GetSentAt()),
+	}))
+	_, err := repo.GetMessageByID(ctx, message.GetID())
+	require.NoError(t, err)
+	var kinds []string
+	require.NoError(t, db.Select(&kinds, `SELECT kind FROM message_mentions WHERE message_id = ?`, testMessageID))
+	assert.Equal(t, []string{string(entity.MentionKindUser)}, kinds)
+
+	// メンションを保存できない場合はメッセージも保存しない
+	const failedID = "8a9b0c1d-2e3f-4a5b-8c6d-7e8f9a0b1c2d"
+	failed := entity.NewMessage(entity.MessageParams{
+		ID:      failedID,
+		RoomID:  testRoomID,
+		UserID:  testUserID,
+		Content: "@alice",
+		SentAt:  time.Now().UTC(),
+	})
+	err = repo.CreateMessageWithMentions(ctx, failed, []*entity.Mention{
+		newMention(failed.GetID(), "invalid-user-id", entity.MentionKindUser, failed.GetSentAt()),
+	})
+	require.Error(t, err)
+	_, err = repo.GetMessageByID(ctx, failed.GetID())
+	assert.ErrorIs(t, err, repository.ErrMessageNotFound)
+}
+
+func TestMessageRepositoryImpl_CreateMessageWithMentions_Attachments(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	const (
+		imageID = "5e6f7a8b-9c0d-4e1f-8a2b-4c5d6e7f8091"
+		textID  = "6f7a8b9c-0d1e-4f2a-9b3c-5d6e7f809102"
+		otherID = "8a9b0c1d-2e3f-4a5b-8c6d-7e8f9a0b1c2d"
+	)
+	attachments := make(map[string]*entity.Attachment)
+	for _, id := range []string{imageID, textID} {
+		_, err := db.Exec(`INSERT INTO attachments (id, room_id, uploader_id, file_name, mime_type, size, created_at) VALUES (?, ?, ?, 'a.txt', 'text/plain', 1, ?)`,
+			id, testRoomID, testUserID, time.Now().UTC())
+		require.NoError(t, err)
+		attachments[id] = entity.NewAttachment(entity.AttachmentParams{ID: entity.AttachmentID(id), RoomID: testRoomID, UploaderID: testUserID})
+	}
+	attachedTo := func(id string) string {
+		var messageID *string
+		require.NoError(t, db.Get(&messageID, `SELECT message_id FROM attachments WHERE id = ?`, id))
+		if messageID == nil {
+			return ""
+		}
+		return *messageID
+	}
+
+	// 添付ファイルはメッセージと一緒に添付済みにする
+	message := entity.NewMessage(entity.MessageParams{
+		ID:          testMessageID,
+		RoomID:      testRoomID,
+		UserID:      testUserID,
+		Content:     "photo",
+		SentAt:      time.Now().UTC(),
+		Attachments: []*entity.Attachment{attachments[imageID]},
+	})
+	require.NoError(t, repo.CreateMessageWithMentions(ctx, message, nil))
+	assert.Equal(t, testMessageID, attachedTo(imageID))
+
+	// 1つでも添付済みなら、メッセージも保存せず何も添付しない
+	failed := entity.NewMessage(entity.MessageParams{
+		ID:          otherID,
+		RoomID:      testRoomID,
+		UserID:      testUserID,
+		Content:     "again",
+		SentAt:      time.Now().UTC(),
+		Attachments: []*entity.Attachment{attachments[textID], attachments[imageID]},
+	})
+	err := repo.CreateMessageWithMentions(ctx, failed, nil)
+	assert.ErrorIs(t, err, repository.ErrAttachmentAlreadyAttached)
+	_, err = repo.GetMessageByID(ctx, failed.GetID())
+	assert.ErrorIs(t, err, repository.ErrMessageNotFound)
+	assert.Empty(t, attachedTo(textID))
+	assert.Equal(t, testMessageID, attachedTo(imageID))
+}
+
+func TestMessageRepositoryImpl_EditAndDeleteMessage(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	message := entity.NewMessage(entity.MessageParams{
+		ID:      entity.MessageID(testMessageID),
+		RoomID:  entity.RoomID(testRoomID),
+		UserID:  entity.UserID(testUserID),
+		Content: "v1",
+		SentAt:  time.Now().UTC(),
+	})
+	require.NoError(t, repo.CreateMessage(ctx, message))
+
+	// 2回編集すると、編集前の本文が古い順に残る
+	editedAt := time.Now().UTC()
+	require.NoError(t, repo.UpdateMessage(ctx, message, message.Edit("v2", editedAt)))
+	require.NoError(t, repo.UpdateMessage(ctx, message, message.Edit("v3", editedAt.Add(time.Second))))
+
+	got, err := repo.GetMessageByID(ctx, message.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, "v3", got.GetContent())
+	require.NotNil(t, got.GetEditedAt())
+	assert.WithinDuration(t, editedAt.Add(time.Second), *got.GetEditedAt(), time.Second)
+
+	revisions, err := repo.GetMessageRevisions(ctx, message.GetID())
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "v1", revisions[0].GetContent())
+	assert.Equal(t, "v2", revisions[1].GetContent())
+
+	// 削除すると本文は返らず、再度の編集・削除はできない
+	require.NoError(t, repo.DeleteMessage(ctx, message.GetID(), time.Now().UTC()))
+	got, err = repo.GetMessageByID(ctx, message.GetID())
+	require.NoError(t, err)
+	assert.True(t, got.IsDeleted())
+	assert.Empty(t, got.GetContent())
+
+	assert.ErrorIs(t, repo.DeleteMessage(ctx, message.GetID(), time.Now().UTC()), repository.ErrMessageNotFound)
+	assert.ErrorIs(t, repo.UpdateMessage(ctx, message, message.Edit("v4", time.Now().UTC())), repository.ErrMessageNotFound)
+
+	messages, _, _, err := repo.GetMessageHistoryInRoom(ctx, entity.RoomID(testRoomID), 10, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.True(t, messages[0].IsDeleted())
+
+	_, err = repo.GetMessageByID(ctx, "00000000-0000-0000-0000-000000000000")
+	assert.ErrorIs(t, err, repository.ErrMessageNotFound)
+}
+
+func TestMessageRepositoryImpl_Thread(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	base := time.Now().UTC()
+	parent := entity.NewMessage(entity.MessageParams{
+		ID:      entity.MessageID(testMessageID),
+		RoomID:  entity.RoomID(testRoomID),
+		UserID:  entity.UserID(testUserID),
+		Content: "parent",
+		SentAt:  base,
+	})
+	require.NoError(t, repo.CreateMessage(ctx, parent))
+
+	replyIDs := []string{
+		"11111111-1111-4111-8111-111111111111",
+		"22222222-2222-4222-8222-222222222222",
+		"33333333-3333-4333-8333-333333333333",
+	}
+	for i, id := range replyIDs {
+		require.NoError(t, repo.CreateMessage(ctx, entity.NewMessage(entity.MessageParams{
+			ID:       entity.MessageID(id),
+			RoomID:   entity.RoomID(testRoomID),
+			UserID:   entity.UserID(testUserID),
+			ParentID: parent.GetID(),
+			Content:  "reply",
+			SentAt:   base.Add(time.Duration(i+1) * time.Second),
+		})))
+	}
+	require.NoError(t, repo.DeleteMessage(ctx, entity.MessageID(replyIDs[2]), base.Add(time.Minute)))
+
+	// 返信は部屋のメッセージ一覧に含まれない
+	messages, _, _, err := repo.GetMessageHistoryInRoom(ctx, entity.RoomID(testRoomID), 10, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, parent.GetID(), messages[0].GetID())
+	assert.False(t, messages[0].IsReply())
+
+	// 返信は古い順にページングして取得できる
+	replies, next, hasNext, err := repo.GetThread(ctx, parent.GetID(), 2, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, replies, 2)
+	assert.True(t, hasNext)
+	assert.Equal(t, entity.MessageID(replyIDs[0]), replies[0].GetID())
+	assert.Equal(t, parent.GetID(), replies[0].GetParentID())
+
+	replies, _, hasNext, err = repo.GetThread(ctx, parent.GetID(), 2, next)
+	require.NoError(t, err)
+	require.Len(t, replies, 1)
+	assert.False(t, hasNext)
+	assert.True(t, replies[0].IsDeleted())
+
+	// 要約には削除された返信を含めない
+	summaries, err := repo.GetThreadSummaries(ctx, []entity.MessageID{parent.GetID(), entity.MessageID(replyIDs[0])})
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, 2, summaries[parent.GetID()].GetReplyCount())
+	assert.WithinDuration(t, base.Add(2*time.Second), summaries[parent.GetID()].GetLastReplyAt(), time.Millisecond)
+}
+
+func TestMessageRepositoryImpl_GetUserSentTimesSince(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	// 送信日時はローカル時刻（time.Now()）で渡されるため、UTC 以外のタイムゾーンで確認する
+	local := time.Local
+	time.Local = time.FixedZone("JST", 9*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	base := time.Now().Add(-time.Minute)
+	otherRoomID := "3c1d9a7f-2e5b-4a8c-8d6e-1f2a3b4c5d6e"
+	otherUserID := "d5e3f2a1-6b7c-4d8e-9f0a-1b2c3d4e5f60"
+	messages := []struct {
+		id       string
+		roomID   string
+		userID   string
+		parentID string
+		sentAt   time.Time
+	}{
+		{"11111111-1111-4111-8111-111111111111", testRoomID, testUserID, "", base},
+		{"22222222-2222-4222-8222-222222222222", testRoomID, testUserID, "11111111-1111-4111-8111-111111111111", base.Add(10 * time.Second)},
+		{"33333333-3333-4333-8333-333333333333", testRoomID, testUserID, "", base.Add(20 * time.Second)},
+		{"44444444-4444-4444-8444-444444444444", testRoomID, otherUserID, "", base.Add(30 * time.Second)},
+		{"55555555-5555-4555-8555-555555555555", otherRoomID, testUserID, "", base.Add(40 * time.Second)},
+	}
+	for _, m := range messages {
+		require.NoError(t, repo.CreateMessage(ctx, entity.NewMessage(entity.MessageParams{
+			ID:       entity.MessageID(m.id),
+			RoomID:   entity.RoomID(m.roomID),
+			UserID:   entity.UserID(m.userID),
+			ParentID: entity.MessageID(m.parentID),
+			Content:  "hello",
+			SentAt:   m.sentAt,
+		})))
+	}
+	require.NoError(t, repo.DeleteMessage(ctx, entity.MessageID(messages[2].id), base.Add(time.Minute)))
+
+	// スレッドの返信と削除済みのメッセージも含め、新しい順に返す
+	sentTimes, err := repo.GetUserSentTimesSince(ctx, entity.RoomID(testRoomID), entity.UserID(testUserID), base.Add(5*time.Second), 10)
+	require.NoError(t, err)
+	require.Len(t, sentTimes, 2)
+	assert.WithinDuration(t, base.Add(20*time.Second), sentTimes[0], time.Millisecond)
+	assert.WithinDuration(t, base.Add(10*time.Second), sentTimes[1], time.Millisecond)
+
+	// 件数の上限
+	sentTimes, err = repo.GetUserSentTimesSince(ctx, entity.RoomID(testRoomID), entity.UserID(testUserID), base.Add(-time.Hour), 1)
+	require.NoError(t, err)
+	require.Len(t, sentTimes, 1)
+	assert.WithinDuration(t, base.Add(20*time.Second), sentTimes[0], time.Millisecond)
+
+	// 保存されたタイムゾーンと異なるタイムゾーンで指定しても同じ時刻として比較する
+	for _, loc := range []*time.Location{time.UTC, time.FixedZone("EST", -5*60*60)} {
+		sentTimes, err = repo.GetUserSentTimesSince(ctx, entity.RoomID(testRoomID), entity.UserID(testUserID), base.Add(15*time.Second).In(loc), 10)
+		require.NoError(t, err)
+		require.Len(t, sentTimes, 1, loc.String())
+		assert.WithinDuration(t, base.Add(20*time.Second), sentTimes[0], time.Millisecond)
+	}
+
+	// 日時は UTC で保存する
+	var sentAt string
+	require.NoError(t, db.Get(&sentAt, "SELECT CAST(sent_at AS TEXT) FROM messages WHERE id = ?", messages[0].id))
+	assert.True(t, strings.HasSuffix(sentAt, "+00:00"), sentAt)
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type AttachmentModel struct {
+	ID           uuid.UUID     `db:"id"`
+	RoomID       uuid.UUID     `db:"room_id"`
+	UploaderID   uuid.UUID     `db:"uploader_id"`
+	MessageID    uuid.NullUUID `db:"message_id"` // メッセージを送信するまでは NULL
+	FileName     string        `db:"file_name"`
+	MimeType     string        `db:"mime_type"`
+	Size         int64         `db:"size"`
+	Width        int           `db:"width"`
+	Height       int           `db:"height"`
+	HasThumbnail bool          `db:"has_thumbnail"`
+	CreatedAt    time.Time     `db:"created_at"`
+}
+
+func (m *AttachmentModel) FromEntity(attachment *entity.Attachment) error {
+	id := attachment.GetID()
+	idUUID, err := id.AttachmentID2UUID()
+	if err != nil {
+		return err
+	}
+	roomID := attachment.GetRoomID()
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+	uploaderID := attachment.GetUploaderID()
+	uploaderIDUUID, err := uploaderID.UserID2UUID()
+	if err != nil {
+		return err
+	}
+	m.ID = idUUID
+	m.RoomID = roomIDUUID
+	m.UploaderID = uploaderIDUUID
+	m.MessageID = uuid.NullUUID{}
+	if attachment.IsAttached() {
+		messageID := attachment.GetMessageID()
+		messageIDUUID, err := messageID.MessageID2UUID()
+		if err != nil {
+			return err
+		}
+		m.MessageID = uuid.NullUUID{UUID: messageIDUUID, Valid: true}
+	}
+	m.FileName = attachment.GetFileName()
+	m.MimeType = attachment.GetMimeType()
+	m.Size = attachment.GetSize()
+	m.Width = attachment.GetWidth()
+	m.Height = attachment.GetHeight()
+	m.HasThumbnail = attachment.HasThumbnail()
+	m.CreatedAt = attachment.GetCreatedAt().UTC()
+	return nil
+}
+
+func (m *AttachmentModel) ToEntity() *entity.Attachment {
+	var messageID entity.MessageID
+	if m.MessageID.Valid {
+		messageID = entity.MessageID(m.MessageID.UUID.String())
+	}
+	return entity.NewAttachment(entity.AttachmentParams{
+		ID:           entity.AttachmentID(m.ID.String()),
+		RoomID:       entity.RoomID(m.RoomID.String()),
+		UploaderID:   entity.UserID(m.UploaderID.String()),
+		MessageID:    messageID,
+		FileName:     m.FileName,
+		MimeType:     m.MimeType,
+		Size:         m.Size,
+		Width:        m.Width,
+		Height:       m.Height,
+		HasThumbnail: m.HasThumbnail,
+		CreatedAt:    m.CreatedAt,
+	})
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type MentionModel struct {
+	MessageID uuid.UUID `db:"message_id"`
+	UserID    uuid.UUID `db:"user_id"`
+	RoomID    uuid.UUID `db:"room_id"`
+	Kind      string    `db:"kind"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (m *MentionModel) FromEntity(mention *entity.Mention) error {
+	messageID := mention.GetMessageID()
+	messageIDUUID, err := messageID.MessageID2UUID()
+	if err != nil {
+		return err
+	}
+	userID := mention.GetUserID()
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return err
+	}
+	roomID := mention.GetRoomID()
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+	m.MessageID = messageIDUUID
+	m.UserID = userIDUUID
+	m.RoomID = roomIDUUID
+	m.Kind = string(mention.GetKind())
+	m.CreatedAt = mention.GetCreatedAt().UTC()
+	return nil
+}
+
+// MentionedMessageModel はメンションとメッセージを結合した行
+type MentionedMessageModel struct {
+	MessageModel
+	MentionedUserID uuid.UUID `db:"mentioned_user_id"`
+	Kind            string    `db:"kind"`
+	CreatedAt       time.Time `db:"created_at"`
+	IsRead          bool      `db:"is_read"`
+}
+
+func (m *MentionedMessageModel) ToEntity() *entity.MentionedMessage {
+	msg := m.MessageModel.ToEntity()
+	mention := entity.NewMention(entity.MentionParams{
+		MessageID: msg.GetID(),
+		RoomID:    msg.GetRoomID(),
+		UserID:    entity.UserID(m.MentionedUserID.String()),
+		Kind:      entity.MentionKind(m.Kind),
+		CreatedAt: m.CreatedAt,
+	})
+	return entity.NewMentionedMessage(mention, msg, m.IsRead)
+}
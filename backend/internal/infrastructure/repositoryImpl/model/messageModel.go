@@ -13,6 +13,10 @@ type MessageModel struct {
 	UserID  uuid.UUID `db:"user_id"`
 	Content string    `db:"content"`
 	SentAt  time.Time `db:"sent_at"`
+
+	ParentID  uuid.NullUUID `db:"parent_id"` // スレッドの返信でない場合は NULL
+	EditedAt  *time.Time    `db:"edited_at"`
+	DeletedAt *time.Time    `db:"deleted_at"`
 }
 
 func (m *MessageModel) FromEntity(message *entity.Message) error {
@@ -34,17 +38,74 @@ func (m *MessageModel) FromEntity(message *entity.Message) error {
 		return err
 	}
 	m.UserID = userIDUUID
+	m.ParentID = uuid.NullUUID{}
+	if message.IsReply() {
+		parentID := message.GetParentID()
+		parentIDUUID, err := parentID.MessageID2UUID()
+		if err != nil {
+			return err
+		}
+		m.ParentID = uuid.NullUUID{UUID: parentIDUUID, Valid: true}
+	}
 	m.Content = message.GetContent()
 	m.SentAt = message.GetSentAt()
+	m.EditedAt = message.GetEditedAt()
+	m.DeletedAt = message.GetDeletedAt()
 	return nil
 }
 
 func (m *MessageModel) ToEntity() *entity.Message {
+	var parentID entity.MessageID
+	if m.ParentID.Valid {
+		parentID = entity.MessageID(m.ParentID.UUID.String())
+	}
 	return entity.NewMessage(entity.MessageParams{
-		ID:      entity.MessageID(m.ID.String()), // UUID -> MessageID
-		RoomID:  entity.RoomID(m.RoomID.String()),
-		UserID:  entity.UserID(m.UserID.String()),
-		Content: m.Content,
-		SentAt:  m.SentAt,
+		ID:        entity.MessageID(m.ID.String()), // UUID -> MessageID
+		RoomID:    entity.RoomID(m.RoomID.String()),
+		UserID:    entity.UserID(m.UserID.String()),
+		ParentID:  parentID,
+		Content:   m.Content,
+		SentAt:    m.SentAt,
+		EditedAt:  m.EditedAt,
+		DeletedAt: m.DeletedAt,
+	})
+}
+
+type MessageRevisionModel struct {
+	MessageID uuid.UUID `db:"message_id"`
+	Content   string    `db:"content"`
+	EditedAt  time.Time `db:"edited_at"`
+}
+
+func (m *MessageRevisionModel) FromEntity(rev *entity.MessageRevision) error {
+	messageID := rev.GetMessageID()
+	messageIDUUID, err := messageID.MessageID2UUID()
+	if err != nil {
+		return err
+	}
+	m.MessageID = messageIDUUID
+	m.Content = rev.GetContent()
+	m.EditedAt = rev.GetEditedAt()
+	return nil
+}
+
+func (m *MessageRevisionModel) ToEntity() *entity.MessageRevision {
+	return entity.NewMessageRevision(entity.MessageRevisionParams{
+		MessageID: entity.MessageID(m.MessageID.String()),
+		Content:   m.Content,
+		EditedAt:  m.EditedAt,
+	})
+}
+
+type ThreadSummaryModel struct {
+	ParentID    uuid.UUID `db:"parent_id"`
+	ReplyCount  int       `db:"reply_count"`
+	LastReplyAt time.Time `db:"last_reply_at"`
+}
+
+func (m *ThreadSummaryModel) ToEntity() *entity.ThreadSummary {
+	return entity.NewThreadSummary(entity.ThreadSummaryParams{
+		ReplyCount:  m.ReplyCount,
+		LastReplyAt: m.LastReplyAt,
 	})
 }
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type PinModel struct {
+	RoomID    uuid.UUID `db:"room_id"`
+	MessageID uuid.UUID `db:"message_id"`
+	PinnedBy  uuid.UUID `db:"pinned_by"`
+	PinnedAt  time.Time `db:"pinned_at"`
+}
+
+func (m *PinModel) FromEntity(pin *entity.Pin) error {
+	roomID := pin.GetRoomID()
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+	messageID := pin.GetMessageID()
+	messageIDUUID, err := messageID.MessageID2UUID()
+	if err != nil {
+		return err
+	}
+	pinnedBy := pin.GetPinnedBy()
+	pinnedByUUID, err := pinnedBy.UserID2UUID()
+	if err != nil {
+		return err
+	}
+	m.RoomID = roomIDUUID
+	m.MessageID = messageIDUUID
+	m.PinnedBy = pinnedByUUID
+	m.PinnedAt = pin.GetPinnedAt().UTC()
+	return nil
+}
+
+// PinnedMessageModel はピン留めとメッセージを結合した行
+type PinnedMessageModel struct {
+	MessageModel
+	PinnedBy uuid.UUID `db:"pinned_by"`
+	PinnedAt time.Time `db:"pinned_at"`
+}
+
+func (m *PinnedMessageModel) ToEntity() *entity.PinnedMessage {
+	msg := m.MessageModel.ToEntity()
+	pin := entity.NewPin(entity.PinParams{
+		RoomID:    msg.GetRoomID(),
+		MessageID: msg.GetID(),
+		PinnedBy:  entity.UserID(m.PinnedBy.String()),
+		PinnedAt:  m.PinnedAt,
+	})
+	return entity.NewPinnedMessage(pin, msg)
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type ReactionModel struct {
+	MessageID uuid.UUID `db:"message_id"`
+	UserID    uuid.UUID `db:"user_id"`
+	Emoji     string    `db:"emoji"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (m *ReactionModel) FromEntity(reaction *entity.Reaction) error {
+	messageID := reaction.GetMessageID()
+	messageIDUUID, err := messageID.MessageID2UUID()
+	if err != nil {
+		return err
+	}
+	m.MessageID = messageIDUUID
+	userID := reaction.GetUserID()
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return err
+	}
+	m.UserID = userIDUUID
+	m.Emoji = reaction.GetEmoji()
+	m.CreatedAt = reaction.GetCreatedAt()
+	return nil
+}
+
+func (m *ReactionModel) ToEntity() *entity.Reaction {
+	return entity.NewReaction(entity.ReactionParams{
+		MessageID: entity.MessageID(m.MessageID.String()),
+		UserID:    entity.UserID(m.UserID.String()),
+		Emoji:     m.Emoji,
+		CreatedAt: m.CreatedAt,
+	})
+}
+
+type ReactionSummaryModel struct {
+	MessageID uuid.UUID `db:"message_id"`
+	Emoji     string    `db:"emoji"`
+	Count     int       `db:"count"`
+	Reacted   bool      `db:"reacted"`
+}
+
+func (m *ReactionSummaryModel) ToEntity() *entity.ReactionSummary {
+	return entity.NewReactionSummary(entity.ReactionSummaryParams{
+		Emoji:   m.Emoji,
+		Count:   m.Count,
+		Reacted: m.Reacted,
+	})
+}
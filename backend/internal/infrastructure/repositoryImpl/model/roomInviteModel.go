@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type RoomInviteModel struct {
+	Token     string    `db:"token"`
+	RoomID    uuid.UUID `db:"room_id"`
+	CreatedBy uuid.UUID `db:"created_by"`
+	MaxUses   int       `db:"max_uses"`
+	Uses      int       `db:"uses"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (m *RoomInviteModel) FromEntity(invite *entity.RoomInvite) error {
+	roomID := invite.GetRoomID()
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+	m.RoomID = roomIDUUID
+	createdBy := invite.GetCreatedBy()
+	createdByUUID, err := createdBy.UserID2UUID()
+	if err != nil {
+		return err
+	}
+	m.CreatedBy = createdByUUID
+	m.Token = string(invite.GetToken())
+	m.MaxUses = invite.GetMaxUses()
+	m.Uses = invite.GetUses()
+	m.ExpiresAt = invite.GetExpiresAt().UTC()
+	m.CreatedAt = invite.GetCreatedAt().UTC()
+	return nil
+}
+
+func (m *RoomInviteModel) ToEntity() *entity.RoomInvite {
+	return entity.NewRoomInvite(entity.RoomInviteParams{
+		Token:     entity.InviteToken(m.Token),
+		RoomID:    entity.RoomID(m.RoomID.String()),
+		CreatedBy: entity.UserID(m.CreatedBy.String()),
+		MaxUses:   m.MaxUses,
+		Uses:      m.Uses,
+		ExpiresAt: m.ExpiresAt,
+		CreatedAt: m.CreatedAt,
+	})
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type RoomJoinRequestModel struct {
+	RoomID    uuid.UUID     `db:"room_id"`
+	UserID    uuid.UUID     `db:"user_id"`
+	Message   string        `db:"message"`
+	Status    string        `db:"status"`
+	CreatedAt time.Time     `db:"created_at"`
+	DecidedBy uuid.NullUUID `db:"decided_by"` // 判断待ちの間は NULL
+	DecidedAt *time.Time    `db:"decided_at"`
+}
+
+func (m *RoomJoinRequestModel) FromEntity(req *entity.RoomJoinRequest) error {
+	roomID := req.GetRoomID()
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+	userID := req.GetUserID()
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return err
+	}
+	m.RoomID = roomIDUUID
+	m.UserID = userIDUUID
+	m.Message = req.GetMessage()
+	m.Status = string(req.GetStatus())
+	m.CreatedAt = req.GetCreatedAt().UTC()
+	if decidedBy := req.GetDecidedBy(); decidedBy != nil {
+		decidedByUUID, err := decidedBy.UserID2UUID()
+		if err != nil {
+			return err
+		}
+		m.DecidedBy = uuid.NullUUID{UUID: decidedByUUID, Valid: true}
+	}
+	if decidedAt := req.GetDecidedAt(); decidedAt != nil {
+		utc := decidedAt.UTC()
+		m.DecidedAt = &utc
+	}
+	return nil
+}
+
+func (m *RoomJoinRequestModel) ToEntity() *entity.RoomJoinRequest {
+	var decidedBy *entity.UserID
+	if m.DecidedBy.Valid {
+		id := entity.UserID(m.DecidedBy.UUID.String())
+		decidedBy = &id
+	}
+	return entity.NewRoomJoinRequest(entity.RoomJoinRequestParams{
+		RoomID:    entity.RoomID(m.RoomID.String()),
+		UserID:    entity.UserID(m.UserID.String()),
+		Message:   m.Message,
+		Status:    entity.RoomJoinRequestStatus(m.Status),
+		CreatedAt: m.CreatedAt,
+		DecidedBy: decidedBy,
+		DecidedAt: m.DecidedAt,
+	})
+}
@@ -1,10 +1,101 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"github.com/google/uuid"
+)
 
 type RoomModel struct {
-	ID   uuid.UUID `db:"id"`
-	Name string `db:"name"`
+	ID              uuid.UUID  `db:"id"`
+	Name            string     `db:"name"`
+	Kind            string     `db:"kind"`
+	Visibility      string     `db:"visibility"`
+	Description     string     `db:"description"`
+	PinLimit        int        `db:"pin_limit"`
+	SlowModeSeconds int        `db:"slow_mode_seconds"`
+	ArchivedAt      *time.Time `db:"archived_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+}
+
+func (m *RoomModel) ToEntity(members []entity.UserID) *entity.Room {
+	return entity.NewRoom(entity.RoomParams{
+		ID:          entity.RoomID(m.ID.String()),
+		Name:        m.Name,
+		Kind:        entity.RoomKind(m.Kind),
+		Visibility:  entity.RoomVisibility(m.Visibility),
+		Description: m.Description,
+		PinLimit:    m.PinLimit,
+		SlowMode:    time.Duration(m.SlowModeSeconds) * time.Second,
+		ArchivedAt:  m.ArchivedAt,
+		CreatedAt:   m.CreatedAt,
+		Members:     members,
+	})
+}
+
+// RoomSummaryModel は部屋の一覧の1行です（部屋・メンバー数・最新のメッセージ・未読件数）。
+// 最新のメッセージがない部屋は LastMessage* が NULL になります。
+type RoomSummaryModel struct {
+	RoomModel
+	MemberCount         int           `db:"member_count"`
+	LastMessageID       uuid.NullUUID `db:"last_message_id"`
+	LastMessageUserID   uuid.NullUUID `db:"last_message_user_id"`
+	LastMessageContent  *string       `db:"last_message_content"`
+	LastMessageSentAt   *time.Time    `db:"last_message_sent_at"`
+	LastMessageEditedAt *time.Time    `db:"last_message_edited_at"`
+	UnreadCount         int           `db:"unread_count"`
+	MentionCount        int           `db:"mention_count"`
+}
+
+func (m *RoomSummaryModel) ToEntity() *entity.RoomSummary {
+	room := m.RoomModel.ToEntity([]entity.UserID{})
+	var lastMessage *entity.Message
+	if m.LastMessageID.Valid && m.LastMessageSentAt != nil {
+		var content string
+		if m.LastMessageContent != nil {
+			content = *m.LastMessageContent
+		}
+		lastMessage = entity.NewMessage(entity.MessageParams{
+			ID:       entity.MessageID(m.LastMessageID.UUID.String()),
+			RoomID:   room.GetID(),
+			UserID:   entity.UserID(m.LastMessageUserID.UUID.String()),
+			Content:  content,
+			SentAt:   *m.LastMessageSentAt,
+			EditedAt: m.LastMessageEditedAt,
+		})
+	}
+	return entity.NewRoomSummary(entity.RoomSummaryParams{
+		Room:         room,
+		MemberCount:  m.MemberCount,
+		LastMessage:  lastMessage,
+		UnreadCount:  m.UnreadCount,
+		MentionCount: m.MentionCount,
+	})
+}
+
+// RoomMemberUserModel は部屋のメンバーの一覧の1行です（ユーザーと部屋での役割）。
+type RoomMemberUserModel struct {
+	UserModel
+	Role string `db:"role"`
+}
+
+func (m *RoomMemberUserModel) ToEntity() *entity.RoomMember {
+	return entity.NewRoomMember(entity.RoomMemberParams{
+		User: m.UserModel.ToEntity(),
+		Role: entity.RoomRole(m.Role),
+	})
+}
+
+// DirectRoomKey は2人のユーザーのダイレクトメッセージを一意に識別するキーを返します。
+// rooms.dm_key に保存し、一意制約で同じ組み合わせの部屋が重複しないようにします。
+// ユーザーの順序に依存しないよう、ソートしてから連結します。
+func DirectRoomKey(userA, userB entity.UserID) string {
+	ids := []string{string(userA), string(userB)}
+	sort.Strings(ids)
+	return strings.Join(ids, ":")
 }
 
 type RoomMemberModel struct {
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type RoomModerationActionModel struct {
+	RoomID    uuid.UUID  `db:"room_id"`
+	ActorID   uuid.UUID  `db:"actor_id"`
+	TargetID  uuid.UUID  `db:"target_id"`
+	Action    string     `db:"action"`
+	Reason    string     `db:"reason"`
+	ExpiresAt *time.Time `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func (m *RoomModerationActionModel) FromEntity(action *entity.RoomModerationAction) error {
+	roomID := action.GetRoomID()
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+	actorID := action.GetActorID()
+	actorUUID, err := actorID.UserID2UUID()
+	if err != nil {
+		return err
+	}
+	targetID := action.GetTargetID()
+	targetUUID, err := targetID.UserID2UUID()
+	if err != nil {
+		return err
+	}
+	m.RoomID = roomIDUUID
+	m.ActorID = actorUUID
+	m.TargetID = targetUUID
+	m.Action = string(action.GetAction())
+	m.Reason = action.GetReason()
+	if expiresAt := action.GetExpiresAt(); expiresAt != nil {
+		utc := expiresAt.UTC()
+		m.ExpiresAt = &utc
+	}
+	m.CreatedAt = action.GetCreatedAt().UTC()
+	return nil
+}
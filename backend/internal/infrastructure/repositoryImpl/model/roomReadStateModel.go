@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type RoomReadStateModel struct {
+	RoomID            uuid.UUID `db:"room_id"`
+	UserID            uuid.UUID `db:"user_id"`
+	LastReadMessageID uuid.UUID `db:"last_read_message_id"`
+	LastReadAt        time.Time `db:"last_read_at"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
+
+func (m *RoomReadStateModel) FromEntity(state *entity.RoomReadState) error {
+	roomID := state.GetRoomID()
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+	userID := state.GetUserID()
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return err
+	}
+	messageID := state.GetLastReadMessageID()
+	messageIDUUID, err := messageID.MessageID2UUID()
+	if err != nil {
+		return err
+	}
+	m.RoomID = roomIDUUID
+	m.UserID = userIDUUID
+	m.LastReadMessageID = messageIDUUID
+	m.LastReadAt = state.GetLastReadAt().UTC()
+	m.UpdatedAt = state.GetUpdatedAt().UTC()
+	return nil
+}
+
+func (m *RoomReadStateModel) ToEntity() *entity.RoomReadState {
+	return entity.NewRoomReadState(entity.RoomReadStateParams{
+		RoomID:            entity.RoomID(m.RoomID.String()),
+		UserID:            entity.UserID(m.UserID.String()),
+		LastReadMessageID: entity.MessageID(m.LastReadMessageID.String()),
+		LastReadAt:        m.LastReadAt,
+		UpdatedAt:         m.UpdatedAt,
+	})
+}
@@ -14,6 +14,7 @@ type UserModel struct {
 	PasswordHash string     `db:"password_hash"`
 	CreatedAt    time.Time  `db:"created_at"`
 	UpdatedAt    *time.Time `db:"updated_at"`
+	LastSeenAt   *time.Time `db:"last_seen_at"`
 }
 
 func (u *UserModel) ToEntity() *entity.User {
@@ -24,5 +25,6 @@ func (u *UserModel) ToEntity() *entity.User {
 		PasswdHash: u.PasswordHash,
 		CreatedAt:  u.CreatedAt,
 		UpdatedAt:  u.UpdatedAt,
+		LastSeenAt: u.LastSeenAt,
 	})
 }
@@ -0,0 +1,134 @@
+package mysqlpinrepo
+
+import (
+	"context"
+	"errors"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type PinRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewPinRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewPinRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewPinRepositoryImpl(params *NewPinRepositoryImplParams) repository.PinRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &PinRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *PinRepositoryImpl) SavePin(ctx context.Context, pin *entity.Pin) error {
+	if pin == nil {
+		return errors.New("pin cannot be nil")
+	}
+	var m model.PinModel
+	if err := m.FromEntity(pin); err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		INSERT IGNORE INTO message_pins (message_id, room_id, pinned_by, pinned_at)
+		VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), UUID_TO_BIN(?), ?)`,
+		m.MessageID, m.RoomID, m.PinnedBy, m.PinnedAt)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrAlreadyPinned
+	}
+	return nil
+}
+
+func (r *PinRepositoryImpl) DeletePin(ctx context.Context, roomID entity.RoomID, messageID entity.MessageID) error {
+	roomUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+	messageUUID, err := messageID.MessageID2UUID()
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM message_pins WHERE room_id = UUID_TO_BIN(?) AND message_id = UUID_TO_BIN(?)`, roomUUID, messageUUID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrPinNotFound
+	}
+	return nil
+}
+
+func (r *PinRepositoryImpl) CountPins(ctx context.Context, roomID entity.RoomID) (int, error) {
+	roomUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	err = r.db.GetContext(ctx, &count, `
+		SELECT COUNT(*) FROM message_pins p
+		JOIN messages m ON m.id = p.message_id
+		WHERE p.room_id = UUID_TO_BIN(?) AND m.deleted_at IS NULL`, roomUUID)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *PinRepositoryImpl) ListPinnedMessages(ctx context.Context, roomID entity.RoomID) ([]*entity.PinnedMessage, error) {
+	roomUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return nil, err
+	}
+	var rows []model.PinnedMessageModel
+	err = r.db.SelectContext(ctx, &rows, `
+		SELECT
+			BIN_TO_UUID(m.id) AS id,
+			BIN_TO_UUID(m.room_id) AS room_id,
+			BIN_TO_UUID(m.user_id) AS user_id,
+			BIN_TO_UUID(m.parent_id) AS parent_id,
+			m.content,
+			m.sent_at,
+			m.edited_at,
+			m.deleted_at,
+			BIN_TO_UUID(p.pinned_by) AS pinned_by,
+			p.pinned_at
+		FROM message_pins p
+		JOIN messages m ON m.id = p.message_id
+		WHERE p.room_id = UUID_TO_BIN(?) AND m.deleted_at IS NULL
+		ORDER BY p.pinned_at DESC`, roomUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := make([]*entity.PinnedMessage, len(rows))
+	for i := range rows {
+		pinned[i] = rows[i].ToEntity()
+	}
+	return pinned, nil
+}
@@ -0,0 +1,107 @@
+package sqlitepinrepo
+
+import (
+	"context"
+	"errors"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type PinRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewPinRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewPinRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewPinRepositoryImpl(params *NewPinRepositoryImplParams) repository.PinRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &PinRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *PinRepositoryImpl) SavePin(ctx context.Context, pin *entity.Pin) error {
+	if pin == nil {
+		return errors.New("pin cannot be nil")
+	}
+	var m model.PinModel
+	if err := m.FromEntity(pin); err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		INSERT OR IGNORE INTO message_pins (message_id, room_id, pinned_by, pinned_at) VALUES (?, ?, ?, ?)`,
+		m.MessageID.String(), m.RoomID.String(), m.PinnedBy.String(), m.PinnedAt)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrAlreadyPinned
+	}
+	return nil
+}
+
+func (r *PinRepositoryImpl) DeletePin(ctx context.Context, roomID entity.RoomID, messageID entity.MessageID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM message_pins WHERE room_id = ? AND message_id = ?`, roomID, messageID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrPinNotFound
+	}
+	return nil
+}
+
+func (r *PinRepositoryImpl) CountPins(ctx context.Context, roomID entity.RoomID) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `
+		SELECT COUNT(*) FROM message_pins p
+		JOIN messages m ON m.id = p.message_id
+		WHERE p.room_id = ? AND m.deleted_at IS NULL`, roomID)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *PinRepositoryImpl) ListPinnedMessages(ctx context.Context, roomID entity.RoomID) ([]*entity.PinnedMessage, error) {
+	var rows []model.PinnedMessageModel
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT m.id, m.room_id, m.user_id, m.parent_id, m.content, m.sent_at, m.edited_at, m.deleted_at,
+			p.pinned_by, p.pinned_at
+		FROM message_pins p
+		JOIN messages m ON m.id = p.message_id
+		WHERE p.room_id = ? AND m.deleted_at IS NULL
+		ORDER BY p.pinned_at DESC`, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := make([]*entity.PinnedMessage, len(rows))
+	for i := range rows {
+		pinned[i] = rows[i].ToEntity()
+	}
+	return pinned, nil
+}
@@ -0,0 +1,130 @@
+package sqlitepinrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/pinRepositoryImpl/sqlitepinrepo"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // SQLiteドライバ
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoomID     = "0f8e7d6c-5b4a-4392-8170-6f5e4d3c2b1a"
+	testRoomID2    = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	testMessageID  = "7f1f3c1e-9b7a-4d3e-8a55-0c6f1d2b3a40"
+	testMessageID2 = "8a2b4c6d-1e3f-4a5b-9c7d-2e4f6a8b0c12"
+	testMessageID3 = "9b3c5d7e-2f4a-4b6c-8d9e-3f5a7b9c1d23"
+	testUserID     = "c4d2e1f0-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+	testAdminID    = "d5e3f2a1-6b7c-4d8e-9f0a-1b2c3d4e5f60"
+)
+
+func setupTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+CREATE TABLE messages (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	parent_id TEXT,
+	content TEXT NOT NULL,
+	sent_at DATETIME NOT NULL,
+	edited_at DATETIME,
+	deleted_at DATETIME
+);
+CREATE TABLE message_pins (
+	message_id TEXT NOT NULL PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	pinned_by TEXT NOT NULL,
+	pinned_at DATETIME NOT NULL
+);`)
+	require.NoError(t, err)
+
+	return db
+}
+
+func insertMessage(t *testing.T, db *sqlx.DB, id, roomID, content string, deleted bool) {
+	var deletedAt *time.Time
+	if deleted {
+		now := time.Now().UTC()
+		deletedAt = &now
+	}
+	_, err := db.Exec(`INSERT INTO messages (id, room_id, user_id, content, sent_at, deleted_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		id, roomID, testUserID, content, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), deletedAt)
+	require.NoError(t, err)
+}
+
+func newPin(roomID, messageID string, at time.Time) *entity.Pin {
+	return entity.NewPin(entity.PinParams{
+		RoomID:    entity.RoomID(roomID),
+		MessageID: entity.MessageID(messageID),
+		PinnedBy:  entity.UserID(testAdminID),
+		PinnedAt:  at,
+	})
+}
+
+func TestPinRepositoryImpl_SaveAndDeletePin(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitepinrepo.NewPinRepositoryImpl(&sqlitepinrepo.NewPinRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	insertMessage(t, db, testMessageID, testRoomID, "hello", false)
+
+	require.NoError(t, repo.SavePin(ctx, newPin(testRoomID, testMessageID, now)))
+	// 同じメッセージは2回ピン留めできない
+	assert.ErrorIs(t, repo.SavePin(ctx, newPin(testRoomID, testMessageID, now)), repository.ErrAlreadyPinned)
+
+	count, err := repo.CountPins(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	require.NoError(t, repo.DeletePin(ctx, testRoomID, testMessageID))
+	assert.ErrorIs(t, repo.DeletePin(ctx, testRoomID, testMessageID), repository.ErrPinNotFound)
+
+	count, err = repo.CountPins(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestPinRepositoryImpl_ListPinnedMessages(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitepinrepo.NewPinRepositoryImpl(&sqlitepinrepo.NewPinRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	base := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	insertMessage(t, db, testMessageID, testRoomID, "first", false)
+	insertMessage(t, db, testMessageID2, testRoomID, "second", false)
+	insertMessage(t, db, testMessageID3, testRoomID, "deleted", true)
+	require.NoError(t, repo.SavePin(ctx, newPin(testRoomID, testMessageID, base)))
+	require.NoError(t, repo.SavePin(ctx, newPin(testRoomID, testMessageID2, base.Add(time.Minute))))
+	require.NoError(t, repo.SavePin(ctx, newPin(testRoomID, testMessageID3, base.Add(2*time.Minute))))
+
+	// 削除済みのメッセージは含めず、ピン留めした日時の新しい順に返す
+	pins, err := repo.ListPinnedMessages(ctx, testRoomID)
+	require.NoError(t, err)
+	require.Len(t, pins, 2)
+	assert.Equal(t, entity.MessageID(testMessageID2), pins[0].GetMessage().GetID())
+	assert.Equal(t, "second", pins[0].GetMessage().GetContent())
+	assert.Equal(t, entity.UserID(testAdminID), pins[0].GetPin().GetPinnedBy())
+	assert.True(t, base.Add(time.Minute).Equal(pins[0].GetPin().GetPinnedAt()))
+	assert.Equal(t, entity.MessageID(testMessageID), pins[1].GetMessage().GetID())
+
+	count, err := repo.CountPins(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// 別の部屋のピン留めは含まない
+	pins, err = repo.ListPinnedMessages(ctx, testRoomID2)
+	require.NoError(t, err)
+	assert.Empty(t, pins)
+}
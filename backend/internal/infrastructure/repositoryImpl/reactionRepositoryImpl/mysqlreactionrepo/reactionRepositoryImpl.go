@@ -0,0 +1,133 @@
+package mysqlreactionrepo
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type ReactionRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewReactionRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewReactionRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewReactionRepositoryImpl(params *NewReactionRepositoryImplParams) repository.ReactionRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &ReactionRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *ReactionRepositoryImpl) AddReaction(ctx context.Context, reaction *entity.Reaction) error {
+	var m model.ReactionModel
+	if err := m.FromEntity(reaction); err != nil {
+		return err
+	}
+
+	// 既に同じリアクションがある場合は何もしない
+	_, err := r.db.ExecContext(ctx, `
+		INSERT IGNORE INTO message_reactions (message_id, user_id, emoji, created_at)
+		VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), ?, ?)`,
+		m.MessageID, m.UserID, m.Emoji, m.CreatedAt)
+	return err
+}
+
+func (r *ReactionRepositoryImpl) RemoveReaction(ctx context.Context, messageID entity.MessageID, userID entity.UserID, emoji string) error {
+	messageIDUUID, err := messageID.MessageID2UUID()
+	if err != nil {
+		return err
+	}
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		DELETE FROM message_reactions
+		WHERE message_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?) AND emoji = ?`,
+		messageIDUUID, userIDUUID, emoji)
+	return err
+}
+
+func (r *ReactionRepositoryImpl) CountReactions(ctx context.Context, messageID entity.MessageID, emoji string) (int, error) {
+	messageIDUUID, err := messageID.MessageID2UUID()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	err = r.db.GetContext(ctx, &count, `
+		SELECT COUNT(*) FROM message_reactions
+		WHERE message_id = UUID_TO_BIN(?) AND emoji = ?`,
+		messageIDUUID, emoji)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *ReactionRepositoryImpl) GetReactionSummaries(
+	ctx context.Context,
+	messageIDs []entity.MessageID,
+	viewerID entity.UserID,
+) (map[entity.MessageID][]*entity.ReactionSummary, error) {
+	summaries := make(map[entity.MessageID][]*entity.ReactionSummary)
+	if len(messageIDs) == 0 {
+		return summaries, nil
+	}
+
+	viewerIDUUID, err := viewerID.UserID2UUID()
+	if err != nil {
+		return nil, err
+	}
+
+	// IN句の各要素を UUID_TO_BIN で変換する
+	placeholders := strings.TrimSuffix(strings.Repeat("UUID_TO_BIN(?),", len(messageIDs)), ",")
+	args := make([]any, 0, len(messageIDs)+1)
+	args = append(args, viewerIDUUID)
+	for i := range messageIDs {
+		id, err := messageIDs[i].MessageID2UUID()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, id)
+	}
+
+	var summaryModels []model.ReactionSummaryModel
+	err = r.db.SelectContext(ctx, &summaryModels, `
+		SELECT
+			BIN_TO_UUID(message_id) AS message_id,
+			emoji,
+			COUNT(*) AS count,
+			MAX(user_id = UUID_TO_BIN(?)) AS reacted
+		FROM message_reactions
+		WHERE message_id IN (`+placeholders+`)
+		GROUP BY message_id, emoji
+		ORDER BY MIN(created_at) ASC, emoji ASC`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range summaryModels {
+		messageID := entity.MessageID(summaryModels[i].MessageID.String())
+		summaries[messageID] = append(summaries[messageID], summaryModels[i].ToEntity())
+	}
+	return summaries, nil
+}
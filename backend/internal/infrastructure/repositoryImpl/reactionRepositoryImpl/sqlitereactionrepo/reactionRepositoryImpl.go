@@ -0,0 +1,102 @@
+package sqlitereactionrepo
+
+import (
+	"context"
+	"errors"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type ReactionRepositoryImpl struct {
+	DB *sqlx.DB
+}
+
+type NewReactionRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewReactionRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewReactionRepositoryImpl(params *NewReactionRepositoryImplParams) repository.ReactionRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &ReactionRepositoryImpl{
+		DB: params.DB,
+	}
+}
+
+func (r *ReactionRepositoryImpl) AddReaction(ctx context.Context, reaction *entity.Reaction) error {
+	if reaction == nil {
+		return errors.New("reaction cannot be nil")
+	}
+
+	// 既に同じリアクションがある場合は何もしない
+	_, err := r.DB.ExecContext(ctx, "INSERT OR IGNORE INTO message_reactions (message_id, user_id, emoji, created_at) VALUES (?, ?, ?, ?)",
+		string(reaction.GetMessageID()),
+		string(reaction.GetUserID()),
+		reaction.GetEmoji(),
+		reaction.GetCreatedAt(),
+	)
+	return err
+}
+
+func (r *ReactionRepositoryImpl) RemoveReaction(ctx context.Context, messageID entity.MessageID, userID entity.UserID, emoji string) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM message_reactions WHERE message_id = ? AND user_id = ? AND emoji = ?",
+		string(messageID), string(userID), emoji)
+	return err
+}
+
+func (r *ReactionRepositoryImpl) CountReactions(ctx context.Context, messageID entity.MessageID, emoji string) (int, error) {
+	var count int
+	err := r.DB.GetContext(ctx, &count, "SELECT COUNT(*) FROM message_reactions WHERE message_id = ? AND emoji = ?",
+		string(messageID), emoji)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *ReactionRepositoryImpl) GetReactionSummaries(
+	ctx context.Context,
+	messageIDs []entity.MessageID,
+	viewerID entity.UserID,
+) (map[entity.MessageID][]*entity.ReactionSummary, error) {
+	summaries := make(map[entity.MessageID][]*entity.ReactionSummary)
+	if len(messageIDs) == 0 {
+		return summaries, nil
+	}
+
+	ids := make([]string, len(messageIDs))
+	for i, id := range messageIDs {
+		ids[i] = string(id)
+	}
+	query, args, err := sqlx.In(`
+		SELECT message_id, emoji, COUNT(*) AS count, MAX(user_id = ?) AS reacted
+		FROM message_reactions
+		WHERE message_id IN (?)
+		GROUP BY message_id, emoji
+		ORDER BY MIN(created_at) ASC, emoji ASC`, string(viewerID), ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var summaryModels []model.ReactionSummaryModel
+	if err := r.DB.SelectContext(ctx, &summaryModels, r.DB.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	for i := range summaryModels {
+		messageID := entity.MessageID(summaryModels[i].MessageID.String())
+		summaries[messageID] = append(summaries[messageID], summaryModels[i].ToEntity())
+	}
+	return summaries, nil
+}
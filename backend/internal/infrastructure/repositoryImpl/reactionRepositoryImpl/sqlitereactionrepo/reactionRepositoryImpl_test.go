@@ -0,0 +1,110 @@
+package sqlitereactionrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/reactionRepositoryImpl/sqlitereactionrepo"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // SQLiteドライバ
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMessageID  = "7f1f3c1e-9b7a-4d3e-8a55-0c6f1d2b3a40"
+	testMessageID2 = "8a2b4c6d-1e3f-4a5b-9c7d-2e4f6a8b0c12"
+	testUserID     = "c4d2e1f0-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+	testUserID2    = "d5e3f2a1-6b7c-4d8e-9f0a-1b2c3d4e5f60"
+)
+
+func setupTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+
+	schema := `
+CREATE TABLE message_reactions (
+	message_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	emoji TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	PRIMARY KEY (message_id, user_id, emoji)
+);`
+	_, err = db.Exec(schema)
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	return db
+}
+
+func newReaction(messageID, userID, emoji string, at time.Time) *entity.Reaction {
+	return entity.NewReaction(entity.ReactionParams{
+		MessageID: entity.MessageID(messageID),
+		UserID:    entity.UserID(userID),
+		Emoji:     emoji,
+		CreatedAt: at,
+	})
+}
+
+func TestReactionRepositoryImpl_AddAndRemoveReaction(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlitereactionrepo.NewReactionRepositoryImpl(&sqlitereactionrepo.NewReactionRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// 同じリアクションを2回追加しても1件として数える
+	require.NoError(t, repo.AddReaction(ctx, newReaction(testMessageID, testUserID, "👍", now)))
+	require.NoError(t, repo.AddReaction(ctx, newReaction(testMessageID, testUserID, "👍", now)))
+	require.NoError(t, repo.AddReaction(ctx, newReaction(testMessageID, testUserID2, "👍", now)))
+
+	count, err := repo.CountReactions(ctx, entity.MessageID(testMessageID), "👍")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// 取り消しは何度呼んでもよい
+	require.NoError(t, repo.RemoveReaction(ctx, entity.MessageID(testMessageID), entity.UserID(testUserID), "👍"))
+	require.NoError(t, repo.RemoveReaction(ctx, entity.MessageID(testMessageID), entity.UserID(testUserID), "👍"))
+
+	count, err = repo.CountReactions(ctx, entity.MessageID(testMessageID), "👍")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestReactionRepositoryImpl_GetReactionSummaries(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlitereactionrepo.NewReactionRepositoryImpl(&sqlitereactionrepo.NewReactionRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	base := time.Now().UTC()
+
+	require.NoError(t, repo.AddReaction(ctx, newReaction(testMessageID, testUserID2, "🎉", base)))
+	require.NoError(t, repo.AddReaction(ctx, newReaction(testMessageID, testUserID, "👍", base.Add(time.Second))))
+	require.NoError(t, repo.AddReaction(ctx, newReaction(testMessageID, testUserID2, "👍", base.Add(2*time.Second))))
+
+	summaries, err := repo.GetReactionSummaries(ctx,
+		[]entity.MessageID{testMessageID, testMessageID2},
+		entity.UserID(testUserID),
+	)
+	require.NoError(t, err)
+
+	// リアクションのないメッセージは含まれない
+	assert.NotContains(t, summaries, entity.MessageID(testMessageID2))
+
+	// 最初にリアクションされた絵文字から順に並ぶ
+	got := summaries[entity.MessageID(testMessageID)]
+	require.Len(t, got, 2)
+	assert.Equal(t, "🎉", got[0].GetEmoji())
+	assert.Equal(t, 1, got[0].GetCount())
+	assert.False(t, got[0].HasReacted())
+	assert.Equal(t, "👍", got[1].GetEmoji())
+	assert.Equal(t, 2, got[1].GetCount())
+	assert.True(t, got[1].HasReacted())
+
+	empty, err := repo.GetReactionSummaries(ctx, nil, entity.UserID(testUserID))
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
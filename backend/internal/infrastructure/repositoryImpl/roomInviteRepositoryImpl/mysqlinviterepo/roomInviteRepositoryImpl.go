@@ -0,0 +1,114 @@
+package mysqlinviterepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type RoomInviteRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewRoomInviteRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewRoomInviteRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewRoomInviteRepositoryImpl(params *NewRoomInviteRepositoryImplParams) repository.RoomInviteRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &RoomInviteRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *RoomInviteRepositoryImpl) SaveInvite(ctx context.Context, invite *entity.RoomInvite) error {
+	var m model.RoomInviteModel
+	if err := m.FromEntity(invite); err != nil {
+		return err
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO room_invites (token, room_id, created_by, max_uses, uses, expires_at, created_at)
+		VALUES (?, UUID_TO_BIN(?), UUID_TO_BIN(?), ?, ?, ?, ?)`,
+		m.Token, m.RoomID, m.CreatedBy, m.MaxUses, m.Uses, m.ExpiresAt, m.CreatedAt)
+	return err
+}
+
+func (r *RoomInviteRepositoryImpl) GetInviteByToken(ctx context.Context, token entity.InviteToken) (*entity.RoomInvite, error) {
+	var m model.RoomInviteModel
+	err := r.db.GetContext(ctx, &m, `
+		SELECT token, BIN_TO_UUID(room_id) AS room_id, BIN_TO_UUID(created_by) AS created_by,
+		       max_uses, uses, expires_at, created_at
+		FROM room_invites
+		WHERE token = ?`, string(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrInviteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.ToEntity(), nil
+}
+
+func (r *RoomInviteRepositoryImpl) RedeemInvite(ctx context.Context, token entity.InviteToken, userID entity.UserID, now time.Time) error {
+	// UserID -> UUID
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 条件付きの UPDATE で、判定と加算を1つの文で行う
+	result, err := tx.ExecContext(ctx, `
+		UPDATE room_invites
+		SET uses = uses + 1
+		WHERE token = ? AND uses < max_uses AND expires_at > ?`,
+		string(token), now.UTC())
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrInviteUnavailable
+	}
+
+	// すでにメンバーの場合は一意制約によって何もせず、利用回数の加算も取り消す
+	result, err = tx.ExecContext(ctx, `
+		INSERT IGNORE INTO room_members (room_id, user_id, role)
+		SELECT room_id, UUID_TO_BIN(?), ? FROM room_invites WHERE token = ?`,
+		userIDUUID, entity.RoomRoleMember, string(token))
+	if err != nil {
+		return err
+	}
+	if affected, err = result.RowsAffected(); err != nil {
+		return err
+	}
+	if affected == 0 {
+		return nil
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,129 @@
+package sqliteinviterepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomInviteRepositoryImpl/sqliteinviterepo"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // SQLiteドライバ
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoomID = "5b0c9a7e-3f1d-4e2a-9c8b-7d6e5f4a3b21"
+	testUserID = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+)
+
+func setupTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+CREATE TABLE room_invites (
+	token TEXT NOT NULL PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	created_by TEXT NOT NULL,
+	max_uses INTEGER NOT NULL,
+	uses INTEGER NOT NULL DEFAULT 0,
+	expires_at DATETIME NOT NULL,
+	created_at DATETIME NOT NULL
+);
+CREATE TABLE room_members (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	role TEXT NOT NULL DEFAULT 'member'
+);
+CREATE UNIQUE INDEX idx_room_members_room_id_user_id ON room_members(room_id, user_id);`)
+	require.NoError(t, err)
+
+	return db
+}
+
+func newInvite(token string, maxUses int, expiresAt time.Time) *entity.RoomInvite {
+	return entity.NewRoomInvite(entity.RoomInviteParams{
+		Token:     entity.InviteToken(token),
+		RoomID:    testRoomID,
+		CreatedBy: testUserID,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+		CreatedAt: expiresAt.Add(-time.Hour),
+	})
+}
+
+func TestRoomInviteRepositoryImpl_SaveAndGetInvite(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqliteinviterepo.NewRoomInviteRepositoryImpl(&sqliteinviterepo.NewRoomInviteRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	expiresAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, repo.SaveInvite(ctx, newInvite("token1", 3, expiresAt)))
+
+	invite, err := repo.GetInviteByToken(ctx, "token1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoomID(testRoomID), invite.GetRoomID())
+	assert.Equal(t, entity.UserID(testUserID), invite.GetCreatedBy())
+	assert.Equal(t, 3, invite.GetMaxUses())
+	assert.Equal(t, 0, invite.GetUses())
+	assert.True(t, expiresAt.Equal(invite.GetExpiresAt()))
+
+	// 存在しないトークン
+	_, err = repo.GetInviteByToken(ctx, "missing")
+	assert.ErrorIs(t, err, repository.ErrInviteNotFound)
+}
+
+func TestRoomInviteRepositoryImpl_RedeemInvite(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqliteinviterepo.NewRoomInviteRepositoryImpl(&sqliteinviterepo.NewRoomInviteRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	expiresAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := expiresAt.Add(-time.Minute)
+	const (
+		guest1 = "c3d4e5f6-a7b8-4c9d-8e0f-2a3b4c5d6e7f"
+		guest2 = "d4e5f6a7-b8c9-4d0e-9f1a-3b4c5d6e7f80"
+		guest3 = "e5f6a7b8-c9d0-4e1f-8a2b-4c5d6e7f8091"
+	)
+	uses := func(token string) int {
+		invite, err := repo.GetInviteByToken(ctx, entity.InviteToken(token))
+		require.NoError(t, err)
+		return invite.GetUses()
+	}
+	isMember := func(userID string) bool {
+		var exists bool
+		require.NoError(t, db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM room_members WHERE room_id = ? AND user_id = ?)`, testRoomID, userID))
+		return exists
+	}
+
+	require.NoError(t, repo.SaveInvite(ctx, newInvite("token1", 2, expiresAt)))
+
+	// 上限に達するまでは利用でき、利用したユーザーは一般メンバーとして参加する
+	require.NoError(t, repo.RedeemInvite(ctx, "token1", guest1, now))
+	assert.True(t, isMember(guest1))
+	var role string
+	require.NoError(t, db.Get(&role, `SELECT role FROM room_members WHERE user_id = ?`, guest1))
+	assert.Equal(t, string(entity.RoomRoleMember), role)
+
+	// すでにメンバーの場合は利用回数を増やさない
+	require.NoError(t, repo.RedeemInvite(ctx, "token1", guest1, now))
+	assert.Equal(t, 1, uses("token1"))
+
+	require.NoError(t, repo.RedeemInvite(ctx, "token1", guest2, now))
+	assert.ErrorIs(t, repo.RedeemInvite(ctx, "token1", guest3, now), repository.ErrInviteUnavailable)
+	assert.Equal(t, 2, uses("token1"))
+	assert.False(t, isMember(guest3))
+
+	// 期限切れ
+	require.NoError(t, repo.SaveInvite(ctx, newInvite("token2", 5, expiresAt)))
+	assert.ErrorIs(t, repo.RedeemInvite(ctx, "token2", guest3, expiresAt), repository.ErrInviteUnavailable)
+	assert.False(t, isMember(guest3))
+
+	// 存在しないトークン
+	assert.ErrorIs(t, repo.RedeemInvite(ctx, "missing", guest3, now), repository.ErrInviteUnavailable)
+}
@@ -0,0 +1,152 @@
+package mysqljoinrequestrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type RoomJoinRequestRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewRoomJoinRequestRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewRoomJoinRequestRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewRoomJoinRequestRepositoryImpl(params *NewRoomJoinRequestRepositoryImplParams) repository.RoomJoinRequestRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &RoomJoinRequestRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *RoomJoinRequestRepositoryImpl) SaveJoinRequest(ctx context.Context, req *entity.RoomJoinRequest) error {
+	if req == nil {
+		return errors.New("join request cannot be nil")
+	}
+	var m model.RoomJoinRequestModel
+	if err := m.FromEntity(req); err != nil {
+		return err
+	}
+
+	// 判断待ちのリクエストは上書きしない（更新件数が0件になる）
+	// status は他の列の判定に使うため最後に更新する
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO room_join_requests (room_id, user_id, message, status, created_at, decided_by, decided_at)
+		VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), ?, ?, ?, NULL, NULL)
+		ON DUPLICATE KEY UPDATE
+			message = IF(status = 'pending', message, VALUES(message)),
+			created_at = IF(status = 'pending', created_at, VALUES(created_at)),
+			decided_by = IF(status = 'pending', decided_by, NULL),
+			decided_at = IF(status = 'pending', decided_at, NULL),
+			status = IF(status = 'pending', status, VALUES(status))`,
+		m.RoomID, m.UserID, m.Message, m.Status, m.CreatedAt)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrJoinRequestAlreadyExists
+	}
+	return nil
+}
+
+func (r *RoomJoinRequestRepositoryImpl) ListPendingJoinRequests(ctx context.Context, roomID entity.RoomID) ([]*entity.RoomJoinRequest, error) {
+	roomUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return nil, err
+	}
+	var rows []model.RoomJoinRequestModel
+	err = r.db.SelectContext(ctx, &rows, `
+		SELECT
+			BIN_TO_UUID(room_id) AS room_id,
+			BIN_TO_UUID(user_id) AS user_id,
+			message,
+			status,
+			created_at,
+			BIN_TO_UUID(decided_by) AS decided_by,
+			decided_at
+		FROM room_join_requests
+		WHERE room_id = UUID_TO_BIN(?) AND status = 'pending'
+		ORDER BY created_at ASC`, roomUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*entity.RoomJoinRequest, len(rows))
+	for i := range rows {
+		requests[i] = rows[i].ToEntity()
+	}
+	return requests, nil
+}
+
+func (r *RoomJoinRequestRepositoryImpl) DecideJoinRequest(ctx context.Context, roomID entity.RoomID, userID entity.UserID, status entity.RoomJoinRequestStatus, decidedBy entity.UserID, decidedAt time.Time) error {
+	roomUUID, userUUID, err := toUUIDs(roomID, userID)
+	if err != nil {
+		return err
+	}
+	decidedByUUID, err := decidedBy.UserID2UUID()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE room_join_requests SET status = ?, decided_by = UUID_TO_BIN(?), decided_at = ?
+		WHERE room_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?) AND status = 'pending'`,
+		status, decidedByUUID, decidedAt.UTC(), roomUUID, userUUID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrJoinRequestNotFound
+	}
+	return nil
+}
+
+func (r *RoomJoinRequestRepositoryImpl) ReopenJoinRequest(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	roomUUID, userUUID, err := toUUIDs(roomID, userID)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, `
+		UPDATE room_join_requests SET status = 'pending', decided_by = NULL, decided_at = NULL
+		WHERE room_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?) AND status = 'approved'`,
+		roomUUID, userUUID)
+	return err
+}
+
+// toUUIDs は RoomID と UserID を UUID に変換します。
+func toUUIDs(roomID entity.RoomID, userID entity.UserID) (uuid.UUID, uuid.UUID, error) {
+	roomUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	userUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	return roomUUID, userUUID, nil
+}
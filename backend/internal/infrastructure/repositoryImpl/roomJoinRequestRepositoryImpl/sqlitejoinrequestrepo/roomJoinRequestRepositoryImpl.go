@@ -0,0 +1,114 @@
+package sqlitejoinrequestrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type RoomJoinRequestRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewRoomJoinRequestRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewRoomJoinRequestRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewRoomJoinRequestRepositoryImpl(params *NewRoomJoinRequestRepositoryImplParams) repository.RoomJoinRequestRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &RoomJoinRequestRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *RoomJoinRequestRepositoryImpl) SaveJoinRequest(ctx context.Context, req *entity.RoomJoinRequest) error {
+	if req == nil {
+		return errors.New("join request cannot be nil")
+	}
+	var m model.RoomJoinRequestModel
+	if err := m.FromEntity(req); err != nil {
+		return err
+	}
+
+	// 判断待ちのリクエストは上書きしない（更新件数が0件になる）
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO room_join_requests (room_id, user_id, message, status, created_at, decided_by, decided_at)
+		VALUES (?, ?, ?, ?, ?, NULL, NULL)
+		ON CONFLICT (room_id, user_id) DO UPDATE SET
+			message = excluded.message,
+			status = excluded.status,
+			created_at = excluded.created_at,
+			decided_by = NULL,
+			decided_at = NULL
+		WHERE room_join_requests.status <> 'pending'`,
+		m.RoomID.String(), m.UserID.String(), m.Message, m.Status, m.CreatedAt)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrJoinRequestAlreadyExists
+	}
+	return nil
+}
+
+func (r *RoomJoinRequestRepositoryImpl) ListPendingJoinRequests(ctx context.Context, roomID entity.RoomID) ([]*entity.RoomJoinRequest, error) {
+	var rows []model.RoomJoinRequestModel
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT room_id, user_id, message, status, created_at, decided_by, decided_at
+		FROM room_join_requests
+		WHERE room_id = ? AND status = 'pending'
+		ORDER BY created_at ASC`, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*entity.RoomJoinRequest, len(rows))
+	for i := range rows {
+		requests[i] = rows[i].ToEntity()
+	}
+	return requests, nil
+}
+
+func (r *RoomJoinRequestRepositoryImpl) DecideJoinRequest(ctx context.Context, roomID entity.RoomID, userID entity.UserID, status entity.RoomJoinRequestStatus, decidedBy entity.UserID, decidedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE room_join_requests SET status = ?, decided_by = ?, decided_at = ?
+		WHERE room_id = ? AND user_id = ? AND status = 'pending'`,
+		status, decidedBy, decidedAt.UTC(), roomID, userID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrJoinRequestNotFound
+	}
+	return nil
+}
+
+func (r *RoomJoinRequestRepositoryImpl) ReopenJoinRequest(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE room_join_requests SET status = 'pending', decided_by = NULL, decided_at = NULL
+		WHERE room_id = ? AND user_id = ? AND status = 'approved'`,
+		roomID, userID)
+	return err
+}
@@ -0,0 +1,145 @@
+package sqlitejoinrequestrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomJoinRequestRepositoryImpl/sqlitejoinrequestrepo"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // SQLiteドライバ
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoomID  = "0f8e7d6c-5b4a-4392-8170-6f5e4d3c2b1a"
+	testRoomID2 = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	testUserID  = "c4d2e1f0-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+	testUserID2 = "e6f4a3b2-7c8d-4e9f-8a0b-2c3d4e5f6a71"
+	testAdminID = "d5e3f2a1-6b7c-4d8e-9f0a-1b2c3d4e5f60"
+)
+
+func setupTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+CREATE TABLE room_join_requests (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	message TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL DEFAULT 'pending',
+	created_at DATETIME NOT NULL,
+	decided_by TEXT,
+	decided_at DATETIME,
+	PRIMARY KEY (room_id, user_id)
+);`)
+	require.NoError(t, err)
+
+	return db
+}
+
+func newJoinRequest(roomID, userID, message string, at time.Time) *entity.RoomJoinRequest {
+	return entity.NewRoomJoinRequest(entity.RoomJoinRequestParams{
+		RoomID:    entity.RoomID(roomID),
+		UserID:    entity.UserID(userID),
+		Message:   message,
+		CreatedAt: at,
+	})
+}
+
+func TestRoomJoinRequestRepositoryImpl_SaveAndList(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitejoinrequestrepo.NewRoomJoinRequestRepositoryImpl(&sqlitejoinrequestrepo.NewRoomJoinRequestRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	base := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, repo.SaveJoinRequest(ctx, newJoinRequest(testRoomID, testUserID2, "second", base.Add(time.Minute))))
+	require.NoError(t, repo.SaveJoinRequest(ctx, newJoinRequest(testRoomID, testUserID, "first", base)))
+	require.NoError(t, repo.SaveJoinRequest(ctx, newJoinRequest(testRoomID2, testUserID, "other room", base)))
+	// 判断待ちのリクエストがある場合は上書きしない
+	assert.ErrorIs(t, repo.SaveJoinRequest(ctx, newJoinRequest(testRoomID, testUserID, "again", base.Add(time.Hour))), repository.ErrJoinRequestAlreadyExists)
+
+	// リクエストした日時の古い順に返す
+	requests, err := repo.ListPendingJoinRequests(ctx, testRoomID)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	assert.Equal(t, entity.UserID(testUserID), requests[0].GetUserID())
+	assert.Equal(t, "first", requests[0].GetMessage())
+	assert.Equal(t, entity.RoomJoinRequestPending, requests[0].GetStatus())
+	assert.True(t, base.Equal(requests[0].GetCreatedAt()))
+	assert.Nil(t, requests[0].GetDecidedBy())
+	assert.Nil(t, requests[0].GetDecidedAt())
+	assert.Equal(t, entity.UserID(testUserID2), requests[1].GetUserID())
+}
+
+func TestRoomJoinRequestRepositoryImpl_DecideJoinRequest(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitejoinrequestrepo.NewRoomJoinRequestRepositoryImpl(&sqlitejoinrequestrepo.NewRoomJoinRequestRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	base := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	// 判断待ちのリクエストがない
+	err := repo.DecideJoinRequest(ctx, testRoomID, testUserID, entity.RoomJoinRequestApproved, testAdminID, base)
+	assert.ErrorIs(t, err, repository.ErrJoinRequestNotFound)
+
+	require.NoError(t, repo.SaveJoinRequest(ctx, newJoinRequest(testRoomID, testUserID, "hello", base)))
+	require.NoError(t, repo.DecideJoinRequest(ctx, testRoomID, testUserID, entity.RoomJoinRequestDenied, testAdminID, base.Add(time.Minute)))
+
+	// 判断済みのリクエストは一覧に含まず、再度判断することもできない
+	requests, err := repo.ListPendingJoinRequests(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+	err = repo.DecideJoinRequest(ctx, testRoomID, testUserID, entity.RoomJoinRequestApproved, testAdminID, base.Add(time.Minute))
+	assert.ErrorIs(t, err, repository.ErrJoinRequestNotFound)
+
+	var decided struct {
+		Status    string `db:"status"`
+		DecidedBy string `db:"decided_by"`
+	}
+	require.NoError(t, db.Get(&decided, `SELECT status, decided_by FROM room_join_requests WHERE room_id = ? AND user_id = ?`, testRoomID, testUserID))
+	assert.Equal(t, "denied", decided.Status)
+	assert.Equal(t, testAdminID, decided.DecidedBy)
+
+	// 却下された後は再度リクエストでき、判断待ちに戻る
+	require.NoError(t, repo.SaveJoinRequest(ctx, newJoinRequest(testRoomID, testUserID, "please", base.Add(time.Hour))))
+	requests, err = repo.ListPendingJoinRequests(ctx, testRoomID)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "please", requests[0].GetMessage())
+	assert.True(t, base.Add(time.Hour).Equal(requests[0].GetCreatedAt()))
+	assert.Nil(t, requests[0].GetDecidedBy())
+}
+
+func TestRoomJoinRequestRepositoryImpl_ReopenJoinRequest(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitejoinrequestrepo.NewRoomJoinRequestRepositoryImpl(&sqlitejoinrequestrepo.NewRoomJoinRequestRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	base := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	// 承認したリクエストは判断待ちに戻り、再度承認できる
+	require.NoError(t, repo.SaveJoinRequest(ctx, newJoinRequest(testRoomID, testUserID, "hello", base)))
+	require.NoError(t, repo.DecideJoinRequest(ctx, testRoomID, testUserID, entity.RoomJoinRequestApproved, testAdminID, base.Add(time.Minute)))
+	require.NoError(t, repo.ReopenJoinRequest(ctx, testRoomID, testUserID))
+	requests, err := repo.ListPendingJoinRequests(ctx, testRoomID)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "hello", requests[0].GetMessage())
+	assert.Nil(t, requests[0].GetDecidedBy())
+	assert.Nil(t, requests[0].GetDecidedAt())
+	require.NoError(t, repo.DecideJoinRequest(ctx, testRoomID, testUserID, entity.RoomJoinRequestApproved, testAdminID, base.Add(time.Hour)))
+
+	// 却下したリクエストは判断待ちに戻さない
+	require.NoError(t, repo.SaveJoinRequest(ctx, newJoinRequest(testRoomID, testUserID2, "hello", base)))
+	require.NoError(t, repo.DecideJoinRequest(ctx, testRoomID, testUserID2, entity.RoomJoinRequestDenied, testAdminID, base.Add(time.Minute)))
+	require.NoError(t, repo.ReopenJoinRequest(ctx, testRoomID, testUserID2))
+	requests, err = repo.ListPendingJoinRequests(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+}
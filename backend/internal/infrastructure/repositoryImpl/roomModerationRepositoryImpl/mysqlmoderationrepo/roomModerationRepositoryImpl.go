@@ -0,0 +1,174 @@
+package mysqlmoderationrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type RoomModerationRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewRoomModerationRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewRoomModerationRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewRoomModerationRepositoryImpl(params *NewRoomModerationRepositoryImplParams) repository.RoomModerationRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &RoomModerationRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *RoomModerationRepositoryImpl) SaveAction(ctx context.Context, action *entity.RoomModerationAction) error {
+	var m model.RoomModerationActionModel
+	if err := m.FromEntity(action); err != nil {
+		return err
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO room_moderation_actions (room_id, actor_id, target_id, action, reason, expires_at, created_at)
+		VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), UUID_TO_BIN(?), ?, ?, ?, ?)`,
+		m.RoomID, m.ActorID, m.TargetID, m.Action, m.Reason, m.ExpiresAt, m.CreatedAt)
+	return err
+}
+
+func (r *RoomModerationRepositoryImpl) SaveBan(ctx context.Context, roomID entity.RoomID, userID entity.UserID, bannedAt time.Time) error {
+	roomUUID, userUUID, err := toUUIDs(roomID, userID)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT IGNORE INTO room_bans (room_id, user_id, created_at) VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), ?)`,
+		roomUUID, userUUID, bannedAt.UTC())
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM room_members WHERE room_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?)`, roomUUID, userUUID)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *RoomModerationRepositoryImpl) DeleteBan(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	roomUUID, userUUID, err := toUUIDs(roomID, userID)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM room_bans WHERE room_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?)`, roomUUID, userUUID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrBanNotFound
+	}
+	return nil
+}
+
+func (r *RoomModerationRepositoryImpl) IsBanned(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (bool, error) {
+	roomUUID, userUUID, err := toUUIDs(roomID, userID)
+	if err != nil {
+		return false, err
+	}
+	var banned bool
+	err = r.db.GetContext(ctx, &banned, `
+		SELECT EXISTS (SELECT 1 FROM room_bans WHERE room_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?))`,
+		roomUUID, userUUID)
+	if err != nil {
+		return false, err
+	}
+	return banned, nil
+}
+
+func (r *RoomModerationRepositoryImpl) SaveMute(ctx context.Context, roomID entity.RoomID, userID entity.UserID, until time.Time) error {
+	roomUUID, userUUID, err := toUUIDs(roomID, userID)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO room_mutes (room_id, user_id, muted_until) VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), ?)
+		ON DUPLICATE KEY UPDATE muted_until = VALUES(muted_until)`,
+		roomUUID, userUUID, until.UTC())
+	return err
+}
+
+func (r *RoomModerationRepositoryImpl) DeleteMute(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	roomUUID, userUUID, err := toUUIDs(roomID, userID)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM room_mutes WHERE room_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?)`, roomUUID, userUUID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrMuteNotFound
+	}
+	return nil
+}
+
+func (r *RoomModerationRepositoryImpl) GetMutedUntil(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (*time.Time, error) {
+	roomUUID, userUUID, err := toUUIDs(roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	var until time.Time
+	err = r.db.GetContext(ctx, &until, `
+		SELECT muted_until FROM room_mutes WHERE room_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?)`,
+		roomUUID, userUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &until, nil
+}
+
+// toUUIDs は RoomID と UserID を UUID に変換します。
+func toUUIDs(roomID entity.RoomID, userID entity.UserID) (uuid.UUID, uuid.UUID, error) {
+	roomUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	userUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	return roomUUID, userUUID, nil
+}
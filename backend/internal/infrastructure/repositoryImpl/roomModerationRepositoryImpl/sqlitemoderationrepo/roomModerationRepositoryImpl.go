@@ -0,0 +1,134 @@
+package sqlitemoderationrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type RoomModerationRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewRoomModerationRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewRoomModerationRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewRoomModerationRepositoryImpl(params *NewRoomModerationRepositoryImplParams) repository.RoomModerationRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &RoomModerationRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *RoomModerationRepositoryImpl) SaveAction(ctx context.Context, action *entity.RoomModerationAction) error {
+	if action == nil {
+		return errors.New("action cannot be nil")
+	}
+	var m model.RoomModerationActionModel
+	if err := m.FromEntity(action); err != nil {
+		return err
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO room_moderation_actions (room_id, actor_id, target_id, action, reason, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		m.RoomID.String(), m.ActorID.String(), m.TargetID.String(), m.Action, m.Reason, m.ExpiresAt, m.CreatedAt)
+	return err
+}
+
+func (r *RoomModerationRepositoryImpl) SaveBan(ctx context.Context, roomID entity.RoomID, userID entity.UserID, bannedAt time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT OR IGNORE INTO room_bans (room_id, user_id, created_at) VALUES (?, ?, ?)`,
+		roomID, userID, bannedAt.UTC())
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM room_members WHERE room_id = ? AND user_id = ?`, roomID, userID)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *RoomModerationRepositoryImpl) DeleteBan(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM room_bans WHERE room_id = ? AND user_id = ?`, roomID, userID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrBanNotFound
+	}
+	return nil
+}
+
+func (r *RoomModerationRepositoryImpl) IsBanned(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (bool, error) {
+	var banned bool
+	err := r.db.GetContext(ctx, &banned, `
+		SELECT EXISTS (SELECT 1 FROM room_bans WHERE room_id = ? AND user_id = ?)`, roomID, userID)
+	if err != nil {
+		return false, err
+	}
+	return banned, nil
+}
+
+func (r *RoomModerationRepositoryImpl) SaveMute(ctx context.Context, roomID entity.RoomID, userID entity.UserID, until time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO room_mutes (room_id, user_id, muted_until) VALUES (?, ?, ?)
+		ON CONFLICT (room_id, user_id) DO UPDATE SET muted_until = excluded.muted_until`,
+		roomID, userID, until.UTC())
+	return err
+}
+
+func (r *RoomModerationRepositoryImpl) DeleteMute(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM room_mutes WHERE room_id = ? AND user_id = ?`, roomID, userID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return repository.ErrMuteNotFound
+	}
+	return nil
+}
+
+func (r *RoomModerationRepositoryImpl) GetMutedUntil(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (*time.Time, error) {
+	var until time.Time
+	err := r.db.GetContext(ctx, &until, `
+		SELECT muted_until FROM room_mutes WHERE room_id = ? AND user_id = ?`, roomID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &until, nil
+}
@@ -0,0 +1,172 @@
+package sqlitemoderationrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomModerationRepositoryImpl/sqlitemoderationrepo"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // SQLiteドライバ
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoomID  = "5b0c9a7e-3f1d-4e2a-9c8b-7d6e5f4a3b21"
+	testActorID = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+	testUserID  = "0f9e8d7c-6b5a-4c3d-8e2f-1a0b9c8d7e6f"
+)
+
+func setupTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+CREATE TABLE room_bans (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	PRIMARY KEY (room_id, user_id)
+);
+CREATE TABLE room_mutes (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	muted_until DATETIME NOT NULL,
+	PRIMARY KEY (room_id, user_id)
+);
+CREATE TABLE room_moderation_actions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	room_id TEXT NOT NULL,
+	actor_id TEXT NOT NULL,
+	target_id TEXT NOT NULL,
+	action TEXT NOT NULL,
+	reason TEXT NOT NULL DEFAULT '',
+	expires_at DATETIME,
+	created_at DATETIME NOT NULL
+);
+CREATE TABLE room_members (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	role TEXT NOT NULL DEFAULT 'member'
+);
+CREATE UNIQUE INDEX idx_room_members_room_id_user_id ON room_members(room_id, user_id);`)
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestRoomModerationRepositoryImpl_Ban(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitemoderationrepo.NewRoomModerationRepositoryImpl(&sqlitemoderationrepo.NewRoomModerationRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	isMember := func(userID string) bool {
+		var exists bool
+		require.NoError(t, db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM room_members WHERE room_id = ? AND user_id = ?)`, testRoomID, userID))
+		return exists
+	}
+	_, err := db.Exec(`INSERT INTO room_members (id, room_id, user_id) VALUES ('m1', ?, ?), ('m2', ?, ?)`,
+		testRoomID, testUserID, testRoomID, testActorID)
+	require.NoError(t, err)
+
+	banned, err := repo.IsBanned(ctx, testRoomID, testUserID)
+	require.NoError(t, err)
+	assert.False(t, banned)
+
+	// 追放と同時に部屋から退出させる
+	require.NoError(t, repo.SaveBan(ctx, testRoomID, testUserID, time.Now()))
+	assert.False(t, isMember(testUserID))
+	assert.True(t, isMember(testActorID))
+	// 2回目は何もしない
+	require.NoError(t, repo.SaveBan(ctx, testRoomID, testUserID, time.Now()))
+
+	banned, err = repo.IsBanned(ctx, testRoomID, testUserID)
+	require.NoError(t, err)
+	assert.True(t, banned)
+
+	require.NoError(t, repo.DeleteBan(ctx, testRoomID, testUserID))
+	banned, err = repo.IsBanned(ctx, testRoomID, testUserID)
+	require.NoError(t, err)
+	assert.False(t, banned)
+
+	assert.ErrorIs(t, repo.DeleteBan(ctx, testRoomID, testUserID), repository.ErrBanNotFound)
+}
+
+func TestRoomModerationRepositoryImpl_Mute(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitemoderationrepo.NewRoomModerationRepositoryImpl(&sqlitemoderationrepo.NewRoomModerationRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	until, err := repo.GetMutedUntil(ctx, testRoomID, testUserID)
+	require.NoError(t, err)
+	assert.Nil(t, until)
+
+	first := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.SaveMute(ctx, testRoomID, testUserID, first))
+	// 再度禁止した場合は期限を上書きする
+	second := first.Add(time.Hour)
+	require.NoError(t, repo.SaveMute(ctx, testRoomID, testUserID, second))
+
+	until, err = repo.GetMutedUntil(ctx, testRoomID, testUserID)
+	require.NoError(t, err)
+	require.NotNil(t, until)
+	assert.True(t, second.Equal(*until))
+
+	require.NoError(t, repo.DeleteMute(ctx, testRoomID, testUserID))
+	until, err = repo.GetMutedUntil(ctx, testRoomID, testUserID)
+	require.NoError(t, err)
+	assert.Nil(t, until)
+
+	assert.ErrorIs(t, repo.DeleteMute(ctx, testRoomID, testUserID), repository.ErrMuteNotFound)
+}
+
+func TestRoomModerationRepositoryImpl_SaveAction(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitemoderationrepo.NewRoomModerationRepositoryImpl(&sqlitemoderationrepo.NewRoomModerationRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	expiresAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.SaveAction(ctx, entity.NewRoomModerationAction(entity.RoomModerationActionParams{
+		RoomID:    testRoomID,
+		ActorID:   testActorID,
+		TargetID:  testUserID,
+		Action:    entity.RoomModerationMute,
+		Reason:    "spam",
+		ExpiresAt: &expiresAt,
+		CreatedAt: expiresAt.Add(-time.Hour),
+	})))
+	require.NoError(t, repo.SaveAction(ctx, entity.NewRoomModerationAction(entity.RoomModerationActionParams{
+		RoomID:    testRoomID,
+		ActorID:   testActorID,
+		TargetID:  testUserID,
+		Action:    entity.RoomModerationKick,
+		CreatedAt: expiresAt,
+	})))
+
+	var rows []struct {
+		ActorID   string     `db:"actor_id"`
+		TargetID  string     `db:"target_id"`
+		Action    string     `db:"action"`
+		Reason    string     `db:"reason"`
+		ExpiresAt *time.Time `db:"expires_at"`
+	}
+	require.NoError(t, db.Select(&rows, `
+		SELECT actor_id, target_id, action, reason, expires_at
+		FROM room_moderation_actions WHERE room_id = ? ORDER BY id`, testRoomID))
+	require.Len(t, rows, 2)
+	assert.Equal(t, testActorID, rows[0].ActorID)
+	assert.Equal(t, testUserID, rows[0].TargetID)
+	assert.Equal(t, "mute", rows[0].Action)
+	assert.Equal(t, "spam", rows[0].Reason)
+	require.NotNil(t, rows[0].ExpiresAt)
+	assert.True(t, expiresAt.Equal(*rows[0].ExpiresAt))
+	assert.Equal(t, "kick", rows[1].Action)
+	assert.Nil(t, rows[1].ExpiresAt)
+}
@@ -0,0 +1,100 @@
+package mysqlreadstaterepo
+
+import (
+	"context"
+	"errors"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type RoomReadStateRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewRoomReadStateRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewRoomReadStateRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewRoomReadStateRepositoryImpl(params *NewRoomReadStateRepositoryImplParams) repository.RoomReadStateRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &RoomReadStateRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *RoomReadStateRepositoryImpl) SaveReadState(ctx context.Context, state *entity.RoomReadState) (bool, error) {
+	if state == nil {
+		return false, errors.New("read state cannot be nil")
+	}
+	var m model.RoomReadStateModel
+	if err := m.FromEntity(state); err != nil {
+		return false, err
+	}
+
+	// 既読位置を戻す更新は行わない（変更がない行は更新件数が0件になる）
+	// last_read_at は他の列の判定に使うため最後に更新する
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO room_read_states (room_id, user_id, last_read_message_id, last_read_at, updated_at)
+		VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), UUID_TO_BIN(?), ?, ?)
+		ON DUPLICATE KEY UPDATE
+			updated_at = IF(
+				VALUES(last_read_at) > last_read_at
+					OR (VALUES(last_read_at) = last_read_at AND VALUES(last_read_message_id) > last_read_message_id),
+				VALUES(updated_at), updated_at),
+			last_read_message_id = IF(
+				VALUES(last_read_at) > last_read_at
+					OR (VALUES(last_read_at) = last_read_at AND VALUES(last_read_message_id) > last_read_message_id),
+				VALUES(last_read_message_id), last_read_message_id),
+			last_read_at = GREATEST(last_read_at, VALUES(last_read_at))`,
+		m.RoomID, m.UserID, m.LastReadMessageID, m.LastReadAt, m.UpdatedAt)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *RoomReadStateRepositoryImpl) ListReadStates(ctx context.Context, roomID entity.RoomID) ([]*entity.RoomReadState, error) {
+	roomUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return nil, err
+	}
+
+	// 退出したメンバーの既読位置は含めない
+	var rows []model.RoomReadStateModel
+	err = r.db.SelectContext(ctx, &rows, `
+		SELECT
+			BIN_TO_UUID(rs.room_id) AS room_id,
+			BIN_TO_UUID(rs.user_id) AS user_id,
+			BIN_TO_UUID(rs.last_read_message_id) AS last_read_message_id,
+			rs.last_read_at,
+			rs.updated_at
+		FROM room_read_states rs
+		INNER JOIN room_members rm ON rm.room_id = rs.room_id AND rm.user_id = rs.user_id
+		WHERE rs.room_id = UUID_TO_BIN(?)
+		ORDER BY rs.updated_at DESC`, roomUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*entity.RoomReadState, len(rows))
+	for i := range rows {
+		states[i] = rows[i].ToEntity()
+	}
+	return states, nil
+}
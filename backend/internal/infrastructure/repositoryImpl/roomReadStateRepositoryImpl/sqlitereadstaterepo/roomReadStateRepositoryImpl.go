@@ -0,0 +1,86 @@
+package sqlitereadstaterepo
+
+import (
+	"context"
+	"errors"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/jmoiron/sqlx"
+)
+
+type RoomReadStateRepositoryImpl struct {
+	db *sqlx.DB
+}
+
+type NewRoomReadStateRepositoryImplParams struct {
+	DB *sqlx.DB
+}
+
+func (p *NewRoomReadStateRepositoryImplParams) Validate() error {
+	if p.DB == nil {
+		return errors.New("DB is nil")
+	}
+	return nil
+}
+
+func NewRoomReadStateRepositoryImpl(params *NewRoomReadStateRepositoryImplParams) repository.RoomReadStateRepository {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	return &RoomReadStateRepositoryImpl{
+		db: params.DB,
+	}
+}
+
+func (r *RoomReadStateRepositoryImpl) SaveReadState(ctx context.Context, state *entity.RoomReadState) (bool, error) {
+	if state == nil {
+		return false, errors.New("read state cannot be nil")
+	}
+	var m model.RoomReadStateModel
+	if err := m.FromEntity(state); err != nil {
+		return false, err
+	}
+
+	// 既読位置を戻す更新は行わない（更新件数が0件になる）
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO room_read_states (room_id, user_id, last_read_message_id, last_read_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (room_id, user_id) DO UPDATE SET
+			last_read_message_id = excluded.last_read_message_id,
+			last_read_at = excluded.last_read_at,
+			updated_at = excluded.updated_at
+		WHERE excluded.last_read_at > room_read_states.last_read_at
+			OR (excluded.last_read_at = room_read_states.last_read_at
+				AND excluded.last_read_message_id > room_read_states.last_read_message_id)`,
+		m.RoomID.String(), m.UserID.String(), m.LastReadMessageID.String(), m.LastReadAt, m.UpdatedAt)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *RoomReadStateRepositoryImpl) ListReadStates(ctx context.Context, roomID entity.RoomID) ([]*entity.RoomReadState, error) {
+	// 退出したメンバーの既読位置は含めない
+	var rows []model.RoomReadStateModel
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT rs.room_id, rs.user_id, rs.last_read_message_id, rs.last_read_at, rs.updated_at
+		FROM room_read_states rs
+		INNER JOIN room_members rm ON rm.room_id = rs.room_id AND rm.user_id = rs.user_id
+		WHERE rs.room_id = ?
+		ORDER BY rs.updated_at DESC`, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*entity.RoomReadState, len(rows))
+	for i := range rows {
+		states[i] = rows[i].ToEntity()
+	}
+	return states, nil
+}
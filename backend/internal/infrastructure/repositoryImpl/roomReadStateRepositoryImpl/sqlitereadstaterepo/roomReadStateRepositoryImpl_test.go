@@ -0,0 +1,123 @@
+package sqlitereadstaterepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomReadStateRepositoryImpl/sqlitereadstaterepo"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // SQLiteドライバ
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoomID     = "0f8e7d6c-5b4a-4392-8170-6f5e4d3c2b1a"
+	testUserID     = "c4d2e1f0-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+	testUserID2    = "e6f4a3b2-7c8d-4e9f-8a0b-2c3d4e5f6a71"
+	testLeftUserID = "d5e3f2a1-6b7c-4d8e-9f0a-1b2c3d4e5f60"
+	testMessageID  = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	testMessageID2 = "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+	testMessageID3 = "3c4d5e6f-7a8b-4c9d-8e1f-2a3b4c5d6e7f"
+)
+
+func setupTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+CREATE TABLE room_members (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL
+);
+CREATE TABLE room_read_states (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	last_read_message_id TEXT NOT NULL,
+	last_read_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	PRIMARY KEY (room_id, user_id)
+);`)
+	require.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO room_members (id, room_id, user_id) VALUES ('m1', ?, ?), ('m2', ?, ?)`,
+		testRoomID, testUserID, testRoomID, testUserID2)
+	require.NoError(t, err)
+
+	return db
+}
+
+func newReadState(userID, messageID string, readAt, updatedAt time.Time) *entity.RoomReadState {
+	return entity.NewRoomReadState(entity.RoomReadStateParams{
+		RoomID:            testRoomID,
+		UserID:            entity.UserID(userID),
+		LastReadMessageID: entity.MessageID(messageID),
+		LastReadAt:        readAt,
+		UpdatedAt:         updatedAt,
+	})
+}
+
+func TestRoomReadStateRepositoryImpl_SaveReadState(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitereadstaterepo.NewRoomReadStateRepositoryImpl(&sqlitereadstaterepo.NewRoomReadStateRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	base := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	// 初めての既読
+	advanced, err := repo.SaveReadState(ctx, newReadState(testUserID, testMessageID2, base, base.Add(time.Hour)))
+	require.NoError(t, err)
+	assert.True(t, advanced)
+
+	// 前のメッセージ・同じメッセージでは既読位置を戻さない
+	advanced, err = repo.SaveReadState(ctx, newReadState(testUserID, testMessageID3, base.Add(-time.Minute), base.Add(2*time.Hour)))
+	require.NoError(t, err)
+	assert.False(t, advanced)
+	advanced, err = repo.SaveReadState(ctx, newReadState(testUserID, testMessageID2, base, base.Add(2*time.Hour)))
+	require.NoError(t, err)
+	assert.False(t, advanced)
+	advanced, err = repo.SaveReadState(ctx, newReadState(testUserID, testMessageID, base, base.Add(2*time.Hour)))
+	require.NoError(t, err)
+	assert.False(t, advanced)
+
+	// 送信日時が同じ場合はメッセージIDの順に進む
+	advanced, err = repo.SaveReadState(ctx, newReadState(testUserID, testMessageID3, base, base.Add(3*time.Hour)))
+	require.NoError(t, err)
+	assert.True(t, advanced)
+
+	states, err := repo.ListReadStates(ctx, testRoomID)
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, entity.MessageID(testMessageID3), states[0].GetLastReadMessageID())
+	assert.True(t, base.Equal(states[0].GetLastReadAt()))
+	assert.True(t, base.Add(3*time.Hour).Equal(states[0].GetUpdatedAt()))
+}
+
+func TestRoomReadStateRepositoryImpl_ListReadStates(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := sqlitereadstaterepo.NewRoomReadStateRepositoryImpl(&sqlitereadstaterepo.NewRoomReadStateRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	base := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	for _, state := range []*entity.RoomReadState{
+		newReadState(testUserID, testMessageID, base, base.Add(time.Minute)),
+		newReadState(testUserID2, testMessageID2, base.Add(time.Second), base.Add(time.Hour)),
+		newReadState(testLeftUserID, testMessageID3, base.Add(2*time.Second), base.Add(2*time.Hour)),
+	} {
+		_, err := repo.SaveReadState(ctx, state)
+		require.NoError(t, err)
+	}
+
+	// 退出したメンバーは含めず、既読にした日時の新しい順に返す
+	states, err := repo.ListReadStates(ctx, testRoomID)
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+	assert.Equal(t, entity.UserID(testUserID2), states[0].GetUserID())
+	assert.Equal(t, entity.MessageID(testMessageID2), states[0].GetLastReadMessageID())
+	assert.Equal(t, entity.RoomID(testRoomID), states[0].GetRoomID())
+	assert.Equal(t, entity.UserID(testUserID), states[1].GetUserID())
+}
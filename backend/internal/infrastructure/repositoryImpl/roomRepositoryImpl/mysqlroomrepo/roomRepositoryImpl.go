@@ -2,7 +2,11 @@ package mysqlroomrepo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/domain/repository"
@@ -44,13 +48,47 @@ func (r *RoomRepositoryImpl) SaveRoom(ctx context.Context, room *entity.Room) (e
 		return entity.RoomID(""), err
 	}
 	// UUID -> BIN
-	_, err = r.db.ExecContext(ctx, `INSERT INTO rooms (id, name) VALUES (UUID_TO_BIN(?), ?)`, idUUID, room.GetName())
+	_, err = r.db.ExecContext(ctx, `INSERT INTO rooms (id, name, kind, visibility, created_at) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?)`,
+		idUUID, room.GetName(), room.GetKind(), room.GetVisibility(), room.GetCreatedAt())
 	if err != nil {
 		return entity.RoomID(""), err
 	}
 	return room.GetID(), nil
 }
 
+func (r *RoomRepositoryImpl) SaveRoomWithOwner(ctx context.Context, room *entity.Room, ownerID entity.UserID) (entity.RoomID, error) {
+	// RoomID -> UUID
+	id := room.GetID()
+	idUUID, err := id.RoomID2UUID()
+	if err != nil {
+		return "", err
+	}
+	// UserID -> UUID
+	ownerIDUUID, err := ownerID.UserID2UUID()
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO rooms (id, name, kind, visibility, created_at) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?)`,
+		idUUID, room.GetName(), room.GetKind(), room.GetVisibility(), room.GetCreatedAt())
+	if err != nil {
+		return "", err
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO room_members (room_id, user_id, role) VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), ?)`,
+		idUUID, ownerIDUUID, entity.RoomRoleOwner)
+	if err != nil {
+		return "", err
+	}
+
+	return room.GetID(), tx.Commit()
+}
+
 func (r *RoomRepositoryImpl) GetRoomByID(ctx context.Context, id entity.RoomID) (*entity.Room, error) {
 	roomModel := model.RoomModel{}
 	// RoomID -> UUID
@@ -58,7 +96,10 @@ func (r *RoomRepositoryImpl) GetRoomByID(ctx context.Context, id entity.RoomID)
 	if err != nil {
 		return nil, err
 	}
-	err = r.db.Get(&roomModel, `SELECT BIN_TO_UUID(id) AS id, name FROM rooms WHERE id = UUID_TO_BIN(?)`, idUUID)
+	err = r.db.Get(&roomModel, `SELECT BIN_TO_UUID(id) AS id, name, kind, visibility, description, pin_limit, slow_mode_seconds, archived_at, created_at FROM rooms WHERE id = UUID_TO_BIN(?)`, idUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrRoomNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -70,11 +111,7 @@ func (r *RoomRepositoryImpl) GetRoomByID(ctx context.Context, id entity.RoomID)
 		return nil, err
 	}
 
-	room := entity.NewRoom(entity.RoomParams{
-		ID:      entity.RoomID(roomModel.ID.String()),
-		Name:    roomModel.Name,
-		Members: make([]entity.UserID, len(roomMembers)),
-	})
+	room := roomModel.ToEntity(make([]entity.UserID, len(roomMembers)))
 
 	return room, nil
 }
@@ -87,7 +124,7 @@ func (r *RoomRepositoryImpl) GetRoomByPubID(ctx context.Context, id entity.RoomI
 		return nil, err
 	}
 
-	err = r.db.GetContext(ctx, &roomModel, `SELECT BIN_TO_UUID(id) AS id, name FROM rooms WHERE id = UUID_TO_BIN(?)`, idUUID)
+	err = r.db.GetContext(ctx, &roomModel, `SELECT BIN_TO_UUID(id) AS id, name, kind, visibility, description, pin_limit, slow_mode_seconds, archived_at, created_at FROM rooms WHERE id = UUID_TO_BIN(?)`, idUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -99,32 +136,31 @@ func (r *RoomRepositoryImpl) GetRoomByPubID(ctx context.Context, id entity.RoomI
 		return nil, err
 	}
 
-	room := entity.NewRoom(entity.RoomParams{
-		ID:      entity.RoomID(roomModel.ID.String()),
-		Name:    roomModel.Name,
-		Members: make([]entity.UserID, len(roomMembers)),
-	})
+	room := roomModel.ToEntity(make([]entity.UserID, len(roomMembers)))
 
 	return room, nil
 }
 
-func (r *RoomRepositoryImpl) GetAllRooms(ctx context.Context) ([]*entity.Room, error) {
-	roomModels := []model.RoomModel{}
-	err := r.db.SelectContext(ctx, &roomModels, `SELECT BIN_TO_UUID(id) AS id, name FROM rooms`)
+func (r *RoomRepositoryImpl) GetAllRooms(ctx context.Context, viewerID entity.UserID) ([]*entity.Room, error) {
+	// UserID -> UUID
+	viewerUUID, err := viewerID.UserID2UUID()
 	if err != nil {
 		return nil, err
 	}
 
-	rooms := make([]*entity.Room, len(roomModels))
-	for i, roomModel := range roomModels {
-		rooms[i] = entity.NewRoom(entity.RoomParams{
-			ID:      entity.RoomID(roomModel.ID.String()),
-			Name:    roomModel.Name,
-			Members: []entity.UserID{},
-		})
+	roomModels := []model.RoomModel{}
+	err = r.db.SelectContext(ctx, &roomModels, `
+	SELECT BIN_TO_UUID(id) AS id, name, kind, visibility, description, pin_limit, slow_mode_seconds, archived_at, created_at
+	FROM rooms
+	WHERE kind = 'group'
+	  AND (visibility = 'public'
+	       OR id IN (SELECT room_id FROM room_members WHERE user_id = UUID_TO_BIN(?)))
+`, viewerUUID)
+	if err != nil {
+		return nil, err
 	}
 
-	return rooms, nil
+	return toRooms(roomModels), nil
 }
 
 func (r *RoomRepositoryImpl) GetUsersInRoom(ctx context.Context, roomID entity.RoomID) ([]*entity.User, error) {
@@ -179,7 +215,7 @@ func (r *RoomRepositoryImpl) GetUsersInRoom(ctx context.Context, roomID entity.R
 	return users, nil
 }
 
-func (r *RoomRepositoryImpl) AddMemberToRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+func (r *RoomRepositoryImpl) AddMemberToRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID, role entity.RoomRole) error {
 	// RoomID -> UUID
 	roomIDUUID, err := roomID.RoomID2UUID()
 	if err != nil {
@@ -191,13 +227,50 @@ func (r *RoomRepositoryImpl) AddMemberToRoom(ctx context.Context, roomID entity.
 		return err
 	}
 	// UUID -> BIN
-	_, err = r.db.ExecContext(ctx, `INSERT INTO room_members (room_id, user_id) VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?))`, roomIDUUID, userIDUUID)
+	// すでにメンバーの場合は一意制約によって何もしない（役割も変えない）
+	_, err = r.db.ExecContext(ctx, `INSERT IGNORE INTO room_members (room_id, user_id, role) VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), ?)`, roomIDUUID, userIDUUID, role)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+func (r *RoomRepositoryImpl) GetMemberRole(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (entity.RoomRole, error) {
+	// RoomID -> UUID
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return "", err
+	}
+	// UserID -> UUID
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return "", err
+	}
+
+	var role entity.RoomRole
+	err = r.db.GetContext(ctx, &role, `
+		SELECT role FROM room_members
+		WHERE room_id = UUID_TO_BIN(?) AND user_id = UUID_TO_BIN(?)
+		LIMIT 1`, roomIDUUID, userIDUUID)
+	if err == nil {
+		return role, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+
+	// メンバーでない場合は、部屋が存在するかで返すエラーを分ける
+	var exists bool
+	err = r.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM rooms WHERE id = UUID_TO_BIN(?))`, roomIDUUID)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", repository.ErrRoomNotFound
+	}
+	return "", repository.ErrNotRoomMember
+}
+
 func (r *RoomRepositoryImpl) RemoveMemberFromRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
 	// RoomID -> UUID
 	roomIDUUID, err := roomID.RoomID2UUID()
@@ -217,28 +290,201 @@ func (r *RoomRepositoryImpl) RemoveMemberFromRoom(ctx context.Context, roomID en
 	return nil
 }
 
-func (r *RoomRepositoryImpl) GetRoomByNameLike(ctx context.Context, name string) ([]*entity.Room, error) {
+// toRooms は一覧取得の結果をエンティティに変換する（メンバーは含めない）
+func toRooms(roomModels []model.RoomModel) []*entity.Room {
+	rooms := make([]*entity.Room, len(roomModels))
+	for i, roomModel := range roomModels {
+		rooms[i] = roomModel.ToEntity([]entity.UserID{})
+	}
+	return rooms
+}
+
+func (r *RoomRepositoryImpl) SaveDirectRoom(ctx context.Context, room *entity.Room, userA, userB entity.UserID) (entity.RoomID, error) {
+	// RoomID -> UUID
+	roomID := room.GetID()
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return "", err
+	}
+	// UserID -> UUID
+	userAUUID, err := userA.UserID2UUID()
+	if err != nil {
+		return "", err
+	}
+	userBUUID, err := userB.UserID2UUID()
+	if err != nil {
+		return "", err
+	}
+	dmKey := model.DirectRoomKey(userA, userB)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	// 同じ組み合わせの部屋が同時に作成された場合は、一意制約によって後から来た方が何もしない
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO rooms (id, name, kind, visibility, dm_key, created_at) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE id = id`,
+		roomIDUUID, room.GetName(), entity.RoomKindDirect, entity.RoomVisibilityPrivate, dmKey, room.GetCreatedAt())
+	if err != nil {
+		return "", err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return "", err
+	} else if n == 0 {
+		var existingID entity.RoomID
+		if err := tx.GetContext(ctx, &existingID, `SELECT BIN_TO_UUID(id) FROM rooms WHERE dm_key = ?`, dmKey); err != nil {
+			return "", err
+		}
+		return existingID, tx.Commit()
+	}
+
+	for _, userIDUUID := range []any{userAUUID, userBUUID} {
+		_, err = tx.ExecContext(ctx, `INSERT INTO room_members (room_id, user_id, role) VALUES (UUID_TO_BIN(?), UUID_TO_BIN(?), ?)`,
+			roomIDUUID, userIDUUID, entity.RoomRoleMember)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return roomID, tx.Commit()
+}
+
+func (r *RoomRepositoryImpl) GetDirectRoom(ctx context.Context, userA, userB entity.UserID) (*entity.Room, error) {
+	roomModel := model.RoomModel{}
+	err := r.db.GetContext(ctx, &roomModel, `
+		SELECT BIN_TO_UUID(id) AS id, name, kind, visibility, description, pin_limit, slow_mode_seconds, archived_at, created_at FROM rooms WHERE dm_key = ?`,
+		model.DirectRoomKey(userA, userB))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := r.withMembers(ctx, []model.RoomModel{roomModel})
+	if err != nil {
+		return nil, err
+	}
+	return rooms[0], nil
+}
+
+func (r *RoomRepositoryImpl) GetDirectRooms(ctx context.Context, userID entity.UserID) ([]*entity.Room, error) {
+	// UserID -> UUID
+	userIDUUID, err := userID.UserID2UUID()
+	if err != nil {
+		return nil, err
+	}
+
 	roomModels := []model.RoomModel{}
-	// NOTE: FULLTEXT INDEXが前提
-	err := r.db.SelectContext(ctx, &roomModels, `
-	SELECT BIN_TO_UUID(id) AS id, name
-	FROM rooms
-	WHERE MATCH(name) AGAINST(? IN BOOLEAN MODE)
-`, name)
+	err = r.db.SelectContext(ctx, &roomModels, `
+		SELECT BIN_TO_UUID(id) AS id, name, kind, visibility, description, pin_limit, slow_mode_seconds, archived_at, created_at
+		FROM rooms
+		WHERE kind = 'direct'
+		  AND id IN (SELECT room_id FROM room_members WHERE user_id = UUID_TO_BIN(?))`, userIDUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withMembers(ctx, roomModels)
+}
 
+func (r *RoomRepositoryImpl) GetRoomIDsByMember(ctx context.Context, userID entity.UserID) ([]entity.RoomID, error) {
+	// UserID -> UUID
+	userIDUUID, err := userID.UserID2UUID()
 	if err != nil {
 		return nil, err
 	}
 
-	rooms := make([]*entity.Room, len(roomModels))
+	roomIDs := []entity.RoomID{}
+	err = r.db.SelectContext(ctx, &roomIDs, `
+		SELECT DISTINCT BIN_TO_UUID(room_id) AS room_id
+		FROM room_members
+		WHERE user_id = UUID_TO_BIN(?)`, userIDUUID)
+	if err != nil {
+		return nil, err
+	}
+	return roomIDs, nil
+}
+
+func (r *RoomRepositoryImpl) GetMemberIDs(ctx context.Context, roomID entity.RoomID) ([]entity.UserID, error) {
+	// RoomID -> UUID
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := []entity.UserID{}
+	err = r.db.SelectContext(ctx, &userIDs, `
+		SELECT DISTINCT BIN_TO_UUID(user_id) AS user_id
+		FROM room_members
+		WHERE room_id = UUID_TO_BIN(?)`, roomIDUUID)
+	if err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+func (r *RoomRepositoryImpl) GetMemberIDsByNames(ctx context.Context, roomID entity.RoomID, names []string) ([]entity.UserID, error) {
+	if len(names) == 0 {
+		return []entity.UserID{}, nil
+	}
+	// RoomID -> UUID
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT DISTINCT BIN_TO_UUID(rm.user_id) AS user_id
+		FROM room_members rm
+		JOIN users u ON u.id = rm.user_id
+		WHERE rm.room_id = UUID_TO_BIN(?) AND u.name IN (?)`, roomIDUUID, names)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := []entity.UserID{}
+	if err := r.db.SelectContext(ctx, &userIDs, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// withMembers は部屋の一覧をエンティティに変換し、それぞれのメンバーを含める
+func (r *RoomRepositoryImpl) withMembers(ctx context.Context, roomModels []model.RoomModel) ([]*entity.Room, error) {
+	if len(roomModels) == 0 {
+		return []*entity.Room{}, nil
+	}
+
+	// room_id のインデックスを使うため、UUID を BINARY(16) のまま渡す
+	roomIDs := make([][]byte, len(roomModels))
 	for i, roomModel := range roomModels {
-		rooms[i] = entity.NewRoom(entity.RoomParams{
-			ID:      entity.RoomID(roomModel.ID.String()),
-			Name:    roomModel.Name,
-			Members: []entity.UserID{},
-		})
+		roomIDs[i] = roomModel.ID[:]
+	}
+	query, args, err := sqlx.In(`
+		SELECT BIN_TO_UUID(room_id) AS room_id, BIN_TO_UUID(user_id) AS user_id
+		FROM room_members
+		WHERE room_id IN (?)`, roomIDs)
+	if err != nil {
+		return nil, err
+	}
+	var memberModels []model.RoomMemberModel
+	if err := r.db.SelectContext(ctx, &memberModels, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	members := make(map[uuid.UUID][]entity.UserID)
+	for _, m := range memberModels {
+		members[m.RoomID] = append(members[m.RoomID], entity.UserID(m.UserID.String()))
 	}
 
+	rooms := make([]*entity.Room, len(roomModels))
+	for i, roomModel := range roomModels {
+		rooms[i] = roomModel.ToEntity(members[roomModel.ID])
+	}
 	return rooms, nil
 }
 
@@ -256,15 +502,223 @@ func (r *RoomRepositoryImpl) UpdateRoomName(ctx context.Context, roomID entity.R
 	return nil
 }
 
+func (r *RoomRepositoryImpl) UpdateRoomDescription(ctx context.Context, roomID entity.RoomID, description string) error {
+	// RoomID -> UUID
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `UPDATE rooms SET description = ? WHERE id = UUID_TO_BIN(?)`, description, roomIDUUID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RoomRepositoryImpl) UpdateRoomPinLimit(ctx context.Context, roomID entity.RoomID, limit int) error {
+	// RoomID -> UUID
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `UPDATE rooms SET pin_limit = ? WHERE id = UUID_TO_BIN(?)`, limit, roomIDUUID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RoomRepositoryImpl) UpdateRoomSlowMode(ctx context.Context, roomID entity.RoomID, interval time.Duration) error {
+	// RoomID -> UUID
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `UPDATE rooms SET slow_mode_seconds = ? WHERE id = UUID_TO_BIN(?)`, int(interval/time.Second), roomIDUUID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RoomRepositoryImpl) SetRoomArchived(ctx context.Context, roomID entity.RoomID, archivedAt *time.Time) error {
+	// RoomID -> UUID
+	roomIDUUID, err := roomID.RoomID2UUID()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `UPDATE rooms SET archived_at = ? WHERE id = UUID_TO_BIN(?)`, archivedAt, roomIDUUID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *RoomRepositoryImpl) DeleteRoom(ctx context.Context, roomID entity.RoomID) error {
 	// RoomID -> UUID
 	roomIDUUID, err := roomID.RoomID2UUID()
 	if err != nil {
 		return err
 	}
-	_, err = r.db.ExecContext(ctx, `DELETE FROM rooms WHERE id = UUID_TO_BIN(?)`, roomIDUUID)
+	// メンバー・メッセージ（編集履歴・リアクション・ピン留め・メンション・添付ファイルの情報を含む）・招待・モデレーションの記録は外部キーの ON DELETE CASCADE で同じ文の中で削除される
+	res, err := r.db.ExecContext(ctx, `DELETE FROM rooms WHERE id = UUID_TO_BIN(?)`, roomIDUUID)
 	if err != nil {
 		return err
 	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repository.ErrRoomNotFound
+	}
 	return nil
 }
+
+// likeEscaper は LIKE のワイルドカードを通常の文字として扱うためのエスケープ
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// roomSortKeys は並び順ごとの比較に使う式と方向です。
+// MySQL は WHERE で列の別名を参照できないため、式で持ちます。
+var roomSortKeys = map[repository.RoomSort]struct {
+	expr string
+	desc bool
+}{
+	repository.RoomSortName:         {expr: "r.name"},
+	repository.RoomSortCreatedAt:    {expr: "r.created_at", desc: true},
+	repository.RoomSortMemberCount:  {expr: "(SELECT COUNT(*) FROM room_members rm WHERE rm.room_id = r.id)", desc: true},
+	repository.RoomSortLastActivity: {expr: "COALESCE(lm.sent_at, r.created_at)", desc: true},
+}
+
+// unreadMessagesCond は閲覧するユーザー（vu）の既読位置（rs）より後の、他のユーザーのメッセージ（um）の条件です。
+// 最新のメッセージと同じく、スレッドの返信と削除済みのメッセージは数えません。
+const unreadMessagesCond = `um.room_id = r.id AND um.parent_id IS NULL AND um.deleted_at IS NULL AND um.user_id <> vu.id
+	            AND (rs.last_read_at IS NULL OR um.sent_at > rs.last_read_at
+	                 OR (um.sent_at = rs.last_read_at AND um.id > rs.last_read_message_id))`
+
+func (r *RoomRepositoryImpl) ListRoomSummaries(ctx context.Context, q repository.RoomListQuery) ([]*entity.RoomSummary, bool, error) {
+	key, ok := roomSortKeys[q.Sort]
+	if !ok {
+		return nil, false, fmt.Errorf("unknown room sort: %q", q.Sort)
+	}
+	op, dir := ">", "ASC"
+	if key.desc {
+		op, dir = "<", "DESC"
+	}
+
+	// UserID -> UUID
+	viewerUUID, err := q.ViewerID.UserID2UUID()
+	if err != nil {
+		return nil, false, err
+	}
+
+	query := `
+	SELECT BIN_TO_UUID(r.id) AS id, r.name, r.kind, r.visibility, r.description, r.pin_limit, r.slow_mode_seconds, r.archived_at, r.created_at,
+	       (SELECT COUNT(*) FROM room_members rm WHERE rm.room_id = r.id) AS member_count,
+	       BIN_TO_UUID(lm.id) AS last_message_id, BIN_TO_UUID(lm.user_id) AS last_message_user_id,
+	       lm.content AS last_message_content, lm.sent_at AS last_message_sent_at, lm.edited_at AS last_message_edited_at,
+	       CASE WHEN vm.room_id IS NULL THEN 0 ELSE (SELECT COUNT(*) FROM messages um WHERE ` + unreadMessagesCond + `) END AS unread_count,
+	       CASE WHEN vm.room_id IS NULL THEN 0 ELSE (SELECT COUNT(*) FROM messages um WHERE ` + unreadMessagesCond + `
+	            AND EXISTS (SELECT 1 FROM message_mentions mm WHERE mm.message_id = um.id AND mm.user_id = vu.id)) END AS mention_count
+	FROM rooms r
+	LEFT JOIN messages lm ON lm.id = (
+		SELECT m.id FROM messages m
+		WHERE m.room_id = r.id AND m.parent_id IS NULL AND m.deleted_at IS NULL
+		ORDER BY m.sent_at DESC, m.id DESC LIMIT 1)
+	LEFT JOIN (SELECT DISTINCT room_id FROM room_members WHERE user_id = UUID_TO_BIN(?)) vm ON vm.room_id = r.id
+	LEFT JOIN users vu ON vu.id = UUID_TO_BIN(?)
+	LEFT JOIN room_read_states rs ON rs.room_id = r.id AND rs.user_id = vu.id
+	WHERE r.kind = 'group'
+	  AND (r.visibility = 'public' OR vm.room_id IS NOT NULL)`
+	args := []any{viewerUUID, viewerUUID}
+
+	if q.NameLike != "" {
+		query += ` AND r.name LIKE ? ESCAPE '\\'`
+		args = append(args, "%"+likeEscaper.Replace(q.NameLike)+"%")
+	}
+	if c := q.After; c != nil {
+		afterUUID, err := c.RoomID.RoomID2UUID()
+		if err != nil {
+			return nil, false, err
+		}
+		var v any
+		switch q.Sort {
+		case repository.RoomSortName:
+			v = c.Name
+		case repository.RoomSortCreatedAt:
+			v = c.CreatedAt
+		case repository.RoomSortMemberCount:
+			v = c.MemberCount
+		case repository.RoomSortLastActivity:
+			v = c.LastActivityAt
+		}
+		query += fmt.Sprintf(` AND (%[1]s %[2]s ? OR (%[1]s = ? AND r.id %[2]s UUID_TO_BIN(?)))`, key.expr, op)
+		args = append(args, v, v, afterUUID)
+	}
+	// 次のページの有無を判定するため1件多く取得する
+	query += fmt.Sprintf(` ORDER BY %[1]s %[2]s, r.id %[2]s LIMIT ?`, key.expr, dir)
+	args = append(args, q.Limit+1)
+
+	summaryModels := []model.RoomSummaryModel{}
+	if err := r.db.SelectContext(ctx, &summaryModels, query, args...); err != nil {
+		return nil, false, err
+	}
+
+	hasNext := len(summaryModels) > q.Limit
+	if hasNext {
+		summaryModels = summaryModels[:q.Limit]
+	}
+	summaries := make([]*entity.RoomSummary, 0, len(summaryModels))
+	for i := range summaryModels {
+		summaries = append(summaries, summaryModels[i].ToEntity())
+	}
+	return summaries, hasNext, nil
+}
+
+func (r *RoomRepositoryImpl) ListRoomMembers(ctx context.Context, q repository.RoomMemberListQuery) ([]*entity.RoomMember, bool, error) {
+	// RoomID -> UUID
+	roomUUID, err := q.RoomID.RoomID2UUID()
+	if err != nil {
+		return nil, false, err
+	}
+
+	query := `
+	SELECT BIN_TO_UUID(u.id) AS id, u.name, u.created_at, rm.role
+	FROM room_members rm
+	JOIN users u ON u.id = rm.user_id
+	WHERE rm.room_id = UUID_TO_BIN(?)`
+	args := []any{roomUUID}
+
+	if q.NameLike != "" {
+		query += ` AND u.name LIKE ? ESCAPE '\\'`
+		args = append(args, "%"+likeEscaper.Replace(q.NameLike)+"%")
+	}
+	if c := q.After; c != nil {
+		afterUUID, err := c.UserID.UserID2UUID()
+		if err != nil {
+			return nil, false, err
+		}
+		query += ` AND (u.name > ? OR (u.name = ? AND u.id > UUID_TO_BIN(?)))`
+		args = append(args, c.Name, c.Name, afterUUID)
+	}
+	// 次のページの有無を判定するため1件多く取得する
+	query += ` ORDER BY u.name ASC, u.id ASC LIMIT ?`
+	args = append(args, q.Limit+1)
+
+	memberModels := []model.RoomMemberUserModel{}
+	if err := r.db.SelectContext(ctx, &memberModels, query, args...); err != nil {
+		return nil, false, err
+	}
+
+	hasNext := len(memberModels) > q.Limit
+	if hasNext {
+		memberModels = memberModels[:q.Limit]
+	}
+	members := make([]*entity.RoomMember, 0, len(memberModels))
+	for i := range memberModels {
+		members = append(members, memberModels[i].ToEntity())
+	}
+	return members, hasNext, nil
+}
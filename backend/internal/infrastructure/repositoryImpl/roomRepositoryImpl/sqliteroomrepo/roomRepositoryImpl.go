@@ -2,12 +2,17 @@ package sqliteroomrepo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -37,7 +42,8 @@ func NewRoomRepositoryImpl(params *NewRoomRepositoryImplParams) repository.RoomR
 }
 
 func (r *RoomRepositoryImpl) SaveRoom(ctx context.Context, room *entity.Room) (entity.RoomID, error) {
-	_, err := r.db.ExecContext(ctx, `INSERT INTO rooms (id, name) VALUES (?, ?)`, room.GetID(), room.GetName())
+	_, err := r.db.ExecContext(ctx, `INSERT INTO rooms (id, name, kind, visibility, created_at) VALUES (?, ?, ?, ?, ?)`,
+		room.GetID(), room.GetName(), room.GetKind(), room.GetVisibility(), room.GetCreatedAt().UTC())
 	if err != nil {
 		return entity.RoomID(""), err
 	}
@@ -51,9 +57,33 @@ func (r *RoomRepositoryImpl) SaveRoom(ctx context.Context, room *entity.Room) (e
 	return roomID, nil
 }
 
+func (r *RoomRepositoryImpl) SaveRoomWithOwner(ctx context.Context, room *entity.Room, ownerID entity.UserID) (entity.RoomID, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO rooms (id, name, kind, visibility, created_at) VALUES (?, ?, ?, ?, ?)`,
+		room.GetID(), room.GetName(), room.GetKind(), room.GetVisibility(), room.GetCreatedAt().UTC())
+	if err != nil {
+		return "", err
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO room_members (room_id, user_id, role) VALUES (?, ?, ?)`,
+		room.GetID(), ownerID, entity.RoomRoleOwner)
+	if err != nil {
+		return "", err
+	}
+
+	return room.GetID(), tx.Commit()
+}
+
 func (r *RoomRepositoryImpl) GetRoomByID(ctx context.Context, id entity.RoomID) (*entity.Room, error) {
 	roomModel := model.RoomModel{}
-	err := r.db.GetContext(ctx, &roomModel, `SELECT id, name FROM rooms WHERE id = ?`, id)
+	err := r.db.GetContext(ctx, &roomModel, `SELECT id, name, kind, visibility, description, pin_limit, slow_mode_seconds, archived_at, created_at FROM rooms WHERE id = ?`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrRoomNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -65,31 +95,21 @@ func (r *RoomRepositoryImpl) GetRoomByID(ctx context.Context, id entity.RoomID)
 		return nil, err
 	}
 
-	room := entity.NewRoom(entity.RoomParams{
-		ID:       entity.RoomID(roomModel.ID.String()),
-		Name:     roomModel.Name,
-		Members:  make([]entity.UserID, len(roomMembers)),
-	})
+	room := roomModel.ToEntity(make([]entity.UserID, len(roomMembers)))
 	return room, nil
 }
 
-func (r *RoomRepositoryImpl) GetAllRooms(ctx context.Context) ([]*entity.Room, error) {
+func (r *RoomRepositoryImpl) GetAllRooms(ctx context.Context, viewerID entity.UserID) ([]*entity.Room, error) {
 	roomModels := []model.RoomModel{}
-	err := r.db.SelectContext(ctx, &roomModels, `SELECT id, name FROM rooms`)
+	err := r.db.SelectContext(ctx, &roomModels, `
+		SELECT id, name, kind, visibility, description, pin_limit, slow_mode_seconds, archived_at, created_at FROM rooms
+		WHERE kind = 'group'
+		  AND (visibility = 'public' OR id IN (SELECT room_id FROM room_members WHERE user_id = ?))`, viewerID)
 	if err != nil {
 		return nil, err
 	}
 
-	rooms := make([]*entity.Room, len(roomModels))
-	for i, roomModel := range roomModels {
-		rooms[i] = entity.NewRoom(entity.RoomParams{
-			ID:       entity.RoomID(roomModel.ID.String()),
-			Name:     roomModel.Name,
-			Members:  []entity.UserID{},
-		})
-	}
-
-	return rooms, nil
+	return toRooms(roomModels), nil
 }
 
 func (r *RoomRepositoryImpl) GetUsersInRoom(ctx context.Context, roomID entity.RoomID) ([]*entity.User, error) {
@@ -143,14 +163,37 @@ func (r *RoomRepositoryImpl) GetUsersInRoom(ctx context.Context, roomID entity.R
 	return users, nil
 }
 
-func (r *RoomRepositoryImpl) AddMemberToRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
-	_, err := r.db.ExecContext(ctx, `INSERT INTO room_members (room_id, user_id) VALUES (?, ?)`, roomID, userID)
+func (r *RoomRepositoryImpl) AddMemberToRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID, role entity.RoomRole) error {
+	// すでにメンバーの場合は一意制約によって何もしない（役割も変えない）
+	_, err := r.db.ExecContext(ctx, `INSERT OR IGNORE INTO room_members (room_id, user_id, role) VALUES (?, ?, ?)`, roomID, userID, role)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+func (r *RoomRepositoryImpl) GetMemberRole(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (entity.RoomRole, error) {
+	var role entity.RoomRole
+	err := r.db.GetContext(ctx, &role, `SELECT role FROM room_members WHERE room_id = ? AND user_id = ? LIMIT 1`, roomID, userID)
+	if err == nil {
+		return role, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+
+	// メンバーでない場合は、部屋が存在するかで返すエラーを分ける
+	var exists bool
+	err = r.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM rooms WHERE id = ?)`, roomID)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", repository.ErrRoomNotFound
+	}
+	return "", repository.ErrNotRoomMember
+}
+
 func (r *RoomRepositoryImpl) RemoveMemberFromRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
 	_, err := r.db.ExecContext(ctx, `DELETE FROM room_members WHERE room_id = ? AND user_id = ?`, roomID, userID)
 	if err != nil {
@@ -159,22 +202,150 @@ func (r *RoomRepositoryImpl) RemoveMemberFromRoom(ctx context.Context, roomID en
 	return nil
 }
 
-func (r *RoomRepositoryImpl) GetRoomByNameLike(ctx context.Context, name string) ([]*entity.Room, error) {
+// toRooms は一覧取得の結果をエンティティに変換する（メンバーは含めない）
+func toRooms(roomModels []model.RoomModel) []*entity.Room {
+	rooms := make([]*entity.Room, len(roomModels))
+	for i, roomModel := range roomModels {
+		rooms[i] = roomModel.ToEntity([]entity.UserID{})
+	}
+	return rooms
+}
+
+func (r *RoomRepositoryImpl) SaveDirectRoom(ctx context.Context, room *entity.Room, userA, userB entity.UserID) (entity.RoomID, error) {
+	dmKey := model.DirectRoomKey(userA, userB)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	// 同じ組み合わせの部屋が同時に作成された場合は、一意制約によって後から来た方が何もしない
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO rooms (id, name, kind, visibility, dm_key, created_at) VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (dm_key) DO NOTHING`,
+		room.GetID(), room.GetName(), entity.RoomKindDirect, entity.RoomVisibilityPrivate, dmKey, room.GetCreatedAt().UTC())
+	if err != nil {
+		return "", err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return "", err
+	} else if n == 0 {
+		var existingID entity.RoomID
+		if err := tx.GetContext(ctx, &existingID, `SELECT id FROM rooms WHERE dm_key = ?`, dmKey); err != nil {
+			return "", err
+		}
+		return existingID, tx.Commit()
+	}
+
+	for _, userID := range []entity.UserID{userA, userB} {
+		_, err = tx.ExecContext(ctx, `INSERT INTO room_members (room_id, user_id, role) VALUES (?, ?, ?)`,
+			room.GetID(), userID, entity.RoomRoleMember)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return room.GetID(), tx.Commit()
+}
+
+func (r *RoomRepositoryImpl) GetDirectRoom(ctx context.Context, userA, userB entity.UserID) (*entity.Room, error) {
+	roomModel := model.RoomModel{}
+	err := r.db.GetContext(ctx, &roomModel, `SELECT id, name, kind, visibility, description, pin_limit, slow_mode_seconds, archived_at, created_at FROM rooms WHERE dm_key = ?`,
+		model.DirectRoomKey(userA, userB))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := r.withMembers(ctx, []model.RoomModel{roomModel})
+	if err != nil {
+		return nil, err
+	}
+	return rooms[0], nil
+}
+
+func (r *RoomRepositoryImpl) GetDirectRooms(ctx context.Context, userID entity.UserID) ([]*entity.Room, error) {
 	roomModels := []model.RoomModel{}
-	err := r.db.SelectContext(ctx, &roomModels, `SELECT id, name FROM rooms WHERE name LIKE ?`, "%"+name+"%")
+	err := r.db.SelectContext(ctx, &roomModels, `
+		SELECT id, name, kind, visibility, description, pin_limit, slow_mode_seconds, archived_at, created_at FROM rooms
+		WHERE kind = 'direct'
+		  AND id IN (SELECT room_id FROM room_members WHERE user_id = ?)`, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	rooms := make([]*entity.Room, len(roomModels))
+	return r.withMembers(ctx, roomModels)
+}
+
+func (r *RoomRepositoryImpl) GetRoomIDsByMember(ctx context.Context, userID entity.UserID) ([]entity.RoomID, error) {
+	roomIDs := []entity.RoomID{}
+	err := r.db.SelectContext(ctx, &roomIDs, `SELECT DISTINCT room_id FROM room_members WHERE user_id = ?`, userID)
+	if err != nil {
+		return nil, err
+	}
+	return roomIDs, nil
+}
+
+func (r *RoomRepositoryImpl) GetMemberIDs(ctx context.Context, roomID entity.RoomID) ([]entity.UserID, error) {
+	userIDs := []entity.UserID{}
+	err := r.db.SelectContext(ctx, &userIDs, `SELECT DISTINCT user_id FROM room_members WHERE room_id = ?`, roomID)
+	if err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+func (r *RoomRepositoryImpl) GetMemberIDsByNames(ctx context.Context, roomID entity.RoomID, names []string) ([]entity.UserID, error) {
+	if len(names) == 0 {
+		return []entity.UserID{}, nil
+	}
+	query, args, err := sqlx.In(`
+		SELECT DISTINCT rm.user_id
+		FROM room_members rm
+		JOIN users u ON u.id = rm.user_id
+		WHERE rm.room_id = ? AND u.name IN (?)`, roomID, names)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := []entity.UserID{}
+	if err := r.db.SelectContext(ctx, &userIDs, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// withMembers は部屋の一覧をエンティティに変換し、それぞれのメンバーを含める
+func (r *RoomRepositoryImpl) withMembers(ctx context.Context, roomModels []model.RoomModel) ([]*entity.Room, error) {
+	if len(roomModels) == 0 {
+		return []*entity.Room{}, nil
+	}
+
+	roomIDs := make([]string, len(roomModels))
 	for i, roomModel := range roomModels {
-		rooms[i] = entity.NewRoom(entity.RoomParams{
-			ID:       entity.RoomID(roomModel.ID.String()),
-			Name:     roomModel.Name,
-			Members:  []entity.UserID{},
-		})
+		roomIDs[i] = roomModel.ID.String()
+	}
+	query, args, err := sqlx.In(`SELECT room_id, user_id FROM room_members WHERE room_id IN (?)`, roomIDs)
+	if err != nil {
+		return nil, err
+	}
+	var memberModels []model.RoomMemberModel
+	if err := r.db.SelectContext(ctx, &memberModels, r.db.Rebind(query), args...); err != nil {
+		return nil, err
 	}
 
+	members := make(map[uuid.UUID][]entity.UserID)
+	for _, m := range memberModels {
+		members[m.RoomID] = append(members[m.RoomID], entity.UserID(m.UserID.String()))
+	}
+
+	rooms := make([]*entity.Room, len(roomModels))
+	for i, roomModel := range roomModels {
+		rooms[i] = roomModel.ToEntity(members[roomModel.ID])
+	}
 	return rooms, nil
 }
 
@@ -186,11 +357,203 @@ func (r *RoomRepositoryImpl) UpdateRoomName(ctx context.Context, roomID entity.R
 	return nil
 }
 
-func (r *RoomRepositoryImpl) DeleteRoom(ctx context.Context, roomID entity.RoomID) error {
-	_, err := r.db.ExecContext(ctx, `DELETE FROM rooms WHERE id = ?`, roomID)
+func (r *RoomRepositoryImpl) UpdateRoomDescription(ctx context.Context, roomID entity.RoomID, description string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE rooms SET description = ? WHERE id = ?`, description, roomID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RoomRepositoryImpl) UpdateRoomPinLimit(ctx context.Context, roomID entity.RoomID, limit int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE rooms SET pin_limit = ? WHERE id = ?`, limit, roomID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RoomRepositoryImpl) UpdateRoomSlowMode(ctx context.Context, roomID entity.RoomID, interval time.Duration) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE rooms SET slow_mode_seconds = ? WHERE id = ?`, int(interval/time.Second), roomID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+func (r *RoomRepositoryImpl) SetRoomArchived(ctx context.Context, roomID entity.RoomID, archivedAt *time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE rooms SET archived_at = ? WHERE id = ?`, archivedAt, roomID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteRoom は部屋と、部屋に紐づくメンバー・メッセージ（編集履歴・リアクション・ピン留め・メンション・添付ファイルの情報を含む）・招待・参加リクエスト・既読位置を1つのトランザクションで削除する
+// SQLite では外部キー制約が有効とは限らないため、ON DELETE CASCADE に頼らず明示的に削除する
+func (r *RoomRepositoryImpl) DeleteRoom(ctx context.Context, roomID entity.RoomID) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, query := range []string{
+		`DELETE FROM message_pins WHERE room_id = ?`,
+		`DELETE FROM message_mentions WHERE room_id = ?`,
+		`DELETE FROM attachments WHERE room_id = ?`,
+		`DELETE FROM message_reactions WHERE message_id IN (SELECT id FROM messages WHERE room_id = ?)`,
+		`DELETE FROM message_revisions WHERE message_id IN (SELECT id FROM messages WHERE room_id = ?)`,
+		`DELETE FROM messages WHERE room_id = ?`,
+		`DELETE FROM room_members WHERE room_id = ?`,
+		`DELETE FROM room_invites WHERE room_id = ?`,
+		`DELETE FROM room_join_requests WHERE room_id = ?`,
+		`DELETE FROM room_read_states WHERE room_id = ?`,
+		`DELETE FROM room_bans WHERE room_id = ?`,
+		`DELETE FROM room_mutes WHERE room_id = ?`,
+		`DELETE FROM room_moderation_actions WHERE room_id = ?`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, roomID); err != nil {
+			return err
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM rooms WHERE id = ?`, roomID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repository.ErrRoomNotFound
+	}
+	return tx.Commit()
+}
+
+// likeEscaper は LIKE のワイルドカードを通常の文字として扱うためのエスケープ
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// roomSortKeys は並び順ごとの比較に使う式と方向です。
+// カーソルの条件（WHERE）でも使うため、列の別名ではなく式で持ちます。
+// 送信日時と作成日時はどちらも UTC で保存されているため、COALESCE した値も文字列のまま比較できます。
+var roomSortKeys = map[repository.RoomSort]struct {
+	expr string
+	desc bool
+}{
+	repository.RoomSortName:         {expr: "r.name"},
+	repository.RoomSortCreatedAt:    {expr: "r.created_at", desc: true},
+	repository.RoomSortMemberCount:  {expr: "(SELECT COUNT(*) FROM room_members rm WHERE rm.room_id = r.id)", desc: true},
+	repository.RoomSortLastActivity: {expr: "COALESCE(lm.sent_at, r.created_at)", desc: true},
+}
+
+// unreadMessagesCond は閲覧するユーザー（vu）の既読位置（rs）より後の、他のユーザーのメッセージ（um）の条件です。
+// 最新のメッセージと同じく、スレッドの返信と削除済みのメッセージは数えません。
+// 送信日時と既読位置はどちらも UTC で保存されているため、文字列のまま比較できます。
+const unreadMessagesCond = `um.room_id = r.id AND um.parent_id IS NULL AND um.deleted_at IS NULL AND um.user_id <> vu.id
+		            AND (rs.last_read_at IS NULL OR um.sent_at > rs.last_read_at
+		                 OR (um.sent_at = rs.last_read_at AND um.id > rs.last_read_message_id))`
+
+func (r *RoomRepositoryImpl) ListRoomSummaries(ctx context.Context, q repository.RoomListQuery) ([]*entity.RoomSummary, bool, error) {
+	key, ok := roomSortKeys[q.Sort]
+	if !ok {
+		return nil, false, fmt.Errorf("unknown room sort: %q", q.Sort)
+	}
+	op, dir := ">", "ASC"
+	if key.desc {
+		op, dir = "<", "DESC"
+	}
+
+	query := `
+		SELECT r.id, r.name, r.kind, r.visibility, r.description, r.pin_limit, r.slow_mode_seconds, r.archived_at, r.created_at,
+		       (SELECT COUNT(*) FROM room_members rm WHERE rm.room_id = r.id) AS member_count,
+		       lm.id AS last_message_id, lm.user_id AS last_message_user_id, lm.content AS last_message_content,
+		       lm.sent_at AS last_message_sent_at, lm.edited_at AS last_message_edited_at,
+		       CASE WHEN vm.room_id IS NULL THEN 0 ELSE (SELECT COUNT(*) FROM messages um WHERE ` + unreadMessagesCond + `) END AS unread_count,
+		       CASE WHEN vm.room_id IS NULL THEN 0 ELSE (SELECT COUNT(*) FROM messages um WHERE ` + unreadMessagesCond + `
+		            AND EXISTS (SELECT 1 FROM message_mentions mm WHERE mm.message_id = um.id AND mm.user_id = vu.id)) END AS mention_count
+		FROM rooms r
+		LEFT JOIN messages lm ON lm.id = (
+			SELECT m.id FROM messages m
+			WHERE m.room_id = r.id AND m.parent_id IS NULL AND m.deleted_at IS NULL
+			ORDER BY m.sent_at DESC, m.id DESC LIMIT 1)
+		LEFT JOIN (SELECT DISTINCT room_id FROM room_members WHERE user_id = ?) vm ON vm.room_id = r.id
+		LEFT JOIN users vu ON vu.id = ?
+		LEFT JOIN room_read_states rs ON rs.room_id = r.id AND rs.user_id = vu.id
+		WHERE r.kind = 'group'
+		  AND (r.visibility = 'public' OR vm.room_id IS NOT NULL)`
+	args := []any{q.ViewerID, q.ViewerID}
+
+	if q.NameLike != "" {
+		query += ` AND r.name LIKE ? ESCAPE '\'`
+		args = append(args, "%"+likeEscaper.Replace(q.NameLike)+"%")
+	}
+	if c := q.After; c != nil {
+		var v any
+		switch q.Sort {
+		case repository.RoomSortName:
+			v = c.Name
+		case repository.RoomSortCreatedAt:
+			v = c.CreatedAt.UTC()
+		case repository.RoomSortMemberCount:
+			v = c.MemberCount
+		case repository.RoomSortLastActivity:
+			v = c.LastActivityAt.UTC()
+		}
+		query += fmt.Sprintf(` AND (%[1]s %[2]s ? OR (%[1]s = ? AND r.id %[2]s ?))`, key.expr, op)
+		args = append(args, v, v, c.RoomID)
+	}
+	// 次のページの有無を判定するため1件多く取得する
+	query += fmt.Sprintf(` ORDER BY %[1]s %[2]s, r.id %[2]s LIMIT ?`, key.expr, dir)
+	args = append(args, q.Limit+1)
+
+	summaryModels := []model.RoomSummaryModel{}
+	if err := r.db.SelectContext(ctx, &summaryModels, query, args...); err != nil {
+		return nil, false, err
+	}
+
+	hasNext := len(summaryModels) > q.Limit
+	if hasNext {
+		summaryModels = summaryModels[:q.Limit]
+	}
+	summaries := make([]*entity.RoomSummary, 0, len(summaryModels))
+	for i := range summaryModels {
+		summaries = append(summaries, summaryModels[i].ToEntity())
+	}
+	return summaries, hasNext, nil
+}
+
+func (r *RoomRepositoryImpl) ListRoomMembers(ctx context.Context, q repository.RoomMemberListQuery) ([]*entity.RoomMember, bool, error) {
+	query := `
+		SELECT u.id, u.name, u.created_at, rm.role
+		FROM room_members rm
+		JOIN users u ON u.id = rm.user_id
+		WHERE rm.room_id = ?`
+	args := []any{q.RoomID}
+
+	if q.NameLike != "" {
+		query += ` AND u.name LIKE ? ESCAPE '\'`
+		args = append(args, "%"+likeEscaper.Replace(q.NameLike)+"%")
+	}
+	if c := q.After; c != nil {
+		query += ` AND (u.name > ? OR (u.name = ? AND u.id > ?))`
+		args = append(args, c.Name, c.Name, c.UserID)
+	}
+	// 次のページの有無を判定するため1件多く取得する
+	query += ` ORDER BY u.name ASC, u.id ASC LIMIT ?`
+	args = append(args, q.Limit+1)
+
+	memberModels := []model.RoomMemberUserModel{}
+	if err := r.db.SelectContext(ctx, &memberModels, query, args...); err != nil {
+		return nil, false, err
+	}
+
+	hasNext := len(memberModels) > q.Limit
+	if hasNext {
+		memberModels = memberModels[:q.Limit]
+	}
+	members := make([]*entity.RoomMember, 0, len(memberModels))
+	for i := range memberModels {
+		members = append(members, memberModels[i].ToEntity())
+	}
+	return members, hasNext, nil
+}
@@ -0,0 +1,185 @@
+package sqliteroomrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomRepositoryImpl/sqliteroomrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoomRepositoryImpl_DeleteRoom(t *testing.T) {
+	db := setupRoleTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+CREATE TABLE messages (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	content TEXT NOT NULL,
+	sent_at DATETIME NOT NULL
+);
+CREATE TABLE message_revisions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	message_id TEXT NOT NULL,
+	content TEXT NOT NULL,
+	edited_at DATETIME NOT NULL
+);
+CREATE TABLE message_reactions (
+	message_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	emoji TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	PRIMARY KEY (message_id, user_id, emoji)
+);
+CREATE TABLE message_pins (
+	message_id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	pinned_by TEXT NOT NULL,
+	pinned_at DATETIME NOT NULL
+);
+CREATE TABLE message_mentions (
+	message_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	room_id TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	PRIMARY KEY (message_id, user_id)
+);
+CREATE TABLE attachments (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	uploader_id TEXT NOT NULL,
+	message_id TEXT,
+	file_name TEXT NOT NULL,
+	mime_type TEXT NOT NULL,
+	size INTEGER NOT NULL,
+	width INTEGER NOT NULL DEFAULT 0,
+	height INTEGER NOT NULL DEFAULT 0,
+	has_thumbnail BOOLEAN NOT NULL DEFAULT 0,
+	created_at DATETIME NOT NULL
+);
+CREATE TABLE room_invites (
+	token TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	created_by TEXT NOT NULL,
+	max_uses INTEGER NOT NULL,
+	uses INTEGER NOT NULL DEFAULT 0,
+	expires_at DATETIME NOT NULL,
+	created_at DATETIME NOT NULL
+);
+CREATE TABLE room_join_requests (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	message TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL DEFAULT 'pending',
+	created_at DATETIME NOT NULL,
+	decided_by TEXT,
+	decided_at DATETIME,
+	PRIMARY KEY (room_id, user_id)
+);
+CREATE TABLE room_read_states (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	last_read_message_id TEXT NOT NULL,
+	last_read_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	PRIMARY KEY (room_id, user_id)
+);
+CREATE TABLE room_bans (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	PRIMARY KEY (room_id, user_id)
+);
+CREATE TABLE room_mutes (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	muted_until DATETIME NOT NULL,
+	PRIMARY KEY (room_id, user_id)
+);
+CREATE TABLE room_moderation_actions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	room_id TEXT NOT NULL,
+	actor_id TEXT NOT NULL,
+	target_id TEXT NOT NULL,
+	action TEXT NOT NULL,
+	reason TEXT NOT NULL DEFAULT '',
+	expires_at DATETIME,
+	created_at DATETIME NOT NULL
+);`)
+	require.NoError(t, err)
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	// 削除する部屋と、残す部屋にそれぞれデータを用意する
+	for _, roomID := range []string{testRoomID, "other_room"} {
+		_, err := db.Exec(`INSERT INTO rooms (id, name) VALUES (?, 'Room')`, roomID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO room_members (id, room_id, user_id) VALUES (?, ?, ?)`, roomID+"_member", roomID, testOwnerID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO messages (id, room_id, user_id, content, sent_at) VALUES (?, ?, ?, 'hello', CURRENT_TIMESTAMP)`,
+			roomID+"_msg", roomID, testOwnerID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO message_revisions (message_id, content, edited_at) VALUES (?, 'old', CURRENT_TIMESTAMP)`, roomID+"_msg")
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO message_reactions (message_id, user_id, emoji, created_at) VALUES (?, ?, '👍', CURRENT_TIMESTAMP)`,
+			roomID+"_msg", testOwnerID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO message_pins (message_id, room_id, pinned_by, pinned_at) VALUES (?, ?, ?, CURRENT_TIMESTAMP)`,
+			roomID+"_msg", roomID, testOwnerID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO message_mentions (message_id, user_id, room_id, kind, created_at) VALUES (?, ?, ?, 'user', CURRENT_TIMESTAMP)`,
+			roomID+"_msg", testOtherID, roomID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO attachments (id, room_id, uploader_id, message_id, file_name, mime_type, size, created_at)
+			VALUES (?, ?, ?, ?, 'a.txt', 'text/plain', 1, CURRENT_TIMESTAMP)`, roomID+"_attachment", roomID, testOwnerID, roomID+"_msg")
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO room_invites (token, room_id, created_by, max_uses, expires_at, created_at)
+			VALUES (?, ?, ?, 1, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`, roomID+"_invite", roomID, testOwnerID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO room_join_requests (room_id, user_id, created_at) VALUES (?, ?, CURRENT_TIMESTAMP)`, roomID, testOtherID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO room_read_states (room_id, user_id, last_read_message_id, last_read_at, updated_at)
+			VALUES (?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`, roomID, testOwnerID, roomID+"_msg")
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO room_bans (room_id, user_id, created_at) VALUES (?, ?, CURRENT_TIMESTAMP)`, roomID, testOtherID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO room_mutes (room_id, user_id, muted_until) VALUES (?, ?, CURRENT_TIMESTAMP)`, roomID, testOtherID)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO room_moderation_actions (room_id, actor_id, target_id, action, created_at)
+			VALUES (?, ?, ?, 'ban', CURRENT_TIMESTAMP)`, roomID, testOwnerID, testOtherID)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, repo.DeleteRoom(ctx, testRoomID))
+
+	// 削除した部屋のデータはすべて消え、他の部屋のデータは残る
+	for table, query := range map[string]string{
+		"rooms":                   `SELECT COUNT(*) FROM rooms`,
+		"room_members":            `SELECT COUNT(*) FROM room_members`,
+		"messages":                `SELECT COUNT(*) FROM messages`,
+		"message_revisions":       `SELECT COUNT(*) FROM message_revisions`,
+		"message_reactions":       `SELECT COUNT(*) FROM message_reactions`,
+		"message_pins":            `SELECT COUNT(*) FROM message_pins`,
+		"message_mentions":        `SELECT COUNT(*) FROM message_mentions`,
+		"attachments":             `SELECT COUNT(*) FROM attachments`,
+		"room_invites":            `SELECT COUNT(*) FROM room_invites`,
+		"room_join_requests":      `SELECT COUNT(*) FROM room_join_requests`,
+		"room_read_states":        `SELECT COUNT(*) FROM room_read_states`,
+		"room_bans":               `SELECT COUNT(*) FROM room_bans`,
+		"room_mutes":              `SELECT COUNT(*) FROM room_mutes`,
+		"room_moderation_actions": `SELECT COUNT(*) FROM room_moderation_actions`,
+	} {
+		var count int
+		require.NoError(t, db.Get(&count, query))
+		assert.Equal(t, 1, count, table)
+	}
+
+	// 存在しない部屋
+	assert.ErrorIs(t, repo.DeleteRoom(ctx, testRoomID), repository.ErrRoomNotFound)
+}
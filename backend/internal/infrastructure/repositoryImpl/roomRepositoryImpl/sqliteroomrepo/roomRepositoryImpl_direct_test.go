@@ -0,0 +1,54 @@
+package sqliteroomrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomRepositoryImpl/sqliteroomrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoomRepositoryImpl_DirectRooms(t *testing.T) {
+	db := setupRoleTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	const otherDirectRoomID = "7d2e1c9a-5b3f-4a4c-9e0d-9f8a7b6c5d43"
+
+	// まだ部屋がない
+	_, err := repo.GetDirectRoom(ctx, testOwnerID, testOtherID)
+	assert.ErrorIs(t, err, repository.ErrRoomNotFound)
+
+	// 作成すると2人がメンバーになる
+	roomID, err := repo.SaveDirectRoom(ctx, entity.NewDirectRoom(testRoomID, testOwnerID, testOtherID, time.Now()), testOwnerID, testOtherID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoomID(testRoomID), roomID)
+
+	// 順序を入れ替えても同じ部屋になり、重複して作成されない
+	roomID, err = repo.SaveDirectRoom(ctx, entity.NewDirectRoom(otherDirectRoomID, testOtherID, testOwnerID, time.Now()), testOtherID, testOwnerID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoomID(testRoomID), roomID)
+
+	room, err := repo.GetDirectRoom(ctx, testOtherID, testOwnerID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoomID(testRoomID), room.GetID())
+	assert.True(t, room.IsDirect())
+	assert.True(t, room.IsPrivate())
+	assert.ElementsMatch(t, []entity.UserID{testOwnerID, testOtherID}, room.GetMembers())
+
+	// ダイレクトメッセージの一覧には含まれ、部屋の一覧には含まれない
+	rooms, err := repo.GetDirectRooms(ctx, testOwnerID)
+	require.NoError(t, err)
+	require.Len(t, rooms, 1)
+	assert.Equal(t, entity.RoomID(testRoomID), rooms[0].GetID())
+
+	rooms, err = repo.GetAllRooms(ctx, testOwnerID)
+	require.NoError(t, err)
+	assert.Empty(t, rooms)
+}
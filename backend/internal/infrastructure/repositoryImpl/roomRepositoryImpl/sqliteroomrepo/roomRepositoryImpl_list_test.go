@@ -0,0 +1,355 @@
+package sqliteroomrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/messageRepositoryImpl/sqlitemsgrepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomReadStateRepositoryImpl/sqlitereadstaterepo"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomRepositoryImpl/sqliteroomrepo"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupListTestDB は部屋の一覧で参照するテーブルを作成します。
+func setupListTestDB(t *testing.T) *sqlx.DB {
+	db := setupRoleTestDB(t)
+	_, err := db.Exec(`
+CREATE TABLE messages (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	parent_id TEXT,
+	content TEXT NOT NULL,
+	sent_at DATETIME NOT NULL,
+	edited_at DATETIME,
+	deleted_at DATETIME
+);
+CREATE TABLE users (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL
+);
+CREATE TABLE room_read_states (
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	last_read_message_id TEXT NOT NULL,
+	last_read_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	PRIMARY KEY (room_id, user_id)
+);
+CREATE TABLE message_mentions (
+	message_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	room_id TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	PRIMARY KEY (message_id, user_id)
+);`)
+	require.NoError(t, err)
+	return db
+}
+
+func TestRoomRepositoryImpl_ListRoomSummaries(t *testing.T) {
+	db := setupListTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+
+	const (
+		alphaID    = "00000000-0000-4000-8000-00000000000a"
+		betaID     = "00000000-0000-4000-8000-00000000000b"
+		gammaID    = "00000000-0000-4000-8000-00000000000c"
+		privateID  = "00000000-0000-4000-8000-00000000000d"
+		directID   = "00000000-0000-4000-8000-00000000000e"
+		msgID      = "00000000-0000-4000-8000-000000000001"
+		otherMsgID = "00000000-0000-4000-8000-000000000006"
+	)
+	_, err := db.Exec(`INSERT INTO users (id, name) VALUES (?, 'owner'), (?, 'other')`, testOwnerID, testOtherID)
+	require.NoError(t, err)
+
+	// alpha: メンバー1人、最新のメッセージ t5（より新しい返信と削除済みのメッセージは対象外）
+	//        他のユーザーのメッセージが2件（うち1件は owner へのメンション。メンションは本文ではなく message_mentions で判定する）
+	// beta: メンバー3人、メッセージなし
+	// gamma: メンバー2人、最新のメッセージ t3
+	// 参加していない非公開の部屋とダイレクトメッセージの部屋は含めない
+	for _, room := range []struct {
+		id, name, kind, visibility string
+		createdAt                  time.Time
+		members                    []string
+	}{
+		{alphaID, "alpha", "group", "public", at(0), []string{testOwnerID}},
+		{betaID, "beta", "group", "public", at(1), []string{testOwnerID, testOtherID, "user_3"}},
+		{gammaID, "gamma", "group", "public", at(2), []string{testOtherID, "user_3"}},
+		{privateID, "delta", "group", "private", at(3), []string{testOtherID}},
+		{directID, "", "direct", "private", at(4), []string{testOwnerID, testOtherID}},
+	} {
+		_, err := db.Exec(`INSERT INTO rooms (id, name, kind, visibility, created_at) VALUES (?, ?, ?, ?, ?)`,
+			room.id, room.name, room.kind, room.visibility, room.createdAt)
+		require.NoError(t, err)
+		for _, member := range room.members {
+			_, err := db.Exec(`INSERT INTO room_members (id, room_id, user_id) VALUES (?, ?, ?)`, room.id+member, room.id, member)
+			require.NoError(t, err)
+		}
+	}
+	for _, msg := range []struct {
+		id, roomID, userID, parentID, content string
+		sentAt                                time.Time
+		deleted, mentioned                    bool
+	}{
+		{otherMsgID, alphaID, testOtherID, "", "hello owner@owner", at(1), false, false},
+		{"00000000-0000-4000-8000-000000000007", alphaID, testOtherID, "", "hi @owner", at(2), false, true},
+		{"00000000-0000-4000-8000-000000000002", alphaID, testOwnerID, "", "old", at(4), false, false},
+		{msgID, alphaID, testOwnerID, "", "latest", at(5), false, false},
+		{"00000000-0000-4000-8000-000000000003", alphaID, testOtherID, msgID, "reply @owner", at(8), false, true},
+		{"00000000-0000-4000-8000-000000000004", alphaID, testOtherID, "", "deleted @owner", at(9), true, true},
+		{"00000000-0000-4000-8000-000000000005", gammaID, testOtherID, "", "hi", at(3), false, false},
+	} {
+		var parentID, deletedAt any
+		if msg.parentID != "" {
+			parentID = msg.parentID
+		}
+		if msg.deleted {
+			deletedAt = msg.sentAt
+		}
+		_, err := db.Exec(`INSERT INTO messages (id, room_id, user_id, parent_id, content, sent_at, deleted_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			msg.id, msg.roomID, msg.userID, parentID, msg.content, msg.sentAt, deletedAt)
+		require.NoError(t, err)
+		if msg.mentioned {
+			_, err := db.Exec(`INSERT INTO message_mentions (message_id, user_id, room_id, kind, created_at) VALUES (?, ?, ?, 'user', ?)`,
+				msg.id, testOwnerID, msg.roomID, msg.sentAt)
+			require.NoError(t, err)
+		}
+	}
+
+	// 2件ずつ最後のページまで取得し、部屋IDの並びを返す
+	listAll := func(t *testing.T, sort repository.RoomSort, nameLike string) []entity.RoomID {
+		var ids []entity.RoomID
+		var after *repository.RoomListCursor
+		for page := 0; page < 10; page++ {
+			summaries, hasNext, err := repo.ListRoomSummaries(ctx, repository.RoomListQuery{
+				ViewerID: testOwnerID,
+				NameLike: nameLike,
+				Sort:     sort,
+				Limit:    2,
+				After:    after,
+			})
+			require.NoError(t, err)
+			for _, s := range summaries {
+				ids = append(ids, s.GetRoom().GetID())
+			}
+			if !hasNext {
+				return ids
+			}
+			last := summaries[len(summaries)-1]
+			after = &repository.RoomListCursor{
+				RoomID:         last.GetRoom().GetID(),
+				Name:           last.GetRoom().GetName(),
+				CreatedAt:      last.GetRoom().GetCreatedAt(),
+				MemberCount:    last.GetMemberCount(),
+				LastActivityAt: last.GetLastActivityAt(),
+			}
+		}
+		t.Fatal("too many pages")
+		return nil
+	}
+
+	tests := []struct {
+		sort repository.RoomSort
+		want []entity.RoomID
+	}{
+		{repository.RoomSortName, []entity.RoomID{alphaID, betaID, gammaID}},
+		{repository.RoomSortCreatedAt, []entity.RoomID{gammaID, betaID, alphaID}},
+		{repository.RoomSortMemberCount, []entity.RoomID{betaID, gammaID, alphaID}},
+		{repository.RoomSortLastActivity, []entity.RoomID{alphaID, gammaID, betaID}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			assert.Equal(t, tt.want, listAll(t, tt.sort, ""))
+		})
+	}
+
+	t.Run("名前で絞り込む", func(t *testing.T) {
+		assert.Equal(t, []entity.RoomID{gammaID}, listAll(t, repository.RoomSortName, "amm"))
+	})
+
+	t.Run("名前のワイルドカードは文字として扱う", func(t *testing.T) {
+		assert.Empty(t, listAll(t, repository.RoomSortName, "%"))
+		assert.Empty(t, listAll(t, repository.RoomSortName, "_"))
+	})
+
+	t.Run("メンバー数と最新のメッセージ", func(t *testing.T) {
+		summaries, hasNext, err := repo.ListRoomSummaries(ctx, repository.RoomListQuery{
+			ViewerID: testOwnerID,
+			Sort:     repository.RoomSortName,
+			Limit:    10,
+		})
+		require.NoError(t, err)
+		assert.False(t, hasNext)
+		require.Len(t, summaries, 3)
+
+		alpha := summaries[0]
+		assert.Equal(t, 1, alpha.GetMemberCount())
+		require.NotNil(t, alpha.GetLastMessage())
+		assert.Equal(t, entity.MessageID(msgID), alpha.GetLastMessage().GetID())
+		assert.Equal(t, "latest", alpha.GetLastMessage().GetContent())
+		assert.True(t, at(5).Equal(alpha.GetLastActivityAt()))
+
+		beta := summaries[1]
+		assert.Equal(t, 3, beta.GetMemberCount())
+		assert.Nil(t, beta.GetLastMessage())
+		assert.True(t, at(1).Equal(beta.GetLastActivityAt()))
+	})
+
+	t.Run("未読件数とメンション数", func(t *testing.T) {
+		counts := func(t *testing.T) map[entity.RoomID][2]int {
+			summaries, _, err := repo.ListRoomSummaries(ctx, repository.RoomListQuery{
+				ViewerID: testOwnerID,
+				Sort:     repository.RoomSortName,
+				Limit:    10,
+			})
+			require.NoError(t, err)
+			got := make(map[entity.RoomID][2]int)
+			for _, s := range summaries {
+				got[s.GetRoom().GetID()] = [2]int{s.GetUnreadCount(), s.GetMentionCount()}
+			}
+			return got
+		}
+
+		// 既読位置がない場合は他のユーザーのメッセージをすべて数える（返信・削除済みは数えない）
+		// 参加していない部屋は 0
+		assert.Equal(t, map[entity.RoomID][2]int{alphaID: {2, 1}, betaID: {0, 0}, gammaID: {0, 0}}, counts(t))
+
+		// 既読位置より後のメッセージのみ数える
+		_, err := db.Exec(`INSERT INTO room_read_states (room_id, user_id, last_read_message_id, last_read_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+			alphaID, testOwnerID, otherMsgID, at(1), at(10))
+		require.NoError(t, err)
+		assert.Equal(t, [2]int{1, 1}, counts(t)[alphaID])
+	})
+}
+
+func TestRoomRepositoryImpl_ListRoomSummaries_LocalTime(t *testing.T) {
+	// 送信日時はローカル時刻（time.Now()）で渡されるため、UTC 以外のタイムゾーンで確認する
+	local := time.Local
+	time.Local = time.FixedZone("JST", 9*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	db := setupListTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	msgRepo := sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	now := time.Now()
+
+	const (
+		quietID = "00000000-0000-4000-8000-00000000000a"
+		busyID  = "00000000-0000-4000-8000-00000000000b"
+	)
+	// quiet: 1時間前に作成、メッセージなし
+	// busy: 3時間前に作成、最新のメッセージは2時間前
+	for _, room := range []struct {
+		id        entity.RoomID
+		createdAt time.Time
+	}{
+		{quietID, now.Add(-time.Hour)},
+		{busyID, now.Add(-3 * time.Hour)},
+	} {
+		_, err := repo.SaveRoomWithOwner(ctx, entity.NewRoom(entity.RoomParams{ID: room.id, Name: string(room.id), CreatedAt: room.createdAt}), testOwnerID)
+		require.NoError(t, err)
+	}
+	require.NoError(t, msgRepo.CreateMessage(ctx, entity.NewMessage(entity.MessageParams{
+		ID:      "00000000-0000-4000-8000-000000000001",
+		RoomID:  busyID,
+		UserID:  testOwnerID,
+		Content: "hello",
+		SentAt:  now.Add(-2 * time.Hour),
+	})))
+
+	summaries, _, err := repo.ListRoomSummaries(ctx, repository.RoomListQuery{
+		ViewerID: testOwnerID,
+		Sort:     repository.RoomSortLastActivity,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, entity.RoomID(quietID), summaries[0].GetRoom().GetID())
+	assert.Equal(t, entity.RoomID(busyID), summaries[1].GetRoom().GetID())
+
+	// カーソルもタイムゾーンによらず同じ時刻として比較する
+	summaries, _, err = repo.ListRoomSummaries(ctx, repository.RoomListQuery{
+		ViewerID: testOwnerID,
+		Sort:     repository.RoomSortLastActivity,
+		Limit:    10,
+		After: &repository.RoomListCursor{
+			RoomID:         quietID,
+			LastActivityAt: now.Add(-time.Hour),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, entity.RoomID(busyID), summaries[0].GetRoom().GetID())
+}
+
+func TestRoomRepositoryImpl_ListRoomSummaries_UnreadLocalTime(t *testing.T) {
+	// 送信日時と既読位置はローカル時刻（time.Now()）で渡されるため、UTC 以外のタイムゾーンで確認する
+	local := time.Local
+	time.Local = time.FixedZone("JST", 9*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	db := setupListTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	msgRepo := sqlitemsgrepo.NewMessageRepositoryImpl(&sqlitemsgrepo.NewMessageRepositoryImplParams{DB: db})
+	readStateRepo := sqlitereadstaterepo.NewRoomReadStateRepositoryImpl(&sqlitereadstaterepo.NewRoomReadStateRepositoryImplParams{DB: db})
+	ctx := context.Background()
+	now := time.Now()
+
+	const roomID = "00000000-0000-4000-8000-00000000000a"
+	_, err := db.Exec(`INSERT INTO users (id, name) VALUES (?, 'owner'), (?, 'other')`, testOwnerID, testOtherID)
+	require.NoError(t, err)
+	_, err = repo.SaveRoomWithOwner(ctx, entity.NewRoom(entity.RoomParams{ID: roomID, Name: "room", CreatedAt: now.Add(-time.Hour)}), testOwnerID)
+	require.NoError(t, err)
+
+	// 他のユーザーのメッセージ3件のうち、2件目まで既読にする
+	var messages []*entity.Message
+	for i, id := range []entity.MessageID{
+		"00000000-0000-4000-8000-000000000001",
+		"00000000-0000-4000-8000-000000000002",
+		"00000000-0000-4000-8000-000000000003",
+	} {
+		msg := entity.NewMessage(entity.MessageParams{
+			ID:      id,
+			RoomID:  roomID,
+			UserID:  testOtherID,
+			Content: "hello",
+			SentAt:  now.Add(time.Duration(i-3) * time.Minute),
+		})
+		require.NoError(t, msgRepo.CreateMessage(ctx, msg))
+		messages = append(messages, msg)
+	}
+	_, err = readStateRepo.SaveReadState(ctx, entity.NewRoomReadState(entity.RoomReadStateParams{
+		RoomID:            roomID,
+		UserID:            testOwnerID,
+		LastReadMessageID: messages[1].GetID(),
+		LastReadAt:        messages[1].GetSentAt(),
+		UpdatedAt:         now,
+	}))
+	require.NoError(t, err)
+
+	summaries, _, err := repo.ListRoomSummaries(ctx, repository.RoomListQuery{
+		ViewerID: testOwnerID,
+		Sort:     repository.RoomSortName,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, 1, summaries[0].GetUnreadCount())
+}
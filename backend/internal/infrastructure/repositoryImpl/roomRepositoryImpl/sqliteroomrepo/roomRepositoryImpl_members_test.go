@@ -0,0 +1,98 @@
+package sqliteroomrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomRepositoryImpl/sqliteroomrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoomRepositoryImpl_ListRoomMembers(t *testing.T) {
+	db := setupRoleTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`
+CREATE TABLE users (
+	id TEXT NOT NULL PRIMARY KEY,
+	name TEXT NOT NULL,
+	email TEXT NOT NULL UNIQUE,
+	password_hash TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME
+);`)
+	require.NoError(t, err)
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	const (
+		aliceID  = "00000000-0000-4000-8000-000000000001"
+		bobID    = "00000000-0000-4000-8000-000000000002"
+		carolID  = "00000000-0000-4000-8000-000000000003"
+		bob2ID   = "00000000-0000-4000-8000-000000000004"
+		outsider = "00000000-0000-4000-8000-000000000005"
+	)
+	for _, u := range []struct{ id, name string }{
+		{carolID, "carol"}, {bobID, "bob"}, {aliceID, "alice"}, {bob2ID, "bob"}, {outsider, "dave"},
+	} {
+		_, err := db.Exec(`INSERT INTO users (id, name, email, password_hash, created_at) VALUES (?, ?, ?, 'x', CURRENT_TIMESTAMP)`,
+			u.id, u.name, u.id+"@example.com")
+		require.NoError(t, err)
+	}
+	_, err = db.Exec(`INSERT INTO rooms (id, name) VALUES (?, 'Test Room')`, testRoomID)
+	require.NoError(t, err)
+	require.NoError(t, repo.AddMemberToRoom(ctx, testRoomID, aliceID, entity.RoomRoleOwner))
+	require.NoError(t, repo.AddMemberToRoom(ctx, testRoomID, bobID, entity.RoomRoleAdmin))
+	require.NoError(t, repo.AddMemberToRoom(ctx, testRoomID, bob2ID, entity.RoomRoleMember))
+	require.NoError(t, repo.AddMemberToRoom(ctx, testRoomID, carolID, entity.RoomRoleMember))
+
+	// 2件ずつ最後のページまで取得する
+	var got []*entity.RoomMember
+	var after *repository.RoomMemberCursor
+	for {
+		members, hasNext, err := repo.ListRoomMembers(ctx, repository.RoomMemberListQuery{
+			RoomID: testRoomID,
+			Limit:  2,
+			After:  after,
+		})
+		require.NoError(t, err)
+		got = append(got, members...)
+		if !hasNext {
+			break
+		}
+		last := members[len(members)-1].GetUser()
+		after = &repository.RoomMemberCursor{Name: last.GetName(), UserID: last.GetID()}
+	}
+
+	// 名前順、同じ名前は UserID 順。部屋のメンバーでないユーザーは含めない
+	require.Len(t, got, 4)
+	wantIDs := []entity.UserID{aliceID, bobID, bob2ID, carolID}
+	wantRoles := []entity.RoomRole{entity.RoomRoleOwner, entity.RoomRoleAdmin, entity.RoomRoleMember, entity.RoomRoleMember}
+	for i, m := range got {
+		assert.Equal(t, wantIDs[i], m.GetUser().GetID())
+		assert.Equal(t, wantRoles[i], m.GetRole())
+	}
+
+	// 名前で絞り込む
+	members, hasNext, err := repo.ListRoomMembers(ctx, repository.RoomMemberListQuery{
+		RoomID:   testRoomID,
+		NameLike: "bo",
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	assert.False(t, hasNext)
+	assert.Len(t, members, 2)
+
+	// ワイルドカードは文字として扱う
+	members, _, err = repo.ListRoomMembers(ctx, repository.RoomMemberListQuery{
+		RoomID:   testRoomID,
+		NameLike: "_",
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, members)
+}
@@ -0,0 +1,143 @@
+package sqliteroomrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomRepositoryImpl/sqliteroomrepo"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoomID  = "5b0c9a7e-3f1d-4e2a-9c8b-7d6e5f4a3b21"
+	testOwnerID = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+	testOtherID = "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e"
+)
+
+func setupRoleTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+CREATE TABLE rooms (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	kind TEXT NOT NULL DEFAULT 'group',
+	visibility TEXT NOT NULL DEFAULT 'public',
+	dm_key TEXT UNIQUE,
+	description TEXT NOT NULL DEFAULT '',
+	pin_limit INTEGER NOT NULL DEFAULT 50,
+	slow_mode_seconds INTEGER NOT NULL DEFAULT 0,
+	archived_at DATETIME,
+	created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE room_members (
+	id TEXT PRIMARY KEY,
+	room_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	role TEXT NOT NULL DEFAULT 'member',
+	FOREIGN KEY (room_id) REFERENCES rooms(id)
+);
+CREATE UNIQUE INDEX idx_room_members_room_id_user_id ON room_members(room_id, user_id);`)
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestRoomRepositoryImpl_GetMemberRole(t *testing.T) {
+	db := setupRoleTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	_, err := db.Exec(`INSERT INTO rooms (id, name) VALUES (?, 'Test Room')`, testRoomID)
+	require.NoError(t, err)
+	require.NoError(t, repo.AddMemberToRoom(ctx, testRoomID, testOwnerID, entity.RoomRoleOwner))
+
+	// メンバーの役割を返す
+	role, err := repo.GetMemberRole(ctx, testRoomID, testOwnerID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoomRoleOwner, role)
+
+	// 部屋は存在するがメンバーでない
+	_, err = repo.GetMemberRole(ctx, testRoomID, testOtherID)
+	assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+
+	// 部屋が存在しない
+	_, err = repo.GetMemberRole(ctx, "missing", testOwnerID)
+	assert.ErrorIs(t, err, repository.ErrRoomNotFound)
+}
+
+func TestRoomRepositoryImpl_SaveRoomWithOwner(t *testing.T) {
+	db := setupRoleTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	room := entity.NewRoom(entity.RoomParams{ID: testRoomID, Name: "Test Room", CreatedAt: time.Now()})
+	roomID, err := repo.SaveRoomWithOwner(ctx, room, testOwnerID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoomID(testRoomID), roomID)
+
+	role, err := repo.GetMemberRole(ctx, testRoomID, testOwnerID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoomRoleOwner, role)
+
+	// 部屋を保存できない場合はオーナーの参加も保存しない
+	_, err = repo.SaveRoomWithOwner(ctx, room, testOtherID)
+	assert.Error(t, err)
+	_, err = repo.GetMemberRole(ctx, testRoomID, testOtherID)
+	assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+}
+
+func TestRoomRepositoryImpl_AddMemberToRoom(t *testing.T) {
+	db := setupRoleTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	_, err := db.Exec(`INSERT INTO rooms (id, name) VALUES (?, 'Test Room')`, testRoomID)
+	require.NoError(t, err)
+	require.NoError(t, repo.AddMemberToRoom(ctx, testRoomID, testOwnerID, entity.RoomRoleOwner))
+
+	// すでにメンバーの場合は重複させず、役割も変えない
+	require.NoError(t, repo.AddMemberToRoom(ctx, testRoomID, testOwnerID, entity.RoomRoleMember))
+	var count int
+	require.NoError(t, db.Get(&count, `SELECT COUNT(*) FROM room_members WHERE room_id = ? AND user_id = ?`, testRoomID, testOwnerID))
+	assert.Equal(t, 1, count)
+	role, err := repo.GetMemberRole(ctx, testRoomID, testOwnerID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RoomRoleOwner, role)
+}
+
+func TestRoomRepositoryImpl_GetRoomIDsByMember(t *testing.T) {
+	db := setupRoleTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	const otherRoomID = "6c1d0b8f-4a2e-4f3b-8d9c-8e7f6a5b4c32"
+	_, err := db.Exec(`INSERT INTO rooms (id, name) VALUES (?, 'Test Room'), (?, 'Other Room')`, testRoomID, otherRoomID)
+	require.NoError(t, err)
+	require.NoError(t, repo.AddMemberToRoom(ctx, testRoomID, testOwnerID, entity.RoomRoleOwner))
+	require.NoError(t, repo.AddMemberToRoom(ctx, otherRoomID, testOwnerID, entity.RoomRoleMember))
+	require.NoError(t, repo.AddMemberToRoom(ctx, otherRoomID, testOtherID, entity.RoomRoleOwner))
+
+	roomIDs, err := repo.GetRoomIDsByMember(ctx, testOwnerID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []entity.RoomID{testRoomID, otherRoomID}, roomIDs)
+
+	// どの部屋にも参加していない場合は空
+	roomIDs, err = repo.GetRoomIDsByMember(ctx, "c3d4e5f6-a7b8-4c9d-8e0f-2a3b4c5d6e7f")
+	require.NoError(t, err)
+	assert.Empty(t, roomIDs)
+}
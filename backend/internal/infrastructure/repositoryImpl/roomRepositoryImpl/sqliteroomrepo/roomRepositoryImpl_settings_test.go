@@ -0,0 +1,69 @@
+package sqliteroomrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomRepositoryImpl/sqliteroomrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoomRepositoryImpl_RoomSettings(t *testing.T) {
+	db := setupRoleTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	_, err := db.Exec(`INSERT INTO rooms (id, name) VALUES (?, 'Test Room')`, testRoomID)
+	require.NoError(t, err)
+
+	// 初期状態は説明なし・アーカイブされていない
+	room, err := repo.GetRoomByID(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.Equal(t, "", room.GetDescription())
+	assert.Equal(t, entity.DefaultRoomPinLimit, room.GetPinLimit())
+	assert.False(t, room.IsArchived())
+
+	// 説明を更新する
+	require.NoError(t, repo.UpdateRoomDescription(ctx, testRoomID, "雑談用の部屋です"))
+	room, err = repo.GetRoomByID(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.Equal(t, "雑談用の部屋です", room.GetDescription())
+
+	// ピン留めの上限を更新する
+	require.NoError(t, repo.UpdateRoomPinLimit(ctx, testRoomID, 10))
+	room, err = repo.GetRoomByID(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.Equal(t, 10, room.GetPinLimit())
+
+	// スローモードの間隔を更新する
+	assert.Zero(t, room.GetSlowMode())
+	require.NoError(t, repo.UpdateRoomSlowMode(ctx, testRoomID, 30*time.Second))
+	room, err = repo.GetRoomByID(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, room.GetSlowMode())
+
+	// アーカイブする
+	archivedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, repo.SetRoomArchived(ctx, testRoomID, &archivedAt))
+	room, err = repo.GetRoomByID(ctx, testRoomID)
+	require.NoError(t, err)
+	require.True(t, room.IsArchived())
+	assert.True(t, archivedAt.Equal(*room.GetArchivedAt()))
+
+	// 一覧でもアーカイブ状態を返す
+	rooms, err := repo.GetAllRooms(ctx, testOwnerID)
+	require.NoError(t, err)
+	require.Len(t, rooms, 1)
+	assert.True(t, rooms[0].IsArchived())
+
+	// アーカイブを解除する
+	require.NoError(t, repo.SetRoomArchived(ctx, testRoomID, nil))
+	room, err = repo.GetRoomByID(ctx, testRoomID)
+	require.NoError(t, err)
+	assert.False(t, room.IsArchived())
+}
@@ -0,0 +1,53 @@
+package sqliteroomrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/roomRepositoryImpl/sqliteroomrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoomRepositoryImpl_GetAllRooms_Visibility(t *testing.T) {
+	db := setupRoleTestDB(t)
+	defer db.Close()
+
+	repo := sqliteroomrepo.NewRoomRepositoryImpl(&sqliteroomrepo.NewRoomRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	const privateRoomID = "6c1d0b8f-4a2e-4f3b-8d9c-8e7f6a5b4c32"
+	_, err := repo.SaveRoom(ctx, entity.NewRoom(entity.RoomParams{ID: testRoomID, Name: "Public Room"}))
+	require.NoError(t, err)
+	_, err = repo.SaveRoom(ctx, entity.NewRoom(entity.RoomParams{
+		ID:         privateRoomID,
+		Name:       "Private Room",
+		Visibility: entity.RoomVisibilityPrivate,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, repo.AddMemberToRoom(ctx, privateRoomID, testOwnerID, entity.RoomRoleOwner))
+
+	roomIDs := func(rooms []*entity.Room) []entity.RoomID {
+		ids := make([]entity.RoomID, len(rooms))
+		for i, room := range rooms {
+			ids[i] = room.GetID()
+		}
+		return ids
+	}
+
+	// メンバーには非公開の部屋も含める
+	rooms, err := repo.GetAllRooms(ctx, testOwnerID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []entity.RoomID{testRoomID, privateRoomID}, roomIDs(rooms))
+
+	// メンバー以外には公開の部屋のみ
+	rooms, err = repo.GetAllRooms(ctx, testOtherID)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.RoomID{testRoomID}, roomIDs(rooms))
+
+	// 公開設定を取得できる
+	room, err := repo.GetRoomByID(ctx, privateRoomID)
+	require.NoError(t, err)
+	assert.True(t, room.IsPrivate())
+}
@@ -2,7 +2,9 @@ package mysqluserrepo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/domain/repository"
@@ -72,12 +74,14 @@ func (r *UserRepositoryImpl) GetUserByID(ctx context.Context, id entity.UserID)
 	}
 	// UUID -> BIN
 	row := r.db.QueryRowxContext(ctx, `
-		SELECT BIN_TO_UUID(id) AS id, name, email, password_hash, created_at, updated_at
+		SELECT BIN_TO_UUID(id) AS id, name, email, password_hash, created_at, updated_at, last_seen_at
 		FROM users
 		WHERE id = UUID_TO_BIN(?)`, idUUID)
 
 	var userModel model.UserModel
-	if err := row.StructScan(&userModel); err != nil {
+	if err := row.StructScan(&userModel); errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrUserNotFound
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -90,7 +94,7 @@ func (r *UserRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (
 	}
 
 	row := r.db.QueryRowxContext(ctx, `
-		SELECT BIN_TO_UUID(id) AS id, name, email, password_hash, created_at, updated_at
+		SELECT BIN_TO_UUID(id) AS id, name, email, password_hash, created_at, updated_at, last_seen_at
 		FROM users
 		WHERE email = ?`, email)
 
@@ -101,3 +105,57 @@ func (r *UserRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (
 
 	return userModel.ToEntity(), nil
 }
+
+func (r *UserRepositoryImpl) GetUsersByIDs(ctx context.Context, ids []entity.UserID) ([]*entity.User, error) {
+	if len(ids) == 0 {
+		return []*entity.User{}, nil
+	}
+
+	// id のインデックスを使うため、UUID を BINARY(16) に変換して渡す
+	idBins := make([][]byte, len(ids))
+	for i, id := range ids {
+		idUUID, err := id.UserID2UUID()
+		if err != nil {
+			return nil, err
+		}
+		idBins[i] = idUUID[:]
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT BIN_TO_UUID(id) AS id, name, email, password_hash, created_at, updated_at, last_seen_at
+		FROM users
+		WHERE id IN (?)`, idBins)
+	if err != nil {
+		return nil, err
+	}
+
+	var userModels []model.UserModel
+	if err := r.db.SelectContext(ctx, &userModels, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	users := make([]*entity.User, 0, len(userModels))
+	for _, um := range userModels {
+		users = append(users, um.ToEntity())
+	}
+	return users, nil
+}
+
+func (r *UserRepositoryImpl) UpdateLastSeen(ctx context.Context, id entity.UserID, lastSeenAt time.Time) error {
+	// UserID -> UUID
+	idUUID, err := id.UserID2UUID()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET last_seen_at = ? WHERE id = UUID_TO_BIN(?)`, lastSeenAt, idUUID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repository.ErrUserNotFound
+	}
+	return nil
+}
@@ -2,8 +2,11 @@ package sqliteuserrepo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
+	"time"
+
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/infrastructure/repositoryImpl/model"
@@ -61,12 +64,14 @@ func (r *UserRepositoryImpl) GetUserByID(ctx context.Context, id entity.UserID)
 	}
 
 	row := r.db.QueryRowxContext(ctx, `
-		SELECT id, name, email, password_hash, created_at, updated_at
+		SELECT id, name, email, password_hash, created_at, updated_at, last_seen_at
 		FROM users
 		WHERE id = ?`, id)
 
 	var userModel model.UserModel
-	if err := row.StructScan(&userModel); err != nil {
+	if err := row.StructScan(&userModel); errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrUserNotFound
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -79,7 +84,7 @@ func (r *UserRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (
 	}
 
 	row := r.db.QueryRowxContext(ctx, `
-		SELECT id, name, email, password_hash, created_at, updated_at
+		SELECT id, name, email, password_hash, created_at, updated_at, last_seen_at
 		FROM users
 		WHERE email = ?`, email)
 
@@ -90,3 +95,41 @@ func (r *UserRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (
 
 	return userModel.ToEntity(), nil
 }
+
+func (r *UserRepositoryImpl) GetUsersByIDs(ctx context.Context, ids []entity.UserID) ([]*entity.User, error) {
+	if len(ids) == 0 {
+		return []*entity.User{}, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT id, name, email, password_hash, created_at, updated_at, last_seen_at
+		FROM users
+		WHERE id IN (?)`, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var userModels []model.UserModel
+	if err := r.db.SelectContext(ctx, &userModels, r.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	users := make([]*entity.User, 0, len(userModels))
+	for _, um := range userModels {
+		users = append(users, um.ToEntity())
+	}
+	return users, nil
+}
+
+func (r *UserRepositoryImpl) UpdateLastSeen(ctx context.Context, id entity.UserID, lastSeenAt time.Time) error {
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET last_seen_at = ? WHERE id = ?`, lastSeenAt, id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repository.ErrUserNotFound
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package sqliteuserrepo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/infrastructure/repositoryImpl/userRepositoryImpl/sqliteuserrepo"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUserID1   = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+	testUserID2   = "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e"
+	testUnknownID = "c3d4e5f6-a7b8-4c9d-8e0f-2a3b4c5d6e7f"
+)
+
+func setupLastSeenTestDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+CREATE TABLE users (
+	id TEXT NOT NULL PRIMARY KEY,
+	name TEXT NOT NULL,
+	email TEXT NOT NULL UNIQUE,
+	password_hash TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME,
+	last_seen_at DATETIME
+);`)
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestUserRepositoryImpl_LastSeen(t *testing.T) {
+	db := setupLastSeenTestDB(t)
+	defer db.Close()
+
+	repo := sqliteuserrepo.NewUserRepositoryImpl(&sqliteuserrepo.NewUserRepositoryImplParams{DB: db})
+	ctx := context.Background()
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, id := range []entity.UserID{testUserID1, testUserID2} {
+		_, err := repo.SaveUser(ctx, entity.NewUser(entity.UserParams{
+			ID:         id,
+			Name:       fmt.Sprintf("user%d", i+1),
+			Email:      fmt.Sprintf("user%d@example.com", i+1),
+			PasswdHash: "hash",
+			CreatedAt:  createdAt,
+		}))
+		require.NoError(t, err)
+	}
+
+	t.Run("一度も接続していない場合はnil", func(t *testing.T) {
+		user, err := repo.GetUserByID(ctx, testUserID1)
+		require.NoError(t, err)
+		assert.Nil(t, user.GetLastSeenAt())
+	})
+
+	t.Run("最後にオンラインだった日時を更新する", func(t *testing.T) {
+		lastSeenAt := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+		require.NoError(t, repo.UpdateLastSeen(ctx, testUserID1, lastSeenAt))
+
+		user, err := repo.GetUserByID(ctx, testUserID1)
+		require.NoError(t, err)
+		require.NotNil(t, user.GetLastSeenAt())
+		assert.True(t, lastSeenAt.Equal(*user.GetLastSeenAt()))
+	})
+
+	t.Run("存在しないユーザーの更新", func(t *testing.T) {
+		err := repo.UpdateLastSeen(ctx, testUnknownID, time.Now())
+		assert.ErrorIs(t, err, repository.ErrUserNotFound)
+	})
+
+	t.Run("まとめて取得する（存在しないユーザーは含めない）", func(t *testing.T) {
+		users, err := repo.GetUsersByIDs(ctx, []entity.UserID{testUserID1, testUnknownID, testUserID2})
+		require.NoError(t, err)
+
+		ids := make([]entity.UserID, 0, len(users))
+		for _, user := range users {
+			ids = append(ids, user.GetID())
+		}
+		assert.ElementsMatch(t, []entity.UserID{testUserID1, testUserID2}, ids)
+
+		users, err = repo.GetUsersByIDs(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, users)
+	})
+}
@@ -13,7 +13,7 @@ type InMemoryWebsocketClientRepository struct {
 	mu              sync.RWMutex
 	clients         map[entity.WsClientID]*entity.WebsocketClient
 	clientsByRoomID map[entity.RoomID]map[entity.WsClientID]*entity.WebsocketClient
-	clientsByUserID map[entity.UserID]*entity.WebsocketClient
+	clientsByUserID map[entity.UserID]map[entity.WsClientID]*entity.WebsocketClient
 }
 
 type NewInMemoryWebsocketClientRepositoryParams struct{}
@@ -22,7 +22,7 @@ func NewInMemoryWebsocketClientRepository(_ NewInMemoryWebsocketClientRepository
 	return &InMemoryWebsocketClientRepository{
 		clients:         make(map[entity.WsClientID]*entity.WebsocketClient),
 		clientsByRoomID: make(map[entity.RoomID]map[entity.WsClientID]*entity.WebsocketClient),
-		clientsByUserID: make(map[entity.UserID]*entity.WebsocketClient),
+		clientsByUserID: make(map[entity.UserID]map[entity.WsClientID]*entity.WebsocketClient),
 	}
 }
 
@@ -45,7 +45,10 @@ func (r *InMemoryWebsocketClientRepository) CreateClient(ctx context.Context, cl
 	r.clientsByRoomID[client.GetRoomID()][client.GetID()] = client
 
 	// UserID側に登録
-	r.clientsByUserID[client.GetUserID()] = client
+	if _, exists := r.clientsByUserID[client.GetUserID()]; !exists {
+		r.clientsByUserID[client.GetUserID()] = make(map[entity.WsClientID]*entity.WebsocketClient)
+	}
+	r.clientsByUserID[client.GetUserID()][client.GetID()] = client
 
 	return nil
 }
@@ -70,7 +73,12 @@ func (r *InMemoryWebsocketClientRepository) DeleteClient(ctx context.Context, id
 	}
 
 	// UserID側から削除
-	delete(r.clientsByUserID, client.GetUserID())
+	if userClients, exists := r.clientsByUserID[client.GetUserID()]; exists {
+		delete(userClients, id)
+		if len(userClients) == 0 {
+			delete(r.clientsByUserID, client.GetUserID())
+		}
+	}
 
 	return nil
 }
@@ -102,13 +110,18 @@ func (r *InMemoryWebsocketClientRepository) GetClientsByRoomID(ctx context.Conte
 	return result, nil
 }
 
-func (r *InMemoryWebsocketClientRepository) GetClientsByUserID(ctx context.Context, userID entity.UserID) (*entity.WebsocketClient, error) {
+func (r *InMemoryWebsocketClientRepository) GetClientsByUserID(ctx context.Context, userID entity.UserID) ([]*entity.WebsocketClient, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	client, exists := r.clientsByUserID[userID]
+	userClients, exists := r.clientsByUserID[userID]
 	if !exists {
-		return nil, errors.New("client not found for user ID")
+		return nil, nil
 	}
-	return client, nil
+
+	var result []*entity.WebsocketClient
+	for _, client := range userClients {
+		result = append(result, client)
+	}
+	return result, nil
 }
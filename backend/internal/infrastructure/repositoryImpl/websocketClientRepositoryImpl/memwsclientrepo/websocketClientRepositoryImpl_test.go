@@ -55,16 +55,36 @@ func TestInMemoryWebsocketClientRepository(t *testing.T) {
 	t.Run("GetClientsByUserID success", func(t *testing.T) {
 		got, err := repo.GetClientsByUserID(context.Background(), userID)
 		require.NoError(t, err)
-		require.Equal(t, client, got)
+		require.Len(t, got, 1)
+		require.Equal(t, client, got[0])
 	})
 
 	t.Run("GetClientsByUserID not found", func(t *testing.T) {
 		otherUserID := entity.UserID("other-user")
 		got, err := repo.GetClientsByUserID(context.Background(), otherUserID)
-		require.Error(t, err)
+		require.NoError(t, err)
 		require.Nil(t, got)
 	})
 
+	t.Run("同じユーザーの複数の接続", func(t *testing.T) {
+		// 別のタブで同じ部屋、別の部屋にそれぞれ接続する
+		second := entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "second", RoomID: roomID, UserID: userID})
+		third := entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "third", RoomID: "other-room", UserID: userID})
+		require.NoError(t, repo.CreateClient(context.Background(), second))
+		require.NoError(t, repo.CreateClient(context.Background(), third))
+
+		got, err := repo.GetClientsByUserID(context.Background(), userID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []*entity.WebsocketClient{client, second, third}, got)
+
+		// 1つを削除しても他の接続は残る
+		require.NoError(t, repo.DeleteClient(context.Background(), second.GetID()))
+		require.NoError(t, repo.DeleteClient(context.Background(), third.GetID()))
+		got, err = repo.GetClientsByUserID(context.Background(), userID)
+		require.NoError(t, err)
+		require.Equal(t, []*entity.WebsocketClient{client}, got)
+	})
+
 	t.Run("DeleteClient success", func(t *testing.T) {
 		err := repo.DeleteClient(context.Background(), client.GetID())
 		require.NoError(t, err)
@@ -79,7 +99,7 @@ func TestInMemoryWebsocketClientRepository(t *testing.T) {
 		require.Nil(t, roomClients)
 
 		gotUser, err := repo.GetClientsByUserID(context.Background(), userID)
-		require.Error(t, err)
+		require.NoError(t, err)
 		require.Nil(t, gotUser)
 	})
 
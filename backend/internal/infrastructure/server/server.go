@@ -1,6 +1,9 @@
 package server
 
 import (
+	"context"
+	"errors"
+
 	"example.com/infrahandson/config"
 	tokenadapterimpl "example.com/infrahandson/internal/infrastructure/adapterImpl/tokenServiceAdapterImpl/JWT"
 	"example.com/infrahandson/internal/infrastructure/di"
@@ -12,16 +15,28 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// ShutdownReason は停止時に WebSocket クライアントへ通知する切断理由
+const ShutdownReason = "server shutting down"
+
+// Server は起動したサーバーと、停止時に閉じる必要があるリソースをまとめたものです。
+type Server struct {
+	Echo  *echo.Echo
+	DB    *sqlx.DB
+	Cache *memcache.Client // Memcached を使用しない場合は nil
+
+	deps *di.Dependencies
+}
+
 // ServerStart initializes the Echo server, sets up dependencies, middleware, and routes,
-// and returns the Echo instance along with the database connection.
+// and returns the Echo instance along with the resources that must be released on shutdown.
 //
 // Parameters:
 // - cfg: A pointer to the configuration object containing server settings.
 //
 // Returns:
-// - *echo.Echo: The initialized Echo server instance.
-// - *sqlx.DB: The database connection initialized with the provided configuration.
-func ServerStart(cfg *config.Config) (*echo.Echo, *sqlx.DB, *memcache.Client) {
+//   - *Server: The initialized Echo server instance, the database connection and the memcache client.
+//     Call Shutdown to stop it gracefully.
+func ServerStart(cfg *config.Config) *Server {
 	e := echo.New()
 	e.Static("/images/icons", "./images/icons")
 	e.Validator = validator.NewEchoValidator()
@@ -44,5 +59,46 @@ func ServerStart(cfg *config.Config) (*echo.Echo, *sqlx.DB, *memcache.Client) {
 		dependencies.Handler,
 	)
 
-	return e, dependencies.DB, dependencies.Cache
+	return &Server{
+		Echo:  e,
+		DB:    dependencies.DB,
+		Cache: dependencies.Cache,
+		deps:  dependencies,
+	}
+}
+
+// Shutdown はサーバーを停止します。
+// 1. 新しい接続（WebSocket のアップグレードを含む）の受け付けを止め、処理中のリクエストを待つ
+// 2. WebSocket の送信待ちのメッセージを書き出し、切断理由を通知して閉じる
+// 3. バックプレーンのブローカー・DB・Memcached を閉じる
+// ctx の期限を過ぎた場合も、リソースは必ず閉じます。
+func (s *Server) Shutdown(ctx context.Context) error {
+	var errs []error
+
+	// Echo の Shutdown はハイジャックされた WebSocket の接続を待たない
+	if err := s.Echo.Shutdown(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := s.deps.WsManager.Shutdown(ctx, ShutdownReason); err != nil {
+		errs = append(errs, err)
+	}
+
+	if s.deps.WsBroker != nil {
+		if err := s.deps.WsBroker.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := s.DB.Close(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if s.Cache != nil {
+		if err := s.Cache.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
 }
@@ -0,0 +1,90 @@
+// ローカルのディレクトリを使用した添付ファイル保存の実装
+// アイコンと異なり静的配信はせず、部屋のメンバーであることを確認したハンドラーから返す
+package localattachmentsvc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/attachmentStoreServiceImpl/thumbnail"
+)
+
+type localattachmentstoreimpl struct {
+	dirPath string
+}
+
+type NewLocalAttachmentStoreImplParams struct {
+	DirPath string
+}
+
+func (p *NewLocalAttachmentStoreImplParams) Validate() error {
+	if p.DirPath == "" {
+		return errors.New("dir is required")
+	}
+	return nil
+}
+
+func NewLocalAttachmentStoreImpl(p *NewLocalAttachmentStoreImplParams) service.AttachmentStoreService {
+	if err := p.Validate(); err != nil {
+		panic(err)
+	}
+
+	// 存在しないなら、ディレクトリを作成する
+	if err := os.MkdirAll(p.DirPath, 0755); err != nil {
+		panic(err)
+	}
+
+	return &localattachmentstoreimpl{
+		dirPath: p.DirPath,
+	}
+}
+
+func (l *localattachmentstoreimpl) SaveAttachment(ctx context.Context, id entity.AttachmentID, data *service.AttachmentData) (*service.StoredAttachment, error) {
+	// ファイル名はアップロードされたものを使わず、添付ファイルのIDにする
+	if err := os.WriteFile(l.filePath(id, false), data.Data, 0644); err != nil {
+		return nil, err
+	}
+
+	info, thumb := thumbnail.Make(data.Data)
+	if info.HasThumbnail {
+		if err := os.WriteFile(l.filePath(id, true), thumb, 0644); err != nil {
+			_ = os.Remove(l.filePath(id, false))
+			return nil, err
+		}
+	}
+	return &info, nil
+}
+
+func (l *localattachmentstoreimpl) OpenAttachment(ctx context.Context, id entity.AttachmentID, thumbnail bool) (io.ReadCloser, error) {
+	f, err := os.Open(l.filePath(id, thumbnail))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, service.ErrAttachmentFileNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (l *localattachmentstoreimpl) DeleteAttachment(ctx context.Context, id entity.AttachmentID) error {
+	for _, thumbnail := range []bool{false, true} {
+		if err := os.Remove(l.filePath(id, thumbnail)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// filePath は添付ファイル（thumbnail が true の場合はサムネイル）の保存先のパスを返す
+func (l *localattachmentstoreimpl) filePath(id entity.AttachmentID, thumbnail bool) string {
+	if thumbnail {
+		return filepath.Join(l.dirPath, string(id)+"_thumb.webp")
+	}
+	return filepath.Join(l.dirPath, string(id))
+}
@@ -0,0 +1,69 @@
+package localattachmentsvc_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/attachmentStoreServiceImpl/localattachmentsvc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAll(t *testing.T, store service.AttachmentStoreService, id entity.AttachmentID, thumbnail bool) []byte {
+	rc, err := store.OpenAttachment(context.Background(), id, thumbnail)
+	require.NoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return data
+}
+
+func TestLocalAttachmentStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := localattachmentsvc.NewLocalAttachmentStoreImpl(&localattachmentsvc.NewLocalAttachmentStoreImplParams{DirPath: dir})
+
+	t.Run("画像はサムネイルも保存する", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 640, 480))))
+		id := entity.AttachmentID("image")
+
+		info, err := store.SaveAttachment(ctx, id, &service.AttachmentData{Data: buf.Bytes(), FileName: "a.png", MimeType: "image/png"})
+		require.NoError(t, err)
+		assert.Equal(t, &service.StoredAttachment{Width: 640, Height: 480, HasThumbnail: true}, info)
+		assert.Equal(t, buf.Bytes(), readAll(t, store, id, false))
+		assert.NotEmpty(t, readAll(t, store, id, true))
+
+		require.NoError(t, store.DeleteAttachment(ctx, id))
+		_, err = store.OpenAttachment(ctx, id, false)
+		assert.ErrorIs(t, err, service.ErrAttachmentFileNotFound)
+		_, err = store.OpenAttachment(ctx, id, true)
+		assert.ErrorIs(t, err, service.ErrAttachmentFileNotFound)
+	})
+
+	t.Run("画像以外はサムネイルを作成しない", func(t *testing.T) {
+		id := entity.AttachmentID("text")
+		data := []byte("hello")
+
+		info, err := store.SaveAttachment(ctx, id, &service.AttachmentData{Data: data, FileName: "../../a.txt", MimeType: "text/plain"})
+		require.NoError(t, err)
+		assert.Equal(t, &service.StoredAttachment{}, info)
+		assert.Equal(t, data, readAll(t, store, id, false))
+		// ファイル名はIDにするため、アップロードされたファイル名は保存先に影響しない
+		_, err = os.Stat(filepath.Join(dir, "text"))
+		assert.NoError(t, err)
+
+		_, err = store.OpenAttachment(ctx, id, true)
+		assert.ErrorIs(t, err, service.ErrAttachmentFileNotFound)
+		// サムネイルがなくても削除は成功する
+		assert.NoError(t, store.DeleteAttachment(ctx, id))
+	})
+}
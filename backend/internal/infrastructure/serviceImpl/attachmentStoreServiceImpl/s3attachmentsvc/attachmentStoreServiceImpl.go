@@ -0,0 +1,126 @@
+// s3を使用した添付ファイル保存の実装
+// アイコンと異なりオブジェクトのURLは公開せず、部屋のメンバーであることを確認したハンドラーから返す
+package s3attachmentsvc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/attachmentStoreServiceImpl/thumbnail"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+)
+
+type S3AttachmentStoreImpl struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+type NewS3AttachmentStoreImplParams struct {
+	Client *s3.Client
+	Bucket string
+	Prefix string
+}
+
+func (params *NewS3AttachmentStoreImplParams) Validate() error {
+	if params.Client == nil {
+		return errors.New("client is required")
+	}
+	if params.Bucket == "" {
+		return errors.New("bucket is required")
+	}
+	if params.Prefix == "" {
+		return errors.New("prefix is required")
+	}
+	return nil
+}
+
+func NewS3AttachmentStoreImpl(params NewS3AttachmentStoreImplParams) service.AttachmentStoreService {
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+
+	return &S3AttachmentStoreImpl{
+		client: params.Client,
+		bucket: params.Bucket,
+		prefix: params.Prefix,
+	}
+}
+
+func (s *S3AttachmentStoreImpl) SaveAttachment(ctx context.Context, id entity.AttachmentID, data *service.AttachmentData) (*service.StoredAttachment, error) {
+	if err := s.put(ctx, s.objectKey(id, false), data.Data, data.MimeType); err != nil {
+		return nil, err
+	}
+
+	info, thumb := thumbnail.Make(data.Data)
+	if info.HasThumbnail {
+		if err := s.put(ctx, s.objectKey(id, true), thumb, "image/webp"); err != nil {
+			_ = s.DeleteAttachment(ctx, id)
+			return nil, err
+		}
+	}
+	return &info, nil
+}
+
+func (s *S3AttachmentStoreImpl) OpenAttachment(ctx context.Context, id entity.AttachmentID, thumbnail bool) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(id, thumbnail)),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, service.ErrAttachmentFileNotFound
+		}
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+// DeleteAttachment は添付ファイルとサムネイルのオブジェクトを削除する
+// S3 の DeleteObject は存在しないキーでも成功する
+func (s *S3AttachmentStoreImpl) DeleteAttachment(ctx context.Context, id entity.AttachmentID) error {
+	for _, thumbnail := range []bool{false, true} {
+		_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(s.objectKey(id, thumbnail)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// objectKey は添付ファイル（thumbnail が true の場合はサムネイル）のオブジェクトキーを返す
+func (s *S3AttachmentStoreImpl) objectKey(id entity.AttachmentID, thumbnail bool) string {
+	if thumbnail {
+		return s.prefix + "/" + string(id) + "_thumb.webp"
+	}
+	return s.prefix + "/" + string(id)
+}
+
+func (s *S3AttachmentStoreImpl) put(ctx context.Context, objectKey string, data []byte, mimeType string) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(objectKey),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+		ContentType:   aws.String(mimeType),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "EntityTooLarge" {
+			return service.ErrAttachmentTooLarge
+		}
+		return err
+	}
+	return nil
+}
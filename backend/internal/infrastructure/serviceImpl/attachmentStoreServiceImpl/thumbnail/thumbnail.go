@@ -0,0 +1,89 @@
+// 添付ファイルの画像からサムネイルを作成する処理
+// ローカル・S3 の添付ファイルの保存で共通して使う
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+
+	"example.com/infrahandson/internal/domain/service"
+	"github.com/chai2010/webp"
+
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+const (
+	// MaxSize はサムネイルの長辺の最大ピクセル数
+	MaxSize = 320
+
+	// maxSourcePixels を超える画像は展開に時間とメモリがかかるため、サムネイルを作成しない
+	maxSourcePixels = 25_000_000
+)
+
+// Make は画像の大きさを読み取り、長辺が MaxSize 以下になるように縮小した WebP のサムネイルを作成します。
+// 画像として読み込めない場合は大きさを 0 とし、サムネイルを作成せずに返します（エラーにはしません）。
+func Make(data []byte) (service.StoredAttachment, []byte) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return service.StoredAttachment{}, nil
+	}
+	info := service.StoredAttachment{Width: config.Width, Height: config.Height}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxSourcePixels {
+		return info, nil
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return info, nil
+	}
+	w, h := fit(config.Width, config.Height)
+	if w != config.Width || h != config.Height {
+		img = downscale(img, w, h)
+	}
+
+	var buf bytes.Buffer
+	if err := webp.Encode(&buf, img, &webp.Options{Quality: 75}); err != nil {
+		return info, nil
+	}
+	info.HasThumbnail = true
+	return info, buf.Bytes()
+}
+
+// fit は縦横比を保ったまま長辺を MaxSize 以下にした大きさを返す（小さい画像は拡大しない）
+func fit(width, height int) (int, int) {
+	if width <= MaxSize && height <= MaxSize {
+		return width, height
+	}
+	if width >= height {
+		return MaxSize, max(height*MaxSize/width, 1)
+	}
+	return max(width*MaxSize/height, 1), MaxSize
+}
+
+// downscale は各ピクセルを元の画像の対応する範囲の平均色にして縮小する
+func downscale(src image.Image, width, height int) image.Image {
+	b := src.Bounds()
+	dst := image.NewRGBA64(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := b.Min.Y + y*b.Dy()/height
+		y1 := max(b.Min.Y+(y+1)*b.Dy()/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := b.Min.X + x*b.Dx()/width
+			x1 := max(b.Min.X+(x+1)*b.Dx()/width, x0+1)
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			dst.SetRGBA64(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(bl / n), A: uint16(a / n)})
+		}
+	}
+	return dst
+}
@@ -0,0 +1,67 @@
+package thumbnail_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/attachmentStoreServiceImpl/thumbnail"
+	"github.com/chai2010/webp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name                    string
+		width, height           int
+		thumbWidth, thumbHeight int
+	}{
+		{"横長の画像は幅を MaxSize にする", 800, 400, 320, 160},
+		{"縦長の画像は高さを MaxSize にする", 300, 960, 100, 320},
+		{"小さい画像は拡大しない", 64, 48, 64, 48},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, thumb := thumbnail.Make(encodePNG(t, tt.width, tt.height))
+			assert.Equal(t, tt.width, info.Width)
+			assert.Equal(t, tt.height, info.Height)
+			require.True(t, info.HasThumbnail)
+
+			config, err := webp.DecodeConfig(bytes.NewReader(thumb))
+			require.NoError(t, err)
+			assert.Equal(t, tt.thumbWidth, config.Width)
+			assert.Equal(t, tt.thumbHeight, config.Height)
+		})
+	}
+
+	t.Run("画像でない場合はサムネイルを作成しない", func(t *testing.T) {
+		info, thumb := thumbnail.Make([]byte("hello, world"))
+		assert.False(t, info.HasThumbnail)
+		assert.Zero(t, info.Width)
+		assert.Nil(t, thumb)
+	})
+
+	t.Run("壊れた画像は大きさのみ返す", func(t *testing.T) {
+		data := encodePNG(t, 40, 30)
+		info, thumb := thumbnail.Make(data[:len(data)/2])
+		assert.Equal(t, 40, info.Width)
+		assert.Equal(t, 30, info.Height)
+		assert.False(t, info.HasThumbnail)
+		assert.Nil(t, thumb)
+	})
+}
@@ -0,0 +1,196 @@
+package eventcodec
+
+import (
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// MessageDTO は message.created / message.updated / message.deleted のペイロードです。
+type MessageDTO struct {
+	ID      entity.MessageID `json:"id"`      // メッセージID
+	RoomID  entity.RoomID    `json:"room_id"` // 所属するチャットルームのID
+	UserID  entity.UserID    `json:"user_id"` // 投稿者のID
+	Content string           `json:"content"` // 本文
+	SentAt  time.Time        `json:"sent_at"` // 送信日時
+
+	ParentID  entity.MessageID `json:"parent_id,omitempty"`  // スレッドの返信の場合は返信先のメッセージID
+	EditedAt  *time.Time       `json:"edited_at,omitempty"`  // 最終編集日時
+	DeletedAt *time.Time       `json:"deleted_at,omitempty"` // 削除日時
+
+	Attachments []AttachmentDTO `json:"attachments,omitempty"` // 添付ファイル（message.created のみ）
+}
+
+func (m *MessageDTO) ToEntity() *entity.Message {
+	var attachments []*entity.Attachment
+	for _, a := range m.Attachments {
+		attachments = append(attachments, a.ToEntity(m))
+	}
+	return entity.NewMessage(entity.MessageParams{
+		ID:          m.ID,
+		RoomID:      m.RoomID,
+		UserID:      m.UserID,
+		ParentID:    m.ParentID,
+		Content:     m.Content,
+		SentAt:      m.SentAt,
+		EditedAt:    m.EditedAt,
+		DeletedAt:   m.DeletedAt,
+		Attachments: attachments,
+	})
+}
+
+func (m *MessageDTO) FromEntity(msg *entity.Message) {
+	m.ID = msg.GetID()
+	m.RoomID = msg.GetRoomID()
+	m.UserID = msg.GetUserID()
+	m.ParentID = msg.GetParentID()
+	m.Content = msg.GetContent()
+	m.SentAt = msg.GetSentAt()
+	m.EditedAt = msg.GetEditedAt()
+	m.DeletedAt = msg.GetDeletedAt()
+	m.Attachments = nil
+	for _, a := range msg.GetAttachments() {
+		dto := AttachmentDTO{}
+		dto.FromEntity(a)
+		m.Attachments = append(m.Attachments, dto)
+	}
+}
+
+// AttachmentDTO はメッセージの添付ファイルです。
+// ファイルは公開しないため、部屋のメンバーのみがダウンロードできるAPIのパスを返します。
+type AttachmentDTO struct {
+	ID           entity.AttachmentID `json:"id"`                      // 添付ファイルのID
+	FileName     string              `json:"file_name"`               // アップロードされたときのファイル名
+	MimeType     string              `json:"mime_type"`               // 内容から判定した MIME タイプ
+	Size         int64               `json:"size"`                    // バイト数
+	Width        int                 `json:"width,omitempty"`         // 画像の幅
+	Height       int                 `json:"height,omitempty"`        // 画像の高さ
+	DownloadURL  string              `json:"download_url"`            // ダウンロードするAPIのパス
+	ThumbnailURL string              `json:"thumbnail_url,omitempty"` // サムネイルをダウンロードするAPIのパス（画像のみ）
+}
+
+// ToEntity は添付ファイルのエンティティに変換する（部屋・アップロードしたユーザーはメッセージのものとする）
+func (a *AttachmentDTO) ToEntity(msg *MessageDTO) *entity.Attachment {
+	return entity.NewAttachment(entity.AttachmentParams{
+		ID:           a.ID,
+		RoomID:       msg.RoomID,
+		UploaderID:   msg.UserID,
+		MessageID:    msg.ID,
+		FileName:     a.FileName,
+		MimeType:     a.MimeType,
+		Size:         a.Size,
+		Width:        a.Width,
+		Height:       a.Height,
+		HasThumbnail: a.ThumbnailURL != "",
+	})
+}
+
+func (a *AttachmentDTO) FromEntity(attachment *entity.Attachment) {
+	a.ID = attachment.GetID()
+	a.FileName = attachment.GetFileName()
+	a.MimeType = attachment.GetMimeType()
+	a.Size = attachment.GetSize()
+	a.Width = attachment.GetWidth()
+	a.Height = attachment.GetHeight()
+	a.DownloadURL = attachment.GetDownloadPath()
+	a.ThumbnailURL = attachment.GetThumbnailPath()
+}
+
+// ReactionDTO は reaction.added / reaction.removed のペイロードです。
+type ReactionDTO struct {
+	MessageID entity.MessageID `json:"message_id"` // リアクション対象のメッセージID
+	UserID    entity.UserID    `json:"user_id"`    // リアクションを追加・取り消したユーザーのID
+	Emoji     string           `json:"emoji"`      // 絵文字
+	Count     int              `json:"count"`      // 変更後のその絵文字のリアクション数
+}
+
+// MessagePinnedDTO は message.pinned のペイロードです。
+type MessagePinnedDTO struct {
+	Message  MessageDTO    `json:"message"`   // ピン留めされたメッセージ
+	PinnedBy entity.UserID `json:"pinned_by"` // ピン留めしたユーザーのID
+	PinnedAt time.Time     `json:"pinned_at"` // ピン留めした日時
+}
+
+// MessageUnpinnedDTO は message.unpinned のペイロードです。
+type MessageUnpinnedDTO struct {
+	MessageID  entity.MessageID `json:"message_id"`  // ピン留めを外されたメッセージのID
+	UnpinnedBy entity.UserID    `json:"unpinned_by"` // ピン留めを外したユーザーのID
+}
+
+// RoomClosedDTO は room.closed のペイロードです。
+type RoomClosedDTO struct {
+	Reason string `json:"reason"` // 切断理由
+}
+
+// RoomModerationDTO は room.moderation のペイロードです。
+type RoomModerationDTO struct {
+	Action       entity.RoomModerationActionType `json:"action"`               // kick / ban / unban / mute / unmute
+	TargetUserID entity.UserID                   `json:"target_user_id"`       // 対象のユーザーのID
+	ActorUserID  entity.UserID                   `json:"actor_user_id"`        // 操作した管理者のID
+	Reason       string                          `json:"reason,omitempty"`     // 理由
+	ExpiresAt    *time.Time                      `json:"expires_at,omitempty"` // 発言禁止の期限（mute のみ）
+}
+
+// RoomRemovedDTO は room.removed のペイロードです。
+type RoomRemovedDTO struct {
+	UserID entity.UserID `json:"user_id"` // 部屋から退出させられたユーザーのID
+	Reason string        `json:"reason"`  // 切断理由
+}
+
+// JoinRequestDecidedDTO は join_request.decided のペイロードです。
+type JoinRequestDecidedDTO struct {
+	RoomID    entity.RoomID                `json:"room_id"`    // 参加をリクエストした部屋のID
+	Status    entity.RoomJoinRequestStatus `json:"status"`     // approved / denied
+	DecidedBy entity.UserID                `json:"decided_by"` // 承認・却下した管理者のID
+}
+
+// ReadUpdatedDTO は read.updated のペイロードです。
+type ReadUpdatedDTO struct {
+	UserID    entity.UserID    `json:"user_id"`    // 既読にしたメンバーのID
+	MessageID entity.MessageID `json:"message_id"` // 最後に読んだメッセージのID
+	ReadAt    time.Time        `json:"read_at"`
+}
+
+// TypingDTO は typing.updated のペイロードです。
+type TypingDTO struct {
+	UserID entity.UserID `json:"user_id"` // 入力を開始・終了したメンバーのID
+	Typing bool          `json:"typing"`  // 入力中であれば true
+}
+
+// PresenceUpdatedDTO は presence.updated のペイロードです。
+type PresenceUpdatedDTO struct {
+	UserID     entity.UserID         `json:"user_id"`                // オンライン状態が変わったメンバーのID
+	Status     entity.PresenceStatus `json:"status"`                 // online / away / offline
+	LastSeenAt *time.Time            `json:"last_seen_at,omitempty"` // 最後にオンラインだった日時
+}
+
+// MentionCreatedDTO は mention.created のペイロードです。
+type MentionCreatedDTO struct {
+	Message MessageDTO         `json:"message"` // メンションを含むメッセージ
+	Kind    entity.MentionKind `json:"kind"`    // user / here / room
+}
+
+// UserActivityDTO は user.activity のペイロードです（ノード間でのみ送受信する）。
+type UserActivityDTO struct {
+	NodeID       string        `json:"node_id"`        // 送信したノード
+	UserID       entity.UserID `json:"user_id"`        // コネクション数・最後に操作した日時が変わったユーザーのID
+	Connections  int           `json:"connections"`    // ノードでのコネクション数
+	LastActiveAt time.Time     `json:"last_active_at"` // ノードで最後に操作した日時
+}
+
+// NodeHeartbeatDTO は node.heartbeat のペイロードです（ノード間でのみ送受信する）。
+type NodeHeartbeatDTO struct {
+	NodeID string `json:"node_id"` // 送信したノード
+}
+
+// AckDTO は ack のペイロードです。
+type AckDTO struct {
+	MessageID entity.MessageID `json:"message_id,omitempty"`
+}
+
+// ErrorDTO は error のペイロードです。
+type ErrorDTO struct {
+	Code       entity.WebsocketErrorCode `json:"code"`
+	Message    string                    `json:"message"`
+	RetryAfter *time.Time                `json:"retry_after,omitempty"`
+}
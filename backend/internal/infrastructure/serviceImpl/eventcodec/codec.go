@@ -0,0 +1,215 @@
+// Package eventcodec は WebSocket イベントのペイロードと JSON を相互に変換します。
+// クライアントとの WebSocket 接続（gorillawebsocket）とノード間のバックプレーン（tcpbackplane）で同じ形式を使う
+package eventcodec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// ペイロードの型
+// イベントの type だけではペイロードの型が決まらない（message.created と message.updated など）ため、復元する側はこの値で型を判断する
+const (
+	KindMessage    = "message"
+	KindReaction   = "reaction"
+	KindPinned     = "message_pinned"
+	KindUnpinned   = "message_unpinned"
+	KindRoomClosed = "room_closed"
+	KindModeration = "room_moderation"
+	KindRemoved    = "room_removed"
+	KindDecided    = "join_request_decided"
+	KindRead       = "read_updated"
+	KindTyping     = "typing_updated"
+	KindPresence   = "presence_updated"
+	KindMention    = "mention_created"
+	KindActivity   = "user_activity"
+	KindHeartbeat  = "node_heartbeat"
+	KindAck        = "ack"
+	KindError      = "error"
+)
+
+// EncodePayload はイベントのペイロードを JSON に変換し、ペイロードの型とともに返します。
+// ペイロードがない場合は空文字と nil を返します。
+func EncodePayload(payload any) (string, json.RawMessage, error) {
+	var kind string
+	var dto any
+	switch p := payload.(type) {
+	case nil:
+		return "", nil, nil
+	case *entity.Message:
+		m := MessageDTO{}
+		m.FromEntity(p)
+		kind, dto = KindMessage, m
+	case entity.ReactionPayload:
+		kind, dto = KindReaction, ReactionDTO{MessageID: p.MessageID, UserID: p.UserID, Emoji: p.Emoji, Count: p.Count}
+	case entity.MessagePinnedPayload:
+		m := MessagePinnedDTO{PinnedBy: p.PinnedBy, PinnedAt: p.PinnedAt}
+		m.Message.FromEntity(p.Message)
+		kind, dto = KindPinned, m
+	case entity.MessageUnpinnedPayload:
+		kind, dto = KindUnpinned, MessageUnpinnedDTO{MessageID: p.MessageID, UnpinnedBy: p.UnpinnedBy}
+	case entity.RoomClosedPayload:
+		kind, dto = KindRoomClosed, RoomClosedDTO{Reason: p.Reason}
+	case entity.RoomModerationPayload:
+		kind, dto = KindModeration, RoomModerationDTO{
+			Action:       p.Action,
+			TargetUserID: p.TargetUserID,
+			ActorUserID:  p.ActorUserID,
+			Reason:       p.Reason,
+			ExpiresAt:    p.ExpiresAt,
+		}
+	case entity.RoomRemovedPayload:
+		kind, dto = KindRemoved, RoomRemovedDTO{UserID: p.UserID, Reason: p.Reason}
+	case entity.JoinRequestDecidedPayload:
+		kind, dto = KindDecided, JoinRequestDecidedDTO{RoomID: p.RoomID, Status: p.Status, DecidedBy: p.DecidedBy}
+	case entity.ReadUpdatedPayload:
+		kind, dto = KindRead, ReadUpdatedDTO{UserID: p.UserID, MessageID: p.MessageID, ReadAt: p.ReadAt}
+	case entity.TypingPayload:
+		kind, dto = KindTyping, TypingDTO{UserID: p.UserID, Typing: p.Typing}
+	case entity.PresenceUpdatedPayload:
+		kind, dto = KindPresence, PresenceUpdatedDTO{UserID: p.UserID, Status: p.Status, LastSeenAt: p.LastSeenAt}
+	case entity.MentionCreatedPayload:
+		m := MentionCreatedDTO{Kind: p.Kind}
+		m.Message.FromEntity(p.Message)
+		kind, dto = KindMention, m
+	case entity.UserActivityPayload:
+		kind, dto = KindActivity, UserActivityDTO{
+			NodeID:       p.NodeID,
+			UserID:       p.UserID,
+			Connections:  p.Activity.Connections,
+			LastActiveAt: p.Activity.LastActiveAt,
+		}
+	case entity.NodeHeartbeatPayload:
+		kind, dto = KindHeartbeat, NodeHeartbeatDTO{NodeID: p.NodeID}
+	case entity.AckPayload:
+		kind, dto = KindAck, AckDTO{MessageID: p.MessageID}
+	case entity.ErrorPayload:
+		kind, dto = KindError, ErrorDTO{Code: p.Code, Message: p.Message, RetryAfter: p.RetryAfter}
+	default:
+		return "", nil, fmt.Errorf("unsupported payload type %T", p)
+	}
+
+	b, err := json.Marshal(dto)
+	if err != nil {
+		return "", nil, err
+	}
+	return kind, b, nil
+}
+
+// DecodePayload は EncodePayload で変換した JSON をペイロードに戻します。
+func DecodePayload(kind string, raw json.RawMessage) (any, error) {
+	switch kind {
+	case "":
+		return nil, nil
+	case KindMessage:
+		var dto MessageDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return dto.ToEntity(), nil
+	case KindReaction:
+		var dto ReactionDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.ReactionPayload{MessageID: dto.MessageID, UserID: dto.UserID, Emoji: dto.Emoji, Count: dto.Count}, nil
+	case KindPinned:
+		var dto MessagePinnedDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.MessagePinnedPayload{Message: dto.Message.ToEntity(), PinnedBy: dto.PinnedBy, PinnedAt: dto.PinnedAt}, nil
+	case KindUnpinned:
+		var dto MessageUnpinnedDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.MessageUnpinnedPayload{MessageID: dto.MessageID, UnpinnedBy: dto.UnpinnedBy}, nil
+	case KindRoomClosed:
+		var dto RoomClosedDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.RoomClosedPayload{Reason: dto.Reason}, nil
+	case KindModeration:
+		var dto RoomModerationDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.RoomModerationPayload{
+			Action:       dto.Action,
+			TargetUserID: dto.TargetUserID,
+			ActorUserID:  dto.ActorUserID,
+			Reason:       dto.Reason,
+			ExpiresAt:    dto.ExpiresAt,
+		}, nil
+	case KindRemoved:
+		var dto RoomRemovedDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.RoomRemovedPayload{UserID: dto.UserID, Reason: dto.Reason}, nil
+	case KindDecided:
+		var dto JoinRequestDecidedDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.JoinRequestDecidedPayload{RoomID: dto.RoomID, Status: dto.Status, DecidedBy: dto.DecidedBy}, nil
+	case KindRead:
+		var dto ReadUpdatedDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.ReadUpdatedPayload{UserID: dto.UserID, MessageID: dto.MessageID, ReadAt: dto.ReadAt}, nil
+	case KindTyping:
+		var dto TypingDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.TypingPayload{UserID: dto.UserID, Typing: dto.Typing}, nil
+	case KindPresence:
+		var dto PresenceUpdatedDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.PresenceUpdatedPayload{UserID: dto.UserID, Status: dto.Status, LastSeenAt: dto.LastSeenAt}, nil
+	case KindMention:
+		var dto MentionCreatedDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.MentionCreatedPayload{Message: dto.Message.ToEntity(), Kind: dto.Kind}, nil
+	case KindActivity:
+		var dto UserActivityDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.UserActivityPayload{
+			NodeID:   dto.NodeID,
+			UserID:   dto.UserID,
+			Activity: entity.UserActivity{Connections: dto.Connections, LastActiveAt: dto.LastActiveAt},
+		}, nil
+	case KindHeartbeat:
+		var dto NodeHeartbeatDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.NodeHeartbeatPayload{NodeID: dto.NodeID}, nil
+	case KindAck:
+		var dto AckDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.AckPayload{MessageID: dto.MessageID}, nil
+	case KindError:
+		var dto ErrorDTO
+		if err := json.Unmarshal(raw, &dto); err != nil {
+			return nil, err
+		}
+		return entity.ErrorPayload{Code: dto.Code, Message: dto.Message, RetryAfter: dto.RetryAfter}, nil
+	default:
+		return nil, fmt.Errorf("unsupported payload kind %q", kind)
+	}
+}
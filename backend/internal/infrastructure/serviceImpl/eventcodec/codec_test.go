@@ -0,0 +1,67 @@
+package eventcodec_test
+
+import (
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/eventcodec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodePayload(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	msg := entity.NewMessage(entity.MessageParams{
+		ID:      "msg-1",
+		RoomID:  "room-1",
+		UserID:  "user-1",
+		Content: "hello",
+		SentAt:  now,
+	})
+
+	tests := []struct {
+		name    string
+		payload any
+		kind    string
+	}{
+		{"ペイロードなし", nil, ""},
+		{"メッセージ", msg, eventcodec.KindMessage},
+		{"リアクション", entity.ReactionPayload{MessageID: "msg-1", UserID: "user-1", Emoji: "👍", Count: 2}, eventcodec.KindReaction},
+		{"ピン留め", entity.MessagePinnedPayload{Message: msg, PinnedBy: "user-2", PinnedAt: now}, eventcodec.KindPinned},
+		{"ピン留め解除", entity.MessageUnpinnedPayload{MessageID: "msg-1", UnpinnedBy: "user-2"}, eventcodec.KindUnpinned},
+		{"部屋の削除", entity.RoomClosedPayload{Reason: "room deleted"}, eventcodec.KindRoomClosed},
+		{"モデレーション", entity.RoomModerationPayload{Action: entity.RoomModerationMute, TargetUserID: "user-1", ActorUserID: "user-2", Reason: "spam", ExpiresAt: &now}, eventcodec.KindModeration},
+		{"退出", entity.RoomRemovedPayload{UserID: "user-1", Reason: "kicked"}, eventcodec.KindRemoved},
+		{"参加リクエストの承認", entity.JoinRequestDecidedPayload{RoomID: "room-1", Status: entity.RoomJoinRequestApproved, DecidedBy: "user-2"}, eventcodec.KindDecided},
+		{"既読", entity.ReadUpdatedPayload{UserID: "user-1", MessageID: "msg-1", ReadAt: now}, eventcodec.KindRead},
+		{"入力中", entity.TypingPayload{UserID: "user-1", Typing: true}, eventcodec.KindTyping},
+		{"オンライン状態", entity.PresenceUpdatedPayload{UserID: "user-1", Status: entity.PresenceOffline, LastSeenAt: &now}, eventcodec.KindPresence},
+		{"メンション", entity.MentionCreatedPayload{Message: msg, Kind: entity.MentionKindUser}, eventcodec.KindMention},
+		{"ユーザーのアクティビティ", entity.UserActivityPayload{NodeID: "node-1", UserID: "user-1", Activity: entity.UserActivity{Connections: 2, LastActiveAt: now}}, eventcodec.KindActivity},
+		{"ノードのハートビート", entity.NodeHeartbeatPayload{NodeID: "node-1"}, eventcodec.KindHeartbeat},
+		{"ack", entity.AckPayload{MessageID: "msg-1"}, eventcodec.KindAck},
+		{"エラー", entity.ErrorPayload{Code: entity.WebsocketErrorCodeRateLimited, Message: "slow down", RetryAfter: &now}, eventcodec.KindError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, raw, err := eventcodec.EncodePayload(tt.payload)
+			require.NoError(t, err)
+			assert.Equal(t, tt.kind, kind)
+
+			got, err := eventcodec.DecodePayload(kind, raw)
+			require.NoError(t, err)
+			assert.Equal(t, tt.payload, got)
+		})
+	}
+}
+
+func TestEncodePayload_Unsupported(t *testing.T) {
+	_, _, err := eventcodec.EncodePayload(entity.MessageSendPayload{Content: "hello"})
+	assert.Error(t, err)
+}
+
+func TestDecodePayload_Unsupported(t *testing.T) {
+	_, err := eventcodec.DecodePayload("unknown", []byte(`{}`))
+	assert.Error(t, err)
+}
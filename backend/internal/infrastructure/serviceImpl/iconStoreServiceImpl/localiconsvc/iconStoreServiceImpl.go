@@ -48,19 +48,39 @@ func NewLocalIconStoreImpl(p *NewLocalIconStoreImplParams) service.IconStoreServ
 }
 
 func (l *localiconstoreimpl) SaveIcon(ctx context.Context, iconData *service.IconData, userID entity.UserID) error {
-	// サイズ検証
-	if iconData.Size > service.GetMaxIconSize() {
-		return errors.New("file size is too large")
-	}
-
-	// MIMEタイプ検証
-	if iconData.MimeType != "image/jpeg" && iconData.MimeType != "image/png" && iconData.MimeType != "image/webp" {
-		return errors.New("invalid mime type")
-	}
+	// ファイル名はユーザーのUUIDにする
+	fileName := string(userID) + ".webp"
+	return saveWebP(iconData, filepath.Join(l.dirPath, fileName))
+}
 
+func (l *localiconstoreimpl) GetIconPath(ctx context.Context, userID entity.UserID) (string, error) {
 	// ファイル名はユーザーのUUIDにする
 	fileName := string(userID) + ".webp"
-	path := filepath.Join(l.dirPath, fileName)
+	return existingPath(filepath.Join(l.dirPath, fileName))
+}
+
+func (l *localiconstoreimpl) SaveRoomIcon(ctx context.Context, iconData *service.IconData, roomID entity.RoomID) error {
+	// 部屋のアイコンはユーザーのアイコンと衝突しないように rooms/ 以下に保存する
+	dir := filepath.Join(l.dirPath, roomIconDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return saveWebP(iconData, filepath.Join(dir, string(roomID)+".webp"))
+}
+
+func (l *localiconstoreimpl) GetRoomIconPath(ctx context.Context, roomID entity.RoomID) (string, error) {
+	return existingPath(filepath.Join(l.dirPath, roomIconDir, string(roomID)+".webp"))
+}
+
+// roomIconDir は部屋のアイコンを保存するサブディレクトリ名
+const roomIconDir = "rooms"
+
+// saveWebP はアイコンを検証し、WebP に変換して path に書き込む
+func saveWebP(iconData *service.IconData, path string) error {
+	// サイズ・MIMEタイプ検証
+	if err := service.ValidateIconData(iconData); err != nil {
+		return err
+	}
 
 	// イメージをデコード（[]byte を io.Reader に変換）
 	img, _, err := image.Decode(bytes.NewReader(iconData.Icon))
@@ -82,15 +102,12 @@ func (l *localiconstoreimpl) SaveIcon(ctx context.Context, iconData *service.Ico
 	return nil
 }
 
-func (l *localiconstoreimpl) GetIconPath(ctx context.Context, userID entity.UserID) (string, error) {
-	// ファイル名はユーザーのUUIDにする
-	fileName := string(userID) + ".webp"
-	path := filepath.Join(l.dirPath, fileName)
+// existingPath はファイルが存在する場合にリダイレクト用の絶対パスを返す
+func existingPath(path string) (string, error) {
 	// ファイルが存在しない場合はエラーを返す
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return "", errors.New("file not found")
 	}
 	// リダイレクトを絶対パスにする
-	path = "/" + path
-	return path, nil
+	return "/" + path, nil
 }
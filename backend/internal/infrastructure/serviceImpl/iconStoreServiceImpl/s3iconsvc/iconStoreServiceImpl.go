@@ -73,17 +73,25 @@ func NewS3IconStoreImpl(params NewS3IconStoreImplParams) service.IconStoreServic
 // ↑PutObjectInputのフィールドを参照
 
 func (s *S3IconStoreImpl) SaveIcon(ctx context.Context, iconData *service.IconData, userID entity.UserID) error {
-	// サイズ検証
-	if iconData.Size > service.GetMaxIconSize() {
-		return errors.New("file size is too large")
-	}
+	// アイコンの保存先のオブジェクトキーを生成
+	return s.putWebP(ctx, iconData, s.prefix+"/"+string(userID)+".webp")
+}
+
+// SaveRoomIcon は部屋のアイコンを prefix/rooms/ 以下に保存する
+func (s *S3IconStoreImpl) SaveRoomIcon(ctx context.Context, iconData *service.IconData, roomID entity.RoomID) error {
+	return s.putWebP(ctx, iconData, s.roomObjectKey(roomID))
+}
+
+func (s *S3IconStoreImpl) roomObjectKey(roomID entity.RoomID) string {
+	return s.prefix + "/rooms/" + string(roomID) + ".webp"
+}
 
-	// MIMEタイプ検証
-	if iconData.MimeType != "image/jpeg" && iconData.MimeType != "image/png" && iconData.MimeType != "image/webp" {
-		return errors.New("invalid mime type")
+// putWebP はアイコンを検証し、WebP に変換して objectKey にアップロードする
+func (s *S3IconStoreImpl) putWebP(ctx context.Context, iconData *service.IconData, objectKey string) error {
+	// サイズ・MIMEタイプ検証
+	if err := service.ValidateIconData(iconData); err != nil {
+		return err
 	}
-	// アイコンの保存先のオブジェクトキーを生成
-	objectKey := s.prefix + "/" + string(userID) + ".webp"
 
 	//　アイコンをwebp形式に変換
 	// イメージをデコード
@@ -126,7 +134,15 @@ func (s *S3IconStoreImpl) SaveIcon(ctx context.Context, iconData *service.IconDa
 }
 
 func (s *S3IconStoreImpl) GetIconPath(ctx context.Context, userID entity.UserID) (string, error) {
-	objectKey := s.prefix + "/" + string(userID) + ".webp"
+	return s.objectURL(ctx, s.prefix+"/"+string(userID)+".webp")
+}
+
+func (s *S3IconStoreImpl) GetRoomIconPath(ctx context.Context, roomID entity.RoomID) (string, error) {
+	return s.objectURL(ctx, s.roomObjectKey(roomID))
+}
+
+// objectURL はオブジェクトが存在する場合にリダイレクト用のURLを返す
+func (s *S3IconStoreImpl) objectURL(ctx context.Context, objectKey string) (string, error) {
 	// リダイレクトできるように、URLを生成
 	fullURL := s.baseURL + "/" + objectKey
 
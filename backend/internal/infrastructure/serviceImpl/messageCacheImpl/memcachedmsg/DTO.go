@@ -11,8 +11,11 @@ type MessageDTO struct {
 	ID        string
 	RoomID    string
 	UserID    string
+	ParentID  string
 	Content   string
 	CreatedAt time.Time
+	EditedAt  *time.Time
+	DeletedAt *time.Time
 }
 
 func fromEntityMessage(m *entity.Message) *MessageDTO {
@@ -20,8 +23,11 @@ func fromEntityMessage(m *entity.Message) *MessageDTO {
 		ID:        string(m.GetID()),
 		RoomID:    string(m.GetRoomID()),
 		UserID:    string(m.GetUserID()),
+		ParentID:  string(m.GetParentID()),
 		Content:   m.GetContent(),
 		CreatedAt: m.GetSentAt(),
+		EditedAt:  m.GetEditedAt(),
+		DeletedAt: m.GetDeletedAt(),
 	}
 }
 
@@ -30,10 +36,13 @@ func toEntityMessage(d *MessageDTO) (*entity.Message, error) {
 	roomID := entity.RoomID(d.RoomID)
 	userID := entity.UserID(d.UserID)
 	return entity.NewMessage(entity.MessageParams{
-		ID:      id,
-		RoomID:  roomID,
-		UserID:  userID,
-		Content: d.Content,
-		SentAt:  d.CreatedAt,
+		ID:        id,
+		RoomID:    roomID,
+		UserID:    userID,
+		ParentID:  entity.MessageID(d.ParentID),
+		Content:   d.Content,
+		SentAt:    d.CreatedAt,
+		EditedAt:  d.EditedAt,
+		DeletedAt: d.DeletedAt,
 	}), nil
 }
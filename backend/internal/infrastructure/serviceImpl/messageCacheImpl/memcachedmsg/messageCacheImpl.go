@@ -135,3 +135,11 @@ func (m *messageCache) AddMessage(ctx context.Context, roomID entity.RoomID, mes
 		Expiration: 5 * 60,
 	})
 }
+
+func (m *messageCache) InvalidateRoom(ctx context.Context, roomID entity.RoomID) error {
+	err := m.client.Delete(string(roomID))
+	if err == memcache.ErrCacheMiss {
+		return nil
+	}
+	return err
+}
@@ -90,3 +90,11 @@ func (m *messageCache) AddMessage(ctx context.Context, roomID entity.RoomID, mes
 
 	return nil
 }
+
+func (m *messageCache) InvalidateRoom(ctx context.Context, roomID entity.RoomID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.cache, roomID)
+	return nil
+}
@@ -44,3 +44,40 @@ func TestMessageCache(t *testing.T) {
 	assert.Equal(t, "message 1", messages[0].GetContent())
 	assert.Equal(t, "message 20", messages[len(messages)-1].GetContent())
 }
+
+func TestMessageCache_InvalidateRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMsgRepo := mock_repository.NewMockMessageRepository(ctrl)
+	cache := memmsgcache.NewMessageCacheService(&memmsgcache.NewMessageCacheServiceParams{
+		MsgRepo: mockMsgRepo,
+	})
+	roomID := entity.RoomID("test_room")
+
+	msg := entity.NewMessage(entity.MessageParams{
+		ID:      "msg1",
+		RoomID:  roomID,
+		Content: "before edit",
+		SentAt:  time.Now(),
+	})
+	assert.NoError(t, cache.AddMessage(context.Background(), roomID, msg))
+
+	// 破棄した後はリポジトリから取得し直す
+	editedAt := time.Now()
+	edited := entity.NewMessage(entity.MessageParams{
+		ID:       "msg1",
+		RoomID:   roomID,
+		Content:  "after edit",
+		SentAt:   msg.GetSentAt(),
+		EditedAt: &editedAt,
+	})
+	mockMsgRepo.EXPECT().
+		GetMessageHistoryInRoom(gomock.Any(), roomID, service.DefaultRecentMessageLimit(), gomock.Any()).
+		Return([]*entity.Message{edited}, time.Time{}, false, nil)
+
+	assert.NoError(t, cache.InvalidateRoom(context.Background(), roomID))
+	messages, err := cache.GetRecentMessages(context.Background(), roomID)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "after edit", messages[0].GetContent())
+	assert.Equal(t, &editedAt, messages[0].GetEditedAt())
+}
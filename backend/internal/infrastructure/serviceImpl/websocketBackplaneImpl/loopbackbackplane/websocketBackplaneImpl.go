@@ -0,0 +1,116 @@
+// プロセス内で完結するバックプレーンの実装
+// 同じ LoopbackBroker に参加したバックプレーン同士を別ノードとみなして配信する
+// テストや単一プロセスでの動作確認に利用する
+package loopbackbackplane
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+)
+
+// LoopbackBroker はプロセス内のバックプレーン同士を繋ぐブローカー
+type LoopbackBroker struct {
+	mu    sync.RWMutex
+	nodes map[*LoopbackBackplane]struct{}
+}
+
+func NewLoopbackBroker() *LoopbackBroker {
+	return &LoopbackBroker{
+		nodes: make(map[*LoopbackBackplane]struct{}),
+	}
+}
+
+func (b *LoopbackBroker) join(node *LoopbackBackplane) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.nodes[node] = struct{}{}
+}
+
+func (b *LoopbackBroker) leave(node *LoopbackBackplane) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.nodes, node)
+}
+
+func (b *LoopbackBroker) publish(roomID entity.RoomID, event *entity.WebsocketEvent) {
+	b.mu.RLock()
+	nodes := make([]*LoopbackBackplane, 0, len(b.nodes))
+	for node := range b.nodes {
+		nodes = append(nodes, node)
+	}
+	b.mu.RUnlock()
+
+	for _, node := range nodes {
+		node.deliver(roomID, event)
+	}
+}
+
+type LoopbackBackplane struct {
+	broker   *LoopbackBroker
+	mu       sync.RWMutex
+	handlers []service.WebsocketBackplaneHandler
+	closed   bool
+}
+
+type NewLoopbackBackplaneParams struct {
+	Broker *LoopbackBroker
+}
+
+func (p *NewLoopbackBackplaneParams) Validate() error {
+	if p.Broker == nil {
+		return errors.New("broker is required")
+	}
+	return nil
+}
+
+func NewLoopbackBackplane(p *NewLoopbackBackplaneParams) service.WebsocketBackplane {
+	if err := p.Validate(); err != nil {
+		panic(err)
+	}
+	bp := &LoopbackBackplane{
+		broker: p.Broker,
+	}
+	p.Broker.join(bp)
+	return bp
+}
+
+func (bp *LoopbackBackplane) Publish(ctx context.Context, roomID entity.RoomID, event *entity.WebsocketEvent) error {
+	bp.mu.RLock()
+	closed := bp.closed
+	bp.mu.RUnlock()
+	if closed {
+		return errors.New("backplane is closed")
+	}
+
+	bp.broker.publish(roomID, event)
+	return nil
+}
+
+func (bp *LoopbackBackplane) Subscribe(handler service.WebsocketBackplaneHandler) {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	bp.handlers = append(bp.handlers, handler)
+}
+
+func (bp *LoopbackBackplane) Close() error {
+	bp.mu.Lock()
+	bp.closed = true
+	bp.mu.Unlock()
+
+	bp.broker.leave(bp)
+	return nil
+}
+
+func (bp *LoopbackBackplane) deliver(roomID entity.RoomID, event *entity.WebsocketEvent) {
+	bp.mu.RLock()
+	handlers := append([]service.WebsocketBackplaneHandler(nil), bp.handlers...)
+	bp.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(roomID, event)
+	}
+}
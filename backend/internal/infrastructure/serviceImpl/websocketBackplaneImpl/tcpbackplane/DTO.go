@@ -0,0 +1,57 @@
+package tcpbackplane
+
+import (
+	"encoding/json"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/eventcodec"
+)
+
+// FrameDTO はノード間でやり取りする1行分のJSONです。
+// 例）{"room_id":"...","type":"message.created","kind":"message","payload":{...}}
+type FrameDTO struct {
+	RoomID  entity.RoomID             `json:"room_id"`
+	Type    entity.WebsocketEventType `json:"type"`
+	ID      string                    `json:"id,omitempty"`
+	Kind    string                    `json:"kind,omitempty"` // ペイロードの型（type ではなく型で復元する）
+	Payload json.RawMessage           `json:"payload,omitempty"`
+}
+
+// encodeFrame は部屋へのイベントを1行分のJSONに変換します。
+func encodeFrame(roomID entity.RoomID, event *entity.WebsocketEvent) ([]byte, error) {
+	kind, payload, err := eventcodec.EncodePayload(event.GetPayload())
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(FrameDTO{
+		RoomID:  roomID,
+		Type:    event.GetType(),
+		ID:      event.GetID(),
+		Kind:    kind,
+		Payload: payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// decodeFrame は1行分のJSONを部屋IDとイベントに変換します。
+func decodeFrame(line []byte) (entity.RoomID, *entity.WebsocketEvent, error) {
+	var frame FrameDTO
+	if err := json.Unmarshal(line, &frame); err != nil {
+		return "", nil, err
+	}
+
+	payload, err := eventcodec.DecodePayload(frame.Kind, frame.Payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return frame.RoomID, entity.NewWebsocketEvent(entity.WebsocketEventParams{
+		Type:    frame.Type,
+		ID:      frame.ID,
+		Payload: payload,
+	}), nil
+}
@@ -0,0 +1,165 @@
+package tcpbackplane
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// maxFrameSize は1フレームの最大サイズ
+const maxFrameSize = 1 << 20
+
+// writeTimeout はノードへの書き込みのタイムアウト
+const writeTimeout = 5 * time.Second
+
+// TCPBroker はノードから受け取ったフレームを他のすべてのノードへ中継する
+// いずれか1つのプロセスで起動し、各ノードの TCPBackplane から接続する
+type TCPBroker struct {
+	addr     string
+	listener net.Listener
+	mu       sync.Mutex
+	peers    map[*brokerPeer]struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+type brokerPeer struct {
+	conn    net.Conn
+	writeMu sync.Mutex
+}
+
+type NewTCPBrokerParams struct {
+	Addr string // 待ち受けアドレス（例: ":7070"）
+}
+
+func (p *NewTCPBrokerParams) Validate() error {
+	if p.Addr == "" {
+		return errors.New("addr is required")
+	}
+	return nil
+}
+
+func NewTCPBroker(p *NewTCPBrokerParams) *TCPBroker {
+	if err := p.Validate(); err != nil {
+		panic(err)
+	}
+	return &TCPBroker{
+		addr:  p.Addr,
+		peers: make(map[*brokerPeer]struct{}),
+	}
+}
+
+// Start は待ち受けを開始する。接続の受け付けはバックグラウンドで行う
+func (b *TCPBroker) Start() error {
+	listener, err := net.Listen("tcp", b.addr)
+	if err != nil {
+		return err
+	}
+	b.listener = listener
+
+	b.wg.Add(1)
+	go b.acceptLoop()
+	return nil
+}
+
+// Addr は実際に待ち受けているアドレスを返す
+func (b *TCPBroker) Addr() string {
+	if b.listener == nil {
+		return b.addr
+	}
+	return b.listener.Addr().String()
+}
+
+// NumPeers は接続中のノード数を返す
+func (b *TCPBroker) NumPeers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.peers)
+}
+
+// Close は待ち受けを停止し、すべてのノードとの接続を切断する
+func (b *TCPBroker) Close() error {
+	b.mu.Lock()
+	b.closed = true
+	for peer := range b.peers {
+		peer.conn.Close()
+	}
+	b.mu.Unlock()
+
+	var err error
+	if b.listener != nil {
+		err = b.listener.Close()
+	}
+	b.wg.Wait()
+	return err
+}
+
+func (b *TCPBroker) acceptLoop() {
+	defer b.wg.Done()
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		peer := &brokerPeer{conn: conn}
+		b.mu.Lock()
+		if b.closed {
+			b.mu.Unlock()
+			conn.Close()
+			return
+		}
+		b.peers[peer] = struct{}{}
+		b.mu.Unlock()
+
+		b.wg.Add(1)
+		go b.readLoop(peer)
+	}
+}
+
+func (b *TCPBroker) readLoop(peer *brokerPeer) {
+	defer b.wg.Done()
+	defer b.removePeer(peer)
+
+	scanner := bufio.NewScanner(peer.conn)
+	scanner.Buffer(make([]byte, 64*1024), maxFrameSize)
+	for scanner.Scan() {
+		// Scanner のバッファは次の Scan で上書きされるためコピーしてから中継する
+		frame := make([]byte, len(scanner.Bytes())+1)
+		copy(frame, scanner.Bytes())
+		frame[len(frame)-1] = '\n'
+		b.relay(peer, frame)
+	}
+}
+
+// relay は送信元以外のノードへフレームを書き込む
+func (b *TCPBroker) relay(from *brokerPeer, frame []byte) {
+	b.mu.Lock()
+	peers := make([]*brokerPeer, 0, len(b.peers))
+	for peer := range b.peers {
+		if peer != from {
+			peers = append(peers, peer)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, peer := range peers {
+		peer.writeMu.Lock()
+		peer.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		_, err := peer.conn.Write(frame)
+		peer.writeMu.Unlock()
+		if err != nil {
+			// 書き込めないノードは切断する（readLoop 側で取り除かれる）
+			peer.conn.Close()
+		}
+	}
+}
+
+func (b *TCPBroker) removePeer(peer *brokerPeer) {
+	b.mu.Lock()
+	delete(b.peers, peer)
+	b.mu.Unlock()
+	peer.conn.Close()
+}
@@ -0,0 +1,180 @@
+// TCP 上のブローカーを介して複数ノード間でイベントを配信するバックプレーンの実装
+// ノードはブローカーに接続し、改行区切りのJSON（FrameDTO）を送受信する
+// ブローカーとの接続が切れた場合は RetryInterval ごとに再接続する
+package tcpbackplane
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+)
+
+type TCPBackplane struct {
+	addr          string
+	retryInterval time.Duration
+
+	mu       sync.RWMutex
+	handlers []service.WebsocketBackplaneHandler
+
+	connMu sync.Mutex
+	conn   net.Conn
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+type NewTCPBackplaneParams struct {
+	Addr          string        // 接続先ブローカーのアドレス
+	RetryInterval time.Duration // 再接続の間隔（省略時は1秒）
+}
+
+func (p *NewTCPBackplaneParams) Validate() error {
+	if p.Addr == "" {
+		return errors.New("addr is required")
+	}
+	if p.RetryInterval < 0 {
+		return errors.New("retry interval must not be negative")
+	}
+	return nil
+}
+
+func NewTCPBackplane(p *NewTCPBackplaneParams) service.WebsocketBackplane {
+	if err := p.Validate(); err != nil {
+		panic(err)
+	}
+	retryInterval := p.RetryInterval
+	if retryInterval == 0 {
+		retryInterval = time.Second
+	}
+
+	bp := &TCPBackplane{
+		addr:          p.Addr,
+		retryInterval: retryInterval,
+		done:          make(chan struct{}),
+	}
+	bp.wg.Add(1)
+	go bp.run()
+	return bp
+}
+
+// Publish は自ノードのハンドラに配信した後、ブローカーへフレームを送信する
+// ブローカーに接続できていない場合、自ノードへの配信は行われるがエラーを返す
+func (bp *TCPBackplane) Publish(ctx context.Context, roomID entity.RoomID, event *entity.WebsocketEvent) error {
+	frame, err := encodeFrame(roomID, event)
+	if err != nil {
+		return err
+	}
+
+	bp.deliver(roomID, event)
+
+	bp.connMu.Lock()
+	defer bp.connMu.Unlock()
+	if bp.conn == nil {
+		return errors.New("backplane is not connected to broker")
+	}
+
+	deadline := time.Now().Add(writeTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	bp.conn.SetWriteDeadline(deadline)
+	if _, err := bp.conn.Write(frame); err != nil {
+		// 読み取り側で切断を検知して再接続する
+		bp.conn.Close()
+		return err
+	}
+	return nil
+}
+
+func (bp *TCPBackplane) Subscribe(handler service.WebsocketBackplaneHandler) {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	bp.handlers = append(bp.handlers, handler)
+}
+
+func (bp *TCPBackplane) Close() error {
+	bp.closeOnce.Do(func() {
+		close(bp.done)
+		bp.connMu.Lock()
+		if bp.conn != nil {
+			bp.conn.Close()
+		}
+		bp.connMu.Unlock()
+	})
+	bp.wg.Wait()
+	return nil
+}
+
+// Connected はブローカーに接続済みかどうかを返す
+func (bp *TCPBackplane) Connected() bool {
+	bp.connMu.Lock()
+	defer bp.connMu.Unlock()
+	return bp.conn != nil
+}
+
+// run はブローカーへの接続と受信を繰り返す
+func (bp *TCPBackplane) run() {
+	defer bp.wg.Done()
+	for {
+		conn, err := net.DialTimeout("tcp", bp.addr, writeTimeout)
+		if err == nil {
+			if !bp.setConn(conn) {
+				conn.Close()
+				return
+			}
+			bp.readLoop(conn)
+			bp.setConn(nil)
+		}
+
+		select {
+		case <-bp.done:
+			return
+		case <-time.After(bp.retryInterval):
+		}
+	}
+}
+
+// setConn は現在の接続を差し替える。Close 済みの場合は false を返す
+func (bp *TCPBackplane) setConn(conn net.Conn) bool {
+	bp.connMu.Lock()
+	defer bp.connMu.Unlock()
+	select {
+	case <-bp.done:
+		return false
+	default:
+	}
+	bp.conn = conn
+	return true
+}
+
+func (bp *TCPBackplane) readLoop(conn net.Conn) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 64*1024), maxFrameSize)
+	for scanner.Scan() {
+		roomID, event, err := decodeFrame(scanner.Bytes())
+		if err != nil {
+			// 解釈できないフレームは読み飛ばす
+			continue
+		}
+		bp.deliver(roomID, event)
+	}
+}
+
+func (bp *TCPBackplane) deliver(roomID entity.RoomID, event *entity.WebsocketEvent) {
+	bp.mu.RLock()
+	handlers := append([]service.WebsocketBackplaneHandler(nil), bp.handlers...)
+	bp.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(roomID, event)
+	}
+}
@@ -0,0 +1,120 @@
+package tcpbackplane_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketBackplaneImpl/tcpbackplane"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type received struct {
+	roomID entity.RoomID
+	event  *entity.WebsocketEvent
+}
+
+func newNode(t *testing.T, addr string) (*tcpbackplane.TCPBackplane, chan received) {
+	t.Helper()
+	bp := tcpbackplane.NewTCPBackplane(&tcpbackplane.NewTCPBackplaneParams{
+		Addr:          addr,
+		RetryInterval: 10 * time.Millisecond,
+	}).(*tcpbackplane.TCPBackplane)
+	t.Cleanup(func() { bp.Close() })
+
+	ch := make(chan received, 10)
+	bp.Subscribe(func(roomID entity.RoomID, event *entity.WebsocketEvent) {
+		ch <- received{roomID: roomID, event: event}
+	})
+	require.Eventually(t, bp.Connected, time.Second, 10*time.Millisecond)
+	return bp, ch
+}
+
+func TestTCPBackplane(t *testing.T) {
+	broker := tcpbackplane.NewTCPBroker(&tcpbackplane.NewTCPBrokerParams{Addr: "127.0.0.1:0"})
+	require.NoError(t, broker.Start())
+	defer broker.Close()
+
+	nodeA, chA := newNode(t, broker.Addr())
+	_, chB := newNode(t, broker.Addr())
+	require.Eventually(t, func() bool { return broker.NumPeers() == 2 }, time.Second, 10*time.Millisecond)
+
+	sentAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := entity.NewMessageCreatedEvent(entity.NewMessage(entity.MessageParams{
+		ID:      "msg-1",
+		RoomID:  "room-1",
+		UserID:  "user-1",
+		Content: "hello",
+		SentAt:  sentAt,
+		Attachments: []*entity.Attachment{entity.NewAttachment(entity.AttachmentParams{
+			ID:           "att-1",
+			RoomID:       "room-1",
+			UploaderID:   "user-1",
+			MessageID:    "msg-1",
+			FileName:     "photo.png",
+			MimeType:     "image/png",
+			Size:         2048,
+			Width:        640,
+			Height:       480,
+			HasThumbnail: true,
+			CreatedAt:    sentAt,
+		})},
+	}))
+
+	err := nodeA.Publish(context.Background(), "room-1", event)
+	require.NoError(t, err)
+
+	// 自ノードには同期的に配信される
+	select {
+	case got := <-chA:
+		assert.Equal(t, event, got.event)
+	default:
+		t.Fatal("local handler was not called")
+	}
+
+	// 他ノードにはブローカー経由で配信される
+	select {
+	case got := <-chB:
+		assert.Equal(t, entity.RoomID("room-1"), got.roomID)
+		assert.Equal(t, entity.WebsocketEventTypeMessageCreated, got.event.GetType())
+		msg, ok := got.event.GetPayload().(*entity.Message)
+		require.True(t, ok)
+		assert.Equal(t, entity.MessageID("msg-1"), msg.GetID())
+		assert.Equal(t, "hello", msg.GetContent())
+		assert.True(t, sentAt.Equal(msg.GetSentAt()))
+		// 添付ファイルも他ノードの message.created に含める
+		require.Len(t, msg.GetAttachments(), 1)
+		attachment := msg.GetAttachments()[0]
+		assert.Equal(t, entity.AttachmentID("att-1"), attachment.GetID())
+		assert.Equal(t, entity.MessageID("msg-1"), attachment.GetMessageID())
+		assert.Equal(t, "/api/room/room-1/attachments/att-1/thumbnail", attachment.GetThumbnailPath())
+		assert.Equal(t, 640, attachment.GetWidth())
+	case <-time.After(time.Second):
+		t.Fatal("remote handler was not called")
+	}
+
+	// ブローカーは送信元に折り返さない
+	select {
+	case <-chA:
+		t.Fatal("event was echoed back to the publisher")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestTCPBackplane_BrokerUnavailable(t *testing.T) {
+	// 待ち受けていないアドレスに接続しようとするノード
+	bp := tcpbackplane.NewTCPBackplane(&tcpbackplane.NewTCPBackplaneParams{
+		Addr:          "127.0.0.1:1",
+		RetryInterval: 10 * time.Millisecond,
+	})
+	defer bp.Close()
+
+	called := false
+	bp.Subscribe(func(entity.RoomID, *entity.WebsocketEvent) { called = true })
+
+	err := bp.Publish(context.Background(), "room-1", entity.NewErrorEvent("", entity.WebsocketErrorCodeInternal, "x"))
+	assert.Error(t, err)
+	assert.True(t, called, "local handler should be called even when the broker is unavailable")
+}
@@ -0,0 +1,103 @@
+package gorillawebsocket
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/eventcodec"
+)
+
+// EventDTO は WebSocket 上で送受信するイベントのエンベロープです。
+// 例）{"v":1,"type":"message.send","id":"c-1","payload":{"content":"hello"}}
+type EventDTO struct {
+	Version int             `json:"v"`
+	Type    string          `json:"type"`
+	ID      string          `json:"id,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// MessageSendDTO は message.send のペイロードです。
+type MessageSendDTO struct {
+	Content  string           `json:"content"`
+	ParentID entity.MessageID `json:"parent_id,omitempty"` // スレッドに返信する場合に指定する
+
+	AttachmentIDs []entity.AttachmentID `json:"attachment_ids,omitempty"` // アップロード済みの添付ファイルを添付する場合に指定する
+}
+
+// MessageReadDTO は message.read のペイロードです。
+type MessageReadDTO struct {
+	MessageID entity.MessageID `json:"message_id"` // 最後に読んだメッセージのID
+}
+
+// decodeEvent は受信したJSONをイベントに変換します。
+// 形式不正・未対応のイベントは service.ErrInvalidEvent / service.ErrUnsupportedEvent をラップして返します。
+func decodeEvent(data []byte) (*entity.WebsocketEvent, error) {
+	var dto EventDTO
+	if err := json.Unmarshal(data, &dto); err != nil {
+		return nil, fmt.Errorf("%w: %s", service.ErrInvalidEvent, err.Error())
+	}
+	// バージョン省略時は現行バージョンとして扱う
+	if dto.Version != 0 && dto.Version != entity.WebsocketProtocolVersion {
+		return nil, fmt.Errorf("%w: version %d", service.ErrUnsupportedEvent, dto.Version)
+	}
+
+	var payload any
+	switch entity.WebsocketEventType(dto.Type) {
+	case entity.WebsocketEventTypeMessageSend:
+		var p MessageSendDTO
+		if err := unmarshalPayload(dto.Payload, &p); err != nil {
+			return nil, err
+		}
+		payload = entity.MessageSendPayload{Content: p.Content, ParentID: p.ParentID, AttachmentIDs: p.AttachmentIDs}
+	case entity.WebsocketEventTypeMessageRead:
+		var p MessageReadDTO
+		if err := unmarshalPayload(dto.Payload, &p); err != nil {
+			return nil, err
+		}
+		if p.MessageID == "" {
+			return nil, fmt.Errorf("%w: message_id is required", service.ErrInvalidEvent)
+		}
+		payload = entity.MessageReadPayload{MessageID: p.MessageID}
+	case entity.WebsocketEventTypeTypingStart, entity.WebsocketEventTypeTypingStop, entity.WebsocketEventTypePresenceHeartbeat:
+		// ペイロードは持たない（送られてきた場合も無視する）
+		payload = nil
+	case "":
+		return nil, fmt.Errorf("%w: type is required", service.ErrInvalidEvent)
+	default:
+		return nil, fmt.Errorf("%w: type %q", service.ErrUnsupportedEvent, dto.Type)
+	}
+
+	return entity.NewWebsocketEvent(entity.WebsocketEventParams{
+		Type:    entity.WebsocketEventType(dto.Type),
+		ID:      dto.ID,
+		Payload: payload,
+	}), nil
+}
+
+func unmarshalPayload(raw json.RawMessage, v any) error {
+	if len(raw) == 0 {
+		return fmt.Errorf("%w: payload is required", service.ErrInvalidEvent)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("%w: %s", service.ErrInvalidEvent, err.Error())
+	}
+	return nil
+}
+
+// encodeEvent は送信するイベントをエンベロープに変換します。
+func encodeEvent(event *entity.WebsocketEvent) (*EventDTO, error) {
+	// クライアントはイベントの type でペイロードの型を判断するため、ペイロードの型は送らない
+	_, payload, err := eventcodec.EncodePayload(event.GetPayload())
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventDTO{
+		Version: entity.WebsocketProtocolVersion,
+		Type:    string(event.GetType()),
+		ID:      event.GetID(),
+		Payload: payload,
+	}, nil
+}
@@ -2,25 +2,51 @@ package gorillawebsocket
 
 import (
 	"errors"
+	"sync"
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/domain/service"
 	"example.com/infrahandson/internal/interface/adapter"
+	"github.com/gorilla/websocket"
 )
 
+// DefaultWriteTimeout は WriteTimeout を省略した場合の書き込みタイムアウト
+const DefaultWriteTimeout = 10 * time.Second
+
 type GorillaWebSocketConnection struct {
-	conn adapter.ConnAdapter
+	conn         adapter.ConnAdapter
+	writeTimeout time.Duration // 1回の書き込みの期限
+	pingInterval time.Duration // ping を送る間隔（0の場合は送らない）
+	pongWait     time.Duration // pong（または任意のメッセージ）を待つ時間（0の場合は待ち続ける）
+	// gorilla/websocket は同時に1つの書き込みしか許さないため、書き込みを直列化する
+	writeMu sync.Mutex
+
+	done      chan struct{} // Close で閉じられ、ping 用のゴルーチンを終了させる
+	closeOnce sync.Once
+	closeErr  error
 }
 
 type NewGorillaWebSocketConnectionParams struct {
-	Conn adapter.ConnAdapter
+	Conn         adapter.ConnAdapter
+	WriteTimeout time.Duration // 省略時は DefaultWriteTimeout
+	PingInterval time.Duration // 省略時は ping を送らない
+	PongWait     time.Duration // 省略時は読み取りの期限を設けない
 }
 
 func (p *NewGorillaWebSocketConnectionParams) Validate() error {
 	if p.Conn == nil {
 		return errors.New("conn is required")
 	}
+	if p.WriteTimeout < 0 {
+		return errors.New("write timeout must not be negative")
+	}
+	if p.PingInterval < 0 || p.PongWait < 0 {
+		return errors.New("heartbeat intervals must not be negative")
+	}
+	if p.PingInterval > 0 && p.PongWait > 0 && p.PingInterval >= p.PongWait {
+		return errors.New("ping interval must be shorter than pong wait")
+	}
 	return nil
 }
 
@@ -30,62 +56,94 @@ func NewGorillaWebSocketConnection(
 	if err := p.Validate(); err != nil {
 		panic(err)
 	}
-	return &GorillaWebSocketConnection{
-		conn: p.Conn,
+	writeTimeout := p.WriteTimeout
+	if writeTimeout == 0 {
+		writeTimeout = DefaultWriteTimeout
+	}
+	c := &GorillaWebSocketConnection{
+		conn:         p.Conn,
+		writeTimeout: writeTimeout,
+		pingInterval: p.PingInterval,
+		pongWait:     p.PongWait,
+		done:         make(chan struct{}),
 	}
-}
-
-type MessageDTO struct {
-	ID       entity.MessageID       // メッセージID
-	RoomID   entity.RoomID          // 所属するチャットルームのID
-	UserID   entity.UserID          // 投稿者のID（匿名なら名前など）
-	Content  string                 // 本文
-	SentAt   time.Time              // 送信日時
-}
-
-func (m *MessageDTO) ToEntity() *entity.Message {
-	return entity.NewMessage(entity.MessageParams{
-		ID:       m.ID,
-		RoomID:   m.RoomID,
-		UserID:   m.UserID,
-		Content:  m.Content,
-		SentAt:   m.SentAt,
-	})
-}
 
-func (m *MessageDTO) FromEntity(msg *entity.Message) {
-	m.ID = msg.GetID()
-	m.ID = msg.GetID()
-	m.RoomID = msg.GetRoomID()
-	m.UserID = msg.GetUserID()
-	m.Content = msg.GetContent()
-	m.SentAt = msg.GetSentAt()
+	// pong が返ってこない相手は読み取りの期限切れで切断される
+	if c.pongWait > 0 {
+		c.extendReadDeadline()
+		c.conn.SetPongHandler(func(string) error {
+			c.extendReadDeadline()
+			return nil
+		})
+	}
+	if c.pingInterval > 0 {
+		go c.pingLoop()
+	}
+	return c
 }
 
-func (c *GorillaWebSocketConnection) ReadMessage() (*entity.Message, error) {
-	var msgDTO MessageDTO
-	err := c.conn.ReadJSON(&msgDTO)
+func (c *GorillaWebSocketConnection) ReadEvent() (*entity.WebsocketEvent, error) {
+	_, data, err := c.conn.ReadMessageFunc()
 	if err != nil {
 		return nil, err
 	}
+	if c.pongWait > 0 {
+		c.extendReadDeadline()
+	}
 
-	return msgDTO.ToEntity(), nil
+	return decodeEvent(data)
 }
 
-func (c *GorillaWebSocketConnection) WriteMessage(msg *entity.Message) error {
-	msgDTO := MessageDTO{}
-	msgDTO.FromEntity(msg)
-	err := c.conn.WriteJSON(msgDTO)
+func (c *GorillaWebSocketConnection) WriteEvent(event *entity.WebsocketEvent) error {
+	dto, err := encodeEvent(event)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	// 応答しないクライアントへの書き込みでブロックし続けないよう期限を設ける
+	if err := c.conn.SetWriteDeadline(time.Now().Add(c.writeTimeout)); err != nil {
+		return err
+	}
+	return c.conn.WriteJSON(dto)
+}
+
+func (c *GorillaWebSocketConnection) CloseWithReason(code service.WebsocketCloseCode, reason string) error {
+	// 相手に届かなくても接続は閉じる
+	_ = c.conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(int(code), reason),
+		time.Now().Add(c.writeTimeout),
+	)
+	return c.Close()
 }
 
 func (c *GorillaWebSocketConnection) Close() error {
-	err := c.conn.CloseFunc()
-	if err != nil {
-		return err
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.closeErr = c.conn.CloseFunc()
+	})
+	return c.closeErr
+}
+
+func (c *GorillaWebSocketConnection) extendReadDeadline() {
+	_ = c.conn.SetReadDeadline(time.Now().Add(c.pongWait))
+}
+
+// pingLoop は一定間隔で ping を送る
+// 送信に失敗した場合は読み取り側でも切断が検知されるため、そのまま終了する
+func (c *GorillaWebSocketConnection) pingLoop() {
+	ticker := time.NewTicker(c.pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(c.writeTimeout)); err != nil {
+				return
+			}
+		}
 	}
-	return nil
 }
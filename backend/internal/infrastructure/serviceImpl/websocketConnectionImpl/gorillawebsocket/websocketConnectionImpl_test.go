@@ -0,0 +1,434 @@
+package gorillawebsocket_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketConnectionImpl/gorillawebsocket"
+	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReadEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConn := mock_adapter.NewMockConnAdapter(ctrl)
+	conn := gorillawebsocket.NewGorillaWebSocketConnection(&gorillawebsocket.NewGorillaWebSocketConnectionParams{
+		Conn: mockConn,
+	})
+
+	t.Run("message.send", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(websocket.TextMessage, []byte(`{"v":1,"type":"message.send","id":"c-1","payload":{"content":"hello"}}`), nil)
+
+		event, err := conn.ReadEvent()
+		require.NoError(t, err)
+		assert.Equal(t, entity.WebsocketEventTypeMessageSend, event.GetType())
+		assert.Equal(t, "c-1", event.GetID())
+		assert.Equal(t, entity.MessageSendPayload{Content: "hello"}, event.GetPayload())
+	})
+
+	t.Run("message.send（添付ファイルあり）", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(websocket.TextMessage, []byte(`{"v":1,"type":"message.send","id":"c-1","payload":{"content":"","attachment_ids":["att-1","att-2"]}}`), nil)
+
+		event, err := conn.ReadEvent()
+		require.NoError(t, err)
+		assert.Equal(t, entity.MessageSendPayload{AttachmentIDs: []entity.AttachmentID{"att-1", "att-2"}}, event.GetPayload())
+	})
+
+	t.Run("message.read", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(websocket.TextMessage, []byte(`{"v":1,"type":"message.read","id":"c-2","payload":{"message_id":"msg-1"}}`), nil)
+
+		event, err := conn.ReadEvent()
+		require.NoError(t, err)
+		assert.Equal(t, entity.WebsocketEventTypeMessageRead, event.GetType())
+		assert.Equal(t, entity.MessageReadPayload{MessageID: "msg-1"}, event.GetPayload())
+	})
+
+	t.Run("message.read（message_idなし）", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(websocket.TextMessage, []byte(`{"v":1,"type":"message.read","payload":{}}`), nil)
+
+		_, err := conn.ReadEvent()
+		assert.ErrorIs(t, err, service.ErrInvalidEvent)
+	})
+
+	t.Run("typing.start（payloadなし）", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(websocket.TextMessage, []byte(`{"v":1,"type":"typing.start"}`), nil)
+
+		event, err := conn.ReadEvent()
+		require.NoError(t, err)
+		assert.Equal(t, entity.WebsocketEventTypeTypingStart, event.GetType())
+		assert.Nil(t, event.GetPayload())
+	})
+
+	t.Run("壊れたJSON", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(websocket.TextMessage, []byte(`{"type":`), nil)
+
+		_, err := conn.ReadEvent()
+		assert.ErrorIs(t, err, service.ErrInvalidEvent)
+	})
+
+	t.Run("payloadなし", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(websocket.TextMessage, []byte(`{"v":1,"type":"message.send"}`), nil)
+
+		_, err := conn.ReadEvent()
+		assert.ErrorIs(t, err, service.ErrInvalidEvent)
+	})
+
+	t.Run("未対応のtype", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(websocket.TextMessage, []byte(`{"v":1,"type":"unknown","payload":{}}`), nil)
+
+		_, err := conn.ReadEvent()
+		assert.ErrorIs(t, err, service.ErrUnsupportedEvent)
+	})
+
+	t.Run("未対応のバージョン", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(websocket.TextMessage, []byte(`{"v":2,"type":"message.send","payload":{"content":"hello"}}`), nil)
+
+		_, err := conn.ReadEvent()
+		assert.ErrorIs(t, err, service.ErrUnsupportedEvent)
+	})
+
+	t.Run("読み取り失敗", func(t *testing.T) {
+		mockConn.EXPECT().ReadMessageFunc().Return(0, nil, assert.AnError)
+
+		_, err := conn.ReadEvent()
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.NotErrorIs(t, err, service.ErrInvalidEvent)
+	})
+}
+
+func TestWriteEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConn := mock_adapter.NewMockConnAdapter(ctrl)
+	conn := gorillawebsocket.NewGorillaWebSocketConnection(&gorillawebsocket.NewGorillaWebSocketConnectionParams{
+		Conn: mockConn,
+	})
+
+	// 書き込まれたエンベロープをJSONにして比較する
+	capture := func(dst *map[string]any) func(any) error {
+		return func(v any) error {
+			b, err := json.Marshal(v)
+			require.NoError(t, err)
+			return json.Unmarshal(b, dst)
+		}
+	}
+
+	t.Run("message.created", func(t *testing.T) {
+		sentAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		msg := entity.NewMessage(entity.MessageParams{
+			ID:      "msg-1",
+			RoomID:  "room-1",
+			UserID:  "user-1",
+			Content: "hello",
+			SentAt:  sentAt,
+		})
+
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		err := conn.WriteEvent(entity.NewMessageCreatedEvent(msg))
+		require.NoError(t, err)
+		assert.Equal(t, float64(entity.WebsocketProtocolVersion), got["v"])
+		assert.Equal(t, "message.created", got["type"])
+		assert.Equal(t, map[string]any{
+			"id":      "msg-1",
+			"room_id": "room-1",
+			"user_id": "user-1",
+			"content": "hello",
+			"sent_at": "2025-01-01T12:00:00Z",
+		}, got["payload"])
+	})
+
+	t.Run("message.created（添付ファイルあり）", func(t *testing.T) {
+		msg := entity.NewMessage(entity.MessageParams{
+			ID:      "msg-1",
+			RoomID:  "room-1",
+			UserID:  "user-1",
+			Content: "photos",
+			SentAt:  time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+			Attachments: []*entity.Attachment{
+				entity.NewAttachment(entity.AttachmentParams{
+					ID: "att-1", RoomID: "room-1", UploaderID: "user-1", MessageID: "msg-1",
+					FileName: "photo.png", MimeType: "image/png", Size: 2048, Width: 640, Height: 480, HasThumbnail: true,
+				}),
+				entity.NewAttachment(entity.AttachmentParams{
+					ID: "att-2", RoomID: "room-1", UploaderID: "user-1", MessageID: "msg-1",
+					FileName: "memo.txt", MimeType: "text/plain", Size: 5,
+				}),
+			},
+		})
+
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		err := conn.WriteEvent(entity.NewMessageCreatedEvent(msg))
+		require.NoError(t, err)
+		payload := got["payload"].(map[string]any)
+		assert.Equal(t, []any{
+			map[string]any{
+				"id":            "att-1",
+				"file_name":     "photo.png",
+				"mime_type":     "image/png",
+				"size":          float64(2048),
+				"width":         float64(640),
+				"height":        float64(480),
+				"download_url":  "/api/room/room-1/attachments/att-1",
+				"thumbnail_url": "/api/room/room-1/attachments/att-1/thumbnail",
+			},
+			map[string]any{
+				"id":           "att-2",
+				"file_name":    "memo.txt",
+				"mime_type":    "text/plain",
+				"size":         float64(5),
+				"download_url": "/api/room/room-1/attachments/att-2",
+			},
+		}, payload["attachments"])
+	})
+
+	t.Run("message.pinned", func(t *testing.T) {
+		sentAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		pinned := entity.NewPinnedMessage(
+			entity.NewPin(entity.PinParams{RoomID: "room-1", MessageID: "msg-1", PinnedBy: "admin-1", PinnedAt: sentAt.Add(time.Hour)}),
+			entity.NewMessage(entity.MessageParams{ID: "msg-1", RoomID: "room-1", UserID: "user-1", Content: "hello", SentAt: sentAt}),
+		)
+
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		err := conn.WriteEvent(entity.NewMessagePinnedEvent(pinned))
+		require.NoError(t, err)
+		assert.Equal(t, "message.pinned", got["type"])
+		assert.Equal(t, map[string]any{
+			"message": map[string]any{
+				"id":      "msg-1",
+				"room_id": "room-1",
+				"user_id": "user-1",
+				"content": "hello",
+				"sent_at": "2025-01-01T12:00:00Z",
+			},
+			"pinned_by": "admin-1",
+			"pinned_at": "2025-01-01T13:00:00Z",
+		}, got["payload"])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		err := conn.WriteEvent(entity.NewErrorEvent("c-1", entity.WebsocketErrorCodeInvalidEvent, "bad"))
+		require.NoError(t, err)
+		assert.Equal(t, "error", got["type"])
+		assert.Equal(t, "c-1", got["id"])
+		assert.Equal(t, map[string]any{"code": "invalid_event", "message": "bad"}, got["payload"])
+	})
+
+	t.Run("書き込み期限の設定に失敗", func(t *testing.T) {
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(assert.AnError)
+
+		err := conn.WriteEvent(entity.NewErrorEvent("", entity.WebsocketErrorCodeInternal, "x"))
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("未対応のペイロード", func(t *testing.T) {
+		err := conn.WriteEvent(entity.NewWebsocketEvent(entity.WebsocketEventParams{
+			Type:    entity.WebsocketEventTypeAck,
+			Payload: struct{}{},
+		}))
+		assert.Error(t, err)
+	})
+
+	t.Run("error（再送できる日時あり）", func(t *testing.T) {
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		retryAfter := time.Date(2025, 1, 1, 12, 0, 30, 0, time.UTC)
+		err := conn.WriteEvent(entity.NewRetryableErrorEvent("c-2", entity.WebsocketErrorCodeRateLimited, "slow down", retryAfter))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"code":        "rate_limited",
+			"message":     "slow down",
+			"retry_after": "2025-01-01T12:00:30Z",
+		}, got["payload"])
+	})
+
+	t.Run("join_request.decided", func(t *testing.T) {
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		err := conn.WriteEvent(entity.NewJoinRequestDecidedEvent("room-1", entity.RoomJoinRequestApproved, "admin-1"))
+		require.NoError(t, err)
+		assert.Equal(t, "join_request.decided", got["type"])
+		assert.Equal(t, map[string]any{
+			"room_id":    "room-1",
+			"status":     "approved",
+			"decided_by": "admin-1",
+		}, got["payload"])
+	})
+
+	t.Run("read.updated", func(t *testing.T) {
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		err := conn.WriteEvent(entity.NewReadUpdatedEvent(entity.NewRoomReadState(entity.RoomReadStateParams{
+			RoomID:            "room-1",
+			UserID:            "user-1",
+			LastReadMessageID: "msg-1",
+			LastReadAt:        time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+			UpdatedAt:         time.Date(2025, 1, 1, 13, 0, 0, 0, time.UTC),
+		})))
+		require.NoError(t, err)
+		assert.Equal(t, "read.updated", got["type"])
+		assert.Equal(t, map[string]any{
+			"user_id":    "user-1",
+			"message_id": "msg-1",
+			"read_at":    "2025-01-01T13:00:00Z",
+		}, got["payload"])
+	})
+
+	t.Run("typing.updated", func(t *testing.T) {
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		err := conn.WriteEvent(entity.NewTypingUpdatedEvent("user-1", true))
+		require.NoError(t, err)
+		assert.Equal(t, "typing.updated", got["type"])
+		assert.Equal(t, map[string]any{
+			"user_id": "user-1",
+			"typing":  true,
+		}, got["payload"])
+	})
+
+	t.Run("presence.updated", func(t *testing.T) {
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		lastSeenAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		err := conn.WriteEvent(entity.NewPresenceUpdatedEvent(entity.NewUserPresence(entity.UserPresenceParams{
+			UserID:     "user-1",
+			Status:     entity.PresenceAway,
+			LastSeenAt: &lastSeenAt,
+		})))
+		require.NoError(t, err)
+		assert.Equal(t, "presence.updated", got["type"])
+		assert.Equal(t, map[string]any{
+			"user_id":      "user-1",
+			"status":       "away",
+			"last_seen_at": "2025-01-01T12:00:00Z",
+		}, got["payload"])
+	})
+
+	t.Run("mention.created", func(t *testing.T) {
+		var got map[string]any
+		mockConn.EXPECT().SetWriteDeadline(gomock.Any()).Return(nil)
+		mockConn.EXPECT().WriteJSON(gomock.Any()).DoAndReturn(capture(&got))
+
+		sentAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		msg := entity.NewMessage(entity.MessageParams{
+			ID:      "msg-1",
+			RoomID:  "room-1",
+			UserID:  "user-1",
+			Content: "@user-2 hello",
+			SentAt:  sentAt,
+		})
+		err := conn.WriteEvent(entity.NewMentionCreatedEvent(entity.NewMention(entity.MentionParams{
+			MessageID: "msg-1",
+			RoomID:    "room-1",
+			UserID:    "user-2",
+			Kind:      entity.MentionKindUser,
+			CreatedAt: sentAt,
+		}), msg))
+		require.NoError(t, err)
+		assert.Equal(t, "mention.created", got["type"])
+		assert.Equal(t, map[string]any{
+			"message": map[string]any{
+				"id":      "msg-1",
+				"room_id": "room-1",
+				"user_id": "user-1",
+				"content": "@user-2 hello",
+				"sent_at": "2025-01-01T12:00:00Z",
+			},
+			"kind": "user",
+		}, got["payload"])
+	})
+}
+
+func TestHeartbeat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConn := mock_adapter.NewMockConnAdapter(ctrl)
+
+	// 生成時に読み取り期限が設定され、pong を受け取るたびに延長される
+	var pongHandler func(string) error
+	mockConn.EXPECT().SetReadDeadline(gomock.Any()).Return(nil).Times(2)
+	mockConn.EXPECT().SetPongHandler(gomock.Any()).Do(func(h func(string) error) {
+		pongHandler = h
+	})
+	pinged := make(chan struct{}, 1)
+	mockConn.EXPECT().WriteControl(websocket.PingMessage, gomock.Any(), gomock.Any()).DoAndReturn(func(int, []byte, time.Time) error {
+		select {
+		case pinged <- struct{}{}:
+		default:
+		}
+		return nil
+	}).MinTimes(1)
+	mockConn.EXPECT().CloseFunc().Return(nil)
+
+	conn := gorillawebsocket.NewGorillaWebSocketConnection(&gorillawebsocket.NewGorillaWebSocketConnectionParams{
+		Conn:         mockConn,
+		PingInterval: 10 * time.Millisecond,
+		PongWait:     time.Second,
+	})
+
+	select {
+	case <-pinged:
+	case <-time.After(time.Second):
+		t.Fatal("ping was not sent")
+	}
+	require.NotNil(t, pongHandler)
+	assert.NoError(t, pongHandler(""))
+
+	// Close 後は ping を送らない（2回目の Close は何もしない）
+	assert.NoError(t, conn.Close())
+	assert.NoError(t, conn.Close())
+}
+
+func TestCloseWithReason(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConn := mock_adapter.NewMockConnAdapter(ctrl)
+	conn := gorillawebsocket.NewGorillaWebSocketConnection(&gorillawebsocket.NewGorillaWebSocketConnectionParams{
+		Conn: mockConn,
+	})
+
+	gomock.InOrder(
+		mockConn.EXPECT().WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"),
+			gomock.Any(),
+		).Return(nil),
+		mockConn.EXPECT().CloseFunc().Return(nil),
+	)
+
+	err := conn.CloseWithReason(service.WebsocketCloseGoingAway, "server shutting down")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,99 @@
+package memwsmanager
+
+import (
+	"sync"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+)
+
+// DefaultSendQueueSize はコネクションごとの送信キューの既定の長さ
+const DefaultSendQueueSize = 64
+
+// OverflowPolicy は送信キューが溢れた場合の扱い
+type OverflowPolicy string
+
+const (
+	// OverflowPolicyDropOldest は最も古いイベントを捨てて新しいイベントを積む
+	OverflowPolicyDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowPolicyDisconnect は追いつけないコネクションを切断する
+	OverflowPolicyDisconnect OverflowPolicy = "disconnect"
+)
+
+// sendQueue はコネクションごとの送信キュー
+// 積まれたイベントは専用のゴルーチンが順番に書き込む
+type sendQueue struct {
+	conn   service.WebSocketConnection
+	policy OverflowPolicy
+	onFail func() // 書き込みに失敗した場合に呼ばれる
+
+	mu     sync.Mutex
+	events chan *entity.WebsocketEvent
+	closed bool
+
+	done chan struct{} // 書き込み用のゴルーチンが終了すると閉じられる
+}
+
+func newSendQueue(conn service.WebSocketConnection, size int, policy OverflowPolicy, onFail func()) *sendQueue {
+	q := &sendQueue{
+		conn:   conn,
+		policy: policy,
+		onFail: onFail,
+		events: make(chan *entity.WebsocketEvent, size),
+		done:   make(chan struct{}),
+	}
+	go q.writeLoop()
+	return q
+}
+
+// enqueue はイベントを積む
+// キューが溢れ、かつ OverflowPolicyDisconnect の場合は false を返す
+func (q *sendQueue) enqueue(event *entity.WebsocketEvent) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return true
+	}
+
+	select {
+	case q.events <- event:
+		return true
+	default:
+	}
+
+	if q.policy == OverflowPolicyDisconnect {
+		return false
+	}
+
+	// 最も古いイベントを捨てて積み直す
+	select {
+	case <-q.events:
+	default:
+	}
+	select {
+	case q.events <- event:
+	default:
+	}
+	return true
+}
+
+// close はキューを閉じる。積まれているイベントは書き込み用のゴルーチンが書き出してから終了する
+func (q *sendQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.closed = true
+	close(q.events)
+}
+
+func (q *sendQueue) writeLoop() {
+	defer close(q.done)
+	for event := range q.events {
+		if err := q.conn.WriteEvent(event); err != nil {
+			q.onFail()
+			return
+		}
+	}
+}
@@ -4,89 +4,305 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/domain/service"
 )
 
 type InMemoryWebSocketManager struct {
-	mu                sync.RWMutex
-	connectionsByUser map[entity.UserID]service.WebSocketConnection
-	connectionsByRoom map[entity.RoomID]map[entity.UserID]service.WebSocketConnection
-	idByConn          map[service.WebSocketConnection]IDs
+	mu            sync.RWMutex
+	connections   map[entity.WsClientID]*registration
+	clientsByUser map[entity.UserID]map[entity.WsClientID]struct{}
+	clientsByRoom map[entity.RoomID]map[entity.WsClientID]struct{}
+	// lastActive はユーザーが最後に操作した日時
+	// 切断後のオンライン状態の判定にも使うため、コネクションがなくなっても削除しない
+	lastActive map[entity.UserID]time.Time
+
+	queueSize      int
+	overflowPolicy OverflowPolicy
+	shutdown       bool // Shutdown 済みの場合は新しい登録を受け付けない
+}
+
+// registration は登録済みのコネクションとその送信キュー
+type registration struct {
+	client *entity.WebsocketClient
+	conn   service.WebSocketConnection
+	queue  *sendQueue
+}
+
+type NewInMemoryWebSocketManagerParams struct {
+	QueueSize      int            // コネクションごとの送信キューの長さ（0の場合は DefaultSendQueueSize）
+	OverflowPolicy OverflowPolicy // 送信キューが溢れた場合の扱い（空の場合は OverflowPolicyDropOldest）
 }
 
-type IDs struct {
-	UserID entity.UserID
-	RoomID entity.RoomID
+func (p *NewInMemoryWebSocketManagerParams) Validate() error {
+	if p.QueueSize < 0 {
+		return errors.New("queue size must not be negative")
+	}
+	switch p.OverflowPolicy {
+	case "", OverflowPolicyDropOldest, OverflowPolicyDisconnect:
+	default:
+		return errors.New("invalid overflow policy: " + string(p.OverflowPolicy))
+	}
+	return nil
 }
 
-func NewInMemoryWebSocketManager() service.WebsocketManager {
+func NewInMemoryWebSocketManager(p *NewInMemoryWebSocketManagerParams) service.WebsocketManager {
+	if err := p.Validate(); err != nil {
+		panic(err)
+	}
+	queueSize := p.QueueSize
+	if queueSize == 0 {
+		queueSize = DefaultSendQueueSize
+	}
+	overflowPolicy := p.OverflowPolicy
+	if overflowPolicy == "" {
+		overflowPolicy = OverflowPolicyDropOldest
+	}
+
 	return &InMemoryWebSocketManager{
-		connectionsByUser: make(map[entity.UserID]service.WebSocketConnection),
-		connectionsByRoom: make(map[entity.RoomID]map[entity.UserID]service.WebSocketConnection),
-		idByConn:          make(map[service.WebSocketConnection]IDs),
+		connections:    make(map[entity.WsClientID]*registration),
+		clientsByUser:  make(map[entity.UserID]map[entity.WsClientID]struct{}),
+		clientsByRoom:  make(map[entity.RoomID]map[entity.WsClientID]struct{}),
+		lastActive:     make(map[entity.UserID]time.Time),
+		queueSize:      queueSize,
+		overflowPolicy: overflowPolicy,
 	}
 }
 
-func (m *InMemoryWebSocketManager) Register(ctx context.Context, conn service.WebSocketConnection, userID entity.UserID, roomID entity.RoomID) error {
+func (m *InMemoryWebSocketManager) Register(ctx context.Context, client *entity.WebsocketClient, conn service.WebSocketConnection) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	if m.shutdown {
+		return service.ErrManagerShutdown
+	}
+	clientID := client.GetID()
+	if _, exists := m.connections[clientID]; exists {
+		return errors.New("client already registered")
+	}
+
+	// 接続ごとの送信キューと書き込み用のゴルーチンを用意
+	m.connections[clientID] = &registration{
+		client: client,
+		conn:   conn,
+		queue: newSendQueue(conn, m.queueSize, m.overflowPolicy, func() {
+			m.drop(clientID)
+		}),
+	}
+
 	// ユーザーごとの接続を登録
-	m.connectionsByUser[userID] = conn
+	if _, exists := m.clientsByUser[client.GetUserID()]; !exists {
+		m.clientsByUser[client.GetUserID()] = make(map[entity.WsClientID]struct{})
+	}
+	m.clientsByUser[client.GetUserID()][clientID] = struct{}{}
 
 	// 部屋ごとの接続を登録
-	if _, exists := m.connectionsByRoom[roomID]; !exists {
-		m.connectionsByRoom[roomID] = make(map[entity.UserID]service.WebSocketConnection)
-	}
-	m.connectionsByRoom[roomID][userID] = conn
-	// 接続とユーザーID、部屋IDのマッピングを保存
-	m.idByConn[conn] = IDs{
-		UserID: userID,
-		RoomID: roomID,
+	if _, exists := m.clientsByRoom[client.GetRoomID()]; !exists {
+		m.clientsByRoom[client.GetRoomID()] = make(map[entity.WsClientID]struct{})
 	}
+	m.clientsByRoom[client.GetRoomID()][clientID] = struct{}{}
 	return nil
 }
 
-func (m *InMemoryWebSocketManager) Unregister(ctx context.Context, conn service.WebSocketConnection) error {
+func (m *InMemoryWebSocketManager) Unregister(ctx context.Context, clientID entity.WsClientID) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	IDs, exists := m.idByConn[conn]
+	reg, exists := m.connections[clientID]
 	if !exists {
-		return errors.New("connection not found")
+		return service.ErrConnectionNotFound
 	}
 
-	delete(m.idByConn, conn)
-	delete(m.connectionsByUser, IDs.UserID)
-	delete(m.connectionsByRoom[IDs.RoomID], IDs.UserID)
+	delete(m.connections, clientID)
+	userID, roomID := reg.client.GetUserID(), reg.client.GetRoomID()
+	delete(m.clientsByUser[userID], clientID)
+	if len(m.clientsByUser[userID]) == 0 {
+		delete(m.clientsByUser, userID)
+	}
+	delete(m.clientsByRoom[roomID], clientID)
+	if len(m.clientsByRoom[roomID]) == 0 {
+		delete(m.clientsByRoom, roomID)
+	}
 
+	// キューに残っているイベントを書き出してから書き込み用のゴルーチンを終了する
+	reg.queue.close()
 	return nil
 }
 
-func (m *InMemoryWebSocketManager) GetConnectionByUserID(ctx context.Context, userID entity.UserID) (service.WebSocketConnection, error) {
+func (m *InMemoryWebSocketManager) GetConnectionByClientID(ctx context.Context, clientID entity.WsClientID) (service.WebSocketConnection, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	conn, exists := m.connectionsByUser[userID]
+	reg, exists := m.connections[clientID]
 	if !exists {
-		return nil, errors.New("connection not found for userID")
+		return nil, service.ErrConnectionNotFound
+	}
+	return reg.conn, nil
+}
+
+func (m *InMemoryWebSocketManager) GetConnectionsByUserID(ctx context.Context, userID entity.UserID) ([]service.WebSocketConnection, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var conns []service.WebSocketConnection
+	for clientID := range m.clientsByUser[userID] {
+		conns = append(conns, m.connections[clientID].conn)
+	}
+	return conns, nil
+}
+
+// BroadcastToRoom は部屋の各コネクションの送信キューにイベントを積む
+// 書き込みは接続ごとのゴルーチンで行うため、遅いコネクションが他のコネクションへの配信を妨げない
+func (m *InMemoryWebSocketManager) BroadcastToRoom(ctx context.Context, roomID entity.RoomID, event *entity.WebsocketEvent) error {
+	return m.broadcast(roomID, "", event)
+}
+
+// BroadcastToRoomExcept は exclude のユーザーのコネクションを除いて、部屋の各コネクションの送信キューにイベントを積む
+func (m *InMemoryWebSocketManager) BroadcastToRoomExcept(ctx context.Context, roomID entity.RoomID, exclude entity.UserID, event *entity.WebsocketEvent) error {
+	return m.broadcast(roomID, exclude, event)
+}
+
+// broadcast は exclude のユーザーのコネクションを除いて、部屋の各コネクションの送信キューにイベントを積む（exclude が空の場合は除かない）
+func (m *InMemoryWebSocketManager) broadcast(roomID entity.RoomID, exclude entity.UserID, event *entity.WebsocketEvent) error {
+	m.mu.RLock()
+	clientIDs := m.clientsByRoom[roomID]
+	regs := make([]*registration, 0, len(clientIDs))
+	for clientID := range clientIDs {
+		reg := m.connections[clientID]
+		if exclude != "" && reg.client.GetUserID() == exclude {
+			continue
+		}
+		regs = append(regs, reg)
+	}
+	m.mu.RUnlock()
+
+	for _, reg := range regs {
+		if !reg.queue.enqueue(event) {
+			// 溢れたコネクションは切断する
+			m.drop(reg.client.GetID())
+		}
 	}
-	return conn, nil
+	return nil
+}
+
+// SendToUser はユーザーの各コネクションの送信キューにイベントを積む
+func (m *InMemoryWebSocketManager) SendToUser(ctx context.Context, userID entity.UserID, event *entity.WebsocketEvent) error {
+	m.mu.RLock()
+	clientIDs := m.clientsByUser[userID]
+	regs := make([]*registration, 0, len(clientIDs))
+	for clientID := range clientIDs {
+		regs = append(regs, m.connections[clientID])
+	}
+	m.mu.RUnlock()
+
+	for _, reg := range regs {
+		if !reg.queue.enqueue(event) {
+			// 溢れたコネクションは切断する
+			m.drop(reg.client.GetID())
+		}
+	}
+	return nil
+}
+
+// TouchUser はユーザーが最後に操作した日時を記録する（古い日時では巻き戻さない）
+func (m *InMemoryWebSocketManager) TouchUser(ctx context.Context, userID entity.UserID, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if at.After(m.lastActive[userID]) {
+		m.lastActive[userID] = at
+	}
+	return nil
 }
 
-func (m *InMemoryWebSocketManager) BroadcastToRoom(ctx context.Context, roomID entity.RoomID, msg *entity.Message) error {
+// GetUserActivity はこのプロセスに登録されたユーザーのコネクション数と最後に操作した日時を返す
+func (m *InMemoryWebSocketManager) GetUserActivity(ctx context.Context, userID entity.UserID) (entity.UserActivity, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
+	return entity.UserActivity{
+		Connections:  len(m.clientsByUser[userID]),
+		LastActiveAt: m.lastActive[userID],
+	}, nil
+}
 
-	users, exists := m.connectionsByRoom[roomID]
+// drop は送信できなくなったコネクションを登録解除して閉じる
+func (m *InMemoryWebSocketManager) drop(clientID entity.WsClientID) {
+	m.mu.RLock()
+	reg, exists := m.connections[clientID]
+	m.mu.RUnlock()
 	if !exists {
-		return errors.New("room not found")
+		return
+	}
+	if err := m.Unregister(context.Background(), clientID); err != nil {
+		// すでに登録解除済み
+		return
 	}
+	_ = reg.conn.Close()
+}
 
-	for _, conn := range users {
-		if err := conn.WriteMessage(msg); err != nil {
-			return err
+func (m *InMemoryWebSocketManager) Shutdown(ctx context.Context, reason string) error {
+	m.mu.Lock()
+	m.shutdown = true
+	regs := make([]*registration, 0, len(m.connections))
+	for _, reg := range m.connections {
+		regs = append(regs, reg)
+	}
+	m.mu.Unlock()
+
+	m.closeAll(ctx, regs, service.WebsocketCloseGoingAway, reason)
+	return ctx.Err()
+}
+
+// CloseRoom は部屋のコネクションに room.closed を送ってから閉じる
+// 他の部屋のコネクションや新しい登録には影響しない
+func (m *InMemoryWebSocketManager) CloseRoom(ctx context.Context, roomID entity.RoomID, reason string) error {
+	m.mu.RLock()
+	regs := make([]*registration, 0, len(m.clientsByRoom[roomID]))
+	for clientID := range m.clientsByRoom[roomID] {
+		regs = append(regs, m.connections[clientID])
+	}
+	m.mu.RUnlock()
+
+	event := entity.NewRoomClosedEvent(reason)
+	for _, reg := range regs {
+		// この後閉じるため、溢れた場合も切断はしない
+		_ = reg.queue.enqueue(event)
+	}
+	m.closeAll(ctx, regs, service.WebsocketCloseNormal, reason)
+	return nil
+}
+
+// DisconnectUser は部屋にいるユーザーのコネクションに room.removed を送ってから閉じる
+func (m *InMemoryWebSocketManager) DisconnectUser(ctx context.Context, roomID entity.RoomID, userID entity.UserID, reason string) error {
+	m.mu.RLock()
+	var regs []*registration
+	for clientID := range m.clientsByRoom[roomID] {
+		if reg := m.connections[clientID]; reg.client.GetUserID() == userID {
+			regs = append(regs, reg)
 		}
 	}
+	m.mu.RUnlock()
+
+	event := entity.NewRoomRemovedEvent(userID, reason)
+	for _, reg := range regs {
+		// この後閉じるため、溢れた場合も切断はしない
+		_ = reg.queue.enqueue(event)
+	}
+	m.closeAll(ctx, regs, service.WebsocketClosePolicyViolation, reason)
 	return nil
 }
+
+// closeAll はコネクションの登録を解除し、送信待ちのイベントを書き出してから切断理由を通知して閉じる
+func (m *InMemoryWebSocketManager) closeAll(ctx context.Context, regs []*registration, code service.WebsocketCloseCode, reason string) {
+	// 登録を解除すると送信キューが閉じられ、残りのイベントが書き出される
+	for _, reg := range regs {
+		_ = m.Unregister(ctx, reg.client.GetID())
+	}
+	for _, reg := range regs {
+		select {
+		case <-reg.queue.done:
+		case <-ctx.Done():
+			// 期限を過ぎた場合は書き出しを待たずに閉じる
+		}
+		_ = reg.conn.CloseWithReason(code, reason)
+	}
+}
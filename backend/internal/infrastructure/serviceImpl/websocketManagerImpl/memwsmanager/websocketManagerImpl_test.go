@@ -0,0 +1,367 @@
+package memwsmanager_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketManagerImpl/memwsmanager"
+	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newEvent(content string) *entity.WebsocketEvent {
+	return entity.NewMessageCreatedEvent(entity.NewMessage(entity.MessageParams{
+		ID:      entity.MessageID("msg-" + content),
+		RoomID:  "room-1",
+		UserID:  "user-1",
+		Content: content,
+	}))
+}
+
+func newClient(id entity.WsClientID, userID entity.UserID, roomID entity.RoomID) *entity.WebsocketClient {
+	return entity.NewWebsocketClient(entity.WebsocketClientParams{
+		ID:     id,
+		UserID: userID,
+		RoomID: roomID,
+	})
+}
+
+func TestMultipleConnectionsPerUser(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{})
+
+	// 同じユーザーが同じ部屋に2つのタブ、別の部屋に1つ接続する
+	tab1 := mock_service.NewMockWebSocketConnection(ctrl)
+	tab2 := mock_service.NewMockWebSocketConnection(ctrl)
+	other := mock_service.NewMockWebSocketConnection(ctrl)
+	require.NoError(t, m.Register(ctx, newClient("c1", "user-1", "room-1"), tab1))
+	require.NoError(t, m.Register(ctx, newClient("c2", "user-1", "room-1"), tab2))
+	require.NoError(t, m.Register(ctx, newClient("c3", "user-1", "room-2"), other))
+	assert.Error(t, m.Register(ctx, newClient("c1", "user-1", "room-1"), tab1))
+
+	conns, err := m.GetConnectionsByUserID(ctx, "user-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []service.WebSocketConnection{tab1, tab2, other}, conns)
+
+	// 1つを切断しても他の接続は残る
+	require.NoError(t, m.Unregister(ctx, "c1"))
+	_, err = m.GetConnectionByClientID(ctx, "c1")
+	assert.ErrorIs(t, err, service.ErrConnectionNotFound)
+	conn, err := m.GetConnectionByClientID(ctx, "c2")
+	require.NoError(t, err)
+	assert.Equal(t, tab2, conn)
+
+	received := make(chan struct{})
+	tab2.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(*entity.WebsocketEvent) error {
+		close(received)
+		return nil
+	})
+	assert.NoError(t, m.BroadcastToRoom(ctx, "room-1", newEvent("1")))
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		t.Fatal("remaining tab did not receive the event")
+	}
+}
+
+func TestBroadcastToRoom(t *testing.T) {
+	ctx := context.Background()
+	roomID := entity.RoomID("room-1")
+
+	t.Run("遅いコネクションが他のコネクションへの配信を妨げない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{QueueSize: 1})
+
+		slow := mock_service.NewMockWebSocketConnection(ctrl)
+		fast := mock_service.NewMockWebSocketConnection(ctrl)
+		require.NoError(t, m.Register(ctx, newClient("slow", "slow", roomID), slow))
+		require.NoError(t, m.Register(ctx, newClient("fast", "fast", roomID), fast))
+
+		// slow は解放されるまで書き込みから戻らない
+		release := make(chan struct{})
+		slow.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(*entity.WebsocketEvent) error {
+			<-release
+			return nil
+		}).AnyTimes()
+		received := make(chan string, 10)
+		fast.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(e *entity.WebsocketEvent) error {
+			received <- e.GetPayload().(*entity.Message).GetContent()
+			return nil
+		}).Times(3)
+
+		for _, content := range []string{"1", "2", "3"} {
+			assert.NoError(t, m.BroadcastToRoom(ctx, roomID, newEvent(content)))
+			select {
+			case got := <-received:
+				assert.Equal(t, content, got)
+			case <-time.After(time.Second):
+				t.Fatal("fast connection did not receive the event")
+			}
+		}
+		close(release)
+	})
+
+	t.Run("溢れたコネクションは切断される", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{
+			QueueSize:      1,
+			OverflowPolicy: memwsmanager.OverflowPolicyDisconnect,
+		})
+
+		slow := mock_service.NewMockWebSocketConnection(ctrl)
+		require.NoError(t, m.Register(ctx, newClient("slow", "slow", roomID), slow))
+
+		writing := make(chan struct{})
+		release := make(chan struct{})
+		slow.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(*entity.WebsocketEvent) error {
+			close(writing)
+			<-release
+			return nil
+		})
+		slow.EXPECT().WriteEvent(gomock.Any()).Return(nil).AnyTimes()
+		slow.EXPECT().Close().Return(nil)
+
+		// 1件目は書き込み中、2件目でキューが埋まり、3件目で溢れる
+		assert.NoError(t, m.BroadcastToRoom(ctx, roomID, newEvent("1")))
+		<-writing
+		assert.NoError(t, m.BroadcastToRoom(ctx, roomID, newEvent("2")))
+		assert.NoError(t, m.BroadcastToRoom(ctx, roomID, newEvent("3")))
+		close(release)
+
+		_, err := m.GetConnectionByClientID(ctx, "slow")
+		assert.ErrorIs(t, err, service.ErrConnectionNotFound)
+	})
+
+	t.Run("コネクションのない部屋は何もしない", func(t *testing.T) {
+		m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{})
+		assert.NoError(t, m.BroadcastToRoom(ctx, "room-x", newEvent("1")))
+	})
+
+	t.Run("書き込みに失敗したコネクションは自動的に登録解除される", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{})
+
+		broken := mock_service.NewMockWebSocketConnection(ctrl)
+		require.NoError(t, m.Register(ctx, newClient("broken", "broken", roomID), broken))
+
+		closed := make(chan struct{})
+		broken.EXPECT().WriteEvent(gomock.Any()).Return(assert.AnError)
+		broken.EXPECT().Close().DoAndReturn(func() error {
+			close(closed)
+			return nil
+		})
+
+		assert.NoError(t, m.BroadcastToRoom(ctx, roomID, newEvent("1")))
+		select {
+		case <-closed:
+		case <-time.After(time.Second):
+			t.Fatal("broken connection was not closed")
+		}
+
+		_, err := m.GetConnectionByClientID(ctx, "broken")
+		assert.ErrorIs(t, err, service.ErrConnectionNotFound)
+		assert.ErrorIs(t, m.Unregister(ctx, "broken"), service.ErrConnectionNotFound)
+	})
+
+	t.Run("BroadcastToRoomExcept は除いたユーザーのコネクションには配信しない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{})
+
+		// 同じユーザーの別タブ（c2）にも配信しない
+		c1 := mock_service.NewMockWebSocketConnection(ctrl)
+		c2 := mock_service.NewMockWebSocketConnection(ctrl)
+		c3 := mock_service.NewMockWebSocketConnection(ctrl)
+		require.NoError(t, m.Register(ctx, newClient("c1", "user-1", roomID), c1))
+		require.NoError(t, m.Register(ctx, newClient("c2", "user-1", roomID), c2))
+		require.NoError(t, m.Register(ctx, newClient("c3", "user-2", roomID), c3))
+
+		event := entity.NewTypingUpdatedEvent("user-1", true)
+		received := make(chan struct{})
+		c3.EXPECT().WriteEvent(event).DoAndReturn(func(*entity.WebsocketEvent) error {
+			close(received)
+			return nil
+		})
+
+		assert.NoError(t, m.BroadcastToRoomExcept(ctx, roomID, "user-1", event))
+		select {
+		case <-received:
+		case <-time.After(time.Second):
+			t.Fatal("event was not delivered to the other user")
+		}
+		// 送信者のコネクションへの書き込みが遅れて起きていないことを確かめる
+		time.Sleep(50 * time.Millisecond)
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{})
+
+	conn := mock_service.NewMockWebSocketConnection(ctrl)
+	require.NoError(t, m.Register(ctx, newClient("c1", "user-1", "room-1"), conn))
+
+	// 送信待ちのイベントを書き出してから切断理由を通知する
+	release := make(chan struct{})
+	gomock.InOrder(
+		conn.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(*entity.WebsocketEvent) error {
+			<-release
+			return nil
+		}),
+		conn.EXPECT().WriteEvent(gomock.Any()).Return(nil),
+		conn.EXPECT().CloseWithReason(service.WebsocketCloseGoingAway, "bye").Return(nil),
+	)
+	assert.NoError(t, m.BroadcastToRoom(ctx, "room-1", newEvent("1")))
+	assert.NoError(t, m.BroadcastToRoom(ctx, "room-1", newEvent("2")))
+	close(release)
+
+	assert.NoError(t, m.Shutdown(ctx, "bye"))
+
+	// 停止後は登録を受け付けない
+	err := m.Register(ctx, newClient("c2", "user-1", "room-1"), conn)
+	assert.ErrorIs(t, err, service.ErrManagerShutdown)
+}
+
+func TestCloseRoom(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{})
+
+	conn1 := mock_service.NewMockWebSocketConnection(ctrl)
+	conn2 := mock_service.NewMockWebSocketConnection(ctrl)
+	other := mock_service.NewMockWebSocketConnection(ctrl)
+	require.NoError(t, m.Register(ctx, newClient("c1", "user-1", "room-1"), conn1))
+	require.NoError(t, m.Register(ctx, newClient("c2", "user-2", "room-1"), conn2))
+	require.NoError(t, m.Register(ctx, newClient("c3", "user-1", "room-2"), other))
+
+	// 部屋のコネクションには room.closed を送ってから切断理由を通知して閉じる
+	for _, conn := range []*mock_service.MockWebSocketConnection{conn1, conn2} {
+		gomock.InOrder(
+			conn.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(event *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeRoomClosed, event.GetType())
+				assert.Equal(t, entity.RoomClosedPayload{Reason: "room deleted"}, event.GetPayload())
+				return nil
+			}),
+			conn.EXPECT().CloseWithReason(service.WebsocketCloseNormal, "room deleted").Return(nil),
+		)
+	}
+	require.NoError(t, m.CloseRoom(ctx, "room-1", "room deleted"))
+
+	_, err := m.GetConnectionByClientID(ctx, "c1")
+	assert.ErrorIs(t, err, service.ErrConnectionNotFound)
+	conns, err := m.GetConnectionsByUserID(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, []service.WebSocketConnection{other}, conns)
+
+	// コネクションのない部屋は何もしない
+	assert.NoError(t, m.CloseRoom(ctx, "room-x", "room deleted"))
+}
+
+func TestDisconnectUser(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{})
+
+	tab1 := mock_service.NewMockWebSocketConnection(ctrl)
+	tab2 := mock_service.NewMockWebSocketConnection(ctrl)
+	otherUser := mock_service.NewMockWebSocketConnection(ctrl)
+	otherRoom := mock_service.NewMockWebSocketConnection(ctrl)
+	require.NoError(t, m.Register(ctx, newClient("c1", "user-1", "room-1"), tab1))
+	require.NoError(t, m.Register(ctx, newClient("c2", "user-1", "room-1"), tab2))
+	require.NoError(t, m.Register(ctx, newClient("c3", "user-2", "room-1"), otherUser))
+	require.NoError(t, m.Register(ctx, newClient("c4", "user-1", "room-2"), otherRoom))
+
+	// 対象のユーザーの部屋のコネクションには room.removed を送ってから切断理由を通知して閉じる
+	for _, conn := range []*mock_service.MockWebSocketConnection{tab1, tab2} {
+		gomock.InOrder(
+			conn.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(event *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeRoomRemoved, event.GetType())
+				assert.Equal(t, entity.RoomRemovedPayload{UserID: "user-1", Reason: "kicked"}, event.GetPayload())
+				return nil
+			}),
+			conn.EXPECT().CloseWithReason(service.WebsocketClosePolicyViolation, "kicked").Return(nil),
+		)
+	}
+	require.NoError(t, m.DisconnectUser(ctx, "room-1", "user-1", "kicked"))
+
+	// 他のユーザーや他の部屋のコネクションは残る
+	conns, err := m.GetConnectionsByUserID(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, []service.WebSocketConnection{otherRoom}, conns)
+	conn, err := m.GetConnectionByClientID(ctx, "c3")
+	require.NoError(t, err)
+	assert.Equal(t, otherUser, conn)
+
+	// コネクションのないユーザーは何もしない
+	assert.NoError(t, m.DisconnectUser(ctx, "room-1", "user-x", "kicked"))
+}
+
+func TestSendToUser(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{})
+
+	// 対象のユーザーのコネクションには、接続している部屋を問わず送信する
+	tab1 := mock_service.NewMockWebSocketConnection(ctrl)
+	tab2 := mock_service.NewMockWebSocketConnection(ctrl)
+	otherUser := mock_service.NewMockWebSocketConnection(ctrl)
+	require.NoError(t, m.Register(ctx, newClient("c1", "user-1", "room-1"), tab1))
+	require.NoError(t, m.Register(ctx, newClient("c2", "user-1", "room-2"), tab2))
+	require.NoError(t, m.Register(ctx, newClient("c3", "user-2", "room-1"), otherUser))
+
+	event := entity.NewJoinRequestDecidedEvent("room-3", entity.RoomJoinRequestApproved, "admin-1")
+	received := make(chan struct{}, 2)
+	for _, conn := range []*mock_service.MockWebSocketConnection{tab1, tab2} {
+		conn.EXPECT().WriteEvent(event).DoAndReturn(func(*entity.WebsocketEvent) error {
+			received <- struct{}{}
+			return nil
+		})
+	}
+	require.NoError(t, m.SendToUser(ctx, "user-1", event))
+	for range 2 {
+		select {
+		case <-received:
+		case <-time.After(time.Second):
+			t.Fatal("user connection did not receive the event")
+		}
+	}
+
+	// コネクションのないユーザーは何もしない
+	assert.NoError(t, m.SendToUser(ctx, "user-x", event))
+}
+
+func TestUserActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	m := memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{})
+
+	activity, err := m.GetUserActivity(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserActivity{}, activity)
+
+	require.NoError(t, m.Register(ctx, newClient("c1", "user-1", "room-1"), mock_service.NewMockWebSocketConnection(ctrl)))
+	require.NoError(t, m.Register(ctx, newClient("c2", "user-1", "room-2"), mock_service.NewMockWebSocketConnection(ctrl)))
+	require.NoError(t, m.Register(ctx, newClient("c3", "user-2", "room-1"), mock_service.NewMockWebSocketConnection(ctrl)))
+
+	// 古い日時では巻き戻さない
+	latest := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, m.TouchUser(ctx, "user-1", latest))
+	require.NoError(t, m.TouchUser(ctx, "user-1", latest.Add(-time.Minute)))
+
+	activity, err = m.GetUserActivity(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserActivity{Connections: 2, LastActiveAt: latest}, activity)
+
+	// コネクションがなくなっても操作日時は残す
+	require.NoError(t, m.Unregister(ctx, "c1"))
+	require.NoError(t, m.Unregister(ctx, "c2"))
+	activity, err = m.GetUserActivity(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserActivity{Connections: 0, LastActiveAt: latest}, activity)
+}
@@ -0,0 +1,315 @@
+// バックプレーンを介して複数ノードにブロードキャストする WebsocketManager の実装
+// コネクションの管理は各ノードの Local（memwsmanager など）で行い、
+// BroadcastToRoom はバックプレーンに publish して、受信した各ノードが自身のコネクションに書き込む
+// オンライン状態の判定に使うユーザーのコネクション数と最後に操作した日時は、変化するたびに他のノードへ共有する
+// 各ノードは一定間隔でハートビートを送り、途絶えたノードから共有された分は数えない
+package pubsubwsmanager
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+	"github.com/google/uuid"
+)
+
+// userChannelPrefix はユーザー宛てのイベントをバックプレーンに流す際の宛先の接頭辞
+// 部屋のIDと衝突しないよう、部屋のIDに使われない ":" を含める
+const userChannelPrefix = "user:"
+
+// activityChannelPrefix はユーザーのコネクション数・最後に操作した日時をバックプレーンに流す際の宛先の接頭辞
+const activityChannelPrefix = "activity:"
+
+// exceptChannelPrefix はユーザーを除いて部屋に配信するイベントをバックプレーンに流す際の宛先の接頭辞
+// 宛先は "except:<除くユーザーのID>:<部屋のID>" とする
+const exceptChannelPrefix = "except:"
+
+// heartbeatChannel はノードのハートビートをバックプレーンに流す際の宛先
+const heartbeatChannel = "node:heartbeat"
+
+// nodeTimeoutHeartbeats は他のノードが停止したとみなすまでに、ハートビートが途絶える回数
+const nodeTimeoutHeartbeats = 3
+
+type PubSubWebSocketManager struct {
+	local     service.WebsocketManager // 自ノードが保持するコネクション
+	backplane service.WebsocketBackplane
+	nodeID    string // 自ノードが共有した user.activity を受信時に区別するためのID
+
+	heartbeatInterval time.Duration
+	stopHeartbeat     chan struct{}
+	stopOnce          sync.Once
+
+	mu      sync.Mutex
+	clients map[entity.WsClientID]entity.UserID              // 自ノードに登録したコネクションのユーザー
+	remote  map[entity.UserID]map[string]entity.UserActivity // 他のノードから共有されたユーザーのコネクション数と最後に操作した日時
+	nodes   map[string]time.Time                             // 他のノードから最後に受信した日時
+}
+
+type NewPubSubWebSocketManagerParams struct {
+	Local     service.WebsocketManager // 自ノードのコネクションを管理するマネージャー
+	Backplane service.WebsocketBackplane
+	// HeartbeatInterval はハートビートを送る間隔
+	// 他のノードから nodeTimeoutHeartbeats 回分受信しない場合は、そのノードから共有された分を破棄する
+	HeartbeatInterval time.Duration
+}
+
+func (p *NewPubSubWebSocketManagerParams) Validate() error {
+	if p.Local == nil {
+		return errors.New("local manager is required")
+	}
+	if p.Backplane == nil {
+		return errors.New("backplane is required")
+	}
+	if p.HeartbeatInterval <= 0 {
+		return errors.New("heartbeat interval must be positive")
+	}
+	return nil
+}
+
+func NewPubSubWebSocketManager(p *NewPubSubWebSocketManagerParams) service.WebsocketManager {
+	if err := p.Validate(); err != nil {
+		panic(err)
+	}
+	m := &PubSubWebSocketManager{
+		local:             p.Local,
+		backplane:         p.Backplane,
+		nodeID:            uuid.New().String(),
+		heartbeatInterval: p.HeartbeatInterval,
+		stopHeartbeat:     make(chan struct{}),
+		clients:           make(map[entity.WsClientID]entity.UserID),
+		remote:            make(map[entity.UserID]map[string]entity.UserActivity),
+		nodes:             make(map[string]time.Time),
+	}
+	p.Backplane.Subscribe(m.deliver)
+	go m.runHeartbeat()
+	return m
+}
+
+// Register は自ノードにコネクションを登録し、ユーザーのコネクション数を他のノードに共有する
+func (m *PubSubWebSocketManager) Register(ctx context.Context, client *entity.WebsocketClient, conn service.WebSocketConnection) error {
+	if err := m.local.Register(ctx, client, conn); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	m.clients[client.GetID()] = client.GetUserID()
+	m.mu.Unlock()
+	// 登録は済んでいるため、共有に失敗してもエラーにしない（次に共有したときに反映される）
+	_ = m.shareActivity(ctx, client.GetUserID())
+	return nil
+}
+
+// Unregister は自ノードのコネクションを登録解除し、ユーザーのコネクション数を他のノードに共有する
+// 送信に失敗して Local が自動的に登録解除していた場合（ErrConnectionNotFound）も共有する
+func (m *PubSubWebSocketManager) Unregister(ctx context.Context, clientID entity.WsClientID) error {
+	err := m.local.Unregister(ctx, clientID)
+
+	m.mu.Lock()
+	userID, ok := m.clients[clientID]
+	delete(m.clients, clientID)
+	m.mu.Unlock()
+	if ok {
+		// 登録解除は済んでいるため、共有に失敗してもエラーにしない（次に共有したときに反映される）
+		_ = m.shareActivity(ctx, userID)
+	}
+	return err
+}
+
+// GetConnectionByClientID は自ノードが保持するコネクションのみを返す
+func (m *PubSubWebSocketManager) GetConnectionByClientID(ctx context.Context, clientID entity.WsClientID) (service.WebSocketConnection, error) {
+	return m.local.GetConnectionByClientID(ctx, clientID)
+}
+
+// GetConnectionsByUserID は自ノードが保持するコネクションのみを返す
+func (m *PubSubWebSocketManager) GetConnectionsByUserID(ctx context.Context, userID entity.UserID) ([]service.WebSocketConnection, error) {
+	return m.local.GetConnectionsByUserID(ctx, userID)
+}
+
+func (m *PubSubWebSocketManager) BroadcastToRoom(ctx context.Context, roomID entity.RoomID, event *entity.WebsocketEvent) error {
+	return m.backplane.Publish(ctx, roomID, event)
+}
+
+// BroadcastToRoomExcept はバックプレーンを介して、すべてのノードで exclude のユーザーを除いて部屋にイベントを配信する
+func (m *PubSubWebSocketManager) BroadcastToRoomExcept(ctx context.Context, roomID entity.RoomID, exclude entity.UserID, event *entity.WebsocketEvent) error {
+	return m.backplane.Publish(ctx, entity.RoomID(exceptChannelPrefix+string(exclude)+":"+string(roomID)), event)
+}
+
+// SendToUser はバックプレーンを介して、すべてのノードでユーザーのコネクションにイベントを送信する
+func (m *PubSubWebSocketManager) SendToUser(ctx context.Context, userID entity.UserID, event *entity.WebsocketEvent) error {
+	return m.backplane.Publish(ctx, entity.RoomID(userChannelPrefix+string(userID)), event)
+}
+
+// CloseRoom はバックプレーンを介して、すべてのノードで部屋のコネクションを閉じる
+func (m *PubSubWebSocketManager) CloseRoom(ctx context.Context, roomID entity.RoomID, reason string) error {
+	return m.backplane.Publish(ctx, roomID, entity.NewRoomClosedEvent(reason))
+}
+
+// DisconnectUser はバックプレーンを介して、すべてのノードで部屋にいるユーザーのコネクションを閉じる
+func (m *PubSubWebSocketManager) DisconnectUser(ctx context.Context, roomID entity.RoomID, userID entity.UserID, reason string) error {
+	return m.backplane.Publish(ctx, roomID, entity.NewRoomRemovedEvent(userID, reason))
+}
+
+// TouchUser は自ノードにユーザーが操作した日時を記録し、他のノードに共有する
+func (m *PubSubWebSocketManager) TouchUser(ctx context.Context, userID entity.UserID, at time.Time) error {
+	if err := m.local.TouchUser(ctx, userID, at); err != nil {
+		return err
+	}
+	return m.shareActivity(ctx, userID)
+}
+
+// GetUserActivity は自ノードと、他のノードから共有されたユーザーのコネクション数を合計し、最も新しい操作日時を返す
+// ハートビートが途絶えたノード（異常終了したノードなど）から共有された分は数えない
+func (m *PubSubWebSocketManager) GetUserActivity(ctx context.Context, userID entity.UserID) (entity.UserActivity, error) {
+	activity, err := m.local.GetUserActivity(ctx, userID)
+	if err != nil {
+		return entity.UserActivity{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for nodeID, remote := range m.remote[userID] {
+		if m.isNodeExpired(nodeID, now) {
+			continue
+		}
+		activity.Connections += remote.Connections
+		if remote.LastActiveAt.After(activity.LastActiveAt) {
+			activity.LastActiveAt = remote.LastActiveAt
+		}
+	}
+	return activity, nil
+}
+
+// shareActivity は自ノードでのユーザーのコネクション数と最後に操作した日時をバックプレーンに publish する
+func (m *PubSubWebSocketManager) shareActivity(ctx context.Context, userID entity.UserID) error {
+	activity, err := m.local.GetUserActivity(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return m.backplane.Publish(ctx, entity.RoomID(activityChannelPrefix+string(userID)), entity.NewUserActivityEvent(m.nodeID, userID, activity))
+}
+
+// Shutdown は自ノードのコネクションを閉じ、ユーザーのコネクションがなくなったことを共有してから、バックプレーンから切断する
+func (m *PubSubWebSocketManager) Shutdown(ctx context.Context, reason string) error {
+	m.stopOnce.Do(func() { close(m.stopHeartbeat) })
+	err := m.local.Shutdown(ctx, reason)
+
+	m.mu.Lock()
+	userIDs := make(map[entity.UserID]struct{}, len(m.clients))
+	for _, userID := range m.clients {
+		userIDs[userID] = struct{}{}
+	}
+	clear(m.clients)
+	m.mu.Unlock()
+	for userID := range userIDs {
+		_ = m.shareActivity(ctx, userID)
+	}
+
+	if closeErr := m.backplane.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// deliver はバックプレーンから受信したイベントを自ノードのコネクションに書き込む
+// 自ノードに部屋のコネクションがない場合や書き込みに失敗した場合は、他ノードへの配信に影響させないよう無視する
+// room.closed / room.removed の場合は、自ノードの該当するコネクションを閉じる（イベントの送信は Local が行う）
+// ユーザー宛てのイベント（SendToUser）の場合は、部屋ではなくユーザーのコネクションに書き込む
+// ユーザーを除いたイベント（BroadcastToRoomExcept）の場合は、そのユーザーのコネクションを除いて部屋に書き込む
+// user.activity / node.heartbeat の場合は、コネクションには書き込まずに他のノードの状態として記録する
+func (m *PubSubWebSocketManager) deliver(roomID entity.RoomID, event *entity.WebsocketEvent) {
+	if payload, ok := event.GetPayload().(entity.UserActivityPayload); ok && event.GetType() == entity.WebsocketEventTypeUserActivity {
+		m.recordActivity(payload)
+		return
+	}
+	if payload, ok := event.GetPayload().(entity.NodeHeartbeatPayload); ok && event.GetType() == entity.WebsocketEventTypeNodeHeartbeat {
+		m.recordHeartbeat(payload.NodeID)
+		return
+	}
+	if userID, ok := strings.CutPrefix(string(roomID), userChannelPrefix); ok {
+		_ = m.local.SendToUser(context.Background(), entity.UserID(userID), event)
+		return
+	}
+	if channel, ok := strings.CutPrefix(string(roomID), exceptChannelPrefix); ok {
+		if exclude, room, ok := strings.Cut(channel, ":"); ok {
+			_ = m.local.BroadcastToRoomExcept(context.Background(), entity.RoomID(room), entity.UserID(exclude), event)
+		}
+		return
+	}
+	if payload, ok := event.GetPayload().(entity.RoomClosedPayload); ok && event.GetType() == entity.WebsocketEventTypeRoomClosed {
+		_ = m.local.CloseRoom(context.Background(), roomID, payload.Reason)
+		return
+	}
+	if payload, ok := event.GetPayload().(entity.RoomRemovedPayload); ok && event.GetType() == entity.WebsocketEventTypeRoomRemoved {
+		_ = m.local.DisconnectUser(context.Background(), roomID, payload.UserID, payload.Reason)
+		return
+	}
+	_ = m.local.BroadcastToRoom(context.Background(), roomID, event)
+}
+
+// recordActivity は他のノードから共有されたユーザーのコネクション数と最後に操作した日時を記録する
+// 切断後のオンライン状態の判定にも使うため、コネクションがなくなっても削除しない（ノードのハートビートが途絶えた場合に削除する）
+func (m *PubSubWebSocketManager) recordActivity(payload entity.UserActivityPayload) {
+	if payload.NodeID == m.nodeID {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodes[payload.NodeID] = time.Now()
+	if _, ok := m.remote[payload.UserID]; !ok {
+		m.remote[payload.UserID] = make(map[string]entity.UserActivity)
+	}
+	m.remote[payload.UserID][payload.NodeID] = payload.Activity
+}
+
+// recordHeartbeat は他のノードから受信した日時を記録する
+func (m *PubSubWebSocketManager) recordHeartbeat(nodeID string) {
+	if nodeID == m.nodeID {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodes[nodeID] = time.Now()
+}
+
+// runHeartbeat は Shutdown まで一定間隔でハートビートを送り、途絶えたノードから共有された分を破棄する
+func (m *PubSubWebSocketManager) runHeartbeat() {
+	ticker := time.NewTicker(m.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		// 送信に失敗しても、他のノードで破棄されるまでに次のハートビートで回復すればよい
+		_ = m.backplane.Publish(context.Background(), heartbeatChannel, entity.NewNodeHeartbeatEvent(m.nodeID))
+		select {
+		case <-m.stopHeartbeat:
+			return
+		case now := <-ticker.C:
+			m.removeExpiredNodes(now)
+		}
+	}
+}
+
+// removeExpiredNodes はハートビートが途絶えたノードと、そのノードから共有されたユーザーの状態を削除する
+func (m *PubSubWebSocketManager) removeExpiredNodes(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for nodeID := range m.nodes {
+		if !m.isNodeExpired(nodeID, now) {
+			continue
+		}
+		delete(m.nodes, nodeID)
+		for userID, activities := range m.remote {
+			delete(activities, nodeID)
+			if len(activities) == 0 {
+				delete(m.remote, userID)
+			}
+		}
+	}
+}
+
+// isNodeExpired は他のノードのハートビートが途絶えているかを返す（m.mu を保持して呼び出す）
+func (m *PubSubWebSocketManager) isNodeExpired(nodeID string, now time.Time) bool {
+	return now.Sub(m.nodes[nodeID]) > m.heartbeatInterval*nodeTimeoutHeartbeats
+}
@@ -0,0 +1,339 @@
+package pubsubwsmanager_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketBackplaneImpl/loopbackbackplane"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketManagerImpl/memwsmanager"
+	"example.com/infrahandson/internal/infrastructure/serviceImpl/websocketManagerImpl/pubsubwsmanager"
+	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBroadcastToRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	// 同じブローカーに参加した2ノードを用意する
+	broker := loopbackbackplane.NewLoopbackBroker()
+	nodeA := pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+		Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+		Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+		HeartbeatInterval: time.Minute,
+	})
+	nodeB := pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+		Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+		Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+		HeartbeatInterval: time.Minute,
+	})
+
+	roomID := entity.RoomID("room-1")
+	connA := mock_service.NewMockWebSocketConnection(ctrl)
+	connB := mock_service.NewMockWebSocketConnection(ctrl)
+	connOther := mock_service.NewMockWebSocketConnection(ctrl)
+	assert.NoError(t, nodeA.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-a", UserID: "user-a", RoomID: roomID}), connA))
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-b", UserID: "user-b", RoomID: roomID}), connB))
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-c", UserID: "user-c", RoomID: "room-2"}), connOther))
+
+	event := entity.NewMessageCreatedEvent(entity.NewMessage(entity.MessageParams{
+		ID:      "msg-1",
+		RoomID:  roomID,
+		UserID:  "user-a",
+		Content: "hello",
+	}))
+
+	// 書き込みは接続ごとのゴルーチンで行われるため、完了を待ち合わせる
+	var wg sync.WaitGroup
+	written := func(*entity.WebsocketEvent) error {
+		wg.Done()
+		return nil
+	}
+
+	t.Run("他ノードのコネクションにも配信される", func(t *testing.T) {
+		wg.Add(2)
+		connA.EXPECT().WriteEvent(event).DoAndReturn(written)
+		connB.EXPECT().WriteEvent(event).DoAndReturn(written)
+
+		err := nodeA.BroadcastToRoom(ctx, roomID, event)
+		assert.NoError(t, err)
+		wg.Wait()
+	})
+
+	t.Run("自ノードに部屋のコネクションがなくても成功する", func(t *testing.T) {
+		wg.Add(1)
+		connB.EXPECT().WriteEvent(event).DoAndReturn(written)
+
+		assert.NoError(t, nodeA.Unregister(ctx, "client-a"))
+
+		err := nodeA.BroadcastToRoom(ctx, roomID, event)
+		assert.NoError(t, err)
+		err = nodeB.BroadcastToRoom(ctx, "room-x", event)
+		assert.NoError(t, err)
+		wg.Wait()
+	})
+}
+
+func TestCloseRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	broker := loopbackbackplane.NewLoopbackBroker()
+	newNode := func() service.WebsocketManager {
+		return pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+			Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+			Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+			HeartbeatInterval: time.Minute,
+		})
+	}
+	nodeA, nodeB := newNode(), newNode()
+
+	roomID := entity.RoomID("room-1")
+	connA := mock_service.NewMockWebSocketConnection(ctrl)
+	connB := mock_service.NewMockWebSocketConnection(ctrl)
+	assert.NoError(t, nodeA.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-a", UserID: "user-a", RoomID: roomID}), connA))
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-b", UserID: "user-b", RoomID: roomID}), connB))
+
+	// 他ノードのコネクションも閉じられる
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for _, conn := range []*mock_service.MockWebSocketConnection{connA, connB} {
+		conn.EXPECT().WriteEvent(gomock.Any()).Return(nil)
+		conn.EXPECT().CloseWithReason(service.WebsocketCloseNormal, "room deleted").DoAndReturn(func(service.WebsocketCloseCode, string) error {
+			wg.Done()
+			return nil
+		})
+	}
+	assert.NoError(t, nodeA.CloseRoom(ctx, roomID, "room deleted"))
+	wg.Wait()
+
+	_, err := nodeB.GetConnectionByClientID(ctx, "client-b")
+	assert.ErrorIs(t, err, service.ErrConnectionNotFound)
+}
+
+func TestDisconnectUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	broker := loopbackbackplane.NewLoopbackBroker()
+	newNode := func() service.WebsocketManager {
+		return pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+			Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+			Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+			HeartbeatInterval: time.Minute,
+		})
+	}
+	nodeA, nodeB := newNode(), newNode()
+
+	roomID := entity.RoomID("room-1")
+	target := mock_service.NewMockWebSocketConnection(ctrl)
+	other := mock_service.NewMockWebSocketConnection(ctrl)
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-a", UserID: "user-a", RoomID: roomID}), target))
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-b", UserID: "user-b", RoomID: roomID}), other))
+
+	// 他ノードにある対象のユーザーのコネクションだけが閉じられる
+	closed := make(chan struct{})
+	target.EXPECT().WriteEvent(gomock.Any()).Return(nil)
+	target.EXPECT().CloseWithReason(service.WebsocketClosePolicyViolation, "banned").DoAndReturn(func(service.WebsocketCloseCode, string) error {
+		close(closed)
+		return nil
+	})
+	assert.NoError(t, nodeA.DisconnectUser(ctx, roomID, "user-a", "banned"))
+	<-closed
+
+	_, err := nodeB.GetConnectionByClientID(ctx, "client-a")
+	assert.ErrorIs(t, err, service.ErrConnectionNotFound)
+	conn, err := nodeB.GetConnectionByClientID(ctx, "client-b")
+	assert.NoError(t, err)
+	assert.Equal(t, other, conn)
+}
+
+func TestSendToUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	broker := loopbackbackplane.NewLoopbackBroker()
+	nodeA := pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+		Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+		Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+		HeartbeatInterval: time.Minute,
+	})
+	nodeB := pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+		Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+		Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+		HeartbeatInterval: time.Minute,
+	})
+
+	// ユーザーのコネクションが別々のノードにある（他のユーザーのコネクションには送らない）
+	connA := mock_service.NewMockWebSocketConnection(ctrl)
+	connB := mock_service.NewMockWebSocketConnection(ctrl)
+	connOther := mock_service.NewMockWebSocketConnection(ctrl)
+	assert.NoError(t, nodeA.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-a", UserID: "user-a", RoomID: "room-1"}), connA))
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-b", UserID: "user-a", RoomID: "room-2"}), connB))
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-c", UserID: "user-c", RoomID: "room-1"}), connOther))
+
+	event := entity.NewJoinRequestDecidedEvent("room-3", entity.RoomJoinRequestDenied, "admin-1")
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for _, conn := range []*mock_service.MockWebSocketConnection{connA, connB} {
+		conn.EXPECT().WriteEvent(event).DoAndReturn(func(*entity.WebsocketEvent) error {
+			wg.Done()
+			return nil
+		})
+	}
+
+	err := nodeA.SendToUser(ctx, "user-a", event)
+	assert.NoError(t, err)
+	wg.Wait()
+}
+
+func TestBroadcastToRoomExcept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	broker := loopbackbackplane.NewLoopbackBroker()
+	nodeA := pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+		Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+		Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+		HeartbeatInterval: time.Minute,
+	})
+	nodeB := pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+		Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+		Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+		HeartbeatInterval: time.Minute,
+	})
+
+	// 除くユーザーのコネクションが両方のノードにある（どちらにも配信しない）
+	roomID := entity.RoomID("room-1")
+	connA := mock_service.NewMockWebSocketConnection(ctrl)
+	connB := mock_service.NewMockWebSocketConnection(ctrl)
+	connOther := mock_service.NewMockWebSocketConnection(ctrl)
+	assert.NoError(t, nodeA.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-a", UserID: "user-a", RoomID: roomID}), connA))
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-b", UserID: "user-a", RoomID: roomID}), connB))
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-c", UserID: "user-c", RoomID: roomID}), connOther))
+
+	event := entity.NewTypingUpdatedEvent("user-a", true)
+	received := make(chan struct{})
+	connOther.EXPECT().WriteEvent(event).DoAndReturn(func(*entity.WebsocketEvent) error {
+		close(received)
+		return nil
+	})
+
+	assert.NoError(t, nodeA.BroadcastToRoomExcept(ctx, roomID, "user-a", event))
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		t.Fatal("event was not delivered to the other user")
+	}
+	// 除いたユーザーのコネクションへの書き込みが遅れて起きていないことを確かめる
+	time.Sleep(50 * time.Millisecond)
+}
+
+func TestUserActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	broker := loopbackbackplane.NewLoopbackBroker()
+	newNode := func() service.WebsocketManager {
+		return pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+			Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+			Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+			HeartbeatInterval: time.Minute,
+		})
+	}
+	nodeA, nodeB := newNode(), newNode()
+
+	// ユーザーのコネクションが別々のノードにある
+	connA := mock_service.NewMockWebSocketConnection(ctrl)
+	connB := mock_service.NewMockWebSocketConnection(ctrl)
+	assert.NoError(t, nodeA.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-a", UserID: "user-a", RoomID: "room-1"}), connA))
+	assert.NoError(t, nodeB.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-b", UserID: "user-a", RoomID: "room-2"}), connB))
+
+	t.Run("すべてのノードのコネクション数と最新の操作日時を返す", func(t *testing.T) {
+		before := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		latest := before.Add(time.Minute)
+		assert.NoError(t, nodeA.TouchUser(ctx, "user-a", before))
+		assert.NoError(t, nodeB.TouchUser(ctx, "user-a", latest))
+
+		for _, node := range []service.WebsocketManager{nodeA, nodeB} {
+			activity, err := node.GetUserActivity(ctx, "user-a")
+			assert.NoError(t, err)
+			assert.Equal(t, 2, activity.Connections)
+			assert.True(t, latest.Equal(activity.LastActiveAt))
+		}
+	})
+
+	t.Run("登録解除したコネクションは他のノードでも数えない", func(t *testing.T) {
+		assert.NoError(t, nodeB.Unregister(ctx, "client-b"))
+
+		activity, err := nodeA.GetUserActivity(ctx, "user-a")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, activity.Connections)
+	})
+
+	t.Run("停止したノードのコネクションは他のノードで数えない", func(t *testing.T) {
+		connA.EXPECT().CloseWithReason(service.WebsocketCloseGoingAway, "shutdown").Return(nil)
+		assert.NoError(t, nodeA.Shutdown(ctx, "shutdown"))
+
+		activity, err := nodeB.GetUserActivity(ctx, "user-a")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, activity.Connections)
+		// 切断後のオンライン状態の判定に使うため、操作日時は残す
+		assert.False(t, activity.LastActiveAt.IsZero())
+	})
+}
+
+func TestUserActivity_NodeHeartbeat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	const heartbeatInterval = 20 * time.Millisecond
+	broker := loopbackbackplane.NewLoopbackBroker()
+	backplaneA := loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker})
+	nodeA := pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+		Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+		Backplane:         backplaneA,
+		HeartbeatInterval: heartbeatInterval,
+	})
+	nodeB := pubsubwsmanager.NewPubSubWebSocketManager(&pubsubwsmanager.NewPubSubWebSocketManagerParams{
+		Local:             memwsmanager.NewInMemoryWebSocketManager(&memwsmanager.NewInMemoryWebSocketManagerParams{}),
+		Backplane:         loopbackbackplane.NewLoopbackBackplane(&loopbackbackplane.NewLoopbackBackplaneParams{Broker: broker}),
+		HeartbeatInterval: heartbeatInterval,
+	})
+	defer nodeB.Shutdown(ctx, "shutdown")
+
+	connA := mock_service.NewMockWebSocketConnection(ctrl)
+	connA.EXPECT().CloseWithReason(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	assert.NoError(t, nodeA.Register(ctx, entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "client-a", UserID: "user-a", RoomID: "room-1"}), connA))
+	assert.NoError(t, nodeA.TouchUser(ctx, "user-a", time.Now()))
+
+	t.Run("ハートビートが続いている間は他のノードのコネクションを数える", func(t *testing.T) {
+		time.Sleep(5 * heartbeatInterval)
+
+		activity, err := nodeB.GetUserActivity(ctx, "user-a")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, activity.Connections)
+	})
+
+	t.Run("ハートビートが途絶えたノードのコネクションは数えない", func(t *testing.T) {
+		// 異常終了したノードは接続数を共有しないまま、バックプレーンから切断される
+		assert.NoError(t, backplaneA.Close())
+
+		assert.Eventually(t, func() bool {
+			activity, err := nodeB.GetUserActivity(ctx, "user-a")
+			return err == nil && activity.Connections == 0
+		}, time.Second, heartbeatInterval)
+	})
+}
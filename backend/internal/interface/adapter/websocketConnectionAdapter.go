@@ -2,6 +2,8 @@
 // 具体実装はbackend/internal/infrastructure/adapterImpl/connAdapterImpl
 package adapter
 
+import "time"
+
 type ConnAdapter interface {
 	// ReadMessageFunc はメッセージ([]byte)を読み取る関数です。
 	ReadMessageFunc() (int, []byte, error)
@@ -9,6 +11,19 @@ type ConnAdapter interface {
 	// WriteMessageFunc はメッセージ([]byte)を書き込む関数です。
 	WriteMessageFunc(int, []byte) error
 
+	// SetWriteDeadline は書き込みの期限を設定する関数です。
+	SetWriteDeadline(t time.Time) error
+
+	// SetReadDeadline は読み取りの期限を設定する関数です。
+	SetReadDeadline(t time.Time) error
+
+	// SetPongHandler は pong を受信したときに呼ばれる関数を設定します。
+	SetPongHandler(h func(appData string) error)
+
+	// WriteControl は ping・close などの制御メッセージを書き込む関数です。
+	// 他の書き込みと並行して呼び出すことができます。
+	WriteControl(messageType int, data []byte, deadline time.Time) error
+
 	// CloseFunc は接続を閉じる関数です。
 	CloseFunc() error
 
@@ -5,8 +5,12 @@ type Factory struct {
 	UserIDFactory     UserIDFactory
 	RoomIDFactory     RoomIDFactory
 	MessageIDFactory  MessageIDFactory
+	AttachmentIDFactory AttachmentIDFactory
 	WsClientIDFactory WsClientIDFactory
 
+	// 招待リンクのトークンを生成するファクトリー
+	InviteTokenFactory InviteTokenFactory
+
 	// WebSocket接続を生成するファクトリー
 	WsConnFactory     WebSocketConnectionFactory
 }
@@ -16,6 +16,16 @@ type MessageIDFactory interface {
 	NewMessageID() (entity.MessageID, error)
 }
 
+type AttachmentIDFactory interface {
+	NewAttachmentID() (entity.AttachmentID, error)
+}
+
 type WsClientIDFactory interface {
 	NewWsClientID() (entity.WsClientID, error)
 }
+
+// InviteTokenFactory は招待リンクのトークンを生成する
+// トークンを知っていれば参加できるため、推測できない値を返すこと
+type InviteTokenFactory interface {
+	NewInviteToken() (entity.InviteToken, error)
+}
@@ -0,0 +1,149 @@
+package messagehandler
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+type AttachmentResponse struct {
+	ID           string    `json:"id"`
+	FileName     string    `json:"file_name"`
+	MimeType     string    `json:"mime_type"` // 内容から判定した MIME タイプ
+	Size         int64     `json:"size"`
+	Width        int       `json:"width,omitempty"`         // 画像の場合のみ
+	Height       int       `json:"height,omitempty"`        // 画像の場合のみ
+	DownloadURL  string    `json:"download_url"`            // 部屋のメンバーのみダウンロードできる
+	ThumbnailURL string    `json:"thumbnail_url,omitempty"` // サムネイルがある場合のみ
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func newAttachmentResponse(attachment *entity.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:           string(attachment.GetID()),
+		FileName:     attachment.GetFileName(),
+		MimeType:     attachment.GetMimeType(),
+		Size:         attachment.GetSize(),
+		Width:        attachment.GetWidth(),
+		Height:       attachment.GetHeight(),
+		DownloadURL:  attachment.GetDownloadPath(),
+		ThumbnailURL: attachment.GetThumbnailPath(),
+		CreatedAt:    attachment.GetCreatedAt(),
+	}
+}
+
+func newAttachmentResponses(attachments []*entity.Attachment) []AttachmentResponse {
+	if len(attachments) == 0 {
+		return nil
+	}
+	responses := make([]AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		responses[i] = newAttachmentResponse(attachment)
+	}
+	return responses
+}
+
+// UploadAttachment はメッセージに添付するファイルをアップロードするハンドラーです。
+// multipart/form-data の file フィールドで受け付けます。
+// 返した id を WebSocket の message.send の attachment_ids に指定して送信すると、メッセージに添付されます。
+func (h *MessageHandler) UploadAttachment(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("UploadAttachment called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("room_id is required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id is required")
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		h.Logger.Error("file is required", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "file is required")
+	}
+
+	res, err := h.MsgUseCase.UploadAttachment(ctx, messagecase.UploadAttachmentRequest{
+		RoomID: entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
+		File:   file,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to upload attachment", err)
+		return newMessageHTTPError(err, "Failed to upload attachment")
+	}
+
+	return c.JSON(http.StatusCreated, newAttachmentResponse(res.Attachment))
+}
+
+// GetAttachment は添付ファイルを返すハンドラーです（部屋のメンバーのみ）。
+func (h *MessageHandler) GetAttachment(c echo.Context) error {
+	return h.serveAttachment(c, false)
+}
+
+// GetAttachmentThumbnail は画像の添付ファイルのサムネイル（WebP）を返すハンドラーです（部屋のメンバーのみ）。
+func (h *MessageHandler) GetAttachmentThumbnail(c echo.Context) error {
+	return h.serveAttachment(c, true)
+}
+
+// serveAttachment は添付ファイルまたはサムネイルをレスポンスに書き込む
+// ブラウザに内容から種類を推測させず、画像以外はダウンロードさせる
+func (h *MessageHandler) serveAttachment(c echo.Context, thumbnail bool) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("GetAttachment called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	attachmentID := c.Param("attachment_id")
+	if roomID == "" || attachmentID == "" {
+		h.Logger.Error("room_id and attachment_id are required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id and attachment_id are required")
+	}
+
+	res, err := h.MsgUseCase.OpenAttachment(ctx, messagecase.OpenAttachmentRequest{
+		RoomID:       entity.RoomID(roomID),
+		AttachmentID: entity.AttachmentID(attachmentID),
+		UserID:       entity.UserID(userID),
+		Thumbnail:    thumbnail,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to get attachment", err)
+		return newMessageHTTPError(err, "Failed to get attachment")
+	}
+	defer res.Body.Close()
+
+	attachment := res.Attachment
+	contentType := attachment.GetMimeType()
+	disposition := "attachment"
+	if thumbnail {
+		contentType = "image/webp"
+	}
+	if thumbnail || attachment.IsImage() {
+		disposition = "inline"
+	}
+
+	header := c.Response().Header()
+	header.Set("X-Content-Type-Options", "nosniff")
+	header.Set("Content-Security-Policy", "default-src 'none'")
+	header.Set("Cache-Control", "private, max-age=86400")
+	header.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": attachment.GetFileName()}))
+	if !thumbnail {
+		header.Set(echo.HeaderContentLength, strconv.FormatInt(attachment.GetSize(), 10))
+	}
+	return c.Stream(http.StatusOK, contentType, res.Body)
+}
@@ -0,0 +1,184 @@
+package messagehandler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/interface/handler/messagehandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUploadAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(body io.Reader, contentType string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/room/room1/attachments", body)
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id")
+		c.SetParamValues("room1")
+		c.Set("user_id", "user1")
+		return c, rec
+	}
+	newFileBody := func(t *testing.T) (*bytes.Buffer, string) {
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		fw, err := w.CreateFormFile("file", "photo.png")
+		require.NoError(t, err)
+		_, err = fw.Write([]byte("png data"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return &body, w.FormDataContentType()
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		attachment := entity.NewAttachment(entity.AttachmentParams{
+			ID:           "att1",
+			RoomID:       "room1",
+			UploaderID:   "user1",
+			FileName:     "photo.png",
+			MimeType:     "image/png",
+			Size:         8,
+			Width:        640,
+			Height:       480,
+			HasThumbnail: true,
+		})
+		mockDeps.MsgUseCase.EXPECT().UploadAttachment(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, req messagecase.UploadAttachmentRequest) (messagecase.UploadAttachmentResponse, error) {
+				assert.Equal(t, entity.RoomID("room1"), req.RoomID)
+				assert.Equal(t, entity.UserID("user1"), req.UserID)
+				require.NotNil(t, req.File)
+				assert.Equal(t, "photo.png", req.File.Filename)
+				return messagecase.UploadAttachmentResponse{Attachment: attachment}, nil
+			})
+
+		c, rec := newContext(newFileBody(t))
+		assert.NoError(t, handler.UploadAttachment(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var body messagehandler.AttachmentResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "att1", body.ID)
+		assert.Equal(t, 640, body.Width)
+		assert.Equal(t, "/api/room/room1/attachments/att1", body.DownloadURL)
+		assert.Equal(t, "/api/room/room1/attachments/att1/thumbnail", body.ThumbnailURL)
+	})
+
+	t.Run("ファイルがない", func(t *testing.T) {
+		c, _ := newContext(strings.NewReader(`{}`), echo.MIMEApplicationJSON)
+		err := handler.UploadAttachment(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("アップロードの異常系のステータスコード", func(t *testing.T) {
+		cases := []struct {
+			err  error
+			code int
+		}{
+			{service.ErrAttachmentTooLarge, http.StatusRequestEntityTooLarge},
+			{service.ErrInvalidAttachmentType, http.StatusUnsupportedMediaType},
+			{repository.ErrNotRoomMember, http.StatusForbidden},
+		}
+		for _, tc := range cases {
+			mockDeps.MsgUseCase.EXPECT().UploadAttachment(gomock.Any(), gomock.Any()).Return(messagecase.UploadAttachmentResponse{}, tc.err)
+			c, _ := newContext(newFileBody(t))
+			err := handler.UploadAttachment(c)
+			assert.Equal(t, tc.code, err.(*echo.HTTPError).Code, tc.err.Error())
+		}
+	})
+}
+
+func TestGetAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/room/room1/attachments/att1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id", "attachment_id")
+		c.SetParamValues("room1", "att1")
+		c.Set("user_id", "user1")
+		return c, rec
+	}
+	openRequest := func(thumbnail bool) messagecase.OpenAttachmentRequest {
+		return messagecase.OpenAttachmentRequest{RoomID: "room1", AttachmentID: "att1", UserID: "user1", Thumbnail: thumbnail}
+	}
+	newResponse := func(fileName, mimeType, content string) messagecase.OpenAttachmentResponse {
+		return messagecase.OpenAttachmentResponse{
+			Attachment: entity.NewAttachment(entity.AttachmentParams{
+				ID:           "att1",
+				RoomID:       "room1",
+				FileName:     fileName,
+				MimeType:     mimeType,
+				Size:         int64(len(content)),
+				HasThumbnail: true,
+			}),
+			Body: io.NopCloser(strings.NewReader(content)),
+		}
+	}
+
+	t.Run("正常系：画像以外はダウンロードさせる", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().OpenAttachment(gomock.Any(), openRequest(false)).
+			Return(newResponse("報告書.pdf", "application/pdf", "%PDF-1.4"), nil)
+
+		c, rec := newContext()
+		assert.NoError(t, handler.GetAttachment(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "%PDF-1.4", rec.Body.String())
+		assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+		assert.Equal(t, "8", rec.Header().Get(echo.HeaderContentLength))
+		assert.Equal(t, "attachment; filename*=utf-8''%E5%A0%B1%E5%91%8A%E6%9B%B8.pdf", rec.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("正常系：サムネイルは WebP で表示させる", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().OpenAttachment(gomock.Any(), openRequest(true)).
+			Return(newResponse("photo.png", "image/png", "webp data"), nil)
+
+		c, rec := newContext()
+		assert.NoError(t, handler.GetAttachmentThumbnail(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "image/webp", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "inline; filename=photo.png", rec.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("ダウンロードの異常系のステータスコード", func(t *testing.T) {
+		cases := []struct {
+			err  error
+			code int
+		}{
+			{repository.ErrAttachmentNotFound, http.StatusNotFound},
+			{service.ErrAttachmentFileNotFound, http.StatusNotFound},
+			{repository.ErrNotRoomMember, http.StatusForbidden},
+		}
+		for _, tc := range cases {
+			mockDeps.MsgUseCase.EXPECT().OpenAttachment(gomock.Any(), openRequest(false)).Return(messagecase.OpenAttachmentResponse{}, tc.err)
+			c, _ := newContext()
+			err := handler.GetAttachment(c)
+			assert.Equal(t, tc.code, err.(*echo.HTTPError).Code, tc.err.Error())
+		}
+	})
+}
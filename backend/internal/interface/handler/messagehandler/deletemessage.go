@@ -0,0 +1,43 @@
+package messagehandler
+
+import (
+	"net/http"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+// DeleteMessage はメッセージを削除（論理削除）するハンドラーです。
+// 削除できるのは投稿者のみで、削除は WebSocket で message.deleted として部屋に配信されます。
+func (h *MessageHandler) DeleteMessage(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("DeleteMessage called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	messageID := c.Param("message_id")
+	if roomID == "" || messageID == "" {
+		h.Logger.Error("room_id and message_id are required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id and message_id are required")
+	}
+
+	err := h.MsgUseCase.DeleteMessage(ctx, messagecase.DeleteMessageRequest{
+		RoomID:    entity.RoomID(roomID),
+		MessageID: entity.MessageID(messageID),
+		UserID:    entity.UserID(userID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to delete message", err)
+		return newMessageHTTPError(err, "Failed to delete message")
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "Message deleted successfully",
+	})
+}
@@ -0,0 +1,62 @@
+package messagehandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"example.com/infrahandson/internal/interface/handler/messagehandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(userID string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/message/room1/msg1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.SetParamNames("room_id", "message_id")
+		c.SetParamValues("room1", "msg1")
+		return c, rec
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().
+			DeleteMessage(gomock.Any(), messagecase.DeleteMessageRequest{
+				RoomID:    "room1",
+				MessageID: "msg1",
+				UserID:    "author",
+			}).
+			Return(nil)
+
+		c, rec := newContext("author")
+		assert.NoError(t, handler.DeleteMessage(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("ユーザーIDがない", func(t *testing.T) {
+		c, _ := newContext("")
+		err := handler.DeleteMessage(c)
+		assert.Equal(t, http.StatusUnauthorized, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("投稿者以外", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().DeleteMessage(gomock.Any(), gomock.Any()).Return(messagecase.ErrNotMessageAuthor)
+
+		c, _ := newContext("someone-else")
+		err := handler.DeleteMessage(c)
+		assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	})
+}
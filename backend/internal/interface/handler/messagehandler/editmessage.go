@@ -0,0 +1,105 @@
+package messagehandler
+
+import (
+	"net/http"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+type EditMessageRequest struct {
+	Content string `json:"content" validate:"required"`
+}
+
+type MessageRevisionResponse struct {
+	Content  string    `json:"content"`   // 編集される前の本文
+	EditedAt time.Time `json:"edited_at"` // 編集された日時
+}
+
+type GetMessageRevisionsResponse struct {
+	Revisions []MessageRevisionResponse `json:"revisions"`
+}
+
+// EditMessage はメッセージの本文を編集するハンドラーです。
+// 編集できるのは投稿者のみで、編集前の本文は履歴として残ります。
+// 編集後のメッセージは WebSocket で message.updated として部屋に配信されます。
+func (h *MessageHandler) EditMessage(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("EditMessage called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	messageID := c.Param("message_id")
+	if roomID == "" || messageID == "" {
+		h.Logger.Error("room_id and message_id are required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id and message_id are required")
+	}
+
+	var req EditMessageRequest
+	if err := c.Bind(&req); err != nil {
+		h.Logger.Error("Failed to bind request", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	if err := c.Validate(req); err != nil {
+		h.Logger.Error("Validation failed", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Validation failed: "+err.Error())
+	}
+
+	res, err := h.MsgUseCase.EditMessage(ctx, messagecase.EditMessageRequest{
+		RoomID:    entity.RoomID(roomID),
+		MessageID: entity.MessageID(messageID),
+		UserID:    entity.UserID(userID),
+		Content:   req.Content,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to edit message", err)
+		return newMessageHTTPError(err, "Failed to edit message")
+	}
+
+	return c.JSON(http.StatusOK, newMessageResponse(res.Message))
+}
+
+// GetMessageRevisions はメッセージの編集履歴（編集前の本文）を古い順に返すハンドラーです。
+func (h *MessageHandler) GetMessageRevisions(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("GetMessageRevisions called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	messageID := c.Param("message_id")
+	if roomID == "" || messageID == "" {
+		h.Logger.Error("room_id and message_id are required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id and message_id are required")
+	}
+
+	res, err := h.MsgUseCase.GetMessageRevisions(ctx, messagecase.GetMessageRevisionsRequest{
+		RoomID:    entity.RoomID(roomID),
+		MessageID: entity.MessageID(messageID),
+		UserID:    entity.UserID(userID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to get message revisions", err)
+		return newMessageHTTPError(err, "Failed to get message revisions")
+	}
+
+	revisions := make([]MessageRevisionResponse, len(res.Revisions))
+	for i, rev := range res.Revisions {
+		revisions[i] = MessageRevisionResponse{
+			Content:  rev.GetContent(),
+			EditedAt: rev.GetEditedAt(),
+		}
+	}
+	return c.JSON(http.StatusOK, GetMessageRevisionsResponse{Revisions: revisions})
+}
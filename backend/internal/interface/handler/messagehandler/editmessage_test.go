@@ -0,0 +1,133 @@
+package messagehandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/messagehandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newEditContext(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPatch, "/api/message/room1/msg1", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", "author")
+	c.SetParamNames("room_id", "message_id")
+	c.SetParamValues("room1", "msg1")
+	return c, rec
+}
+
+func TestEditMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	t.Run("正常系", func(t *testing.T) {
+		editedAt := time.Now()
+		mockDeps.MsgUseCase.EXPECT().
+			EditMessage(gomock.Any(), messagecase.EditMessageRequest{
+				RoomID:    "room1",
+				MessageID: "msg1",
+				UserID:    "author",
+				Content:   "after",
+			}).
+			Return(messagecase.EditMessageResponse{
+				Message: entity.NewMessage(entity.MessageParams{
+					ID:       "msg1",
+					RoomID:   "room1",
+					UserID:   "author",
+					Content:  "after",
+					SentAt:   editedAt.Add(-time.Minute),
+					EditedAt: &editedAt,
+				}),
+			}, nil)
+
+		c, rec := newEditContext(e, `{"content":"after"}`)
+		assert.NoError(t, handler.EditMessage(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"content":"after"`)
+		assert.Contains(t, rec.Body.String(), `"edited_at"`)
+	})
+
+	t.Run("本文が空", func(t *testing.T) {
+		c, _ := newEditContext(e, `{"content":""}`)
+		err := handler.EditMessage(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	cases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"存在しない", repository.ErrMessageNotFound, http.StatusNotFound},
+		{"投稿者以外", messagecase.ErrNotMessageAuthor, http.StatusForbidden},
+		{"削除済み", messagecase.ErrMessageDeleted, http.StatusConflict},
+		{"その他のエラー", assert.AnError, http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDeps.MsgUseCase.EXPECT().EditMessage(gomock.Any(), gomock.Any()).Return(messagecase.EditMessageResponse{}, tc.err)
+
+			c, _ := newEditContext(e, `{"content":"after"}`)
+			err := handler.EditMessage(c)
+			assert.Equal(t, tc.code, err.(*echo.HTTPError).Code)
+		})
+	}
+}
+
+func TestGetMessageRevisions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/message/room1/msg1/revisions", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id", "message_id")
+		c.SetParamValues("room1", "msg1")
+		c.Set("user_id", "user1")
+		return c, rec
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().
+			GetMessageRevisions(gomock.Any(), messagecase.GetMessageRevisionsRequest{RoomID: "room1", MessageID: "msg1", UserID: "user1"}).
+			Return(messagecase.GetMessageRevisionsResponse{
+				Revisions: []*entity.MessageRevision{
+					entity.NewMessageRevision(entity.MessageRevisionParams{MessageID: "msg1", Content: "v1", EditedAt: time.Now()}),
+				},
+			}, nil)
+
+		c, rec := newContext()
+		assert.NoError(t, handler.GetMessageRevisions(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"content":"v1"`)
+	})
+
+	t.Run("存在しない", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().GetMessageRevisions(gomock.Any(), gomock.Any()).
+			Return(messagecase.GetMessageRevisionsResponse{}, repository.ErrMessageNotFound)
+
+		c, _ := newContext()
+		err := handler.GetMessageRevisions(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+}
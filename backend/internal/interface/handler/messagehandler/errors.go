@@ -0,0 +1,52 @@
+package messagehandler
+
+import (
+	"errors"
+	"net/http"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+// newMessageHTTPError はメッセージの取得・編集・削除・リアクション・ピン留め・既読・添付ファイルで発生したエラーを HTTP エラーに変換する
+func newMessageHTTPError(err error, message string) *echo.HTTPError {
+	switch {
+	case errors.Is(err, repository.ErrRoomNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "room not found")
+	case errors.Is(err, repository.ErrNotRoomMember):
+		return echo.NewHTTPError(http.StatusForbidden, "not a member of the room")
+	case errors.Is(err, entity.ErrInsufficientRoomRole):
+		return echo.NewHTTPError(http.StatusForbidden, "insufficient room role")
+	case errors.Is(err, repository.ErrMessageNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "message not found")
+	case errors.Is(err, messagecase.ErrNotMessageAuthor):
+		return echo.NewHTTPError(http.StatusForbidden, "only the author can modify the message")
+	case errors.Is(err, messagecase.ErrMessageDeleted):
+		return echo.NewHTTPError(http.StatusConflict, "message is already deleted")
+	case errors.Is(err, messagecase.ErrEmptyContent):
+		return echo.NewHTTPError(http.StatusBadRequest, "content is required")
+	case errors.Is(err, messagecase.ErrInvalidEmoji):
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid emoji")
+	case errors.Is(err, messagecase.ErrEmptyQuery):
+		return echo.NewHTTPError(http.StatusBadRequest, "q is required")
+	case errors.Is(err, messagecase.ErrPinLimitReached):
+		return echo.NewHTTPError(http.StatusConflict, "room pin limit reached")
+	case errors.Is(err, repository.ErrAlreadyPinned):
+		return echo.NewHTTPError(http.StatusConflict, "message is already pinned")
+	case errors.Is(err, repository.ErrPinNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "message is not pinned")
+	case errors.Is(err, messagecase.ErrReadReceiptsDisabled):
+		return echo.NewHTTPError(http.StatusForbidden, "read receipts are disabled")
+	case errors.Is(err, service.ErrAttachmentTooLarge):
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "attachment is too large")
+	case errors.Is(err, service.ErrInvalidAttachmentType):
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, "attachment type is not allowed")
+	case errors.Is(err, repository.ErrAttachmentNotFound), errors.Is(err, service.ErrAttachmentFileNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "attachment not found")
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, message)
+	}
+}
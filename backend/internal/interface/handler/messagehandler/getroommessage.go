@@ -24,11 +24,57 @@ type GetMessageHistoryInRoomResponse struct {
 }
 
 type MessageResponse struct {
-	ID      string    `json:"id"`
-	RoomID  string    `json:"room_id"`
-	UserID  string    `json:"user_id"`
-	Content string    `json:"content"`
-	SentAt  time.Time `json:"sent_at"`
+	ID        string                 `json:"id"`
+	RoomID    string                 `json:"room_id"`
+	UserID    string                 `json:"user_id"`
+	ParentID  string                 `json:"parent_id,omitempty"` // スレッドの返信の場合のみ
+	Content   string                 `json:"content"`
+	SentAt    time.Time              `json:"sent_at"`
+	EditedAt  *time.Time             `json:"edited_at,omitempty"`
+	DeletedAt *time.Time             `json:"deleted_at,omitempty"` // 削除済みの場合は content が空
+	Thread    *ThreadSummaryResponse `json:"thread,omitempty"`     // 返信がある場合のみ
+	Reactions []ReactionResponse     `json:"reactions,omitempty"`  // リアクションがある場合のみ
+
+	Attachments []AttachmentResponse `json:"attachments,omitempty"` // 添付ファイルがある場合のみ
+}
+
+type ThreadSummaryResponse struct {
+	ReplyCount  int       `json:"reply_count"`
+	LastReplyAt time.Time `json:"last_reply_at"`
+}
+
+type ReactionResponse struct {
+	Emoji   string `json:"emoji"`
+	Count   int    `json:"count"`
+	Reacted bool   `json:"reacted"` // リクエストしたユーザー自身がリアクションしているか
+}
+
+func newReactionResponses(summaries []*entity.ReactionSummary) []ReactionResponse {
+	if len(summaries) == 0 {
+		return nil
+	}
+	reactions := make([]ReactionResponse, len(summaries))
+	for i, summary := range summaries {
+		reactions[i] = ReactionResponse{
+			Emoji:   summary.GetEmoji(),
+			Count:   summary.GetCount(),
+			Reacted: summary.HasReacted(),
+		}
+	}
+	return reactions
+}
+
+func newMessageResponse(msg *entity.Message) MessageResponse {
+	return MessageResponse{
+		ID:        string(msg.GetID()),
+		RoomID:    string(msg.GetRoomID()),
+		UserID:    string(msg.GetUserID()),
+		ParentID:  string(msg.GetParentID()),
+		Content:   msg.GetContent(),
+		SentAt:    msg.GetSentAt(),
+		EditedAt:  msg.GetEditedAt(),
+		DeletedAt: msg.GetDeletedAt(),
+	}
 }
 
 // GetRoomMessage は指定されたルームのメッセージ履歴を取得するハンドラーです。
@@ -51,6 +97,13 @@ func (h *MessageHandler) GetRoomMessage(c echo.Context) error {
 	}
 	req.RoomID = entity.RoomID(roomIDStr)
 
+	// 部屋のメンバーのみ閲覧できる
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
 	// クエリ: limit（任意、デフォルト 10）
 	req.Limit = 10
 	if limitStr := c.QueryParam("limit"); limitStr != "" {
@@ -82,24 +135,27 @@ func (h *MessageHandler) GetRoomMessage(c echo.Context) error {
 	// Usecase呼び出し
 	res, err := h.MsgUseCase.GetMessageHistoryInRoom(ctx, messagecase.GetMessageHistoryInRoomRequest{
 		RoomID:       req.RoomID,
+		UserID:       entity.UserID(userID),
 		Limit:        req.Limit,
 		BeforeSentAt: req.BeforeSentAt,
 	})
 	if err != nil {
 		h.Logger.Error("failed to get message history: %v", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get message history")
+		return newMessageHTTPError(err, "failed to get message history")
 	}
 
 	// レスポンス構築
 	messages := make([]MessageResponse, len(res.Messages))
 	for i, msg := range res.Messages {
-		messages[i] = MessageResponse{
-			ID:      string(msg.GetID()),
-			RoomID:  string(msg.GetRoomID()),
-			UserID:  string(msg.GetUserID()),
-			Content: msg.GetContent(),
-			SentAt:  msg.GetSentAt(),
+		messages[i] = newMessageResponse(msg)
+		if summary, ok := res.ThreadSummaries[msg.GetID()]; ok {
+			messages[i].Thread = &ThreadSummaryResponse{
+				ReplyCount:  summary.GetReplyCount(),
+				LastReplyAt: summary.GetLastReplyAt(),
+			}
 		}
+		messages[i].Reactions = newReactionResponses(res.Reactions[msg.GetID()])
+		messages[i].Attachments = newAttachmentResponses(res.Attachments[msg.GetID()])
 	}
 	return c.JSON(http.StatusOK, GetMessageHistoryInRoomResponse{
 		Messages:         messages,
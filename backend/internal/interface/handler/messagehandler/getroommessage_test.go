@@ -1,16 +1,19 @@
 package messagehandler_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/interface/handler/messagehandler"
 	"example.com/infrahandson/internal/usecase/messagecase"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -45,6 +48,15 @@ func TestGetRoomMessage(t *testing.T) {
 				},
 				NextBeforeSentAt: now,
 				HasNext:          false,
+				Attachments: map[entity.MessageID][]*entity.Attachment{
+					"msg1": {entity.NewAttachment(entity.AttachmentParams{
+						ID:       "att1",
+						RoomID:   entity.RoomID(roomID),
+						FileName: "memo.txt",
+						MimeType: "text/plain",
+						Size:     5,
+					})},
+				},
 			}, nil)
 
 		req := httptest.NewRequest("GET", "/rooms/"+roomID+"/messages?limit=10", nil)
@@ -53,6 +65,7 @@ func TestGetRoomMessage(t *testing.T) {
 		c.SetPath("/rooms/:room_id/messages")
 		c.SetParamNames("room_id")
 		c.SetParamValues(roomID)
+		c.Set("user_id", "user1")
 
 		if err := handler.GetRoomMessage(c); err != nil {
 			t.Errorf("expected no error, got %v", err)
@@ -60,6 +73,13 @@ func TestGetRoomMessage(t *testing.T) {
 		if rec.Code != http.StatusOK {
 			t.Errorf("expected status code 200, got %d", rec.Code)
 		}
+		var body messagehandler.GetMessageHistoryInRoomResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Len(t, body.Messages, 1)
+		require.Len(t, body.Messages[0].Attachments, 1)
+		assert.Equal(t, "memo.txt", body.Messages[0].Attachments[0].FileName)
+		assert.Equal(t, "/api/room/room123/attachments/att1", body.Messages[0].Attachments[0].DownloadURL)
+		assert.Empty(t, body.Messages[0].Attachments[0].ThumbnailURL)
 	})
 
 	// 2. room_id が空文字列の場合
@@ -70,6 +90,7 @@ func TestGetRoomMessage(t *testing.T) {
 		c.SetPath("/rooms/:room_id/messages")
 		c.SetParamNames("room_id")
 		c.SetParamValues("")
+		c.Set("user_id", "user1")
 
 		err := handler.GetRoomMessage(c)
 		if err == nil {
@@ -89,6 +110,7 @@ func TestGetRoomMessage(t *testing.T) {
 		c.SetPath("/rooms/:room_id/messages")
 		c.SetParamNames("room_id")
 		c.SetParamValues("room123")
+		c.Set("user_id", "user1")
 
 		err := handler.GetRoomMessage(c)
 		if err == nil {
@@ -108,6 +130,7 @@ func TestGetRoomMessage(t *testing.T) {
 		c.SetPath("/rooms/:room_id/messages")
 		c.SetParamNames("room_id")
 		c.SetParamValues("room123")
+		c.Set("user_id", "user1")
 
 		err := handler.GetRoomMessage(c)
 		if err == nil {
@@ -132,6 +155,7 @@ func TestGetRoomMessage(t *testing.T) {
 		c.SetPath("/rooms/:room_id/messages")
 		c.SetParamNames("room_id")
 		c.SetParamValues(roomID)
+		c.Set("user_id", "user1")
 
 		err := handler.GetRoomMessage(c)
 		if err == nil {
@@ -142,4 +166,49 @@ func TestGetRoomMessage(t *testing.T) {
 			t.Errorf("expected 500 InternalServerError, got %v", err)
 		}
 	})
+
+	// 6. ユーザーIDがない場合
+	t.Run("user_id is missing", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/rooms/room123/messages", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/rooms/:room_id/messages")
+		c.SetParamNames("room_id")
+		c.SetParamValues("room123")
+
+		err := handler.GetRoomMessage(c)
+		he, ok := err.(*echo.HTTPError)
+		if !ok || he.Code != http.StatusUnauthorized {
+			t.Errorf("expected 401 Unauthorized, got %v", err)
+		}
+	})
+
+	// 7. 部屋のメンバーでない・部屋が存在しない場合
+	for _, tc := range []struct {
+		err  error
+		code int
+	}{
+		{repository.ErrNotRoomMember, http.StatusForbidden},
+		{repository.ErrRoomNotFound, http.StatusNotFound},
+	} {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			mockDeps.MsgUseCase.EXPECT().
+				GetMessageHistoryInRoom(gomock.Any(), gomock.Any()).
+				Return(messagecase.GetMessageHistoryInRoomResponse{}, tc.err)
+
+			req := httptest.NewRequest("GET", "/rooms/room123/messages", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/rooms/:room_id/messages")
+			c.SetParamNames("room_id")
+			c.SetParamValues("room123")
+			c.Set("user_id", "user1")
+
+			err := handler.GetRoomMessage(c)
+			he, ok := err.(*echo.HTTPError)
+			if !ok || he.Code != tc.code {
+				t.Errorf("expected %d, got %v", tc.code, err)
+			}
+		})
+	}
 }
@@ -0,0 +1,88 @@
+package messagehandler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+type GetThreadResponse struct {
+	Parent          MessageResponse   `json:"parent"`
+	Replies         []MessageResponse `json:"replies"`
+	NextAfterSentAt string            `json:"next_after_sent_at"`
+	HasNext         bool              `json:"has_next"`
+}
+
+// GetThread はスレッドの親メッセージと返信を古い順に取得するハンドラーです。
+// - `after_sent_at` パラメータを使用して、指定された時刻より後の返信を取得します（省略時は最初から）。
+// - `limit` パラメータで取得する返信数を制限できます（デフォルトは10）。
+func (h *MessageHandler) GetThread(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("GetThread called")
+
+	// 部屋のメンバーのみ閲覧できる
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	messageID := c.Param("message_id")
+	if roomID == "" || messageID == "" {
+		h.Logger.Error("room_id and message_id are required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id and message_id are required")
+	}
+
+	limit := 10
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limitNum, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.Logger.Error("limit must be an integer")
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be an integer")
+		}
+		limit = limitNum
+	}
+
+	var afterSentAt time.Time
+	if afterSentAtStr := c.QueryParam("after_sent_at"); afterSentAtStr != "" && afterSentAtStr != "undefined" {
+		fixedStr := strings.Replace(afterSentAtStr, " ", "+", 1)
+		var err error
+		afterSentAt, err = time.Parse(time.RFC3339Nano, fixedStr)
+		if err != nil {
+			h.Logger.Error("after_sent_at must be in RFC3339 format")
+			return echo.NewHTTPError(http.StatusBadRequest, "after_sent_at must be in RFC3339 format")
+		}
+	}
+
+	res, err := h.MsgUseCase.GetThread(ctx, messagecase.GetThreadRequest{
+		RoomID:      entity.RoomID(roomID),
+		ParentID:    entity.MessageID(messageID),
+		UserID:      entity.UserID(userID),
+		Limit:       limit,
+		AfterSentAt: afterSentAt,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to get thread", err)
+		return newMessageHTTPError(err, "Failed to get thread")
+	}
+
+	replies := make([]MessageResponse, len(res.Replies))
+	for i, reply := range res.Replies {
+		replies[i] = newMessageResponse(reply)
+		replies[i].Reactions = newReactionResponses(res.Reactions[reply.GetID()])
+	}
+	parent := newMessageResponse(res.Parent)
+	parent.Reactions = newReactionResponses(res.Reactions[res.Parent.GetID()])
+	return c.JSON(http.StatusOK, GetThreadResponse{
+		Parent:          parent,
+		Replies:         replies,
+		NextAfterSentAt: res.NextAfterSentAt.Format(time.RFC3339Nano),
+		HasNext:         res.HasNext,
+	})
+}
@@ -0,0 +1,77 @@
+package messagehandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/messagehandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetThread(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(query string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/message/room1/msg1/thread"+query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id", "message_id")
+		c.SetParamValues("room1", "msg1")
+		c.Set("user_id", "user1")
+		return c, rec
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		after := time.Date(2023, 1, 1, 12, 0, 0, 500, time.UTC)
+		mockDeps.MsgUseCase.EXPECT().
+			GetThread(gomock.Any(), messagecase.GetThreadRequest{
+				RoomID:      "room1",
+				ParentID:    "msg1",
+				UserID:      "user1",
+				Limit:       5,
+				AfterSentAt: after,
+			}).
+			Return(messagecase.GetThreadResponse{
+				Parent: entity.NewMessage(entity.MessageParams{ID: "msg1", RoomID: "room1", Content: "parent"}),
+				Replies: []*entity.Message{
+					entity.NewMessage(entity.MessageParams{ID: "reply1", RoomID: "room1", ParentID: "msg1", Content: "reply"}),
+				},
+				Reactions: map[entity.MessageID][]*entity.ReactionSummary{
+					"reply1": {entity.NewReactionSummary(entity.ReactionSummaryParams{Emoji: "👍", Count: 1, Reacted: true})},
+				},
+			}, nil)
+
+		c, rec := newContext("?limit=5&after_sent_at=" + after.Format(time.RFC3339Nano))
+		assert.NoError(t, handler.GetThread(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"parent_id":"msg1"`)
+		assert.Contains(t, rec.Body.String(), `"reactions":[{"emoji":"👍","count":1,"reacted":true}]`)
+	})
+
+	t.Run("limit が整数でない", func(t *testing.T) {
+		c, _ := newContext("?limit=abc")
+		err := handler.GetThread(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("親メッセージが存在しない", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().GetThread(gomock.Any(), gomock.Any()).
+			Return(messagecase.GetThreadResponse{}, repository.ErrMessageNotFound)
+
+		c, _ := newContext("")
+		err := handler.GetThread(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+}
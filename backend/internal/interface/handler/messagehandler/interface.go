@@ -5,4 +5,52 @@ import "github.com/labstack/echo/v4"
 type MessageHandlerInterface interface {
 	// GetMessageHistoryInRoom は指定されたルームのメッセージ履歴を取得する
 	GetRoomMessage(c echo.Context) error
+
+	// SearchMessages は参加している部屋のメッセージを本文で検索する
+	SearchMessages(c echo.Context) error
+
+	// GetThread はスレッドの親メッセージと返信を取得する
+	GetThread(c echo.Context) error
+
+	// EditMessage は投稿者がメッセージを編集する
+	EditMessage(c echo.Context) error
+
+	// DeleteMessage は投稿者がメッセージを削除する
+	DeleteMessage(c echo.Context) error
+
+	// GetMessageRevisions はメッセージの編集履歴を取得する
+	GetMessageRevisions(c echo.Context) error
+
+	// AddReaction はメッセージにリアクションを追加する
+	AddReaction(c echo.Context) error
+
+	// RemoveReaction はメッセージのリアクションを取り消す
+	RemoveReaction(c echo.Context) error
+
+	// PinMessage は管理者がメッセージを部屋にピン留めする
+	PinMessage(c echo.Context) error
+
+	// UnpinMessage は管理者がメッセージのピン留めを外す
+	UnpinMessage(c echo.Context) error
+
+	// GetPinnedMessages は部屋にピン留めされているメッセージを取得する
+	GetPinnedMessages(c echo.Context) error
+
+	// MarkAsRead は部屋のメッセージを指定したメッセージまで既読にする
+	MarkAsRead(c echo.Context) error
+
+	// GetReadReceipts は部屋のメンバーの既読位置を取得する
+	GetReadReceipts(c echo.Context) error
+
+	// GetMentions は自分宛てのメンションを既読・未読の状態とともに取得する
+	GetMentions(c echo.Context) error
+
+	// UploadAttachment はメッセージに添付するファイルをアップロードする
+	UploadAttachment(c echo.Context) error
+
+	// GetAttachment は添付ファイルを返す
+	GetAttachment(c echo.Context) error
+
+	// GetAttachmentThumbnail は画像の添付ファイルのサムネイルを返す
+	GetAttachmentThumbnail(c echo.Context) error
 }
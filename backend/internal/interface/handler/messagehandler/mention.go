@@ -0,0 +1,95 @@
+package messagehandler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+type MentionResponse struct {
+	Message MessageResponse    `json:"message"`
+	Kind    entity.MentionKind `json:"kind"` // user / here / room
+	Read    bool               `json:"read"` // 部屋の既読位置がメッセージまで進んでいるか
+}
+
+type GetMentionsResponse struct {
+	Mentions         []MentionResponse `json:"mentions"`
+	NextBeforeSentAt string            `json:"next_before_sent_at"`
+	HasNext          bool              `json:"has_next"`
+}
+
+// GetMentions は自分宛てのメンションを新しい順に取得するハンドラーです。
+// - `unread` パラメータに true を指定すると未読のメンションのみを返します。
+// - `before_sent_at` と `limit` パラメータでページングします（limit のデフォルトは20、最大100）。
+// メンションは部屋の既読位置がメッセージまで進むと既読になります。
+func (h *MessageHandler) GetMentions(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("GetMentions called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	unreadOnly := false
+	if unreadStr := c.QueryParam("unread"); unreadStr != "" {
+		var err error
+		unreadOnly, err = strconv.ParseBool(unreadStr)
+		if err != nil {
+			h.Logger.Error("unread must be a boolean")
+			return echo.NewHTTPError(http.StatusBadRequest, "unread must be a boolean")
+		}
+	}
+
+	limit := 20
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limitNum, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.Logger.Error("limit must be an integer")
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be an integer")
+		}
+		limit = limitNum
+	}
+
+	beforeSentAt := time.Now()
+	if beforeSentAtStr := c.QueryParam("before_sent_at"); beforeSentAtStr != "" && beforeSentAtStr != "undefined" {
+		fixedStr := strings.Replace(beforeSentAtStr, " ", "+", 1)
+		var err error
+		beforeSentAt, err = time.Parse(time.RFC3339Nano, fixedStr)
+		if err != nil {
+			h.Logger.Error("before_sent_at must be in RFC3339 format")
+			return echo.NewHTTPError(http.StatusBadRequest, "before_sent_at must be in RFC3339 format")
+		}
+	}
+
+	res, err := h.MsgUseCase.ListMentions(ctx, messagecase.ListMentionsRequest{
+		UserID:       entity.UserID(userID),
+		UnreadOnly:   unreadOnly,
+		Limit:        limit,
+		BeforeSentAt: beforeSentAt,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to list mentions", err)
+		return newMessageHTTPError(err, "Failed to list mentions")
+	}
+
+	mentions := make([]MentionResponse, len(res.Mentions))
+	for i, mentioned := range res.Mentions {
+		mentions[i] = MentionResponse{
+			Message: newMessageResponse(mentioned.GetMessage()),
+			Kind:    mentioned.GetMention().GetKind(),
+			Read:    mentioned.IsRead(),
+		}
+	}
+	return c.JSON(http.StatusOK, GetMentionsResponse{
+		Mentions:         mentions,
+		NextBeforeSentAt: res.NextBeforeSentAt.Format(time.RFC3339Nano),
+		HasNext:          res.HasNext,
+	})
+}
@@ -0,0 +1,104 @@
+package messagehandler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/interface/handler/messagehandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetMentions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(userID string, query url.Values) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/user/me/mentions?"+query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		return c, rec
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		before := time.Date(2023, 1, 1, 12, 0, 0, 500, time.UTC)
+		sentAt := before.Add(-time.Minute)
+		msg := entity.NewMessage(entity.MessageParams{ID: "msg1", RoomID: "room1", UserID: "user2", Content: "@user1 見て", SentAt: sentAt})
+		mention := entity.NewMention(entity.MentionParams{
+			MessageID: "msg1",
+			RoomID:    "room1",
+			UserID:    "user1",
+			Kind:      entity.MentionKindUser,
+			CreatedAt: sentAt,
+		})
+		mockDeps.MsgUseCase.EXPECT().
+			ListMentions(gomock.Any(), messagecase.ListMentionsRequest{
+				UserID:       "user1",
+				UnreadOnly:   true,
+				Limit:        5,
+				BeforeSentAt: before,
+			}).
+			Return(messagecase.ListMentionsResponse{
+				Mentions:         []*entity.MentionedMessage{entity.NewMentionedMessage(mention, msg, false)},
+				NextBeforeSentAt: sentAt,
+				HasNext:          true,
+			}, nil)
+
+		c, rec := newContext("user1", url.Values{
+			"unread":         {"true"},
+			"limit":          {"5"},
+			"before_sent_at": {before.Format(time.RFC3339Nano)},
+		})
+		assert.NoError(t, handler.GetMentions(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var body messagehandler.GetMentionsResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		if assert.Len(t, body.Mentions, 1) {
+			assert.Equal(t, "msg1", body.Mentions[0].Message.ID)
+			assert.Equal(t, entity.MentionKindUser, body.Mentions[0].Kind)
+			assert.False(t, body.Mentions[0].Read)
+		}
+		assert.Equal(t, sentAt.Format(time.RFC3339Nano), body.NextBeforeSentAt)
+		assert.True(t, body.HasNext)
+	})
+
+	t.Run("ユーザーIDがない", func(t *testing.T) {
+		c, _ := newContext("", url.Values{})
+		err := handler.GetMentions(c)
+		assert.Equal(t, http.StatusUnauthorized, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("unread が真偽値でない", func(t *testing.T) {
+		c, _ := newContext("user1", url.Values{"unread": {"yes please"}})
+		err := handler.GetMentions(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("limit が整数でない", func(t *testing.T) {
+		c, _ := newContext("user1", url.Values{"limit": {"abc"}})
+		err := handler.GetMentions(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("取得に失敗", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().ListMentions(gomock.Any(), gomock.Any()).
+			Return(messagecase.ListMentionsResponse{}, assert.AnError)
+		c, _ := newContext("user1", url.Values{})
+		err := handler.GetMentions(c)
+		assert.Equal(t, http.StatusInternalServerError, err.(*echo.HTTPError).Code)
+	})
+}
@@ -0,0 +1,122 @@
+package messagehandler
+
+import (
+	"net/http"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PinnedMessageResponse struct {
+	Message  MessageResponse `json:"message"`
+	PinnedBy string          `json:"pinned_by"`
+	PinnedAt time.Time       `json:"pinned_at"`
+}
+
+type GetPinnedMessagesResponse struct {
+	Pins []PinnedMessageResponse `json:"pins"` // ピン留めした日時の新しい順
+}
+
+func newPinnedMessageResponse(pinned *entity.PinnedMessage) PinnedMessageResponse {
+	return PinnedMessageResponse{
+		Message:  newMessageResponse(pinned.GetMessage()),
+		PinnedBy: string(pinned.GetPin().GetPinnedBy()),
+		PinnedAt: pinned.GetPin().GetPinnedAt(),
+	}
+}
+
+// PinMessage はメッセージを部屋にピン留めするハンドラーです。
+// ピン留めできるのは部屋の管理者以上で、ピン留めは WebSocket で message.pinned として部屋に配信されます。
+func (h *MessageHandler) PinMessage(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("PinMessage called")
+
+	req, err := h.bindPinRequest(c)
+	if err != nil {
+		return err
+	}
+
+	pinned, err := h.MsgUseCase.PinMessage(ctx, req)
+	if err != nil {
+		h.Logger.Error("Failed to pin message", err)
+		return newMessageHTTPError(err, "Failed to pin message")
+	}
+
+	return c.JSON(http.StatusCreated, newPinnedMessageResponse(pinned))
+}
+
+// UnpinMessage はメッセージのピン留めを外すハンドラーです。
+// ピン留めを外せるのは部屋の管理者以上で、WebSocket で message.unpinned として部屋に配信されます。
+func (h *MessageHandler) UnpinMessage(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("UnpinMessage called")
+
+	req, err := h.bindPinRequest(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.MsgUseCase.UnpinMessage(ctx, req); err != nil {
+		h.Logger.Error("Failed to unpin message", err)
+		return newMessageHTTPError(err, "Failed to unpin message")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetPinnedMessages は部屋にピン留めされているメッセージを本文とともに取得するハンドラーです。
+func (h *MessageHandler) GetPinnedMessages(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("GetPinnedMessages called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("room_id is required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id is required")
+	}
+
+	res, err := h.MsgUseCase.GetPinnedMessages(ctx, messagecase.GetPinnedMessagesRequest{
+		RoomID: entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to get pinned messages", err)
+		return newMessageHTTPError(err, "Failed to get pinned messages")
+	}
+
+	pins := make([]PinnedMessageResponse, len(res.Pins))
+	for i, pinned := range res.Pins {
+		pins[i] = newPinnedMessageResponse(pinned)
+	}
+	return c.JSON(http.StatusOK, GetPinnedMessagesResponse{Pins: pins})
+}
+
+// bindPinRequest はパスとログイン中のユーザーからピン留めのリクエストを組み立てます。
+func (h *MessageHandler) bindPinRequest(c echo.Context) (messagecase.PinRequest, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return messagecase.PinRequest{}, echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	messageID := c.Param("message_id")
+	if roomID == "" || messageID == "" {
+		h.Logger.Error("room_id and message_id are required")
+		return messagecase.PinRequest{}, echo.NewHTTPError(http.StatusBadRequest, "room_id and message_id are required")
+	}
+
+	return messagecase.PinRequest{
+		RoomID:    entity.RoomID(roomID),
+		MessageID: entity.MessageID(messageID),
+		UserID:    entity.UserID(userID),
+	}, nil
+}
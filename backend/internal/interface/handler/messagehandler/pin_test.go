@@ -0,0 +1,109 @@
+package messagehandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/messagehandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(method, userID string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/room/room1/pins/msg1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.SetParamNames("room_id", "message_id")
+		c.SetParamValues("room1", "msg1")
+		return c, rec
+	}
+	pinReq := messagecase.PinRequest{RoomID: "room1", MessageID: "msg1", UserID: "admin"}
+	sentAt := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	pinnedAt := time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+	pinned := entity.NewPinnedMessage(
+		entity.NewPin(entity.PinParams{RoomID: "room1", MessageID: "msg1", PinnedBy: "admin", PinnedAt: pinnedAt}),
+		entity.NewMessage(entity.MessageParams{ID: "msg1", RoomID: "room1", UserID: "user1", Content: "hello", SentAt: sentAt}),
+	)
+	pinnedJSON := `{
+		"message":{"id":"msg1","room_id":"room1","user_id":"user1","content":"hello","sent_at":"2023-01-01T12:00:00Z"},
+		"pinned_by":"admin",
+		"pinned_at":"2023-01-02T12:00:00Z"
+	}`
+
+	t.Run("ピン留めの正常系", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().PinMessage(gomock.Any(), pinReq).Return(pinned, nil)
+
+		c, rec := newContext(http.MethodPost, "admin")
+		assert.NoError(t, handler.PinMessage(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, pinnedJSON, rec.Body.String())
+	})
+
+	t.Run("ピン留め解除の正常系", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().UnpinMessage(gomock.Any(), pinReq).Return(nil)
+
+		c, rec := newContext(http.MethodDelete, "admin")
+		assert.NoError(t, handler.UnpinMessage(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("一覧の正常系", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().
+			GetPinnedMessages(gomock.Any(), messagecase.GetPinnedMessagesRequest{RoomID: "room1", UserID: "user1"}).
+			Return(messagecase.GetPinnedMessagesResponse{Pins: []*entity.PinnedMessage{pinned}}, nil)
+
+		c, rec := newContext(http.MethodGet, "user1")
+		assert.NoError(t, handler.GetPinnedMessages(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"pins":[`+pinnedJSON+`]}`, rec.Body.String())
+	})
+
+	t.Run("ユーザーIDがない", func(t *testing.T) {
+		c, _ := newContext(http.MethodPost, "")
+		err := handler.PinMessage(c)
+		assert.Equal(t, http.StatusUnauthorized, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("異常系のステータスコード", func(t *testing.T) {
+		for _, tc := range []struct {
+			err  error
+			code int
+		}{
+			{entity.ErrInsufficientRoomRole, http.StatusForbidden},
+			{messagecase.ErrPinLimitReached, http.StatusConflict},
+			{repository.ErrAlreadyPinned, http.StatusConflict},
+			{repository.ErrMessageNotFound, http.StatusNotFound},
+		} {
+			mockDeps.MsgUseCase.EXPECT().PinMessage(gomock.Any(), pinReq).Return(nil, tc.err)
+
+			c, _ := newContext(http.MethodPost, "admin")
+			err := handler.PinMessage(c)
+			assert.Equal(t, tc.code, err.(*echo.HTTPError).Code, tc.err.Error())
+		}
+	})
+
+	t.Run("ピン留めされていない", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().UnpinMessage(gomock.Any(), pinReq).Return(repository.ErrPinNotFound)
+
+		c, _ := newContext(http.MethodDelete, "admin")
+		err := handler.UnpinMessage(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+}
@@ -0,0 +1,75 @@
+package messagehandler
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+type ReactionResultResponse struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"` // 変更後のその絵文字のリアクション数
+}
+
+// AddReaction はメッセージにリアクションを追加するハンドラーです。
+// 絵文字はパスに URL エンコードして指定します。既にリアクションしている場合も成功として扱います。
+// 追加は WebSocket で reaction.added として部屋に配信されます。
+func (h *MessageHandler) AddReaction(c echo.Context) error {
+	h.Logger.Info("AddReaction called")
+	return h.handleReaction(c, h.MsgUseCase.AddReaction, "Failed to add reaction")
+}
+
+// RemoveReaction はメッセージのリアクションを取り消すハンドラーです。
+// リアクションしていない場合も成功として扱います。
+// 取り消しは WebSocket で reaction.removed として部屋に配信されます。
+func (h *MessageHandler) RemoveReaction(c echo.Context) error {
+	h.Logger.Info("RemoveReaction called")
+	return h.handleReaction(c, h.MsgUseCase.RemoveReaction, "Failed to remove reaction")
+}
+
+func (h *MessageHandler) handleReaction(
+	c echo.Context,
+	react func(ctx context.Context, req messagecase.ReactionRequest) (messagecase.ReactionResponse, error),
+	failedMessage string,
+) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	messageID := c.Param("message_id")
+	if roomID == "" || messageID == "" {
+		h.Logger.Error("room_id and message_id are required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id and message_id are required")
+	}
+
+	emoji, err := url.PathUnescape(c.Param("emoji"))
+	if err != nil {
+		h.Logger.Error("emoji must be URL encoded", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "emoji must be URL encoded")
+	}
+
+	res, err := react(ctx, messagecase.ReactionRequest{
+		RoomID:    entity.RoomID(roomID),
+		MessageID: entity.MessageID(messageID),
+		UserID:    entity.UserID(userID),
+		Emoji:     emoji,
+	})
+	if err != nil {
+		h.Logger.Error(failedMessage, err)
+		return newMessageHTTPError(err, failedMessage)
+	}
+
+	return c.JSON(http.StatusOK, ReactionResultResponse{
+		Emoji: emoji,
+		Count: res.Count,
+	})
+}
@@ -0,0 +1,90 @@
+package messagehandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"example.com/infrahandson/internal/interface/handler/messagehandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(method, userID, emoji string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/message/room1/msg1/reactions/"+emoji, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.SetParamNames("room_id", "message_id", "emoji")
+		c.SetParamValues("room1", "msg1", emoji)
+		return c, rec
+	}
+
+	t.Run("追加の正常系", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().
+			AddReaction(gomock.Any(), messagecase.ReactionRequest{
+				RoomID:    "room1",
+				MessageID: "msg1",
+				UserID:    "user1",
+				Emoji:     "👍",
+			}).
+			Return(messagecase.ReactionResponse{Count: 2}, nil)
+
+		// URL エンコードされた絵文字も受け付ける
+		c, rec := newContext(http.MethodPut, "user1", "%F0%9F%91%8D")
+		assert.NoError(t, handler.AddReaction(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"emoji":"👍","count":2}`, rec.Body.String())
+	})
+
+	t.Run("取り消しの正常系", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().
+			RemoveReaction(gomock.Any(), messagecase.ReactionRequest{
+				RoomID:    "room1",
+				MessageID: "msg1",
+				UserID:    "user1",
+				Emoji:     "👍",
+			}).
+			Return(messagecase.ReactionResponse{Count: 0}, nil)
+
+		c, rec := newContext(http.MethodDelete, "user1", "👍")
+		assert.NoError(t, handler.RemoveReaction(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("ユーザーIDがない", func(t *testing.T) {
+		c, _ := newContext(http.MethodPut, "", "👍")
+		err := handler.AddReaction(c)
+		assert.Equal(t, http.StatusUnauthorized, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("不正な絵文字", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().AddReaction(gomock.Any(), gomock.Any()).
+			Return(messagecase.ReactionResponse{}, messagecase.ErrInvalidEmoji)
+
+		c, _ := newContext(http.MethodPut, "user1", "%20")
+		err := handler.AddReaction(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("削除済みのメッセージ", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().AddReaction(gomock.Any(), gomock.Any()).
+			Return(messagecase.ReactionResponse{}, messagecase.ErrMessageDeleted)
+
+		c, _ := newContext(http.MethodPut, "user1", "👍")
+		err := handler.AddReaction(c)
+		assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+	})
+}
@@ -0,0 +1,103 @@
+package messagehandler
+
+import (
+	"net/http"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+type MarkAsReadRequest struct {
+	MessageID string `json:"message_id" validate:"required"` // 最後に読んだメッセージのID
+}
+
+type ReadReceiptResponse struct {
+	UserID    string    `json:"user_id"`
+	MessageID string    `json:"message_id"` // 最後に読んだメッセージのID
+	ReadAt    time.Time `json:"read_at"`    // 既読にした日時
+}
+
+type GetReadReceiptsResponse struct {
+	Receipts []ReadReceiptResponse `json:"receipts"` // 既読にした日時の新しい順
+}
+
+// MarkAsRead は部屋のメッセージを指定したメッセージまで既読にするハンドラーです。
+// 既読位置が進んだ場合、既読の配信が有効であれば WebSocket で read.updated として部屋に配信されます。
+func (h *MessageHandler) MarkAsRead(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("MarkAsRead called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("room_id is required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id is required")
+	}
+
+	var req MarkAsReadRequest
+	if err := c.Bind(&req); err != nil {
+		h.Logger.Error("Failed to bind request", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	if err := c.Validate(req); err != nil {
+		h.Logger.Error("Validation failed", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Validation failed: "+err.Error())
+	}
+
+	err := h.MsgUseCase.MarkAsRead(ctx, messagecase.MarkAsReadRequest{
+		RoomID:    entity.RoomID(roomID),
+		MessageID: entity.MessageID(req.MessageID),
+		UserID:    entity.UserID(userID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to mark as read", err)
+		return newMessageHTTPError(err, "Failed to mark as read")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetReadReceipts は部屋のメンバーがどのメッセージまで読んだかを返すハンドラーです。
+// 既読の公開が無効な場合は 403 を返します。
+func (h *MessageHandler) GetReadReceipts(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("GetReadReceipts called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("room_id is required")
+		return echo.NewHTTPError(http.StatusBadRequest, "room_id is required")
+	}
+
+	res, err := h.MsgUseCase.GetReadReceipts(ctx, messagecase.GetReadReceiptsRequest{
+		RoomID: entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to get read receipts", err)
+		return newMessageHTTPError(err, "Failed to get read receipts")
+	}
+
+	receipts := make([]ReadReceiptResponse, len(res.ReadStates))
+	for i, state := range res.ReadStates {
+		receipts[i] = ReadReceiptResponse{
+			UserID:    string(state.GetUserID()),
+			MessageID: string(state.GetLastReadMessageID()),
+			ReadAt:    state.GetUpdatedAt(),
+		}
+	}
+	return c.JSON(http.StatusOK, GetReadReceiptsResponse{Receipts: receipts})
+}
@@ -0,0 +1,105 @@
+package messagehandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/messagehandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(method, path, body, userID string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/room/room1"+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.SetParamNames("room_id")
+		c.SetParamValues("room1")
+		return c, rec
+	}
+	markReq := messagecase.MarkAsReadRequest{RoomID: "room1", MessageID: "msg1", UserID: "user1"}
+
+	t.Run("既読の正常系", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().MarkAsRead(gomock.Any(), markReq).Return(nil)
+
+		c, rec := newContext(http.MethodPut, "/read", `{"message_id":"msg1"}`, "user1")
+		assert.NoError(t, handler.MarkAsRead(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("message_idがない", func(t *testing.T) {
+		c, _ := newContext(http.MethodPut, "/read", `{}`, "user1")
+		err := handler.MarkAsRead(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("既読の異常系のステータスコード", func(t *testing.T) {
+		for _, tc := range []struct {
+			err  error
+			code int
+		}{
+			{repository.ErrNotRoomMember, http.StatusForbidden},
+			{repository.ErrMessageNotFound, http.StatusNotFound},
+		} {
+			mockDeps.MsgUseCase.EXPECT().MarkAsRead(gomock.Any(), markReq).Return(tc.err)
+
+			c, _ := newContext(http.MethodPut, "/read", `{"message_id":"msg1"}`, "user1")
+			err := handler.MarkAsRead(c)
+			assert.Equal(t, tc.code, err.(*echo.HTTPError).Code, tc.err.Error())
+		}
+	})
+
+	t.Run("既読位置の一覧の正常系", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().
+			GetReadReceipts(gomock.Any(), messagecase.GetReadReceiptsRequest{RoomID: "room1", UserID: "user1"}).
+			Return(messagecase.GetReadReceiptsResponse{ReadStates: []*entity.RoomReadState{
+				entity.NewRoomReadState(entity.RoomReadStateParams{
+					RoomID:            "room1",
+					UserID:            "user2",
+					LastReadMessageID: "msg1",
+					LastReadAt:        time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+					UpdatedAt:         time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
+				}),
+			}}, nil)
+
+		c, rec := newContext(http.MethodGet, "/read-receipts", "", "user1")
+		assert.NoError(t, handler.GetReadReceipts(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"receipts":[{"user_id":"user2","message_id":"msg1","read_at":"2023-01-02T12:00:00Z"}]}`, rec.Body.String())
+	})
+
+	t.Run("既読の公開が無効", func(t *testing.T) {
+		mockDeps.MsgUseCase.EXPECT().GetReadReceipts(gomock.Any(), gomock.Any()).Return(messagecase.GetReadReceiptsResponse{}, messagecase.ErrReadReceiptsDisabled)
+
+		c, _ := newContext(http.MethodGet, "/read-receipts", "", "user1")
+		err := handler.GetReadReceipts(c)
+		assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("ユーザーIDがない", func(t *testing.T) {
+		c, _ := newContext(http.MethodGet, "/read-receipts", "", "")
+		err := handler.GetReadReceipts(c)
+		assert.Equal(t, http.StatusUnauthorized, err.(*echo.HTTPError).Code)
+	})
+}
@@ -0,0 +1,90 @@
+package messagehandler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+)
+
+type SearchResultResponse struct {
+	MessageResponse
+	Snippet string `json:"snippet"` // HTMLエスケープ済みで、一致した語句は <mark> で囲まれる
+}
+
+type SearchMessagesResponse struct {
+	Results          []SearchResultResponse `json:"results"`
+	NextBeforeSentAt string                 `json:"next_before_sent_at"`
+	HasNext          bool                   `json:"has_next"`
+}
+
+// SearchMessages は参加している部屋のメッセージを本文で検索するハンドラーです。
+// - `q` パラメータに空白区切りの語句を指定します（必須）。すべての語句を含むメッセージを新しい順に返します。
+// - `room_id` パラメータで検索する部屋を絞り込めます。
+// - `before_sent_at` と `limit` パラメータでページングします（limit のデフォルトは10）。
+func (h *MessageHandler) SearchMessages(c echo.Context) error {
+	ctx := c.Request().Context()
+	h.Logger.Info("SearchMessages called")
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	query := c.QueryParam("q")
+	if strings.TrimSpace(query) == "" {
+		h.Logger.Error("q is required")
+		return echo.NewHTTPError(http.StatusBadRequest, "q is required")
+	}
+
+	limit := 10
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limitNum, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.Logger.Error("limit must be an integer")
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be an integer")
+		}
+		limit = limitNum
+	}
+
+	beforeSentAt := time.Now()
+	if beforeSentAtStr := c.QueryParam("before_sent_at"); beforeSentAtStr != "" && beforeSentAtStr != "undefined" {
+		fixedStr := strings.Replace(beforeSentAtStr, " ", "+", 1)
+		var err error
+		beforeSentAt, err = time.Parse(time.RFC3339Nano, fixedStr)
+		if err != nil {
+			h.Logger.Error("before_sent_at must be in RFC3339 format")
+			return echo.NewHTTPError(http.StatusBadRequest, "before_sent_at must be in RFC3339 format")
+		}
+	}
+
+	res, err := h.MsgUseCase.SearchMessages(ctx, messagecase.SearchMessagesRequest{
+		UserID:       entity.UserID(userID),
+		Query:        query,
+		RoomID:       entity.RoomID(c.QueryParam("room_id")),
+		Limit:        limit,
+		BeforeSentAt: beforeSentAt,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to search messages", err)
+		return newMessageHTTPError(err, "Failed to search messages")
+	}
+
+	results := make([]SearchResultResponse, len(res.Results))
+	for i, result := range res.Results {
+		results[i] = SearchResultResponse{
+			MessageResponse: newMessageResponse(result.Message),
+			Snippet:         result.Snippet,
+		}
+	}
+	return c.JSON(http.StatusOK, SearchMessagesResponse{
+		Results:          results,
+		NextBeforeSentAt: res.NextBeforeSentAt.Format(time.RFC3339Nano),
+		HasNext:          res.HasNext,
+	})
+}
@@ -0,0 +1,87 @@
+package messagehandler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/interface/handler/messagehandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := messagehandler.NewTestMessageHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(userID string, query url.Values) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/message/search?"+query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		return c, rec
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		before := time.Date(2023, 1, 1, 12, 0, 0, 500, time.UTC)
+		mockDeps.MsgUseCase.EXPECT().
+			SearchMessages(gomock.Any(), messagecase.SearchMessagesRequest{
+				UserID:       "user1",
+				Query:        "定例 会議",
+				RoomID:       "room1",
+				Limit:        5,
+				BeforeSentAt: before,
+			}).
+			Return(messagecase.SearchMessagesResponse{
+				Results: []*messagecase.SearchResult{{
+					Message: entity.NewMessage(entity.MessageParams{ID: "msg1", RoomID: "room1", Content: "定例の会議"}),
+					Snippet: "<mark>定例</mark>の<mark>会議</mark>",
+				}},
+			}, nil)
+
+		c, rec := newContext("user1", url.Values{
+			"q":              {"定例 会議"},
+			"room_id":        {"room1"},
+			"limit":          {"5"},
+			"before_sent_at": {before.Format(time.RFC3339Nano)},
+		})
+		assert.NoError(t, handler.SearchMessages(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var body messagehandler.SearchMessagesResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		if assert.Len(t, body.Results, 1) {
+			assert.Equal(t, "msg1", body.Results[0].ID)
+			assert.Equal(t, "<mark>定例</mark>の<mark>会議</mark>", body.Results[0].Snippet)
+		}
+	})
+
+	t.Run("ユーザーIDがない", func(t *testing.T) {
+		c, _ := newContext("", url.Values{"q": {"会議"}})
+		err := handler.SearchMessages(c)
+		assert.Equal(t, http.StatusUnauthorized, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("q がない", func(t *testing.T) {
+		c, _ := newContext("user1", url.Values{"q": {" "}})
+		err := handler.SearchMessages(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("before_sent_at の形式が不正", func(t *testing.T) {
+		c, _ := newContext("user1", url.Values{"q": {"会議"}, "before_sent_at": {"yesterday"}})
+		err := handler.SearchMessages(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+}
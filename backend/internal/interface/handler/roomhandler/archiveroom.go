@@ -0,0 +1,56 @@
+package roomhandler
+
+import (
+	"net/http"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+// ArchiveRoom は部屋をアーカイブするハンドラーです。部屋のオーナーのみ操作できます。
+func (h *RoomHandler) ArchiveRoom(c echo.Context) error {
+	return h.setRoomArchived(c, true)
+}
+
+// UnarchiveRoom は部屋のアーカイブを解除するハンドラーです。部屋のオーナーのみ操作できます。
+func (h *RoomHandler) UnarchiveRoom(c echo.Context) error {
+	return h.setRoomArchived(c, false)
+}
+
+func (h *RoomHandler) setRoomArchived(c echo.Context, archived bool) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+
+	req := roomcase.ArchiveRoomRequest{
+		RoomID: entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
+	}
+	var err error
+	if archived {
+		err = h.RoomUseCase.ArchiveRoom(ctx, req)
+	} else {
+		err = h.RoomUseCase.UnarchiveRoom(ctx, req)
+	}
+	if err != nil {
+		h.Logger.Error("Failed to update room archive state", err)
+		return newRoomHTTPError(err, "Failed to update room archive state")
+	}
+
+	h.Logger.Info("Updated room archive state successfully", map[string]any{
+		"roomID":   roomID,
+		"archived": archived,
+	})
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,61 @@
+package roomhandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. アーカイブ
+// 2. アーカイブ解除
+// 3. オーナー以外は操作できない
+func TestArchiveRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(method string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/room/room123/archive", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id")
+		c.SetParamValues("room123")
+		c.Set("user_id", "user123")
+		return c, rec
+	}
+	expected := roomcase.ArchiveRoomRequest{RoomID: "room123", UserID: "user123"}
+
+	t.Run("1. アーカイブ", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().ArchiveRoom(gomock.Any(), expected).Return(nil)
+
+		c, rec := newContext(http.MethodPost)
+		assert.NoError(t, handler.ArchiveRoom(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("2. アーカイブ解除", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UnarchiveRoom(gomock.Any(), expected).Return(nil)
+
+		c, rec := newContext(http.MethodDelete)
+		assert.NoError(t, handler.UnarchiveRoom(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("3. オーナー以外は操作できない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().ArchiveRoom(gomock.Any(), expected).Return(entity.ErrInsufficientRoomRole)
+
+		c, _ := newContext(http.MethodPost)
+		err := handler.ArchiveRoom(c)
+		assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	})
+}
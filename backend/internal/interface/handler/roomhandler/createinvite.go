@@ -0,0 +1,69 @@
+package roomhandler
+
+import (
+	"net/http"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+type CreateInviteRequest struct {
+	ExpiresIn int `json:"expires_in" validate:"required,gt=0"` // 有効期間（秒）
+	MaxUses   int `json:"max_uses" validate:"required,gt=0"`   // 利用できる回数の上限
+}
+
+type CreateInviteResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	MaxUses   int       `json:"max_uses"`
+}
+
+// CreateInvite は部屋への招待リンクを作成するハンドラーです。
+// 部屋のオーナーのみ作成でき、トークンは `POST /api/room/join/:token` で使用します。
+func (h *RoomHandler) CreateInvite(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+
+	var req CreateInviteRequest
+	if err := c.Bind(&req); err != nil {
+		h.Logger.Error("Failed to bind request", err, req)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+	if err := c.Validate(req); err != nil {
+		h.Logger.Error("Validation failed", err)
+		return echo.NewHTTPError(http.StatusBadRequest, "Validation failed: "+err.Error())
+	}
+
+	res, err := h.RoomUseCase.CreateInvite(ctx, roomcase.CreateInviteRequest{
+		RoomID:    entity.RoomID(roomID),
+		UserID:    entity.UserID(userID),
+		MaxUses:   req.MaxUses,
+		ExpiresIn: time.Duration(req.ExpiresIn) * time.Second,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to create invite", err)
+		return newRoomHTTPError(err, "Failed to create invite")
+	}
+
+	h.Logger.Info("Invite created successfully", map[string]any{
+		"roomID": roomID,
+	})
+
+	return c.JSON(http.StatusCreated, CreateInviteResponse{
+		Token:     string(res.Invite.GetToken()),
+		ExpiresAt: res.Invite.GetExpiresAt(),
+		MaxUses:   res.Invite.GetMaxUses(),
+	})
+}
@@ -0,0 +1,76 @@
+package roomhandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系
+// 2. max_uses が指定されていない
+// 3. オーナー以外は作成できない
+func TestCreateInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/room/room1/invites", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id")
+		c.SetParamValues("room1")
+		c.Set("user_id", "owner")
+		return c, rec
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		expiresAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockDeps.RoomUseCase.EXPECT().CreateInvite(gomock.Any(), roomcase.CreateInviteRequest{
+			RoomID:    "room1",
+			UserID:    "owner",
+			MaxUses:   3,
+			ExpiresIn: time.Hour,
+		}).Return(roomcase.CreateInviteResponse{
+			Invite: entity.NewRoomInvite(entity.RoomInviteParams{
+				Token:     "token1",
+				RoomID:    "room1",
+				MaxUses:   3,
+				ExpiresAt: expiresAt,
+			}),
+		}, nil)
+
+		c, rec := newContext(`{"expires_in":3600,"max_uses":3}`)
+		assert.NoError(t, handler.CreateInvite(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"token":"token1","expires_at":"2024-01-01T00:00:00Z","max_uses":3}`, rec.Body.String())
+	})
+
+	t.Run("max_uses が指定されていない", func(t *testing.T) {
+		c, _ := newContext(`{"expires_in":3600}`)
+		err := handler.CreateInvite(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("オーナー以外は作成できない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().CreateInvite(gomock.Any(), gomock.Any()).
+			Return(roomcase.CreateInviteResponse{}, entity.ErrInsufficientRoomRole)
+
+		c, _ := newContext(`{"expires_in":3600,"max_uses":3}`)
+		err := handler.CreateInvite(c)
+		assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	})
+}
@@ -9,12 +9,13 @@ import (
 )
 
 type CreateRoomRequest struct {
-	Name string `json:"name" validate:"required"`
+	Name       string `json:"name" validate:"required"`
+	Visibility string `json:"visibility" validate:"omitempty,oneof=public private"` // 省略時は public
 }
 
 // CreateRoom は新しいルームを作成するハンドラーです。
 // 名前からルームIDを生成し、ルームを作成します。
-// ユーザーは自動的にそのルームに参加します。
+// 作成したユーザーはオーナーとしてそのルームに参加します。
 func (h *RoomHandler) CreateRoom(c echo.Context) error {
 	ctx := c.Request().Context()
 	var req CreateRoomRequest
@@ -36,23 +37,19 @@ func (h *RoomHandler) CreateRoom(c echo.Context) error {
 	}
 
 	// 部屋作成
-	createRoomRes, err := h.RoomUseCase.CreateRoom(ctx, roomcase.CreateRoomRequest{Name: req.Name})
+	createRoomRes, err := h.RoomUseCase.CreateRoom(ctx, roomcase.CreateRoomRequest{
+		Name:       req.Name,
+		CreatorID:  entity.UserID(userID),
+		Visibility: entity.RoomVisibility(req.Visibility),
+	})
 	if err != nil {
 		h.Logger.Error("Failed to create room", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create room: "+err.Error())
+		return newRoomHTTPError(err, "Failed to create room: "+err.Error())
 	}
 	room := createRoomRes.Room
 
-	if err = h.RoomUseCase.JoinRoom(ctx, roomcase.JoinRoomRequest{
-		RoomID: room.GetID(),
-		UserID: entity.UserID(userID),
-	}); err != nil {
-		h.Logger.Error("Failed to add user to room", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to join room: "+err.Error())
-	}
-
 	// NOTE: WebSocketの接続 (ConnectUserToRoom) はこのタイミングでは行わない。
-	// - 部屋の作成および論理参加（JoinRoom）のみを行う
+	// - 部屋の作成およびオーナーとしての論理参加のみを行う
 	// - 実際のWebSocket接続はフロントエンド側で、部屋作成完了後に `/ws` へ接続する形で行う
 
 	h.Logger.Info("Room created successfully", map[string]any{
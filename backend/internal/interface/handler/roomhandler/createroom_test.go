@@ -17,7 +17,6 @@ import (
 // 3. バリデーション失敗
 // 4. コンテキストにユーザーIDがない
 // 5. ルーム作成失敗
-// 6. ルーム参加失敗
 func TestCreateRoom(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -27,7 +26,11 @@ func TestCreateRoom(t *testing.T) {
 	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
 
 	t.Run("正常系", func(t *testing.T) {
-		mockDeps.RoomUseCase.EXPECT().CreateRoom(gomock.Any(), gomock.Any()).Return(
+		// 作成者がオーナーとして登録されるよう CreatorID を渡す
+		mockDeps.RoomUseCase.EXPECT().CreateRoom(gomock.Any(), roomcase.CreateRoomRequest{
+			Name:      "Test Room",
+			CreatorID: "user123",
+		}).Return(
 			roomcase.CreateRoomResponse{
 				Room: entity.NewRoom(entity.RoomParams{
 					ID:      "room123",
@@ -36,7 +39,6 @@ func TestCreateRoom(t *testing.T) {
 				}),
 			}, nil,
 		)
-		mockDeps.RoomUseCase.EXPECT().JoinRoom(gomock.Any(), gomock.Any()).Return(nil)
 
 		req := httptest.NewRequest("POST", "/rooms", strings.NewReader(`{"name":"Test Room"}`))
 		req.Header.Set("Content-Type", "application/json")
@@ -107,28 +109,4 @@ func TestCreateRoom(t *testing.T) {
 			t.Error("expected CreateRoom error, got nil")
 		}
 	})
-
-	t.Run("JoinRoom失敗", func(t *testing.T) {
-		mockDeps.RoomUseCase.EXPECT().CreateRoom(gomock.Any(), gomock.Any()).Return(
-			roomcase.CreateRoomResponse{
-				Room: entity.NewRoom(entity.RoomParams{
-					ID:      "room123",
-					Name:    "Test Room",
-					Members: []entity.UserID{},
-				}),
-			}, nil,
-		)
-		mockDeps.RoomUseCase.EXPECT().JoinRoom(gomock.Any(), gomock.Any()).Return(assert.AnError)
-
-		req := httptest.NewRequest("POST", "/rooms", strings.NewReader(`{"name":"Test Room"}`))
-		req.Header.Set("Content-Type", "application/json")
-		rec := httptest.NewRecorder()
-		c := e.NewContext(req, rec)
-		c.Set("user_id", "user123")
-
-		err := handler.CreateRoom(c)
-		if err == nil {
-			t.Error("expected JoinRoom error, got nil")
-		}
-	})
 }
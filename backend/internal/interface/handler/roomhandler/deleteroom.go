@@ -0,0 +1,40 @@
+package roomhandler
+
+import (
+	"net/http"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+// DeleteRoom は部屋を削除するハンドラーです。部屋のオーナーのみ削除できます。
+func (h *RoomHandler) DeleteRoom(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+
+	if err := h.RoomUseCase.DeleteRoom(ctx, roomcase.DeleteRoomRequest{
+		RoomID: entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
+	}); err != nil {
+		h.Logger.Error("Failed to delete room", err)
+		return newRoomHTTPError(err, "Failed to delete room")
+	}
+
+	h.Logger.Info("Deleted room successfully", map[string]any{
+		"roomID": roomID,
+		"userID": userID,
+	})
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,61 @@
+package roomhandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系
+// 2. オーナー以外は削除できない
+// 3. 部屋が存在しない
+func TestDeleteRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/room/room123", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id")
+		c.SetParamValues("room123")
+		c.Set("user_id", "user123")
+		return c, rec
+	}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().DeleteRoom(gomock.Any(), roomcase.DeleteRoomRequest{RoomID: "room123", UserID: "user123"}).Return(nil)
+
+		c, rec := newContext()
+		assert.NoError(t, handler.DeleteRoom(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("2. オーナー以外は削除できない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().DeleteRoom(gomock.Any(), gomock.Any()).Return(entity.ErrInsufficientRoomRole)
+
+		c, _ := newContext()
+		err := handler.DeleteRoom(c)
+		assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("3. 部屋が存在しない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().DeleteRoom(gomock.Any(), gomock.Any()).Return(repository.ErrRoomNotFound)
+
+		c, _ := newContext()
+		err := handler.DeleteRoom(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+}
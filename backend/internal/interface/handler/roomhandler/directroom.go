@@ -0,0 +1,90 @@
+package roomhandler
+
+import (
+	"net/http"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+type DirectRoomResponse struct {
+	ID     string `json:"room_id"`
+	PeerID string `json:"peer_id"` // 相手のユーザーID
+}
+
+// newDirectRoomResponse はダイレクトメッセージの部屋を userID から見たレスポンスに変換する
+func newDirectRoomResponse(room *entity.Room, userID entity.UserID) DirectRoomResponse {
+	res := DirectRoomResponse{ID: string(room.GetID())}
+	for _, memberID := range room.GetMembers() {
+		if memberID != userID {
+			res.PeerID = string(memberID)
+		}
+	}
+	return res
+}
+
+// OpenDirectRoom は指定したユーザーとのダイレクトメッセージの部屋を返すハンドラーです。
+// すでに部屋がある場合は既存の部屋を 200 で、新しく作成した場合は 201 で返します。
+func (h *RoomHandler) OpenDirectRoom(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	peerID := c.Param("user_id")
+	if peerID == "" {
+		h.Logger.Error("Peer user ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Peer user ID is required")
+	}
+
+	res, err := h.RoomUseCase.OpenDirectRoom(ctx, roomcase.OpenDirectRoomRequest{
+		UserID: entity.UserID(userID),
+		PeerID: entity.UserID(peerID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to open direct room", err)
+		return newRoomHTTPError(err, "Failed to open direct room")
+	}
+
+	h.Logger.Info("Opened direct room successfully", map[string]any{
+		"roomID":  res.Room.GetID(),
+		"created": res.Created,
+	})
+
+	status := http.StatusOK
+	if res.Created {
+		status = http.StatusCreated
+	}
+	return c.JSON(status, newDirectRoomResponse(res.Room, entity.UserID(userID)))
+}
+
+// GetDirectRooms は参加しているダイレクトメッセージの一覧を取得するハンドラーです。
+// ダイレクトメッセージは GetRooms の一覧には含まれません。
+func (h *RoomHandler) GetDirectRooms(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	rooms, err := h.RoomUseCase.GetDirectRooms(ctx, roomcase.GetDirectRoomsRequest{
+		UserID: entity.UserID(userID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to get direct rooms", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get direct rooms")
+	}
+
+	res := []DirectRoomResponse{}
+	for _, room := range rooms {
+		res = append(res, newDirectRoomResponse(room, entity.UserID(userID)))
+	}
+
+	h.Logger.Info("Got direct rooms successfully")
+
+	return c.JSON(http.StatusOK, res)
+}
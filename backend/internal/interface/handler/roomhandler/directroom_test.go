@@ -0,0 +1,99 @@
+package roomhandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 新しく作成した場合は 201
+// 2. 既存の部屋の場合は 200
+// 3. 自分自身とは開けない
+// 4. 相手のユーザーが存在しない
+func TestOpenDirectRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/room/dm/user2", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues("user2")
+		c.Set("user_id", "user1")
+		return c, rec
+	}
+	directRoom := entity.NewDirectRoom("dm1", "user1", "user2", time.Time{})
+
+	t.Run("新しく作成した場合は 201", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().OpenDirectRoom(gomock.Any(), roomcase.OpenDirectRoomRequest{
+			UserID: "user1",
+			PeerID: "user2",
+		}).Return(roomcase.OpenDirectRoomResponse{Room: directRoom, Created: true}, nil)
+
+		c, rec := newContext()
+		assert.NoError(t, handler.OpenDirectRoom(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"room_id":"dm1","peer_id":"user2"}`, rec.Body.String())
+	})
+
+	t.Run("既存の部屋の場合は 200", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().OpenDirectRoom(gomock.Any(), gomock.Any()).
+			Return(roomcase.OpenDirectRoomResponse{Room: directRoom}, nil)
+
+		c, rec := newContext()
+		assert.NoError(t, handler.OpenDirectRoom(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("自分自身とは開けない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().OpenDirectRoom(gomock.Any(), gomock.Any()).
+			Return(roomcase.OpenDirectRoomResponse{}, roomcase.ErrDirectRoomWithSelf)
+
+		c, _ := newContext()
+		err := handler.OpenDirectRoom(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("相手のユーザーが存在しない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().OpenDirectRoom(gomock.Any(), gomock.Any()).
+			Return(roomcase.OpenDirectRoomResponse{}, repository.ErrUserNotFound)
+
+		c, _ := newContext()
+		err := handler.OpenDirectRoom(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+}
+
+func TestGetDirectRooms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any()).AnyTimes()
+
+	mockDeps.RoomUseCase.EXPECT().GetDirectRooms(gomock.Any(), roomcase.GetDirectRoomsRequest{UserID: "user1"}).
+		Return([]*entity.Room{entity.NewDirectRoom("dm1", "user2", "user1", time.Time{})}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/room/dm", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", "user1")
+
+	assert.NoError(t, handler.GetDirectRooms(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"room_id":"dm1","peer_id":"user2"}]`, rec.Body.String())
+}
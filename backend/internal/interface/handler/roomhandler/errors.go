@@ -0,0 +1,53 @@
+package roomhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+// newRoomHTTPError は部屋の操作で発生したエラーを HTTP エラーに変換する
+func newRoomHTTPError(err error, message string) *echo.HTTPError {
+	switch {
+	case errors.Is(err, repository.ErrRoomNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "room not found")
+	case errors.Is(err, repository.ErrNotRoomMember):
+		return echo.NewHTTPError(http.StatusForbidden, "not a member of the room")
+	case errors.Is(err, entity.ErrInsufficientRoomRole):
+		return echo.NewHTTPError(http.StatusForbidden, "insufficient room role")
+	case errors.Is(err, entity.ErrRoomBanned):
+		return echo.NewHTTPError(http.StatusForbidden, "banned from the room")
+	case errors.Is(err, repository.ErrBanNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "ban not found")
+	case errors.Is(err, repository.ErrMuteNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "mute not found")
+	case errors.Is(err, repository.ErrJoinRequestNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "join request not found")
+	case errors.Is(err, repository.ErrJoinRequestAlreadyExists), errors.Is(err, roomcase.ErrAlreadyRoomMember):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, roomcase.ErrOwnerCannotLeave):
+		return echo.NewHTTPError(http.StatusConflict, "room owner cannot leave the room")
+	case errors.Is(err, repository.ErrUserNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "user not found")
+	case errors.Is(err, roomcase.ErrInvalidVisibility), errors.Is(err, roomcase.ErrInvalidInvite),
+		errors.Is(err, roomcase.ErrDirectRoomWithSelf), errors.Is(err, roomcase.ErrInvalidRoomName),
+		errors.Is(err, roomcase.ErrDescriptionTooLong), errors.Is(err, roomcase.ErrInvalidPinLimit),
+		errors.Is(err, roomcase.ErrInvalidSlowMode), errors.Is(err, service.ErrIconTooLarge),
+		errors.Is(err, service.ErrInvalidIconType), errors.Is(err, roomcase.ErrInvalidSort),
+		errors.Is(err, roomcase.ErrInvalidCursor), errors.Is(err, roomcase.ErrCannotModerateSelf),
+		errors.Is(err, roomcase.ErrModerationReasonTooLong), errors.Is(err, roomcase.ErrInvalidMuteDuration),
+		errors.Is(err, roomcase.ErrJoinRequestNotRequired), errors.Is(err, roomcase.ErrJoinRequestMessageTooLong):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, repository.ErrInviteNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "invite not found")
+	case errors.Is(err, roomcase.ErrInviteExpired), errors.Is(err, roomcase.ErrInviteExhausted):
+		return echo.NewHTTPError(http.StatusGone, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, message)
+	}
+}
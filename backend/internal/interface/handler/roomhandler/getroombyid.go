@@ -2,6 +2,7 @@ package roomhandler
 
 import (
 	"net/http"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/usecase/roomcase"
@@ -9,9 +10,14 @@ import (
 )
 
 type GetRoomResponse struct {
-	ID      string     `json:"room_id"`
-	Name    string     `json:"name"`
-	Members []MemberID `json:"members"`
+	ID              string     `json:"room_id"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	PinLimit        int        `json:"pin_limit"`         // ピン留めできるメッセージ数の上限
+	SlowModeSeconds int        `json:"slow_mode_seconds"` // スローモードの間隔（秒）。0 の場合は無効
+	Visibility      string     `json:"visibility"`
+	ArchivedAt      *time.Time `json:"archived_at,omitempty"` // アーカイブされている場合のみ
+	Members         []MemberID `json:"members"`
 }
 type MemberID struct {
 	ID string `json:"id"`
@@ -25,20 +31,33 @@ func (h *RoomHandler) GetRoomByID(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
 	}
 
+	// 非公開の部屋はメンバーのみ取得できる
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
 	GetRoomRes, err := h.RoomUseCase.GetRoomByID(ctx, roomcase.GetRoomByIDRequest{
-		ID: entity.RoomID(roomID),
+		ID:     entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
 	})
 	if err != nil {
 		h.Logger.Error("Failed to get room", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get room: "+err.Error())
+		return newRoomHTTPError(err, "Failed to get room: "+err.Error())
 	}
 
 	room := GetRoomRes.Room
 
 	res := GetRoomResponse{
-		ID:      string(room.GetID()),
-		Name:    room.GetName(),
-		Members: []MemberID{},
+		ID:              string(room.GetID()),
+		Name:            room.GetName(),
+		Description:     room.GetDescription(),
+		PinLimit:        room.GetPinLimit(),
+		SlowModeSeconds: int(room.GetSlowMode() / time.Second),
+		Visibility:      string(room.GetVisibility()),
+		ArchivedAt:      room.GetArchivedAt(),
+		Members:         []MemberID{},
 	}
 
 	for _, memberID := range room.GetMembers() {
@@ -25,7 +25,10 @@ func TestGetRoomByID(t *testing.T) {
 	// 1. 正常系
 	t.Run("正常系", func(t *testing.T) {
 		roomID := "test-room-id"
-		mockDeps.RoomUseCase.EXPECT().GetRoomByID(gomock.Any(), gomock.Any()).Return(
+		mockDeps.RoomUseCase.EXPECT().GetRoomByID(gomock.Any(), roomcase.GetRoomByIDRequest{
+			ID:     entity.RoomID(roomID),
+			UserID: "user1",
+		}).Return(
 			roomcase.GetRoomByIDResponse{
 				Room: entity.NewRoom(entity.RoomParams{
 					ID:      entity.RoomID(roomID),
@@ -41,6 +44,7 @@ func TestGetRoomByID(t *testing.T) {
 		c.SetPath("/rooms/:room_id")
 		c.SetParamNames("room_id")
 		c.SetParamValues(roomID)
+		c.Set("user_id", "user1")
 
 		if err := handler.GetRoomByID(c); err != nil {
 			t.Errorf("Expected no error, got %v", err)
@@ -86,6 +90,7 @@ func TestGetRoomByID(t *testing.T) {
 		c.SetPath("/rooms/:room_id")
 		c.SetParamNames("room_id")
 		c.SetParamValues(roomID)
+		c.Set("user_id", "user1")
 
 		err := handler.GetRoomByID(c)
 		if err == nil {
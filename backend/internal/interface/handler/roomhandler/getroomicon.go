@@ -0,0 +1,42 @@
+package roomhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetRoomIcon は部屋のアイコンの URL にリダイレクトするハンドラーです。
+func (h *RoomHandler) GetRoomIcon(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+
+	iconURL, err := h.RoomUseCase.GetRoomIconPath(ctx, roomcase.GetRoomIconPathRequest{
+		RoomID: entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
+	})
+	if errors.Is(err, repository.ErrRoomNotFound) {
+		return newRoomHTTPError(err, "Failed to get room icon")
+	}
+	if err != nil {
+		// アイコンが未設定の場合もここに来る
+		h.Logger.Error("Failed to get room icon", err)
+		return echo.NewHTTPError(http.StatusNotFound, "Icon not found")
+	}
+
+	return c.Redirect(http.StatusFound, iconURL)
+}
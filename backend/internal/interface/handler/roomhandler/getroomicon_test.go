@@ -0,0 +1,63 @@
+package roomhandler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系: アイコンにリダイレクト
+// 2. アイコンが未設定
+// 3. 部屋が見えない
+func TestGetRoomIcon(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/room/room123/icon", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id")
+		c.SetParamValues("room123")
+		c.Set("user_id", "user123")
+		return c, rec
+	}
+
+	t.Run("1. 正常系: アイコンにリダイレクト", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().
+			GetRoomIconPath(gomock.Any(), roomcase.GetRoomIconPathRequest{RoomID: "room123", UserID: "user123"}).
+			Return("/icons/rooms/room123.webp", nil)
+
+		c, rec := newContext()
+		assert.NoError(t, handler.GetRoomIcon(c))
+		assert.Equal(t, http.StatusFound, rec.Code)
+		assert.Equal(t, "/icons/rooms/room123.webp", rec.Header().Get(echo.HeaderLocation))
+	})
+
+	t.Run("2. アイコンが未設定", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().GetRoomIconPath(gomock.Any(), gomock.Any()).Return("", errors.New("file not found"))
+
+		c, _ := newContext()
+		err := handler.GetRoomIcon(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("3. 部屋が見えない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().GetRoomIconPath(gomock.Any(), gomock.Any()).Return("", repository.ErrRoomNotFound)
+
+		c, _ := newContext()
+		err := handler.GetRoomIcon(c)
+		assert.Equal(t, "room not found", err.(*echo.HTTPError).Message)
+	})
+}
@@ -0,0 +1,86 @@
+package roomhandler
+
+import (
+	"net/http"
+	"strconv"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+// userIconURLPrefix はユーザーのアイコンにリダイレクトするエンドポイント（GET /api/user/icon/:user_id）です。
+const userIconURLPrefix = "/api/user/icon/"
+
+type GetRoomMembersResponse struct {
+	Members    []RoomMemberResponse `json:"members"`
+	NextCursor string               `json:"next_cursor,omitempty"` // 続きがある場合のみ
+	HasNext    bool                 `json:"has_next"`
+}
+
+type RoomMemberResponse struct {
+	UserID  string `json:"user_id"`
+	Name    string `json:"name"`
+	Role    string `json:"role"`
+	IconURL string `json:"icon_url"`
+	Status  string `json:"status"` // オンライン状態（online / away / offline）
+}
+
+// GetRoomMembers は部屋のメンバーを名前順に返します。
+// - `q` パラメータで名前を部分一致で絞り込めます。
+// - `limit` パラメータで取得するメンバー数を制限できます（デフォルトは50、上限は200）。
+// - `cursor` パラメータに前のレスポンスの `next_cursor` を指定すると続きを取得します。
+func (h *RoomHandler) GetRoomMembers(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+
+	var limit int
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limitNum, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.Logger.Error("limit must be an integer")
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be an integer")
+		}
+		limit = limitNum
+	}
+
+	result, err := h.RoomUseCase.ListRoomMembers(ctx, roomcase.ListRoomMembersRequest{
+		RoomID: entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
+		Query:  c.QueryParam("q"),
+		Limit:  limit,
+		Cursor: c.QueryParam("cursor"),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to get room members", err)
+		return newRoomHTTPError(err, "Failed to get room members")
+	}
+
+	res := GetRoomMembersResponse{
+		Members:    []RoomMemberResponse{},
+		NextCursor: result.NextCursor,
+		HasNext:    result.HasNext,
+	}
+	for _, status := range result.Members {
+		user := status.Member.GetUser()
+		res.Members = append(res.Members, RoomMemberResponse{
+			UserID:  string(user.GetID()),
+			Name:    user.GetName(),
+			Role:    string(status.Member.GetRole()),
+			IconURL: userIconURLPrefix + string(user.GetID()),
+			Status:  string(status.Status),
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
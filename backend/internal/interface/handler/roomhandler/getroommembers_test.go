@@ -0,0 +1,90 @@
+package roomhandler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系
+// 2. limit が整数でない
+// 3. 部屋が見えない
+func TestGetRoomMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(query string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/api/room/room123/members"+query, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id")
+		c.SetParamValues("room123")
+		c.Set("user_id", "user123")
+		return c, rec
+	}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().ListRoomMembers(gomock.Any(), roomcase.ListRoomMembersRequest{
+			RoomID: "room123",
+			UserID: "user123",
+			Query:  "al",
+			Limit:  1,
+			Cursor: "abc",
+		}).Return(roomcase.ListRoomMembersResponse{
+			Members: []*roomcase.RoomMemberStatus{{
+				Member: entity.NewRoomMember(entity.RoomMemberParams{
+					User: entity.NewUser(entity.UserParams{ID: "user456", Name: "alice"}),
+					Role: entity.RoomRoleAdmin,
+				}),
+				Status: entity.PresenceAway,
+			}},
+			NextCursor: "next",
+			HasNext:    true,
+		}, nil)
+
+		c, rec := newContext("?q=al&limit=1&cursor=abc")
+		assert.NoError(t, handler.GetRoomMembers(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res roomhandler.GetRoomMembersResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Equal(t, roomhandler.GetRoomMembersResponse{
+			Members: []roomhandler.RoomMemberResponse{{
+				UserID:  "user456",
+				Name:    "alice",
+				Role:    "admin",
+				IconURL: "/api/user/icon/user456",
+				Status:  "away",
+			}},
+			NextCursor: "next",
+			HasNext:    true,
+		}, res)
+	})
+
+	t.Run("2. limit が整数でない", func(t *testing.T) {
+		c, _ := newContext("?limit=abc")
+		err := handler.GetRoomMembers(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("3. 部屋が見えない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().ListRoomMembers(gomock.Any(), gomock.Any()).
+			Return(roomcase.ListRoomMembersResponse{}, repository.ErrRoomNotFound)
+
+		c, _ := newContext("")
+		err := handler.GetRoomMembers(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+}
@@ -2,32 +2,120 @@ package roomhandler
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/roomcase"
 	"github.com/labstack/echo/v4"
 )
 
+// lastMessagePreviewLength は一覧に含める最新のメッセージの本文の長さ（文字数）です。
+const lastMessagePreviewLength = 100
+
 type GetRoomsResponse struct {
-	ID   string `json:"room_id"`
-	Name string `json:"name"`
+	Rooms      []RoomSummaryResponse `json:"rooms"`
+	NextCursor string                `json:"next_cursor,omitempty"` // 続きがある場合のみ
+	HasNext    bool                  `json:"has_next"`
+}
+
+type RoomSummaryResponse struct {
+	ID              string                  `json:"room_id"`
+	Name            string                  `json:"name"`
+	Description     string                  `json:"description"`
+	PinLimit        int                     `json:"pin_limit"`         // ピン留めできるメッセージ数の上限
+	SlowModeSeconds int                     `json:"slow_mode_seconds"` // スローモードの間隔（秒）。0 の場合は無効
+	Visibility      string                  `json:"visibility"`
+	ArchivedAt      *time.Time              `json:"archived_at,omitempty"` // アーカイブされている場合のみ
+	MemberCount     int                     `json:"member_count"`
+	UnreadCount     int                     `json:"unread_count"`           // 既読位置より後の、他のユーザーのメッセージ数（参加していない部屋は 0）
+	MentionCount    int                     `json:"mention_count"`          // unread_count のうち、自分へのメンションを含むメッセージ数
+	LastMessage     *LastMessagePreviewResp `json:"last_message,omitempty"` // メッセージがない場合は省略
 }
 
+type LastMessagePreviewResp struct {
+	ID      string    `json:"id"`
+	UserID  string    `json:"user_id"`
+	Preview string    `json:"preview"` // 本文の先頭 lastMessagePreviewLength 文字
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// GetRooms は公開されている部屋と、参加している非公開の部屋を返します。
+// - `q` パラメータで部屋名を部分一致で絞り込めます。
+// - `sort` パラメータで並び順を指定できます（name / created_at / member_count / last_activity、デフォルトは name）。
+// - `limit` パラメータで取得する部屋数を制限できます（デフォルトは20、上限は100）。
+// - `cursor` パラメータに前のレスポンスの `next_cursor` を指定すると続きを取得します。
 func (h *RoomHandler) GetRooms(c echo.Context) error {
 	ctx := c.Request().Context()
-	rooms, err := h.RoomUseCase.GetAllRooms(ctx)
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	var limit int
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limitNum, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.Logger.Error("limit must be an integer")
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be an integer")
+		}
+		limit = limitNum
+	}
+
+	result, err := h.RoomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{
+		UserID: entity.UserID(userID),
+		Query:  c.QueryParam("q"),
+		Sort:   repository.RoomSort(c.QueryParam("sort")),
+		Limit:  limit,
+		Cursor: c.QueryParam("cursor"),
+	})
 	if err != nil {
 		h.Logger.Error("Failed to get rooms", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get rooms")
+		return newRoomHTTPError(err, "Failed to get rooms")
 	}
 
-	res := []GetRoomsResponse{}
-	for _, room := range rooms {
-		res = append(res, GetRoomsResponse{
-			ID:   string(room.GetID()),
-			Name: room.GetName(),
-		})
+	res := GetRoomsResponse{
+		Rooms:      []RoomSummaryResponse{},
+		NextCursor: result.NextCursor,
+		HasNext:    result.HasNext,
+	}
+	for _, summary := range result.Rooms {
+		room := summary.GetRoom()
+		item := RoomSummaryResponse{
+			ID:              string(room.GetID()),
+			Name:            room.GetName(),
+			Description:     room.GetDescription(),
+			PinLimit:        room.GetPinLimit(),
+			SlowModeSeconds: int(room.GetSlowMode() / time.Second),
+			Visibility:      string(room.GetVisibility()),
+			ArchivedAt:      room.GetArchivedAt(),
+			MemberCount:     summary.GetMemberCount(),
+			UnreadCount:     summary.GetUnreadCount(),
+			MentionCount:    summary.GetMentionCount(),
+		}
+		if msg := summary.GetLastMessage(); msg != nil {
+			item.LastMessage = &LastMessagePreviewResp{
+				ID:      string(msg.GetID()),
+				UserID:  string(msg.GetUserID()),
+				Preview: previewContent(msg.GetContent()),
+				SentAt:  msg.GetSentAt(),
+			}
+		}
+		res.Rooms = append(res.Rooms, item)
 	}
 
 	h.Logger.Info("Got rooms successfully")
 
 	return c.JSON(http.StatusOK, res)
 }
+
+// previewContent は本文を lastMessagePreviewLength 文字に切り詰めます。
+func previewContent(content string) string {
+	runes := []rune(content)
+	if len(runes) <= lastMessagePreviewLength {
+		return content
+	}
+	return string(runes[:lastMessagePreviewLength]) + "…"
+}
@@ -1,19 +1,27 @@
 package roomhandler_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
 
 // 1. 正常系
-// 2. UseCase GetAllRooms がエラーを返す
+// 2. UseCase ListRooms がエラーを返す
+// 3. クエリパラメータを UseCase に渡す
+// 4. limit が整数でない
+// 5. 不正な並び順
 func TestGetRomms(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -22,16 +30,37 @@ func TestGetRomms(t *testing.T) {
 	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 	// 1. 正常系
 	t.Run("正常系", func(t *testing.T) {
-		mockDeps.RoomUseCase.EXPECT().GetAllRooms(gomock.Any()).Return(
-			[]*entity.Room{
-				entity.NewRoom(entity.RoomParams{
-					ID:   "room1",
-					Name: "Test Room 1",
-				}),
-				entity.NewRoom(entity.RoomParams{
-					ID:   "room2",
-					Name: "Test Room 2",
-				}),
+		sentAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockDeps.RoomUseCase.EXPECT().ListRooms(gomock.Any(), roomcase.ListRoomsRequest{UserID: "user1"}).Return(
+			roomcase.ListRoomsResponse{
+				Rooms: []*entity.RoomSummary{
+					entity.NewRoomSummary(entity.RoomSummaryParams{
+						Room: entity.NewRoom(entity.RoomParams{
+							ID:   "room1",
+							Name: "Test Room 1",
+						}),
+					}),
+					entity.NewRoomSummary(entity.RoomSummaryParams{
+						Room: entity.NewRoom(entity.RoomParams{
+							ID:          "room2",
+							Name:        "Test Room 2",
+							Description: "topic",
+							Visibility:  entity.RoomVisibilityPrivate,
+						}),
+						MemberCount:  3,
+						UnreadCount:  5,
+						MentionCount: 1,
+						LastMessage: entity.NewMessage(entity.MessageParams{
+							ID:      "msg1",
+							RoomID:  "room2",
+							UserID:  "user2",
+							Content: strings.Repeat("あ", 120),
+							SentAt:  sentAt,
+						}),
+					}),
+				},
+				NextCursor: "next",
+				HasNext:    true,
 			}, nil,
 		)
 
@@ -39,21 +68,38 @@ func TestGetRomms(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 		c.SetPath("/rooms")
+		c.Set("user_id", "user1")
 
 		if err := handler.GetRooms(c); err != nil {
 			t.Errorf("Expected no error, got %v", err)
 		}
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var res roomhandler.GetRoomsResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		assert.Len(t, res.Rooms, 2)
+		assert.Nil(t, res.Rooms[0].LastMessage)
+		assert.Equal(t, "topic", res.Rooms[1].Description)
+		assert.Equal(t, "private", res.Rooms[1].Visibility)
+		assert.Equal(t, 3, res.Rooms[1].MemberCount)
+		assert.Equal(t, 5, res.Rooms[1].UnreadCount)
+		assert.Equal(t, 1, res.Rooms[1].MentionCount)
+		assert.Equal(t, "msg1", res.Rooms[1].LastMessage.ID)
+		assert.Equal(t, strings.Repeat("あ", 100)+"…", res.Rooms[1].LastMessage.Preview)
+		assert.True(t, sentAt.Equal(res.Rooms[1].LastMessage.SentAt))
+		assert.Equal(t, "next", res.NextCursor)
+		assert.True(t, res.HasNext)
 	})
 
-	// 2. UseCase GetAllRooms がエラーを返す
-	t.Run("UseCase GetAllRooms がエラーを返す", func(t *testing.T) {
-		mockDeps.RoomUseCase.EXPECT().GetAllRooms(gomock.Any()).Return(nil, assert.AnError)
+	// 2. UseCase ListRooms がエラーを返す
+	t.Run("UseCase ListRooms がエラーを返す", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().ListRooms(gomock.Any(), gomock.Any()).Return(roomcase.ListRoomsResponse{}, assert.AnError)
 		mockDeps.Logger.EXPECT().Error("Failed to get rooms", gomock.Any()).Times(1)
 
 		req := httptest.NewRequest("GET", "/rooms", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 		c.SetPath("/rooms")
+		c.Set("user_id", "user1")
 
 		err := handler.GetRooms(c)
 		if err == nil {
@@ -69,4 +115,60 @@ func TestGetRomms(t *testing.T) {
 		assert.Equal(t, "Failed to get rooms", httpErr.Message)
 	})
 
+	// 3. クエリパラメータを UseCase に渡す
+	t.Run("クエリパラメータを UseCase に渡す", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().ListRooms(gomock.Any(), roomcase.ListRoomsRequest{
+			UserID: "user1",
+			Query:  "go",
+			Sort:   repository.RoomSortMemberCount,
+			Limit:  5,
+			Cursor: "abc",
+		}).Return(roomcase.ListRoomsResponse{}, nil)
+
+		req := httptest.NewRequest("GET", "/rooms?q=go&sort=member_count&limit=5&cursor=abc", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/rooms")
+		c.Set("user_id", "user1")
+
+		assert.NoError(t, handler.GetRooms(c))
+		assert.JSONEq(t, `{"rooms":[],"has_next":false}`, rec.Body.String())
+	})
+
+	// 4. limit が整数でない
+	t.Run("limit が整数でない", func(t *testing.T) {
+		mockDeps.Logger.EXPECT().Error("limit must be an integer").Times(1)
+
+		req := httptest.NewRequest("GET", "/rooms?limit=abc", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/rooms")
+		c.Set("user_id", "user1")
+
+		err := handler.GetRooms(c)
+		httpErr, ok := err.(*echo.HTTPError)
+		if !ok {
+			t.Fatalf("Expected *echo.HTTPError, got %T", err)
+		}
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	})
+
+	// 5. 不正な並び順
+	t.Run("不正な並び順", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().ListRooms(gomock.Any(), gomock.Any()).Return(roomcase.ListRoomsResponse{}, roomcase.ErrInvalidSort)
+		mockDeps.Logger.EXPECT().Error("Failed to get rooms", gomock.Any()).Times(1)
+
+		req := httptest.NewRequest("GET", "/rooms?sort=popular", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/rooms")
+		c.Set("user_id", "user1")
+
+		err := handler.GetRooms(c)
+		httpErr, ok := err.(*echo.HTTPError)
+		if !ok {
+			t.Fatalf("Expected *echo.HTTPError, got %T", err)
+		}
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	})
 }
@@ -20,5 +20,76 @@ type RoomHandlerInterface interface {
 	GetRoomByID(c echo.Context) error
 
 	// GetRooms は部屋の情報を一括取得するハンドラーです。
+	// 非公開の部屋は参加しているもののみ含めます。
 	GetRooms(c echo.Context) error
+
+	// GetRoomMembers は部屋のメンバーを名前順にページ単位で取得するハンドラーです。
+	// 各メンバーの役割・アイコンの URL・接続中かどうかを含めます。
+	GetRoomMembers(c echo.Context) error
+
+	// KickMember はユーザーを部屋から退出させ、部屋のコネクションを閉じるハンドラーです。
+	// 部屋の管理者以上が、自分より下の役割のメンバーに対してのみ操作できます。
+	KickMember(c echo.Context) error
+
+	// BanMember はユーザーを部屋から追放するハンドラーです。
+	// 追放されたユーザーは部屋に参加・接続できなくなります。
+	BanMember(c echo.Context) error
+
+	// UnbanMember は追放を解除するハンドラーです。
+	UnbanMember(c echo.Context) error
+
+	// MuteMember は期間を指定してメンバーの発言を禁止するハンドラーです。
+	// 接続は維持され、メッセージの送信のみが拒否されます。
+	MuteMember(c echo.Context) error
+
+	// UnmuteMember は発言の禁止を解除するハンドラーです。
+	UnmuteMember(c echo.Context) error
+
+	// RequestToJoin は非公開の部屋への参加をリクエストするハンドラーです。
+	// 部屋の管理者が承認するとメンバーとして追加されます。
+	RequestToJoin(c echo.Context) error
+
+	// GetJoinRequests は部屋の判断待ちの参加リクエストを取得するハンドラーです。
+	// 部屋の管理者以上のみ取得できます。
+	GetJoinRequests(c echo.Context) error
+
+	// ApproveJoinRequest は参加リクエストを承認するハンドラーです。
+	// リクエストしたユーザーには WebSocket で結果を通知します。
+	ApproveJoinRequest(c echo.Context) error
+
+	// DenyJoinRequest は参加リクエストを却下するハンドラーです。
+	// リクエストしたユーザーには WebSocket で結果を通知します。
+	DenyJoinRequest(c echo.Context) error
+
+	// CreateInvite は部屋への招待リンクを作成するハンドラーです。
+	// 部屋のオーナーのみ作成できます。
+	CreateInvite(c echo.Context) error
+
+	// RedeemInvite は招待リンクのトークンを使用して部屋に参加するハンドラーです。
+	RedeemInvite(c echo.Context) error
+
+	// OpenDirectRoom は指定したユーザーとのダイレクトメッセージの部屋を返すハンドラーです。
+	// 部屋がない場合は作成します。
+	OpenDirectRoom(c echo.Context) error
+
+	// GetDirectRooms は参加しているダイレクトメッセージの一覧を取得するハンドラーです。
+	GetDirectRooms(c echo.Context) error
+
+	// UpdateRoom は部屋名・説明・アイコンを更新するハンドラーです。
+	// 部屋の管理者以上のみ更新できます。
+	UpdateRoom(c echo.Context) error
+
+	// DeleteRoom は部屋を削除するハンドラーです。
+	// 部屋のオーナーのみ削除できます。
+	DeleteRoom(c echo.Context) error
+
+	// ArchiveRoom は部屋をアーカイブするハンドラーです。
+	// アーカイブされた部屋は履歴の閲覧のみ可能になります。
+	ArchiveRoom(c echo.Context) error
+
+	// UnarchiveRoom は部屋のアーカイブを解除するハンドラーです。
+	UnarchiveRoom(c echo.Context) error
+
+	// GetRoomIcon は部屋のアイコンにリダイレクトするハンドラーです。
+	GetRoomIcon(c echo.Context) error
 }
@@ -0,0 +1,161 @@
+package roomhandler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+type RequestToJoinRequest struct {
+	Message string `json:"message"` // 管理者へのメッセージ（任意）
+}
+
+type JoinRequestResponse struct {
+	RoomID    string    `json:"room_id"`
+	UserID    string    `json:"user_id"`
+	Message   string    `json:"message"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type GetJoinRequestsResponse struct {
+	Requests []JoinRequestResponse `json:"requests"`
+}
+
+func newJoinRequestResponse(req *entity.RoomJoinRequest) JoinRequestResponse {
+	return JoinRequestResponse{
+		RoomID:    string(req.GetRoomID()),
+		UserID:    string(req.GetUserID()),
+		Message:   req.GetMessage(),
+		Status:    string(req.GetStatus()),
+		CreatedAt: req.GetCreatedAt(),
+	}
+}
+
+// RequestToJoin は非公開の部屋への参加をリクエストするハンドラーです。
+// 公開の部屋には `POST /api/room/:room_id/join` で参加します。
+func (h *RoomHandler) RequestToJoin(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+
+	var req RequestToJoinRequest
+	if err := c.Bind(&req); err != nil {
+		h.Logger.Error("Failed to bind request", err, req)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+
+	joinRequest, err := h.RoomUseCase.RequestToJoin(ctx, roomcase.RequestToJoinRequest{
+		RoomID:  entity.RoomID(roomID),
+		UserID:  entity.UserID(userID),
+		Message: req.Message,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to request to join room", err)
+		return newRoomHTTPError(err, "Failed to request to join room")
+	}
+
+	h.Logger.Info("Join request created successfully", map[string]any{
+		"roomID": roomID,
+		"userID": userID,
+	})
+
+	return c.JSON(http.StatusCreated, newJoinRequestResponse(joinRequest))
+}
+
+// GetJoinRequests は部屋の判断待ちの参加リクエストを古い順に返すハンドラーです。
+func (h *RoomHandler) GetJoinRequests(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+
+	requests, err := h.RoomUseCase.ListJoinRequests(ctx, roomcase.ListJoinRequestsRequest{
+		RoomID: entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to get join requests", err)
+		return newRoomHTTPError(err, "Failed to get join requests")
+	}
+
+	res := GetJoinRequestsResponse{Requests: make([]JoinRequestResponse, 0, len(requests))}
+	for _, req := range requests {
+		res.Requests = append(res.Requests, newJoinRequestResponse(req))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ApproveJoinRequest は参加リクエストを承認し、リクエストしたユーザーをメンバーに追加するハンドラーです。
+func (h *RoomHandler) ApproveJoinRequest(c echo.Context) error {
+	return h.decideJoinRequest(c, entity.RoomJoinRequestApproved, h.RoomUseCase.ApproveJoinRequest)
+}
+
+// DenyJoinRequest は参加リクエストを却下するハンドラーです。
+func (h *RoomHandler) DenyJoinRequest(c echo.Context) error {
+	return h.decideJoinRequest(c, entity.RoomJoinRequestDenied, h.RoomUseCase.DenyJoinRequest)
+}
+
+func (h *RoomHandler) decideJoinRequest(
+	c echo.Context,
+	status entity.RoomJoinRequestStatus,
+	decide func(context.Context, roomcase.DecideJoinRequestRequest) error,
+) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+	requesterID := c.Param("user_id")
+	if requesterID == "" {
+		h.Logger.Error("Requester user ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Requester user ID is required")
+	}
+
+	err := decide(ctx, roomcase.DecideJoinRequestRequest{
+		RoomID:      entity.RoomID(roomID),
+		UserID:      entity.UserID(userID),
+		RequesterID: entity.UserID(requesterID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to decide join request", err)
+		return newRoomHTTPError(err, "Failed to decide join request")
+	}
+
+	h.Logger.Info("Decided join request successfully", map[string]any{
+		"roomID":      roomID,
+		"requesterID": requesterID,
+		"status":      status,
+	})
+
+	return c.NoContent(http.StatusNoContent)
+}
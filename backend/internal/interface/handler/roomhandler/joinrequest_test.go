@@ -0,0 +1,131 @@
+package roomhandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 参加のリクエスト
+// 2. 判断待ちの参加リクエストの一覧
+// 3. 承認
+// 4. 却下
+// 5. ユーザーIDがない
+// 6. 異常系のステータスコード
+// 7. 判断待ちのリクエストがない
+func TestJoinRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(method, path, body, userID string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/room/room1/join-requests"+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.SetParamNames("room_id", "user_id")
+		c.SetParamValues("room1", "user1")
+		return c, rec
+	}
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	joinRequest := entity.NewRoomJoinRequest(entity.RoomJoinRequestParams{
+		RoomID:    "room1",
+		UserID:    "user1",
+		Message:   "let me in",
+		CreatedAt: createdAt,
+	})
+	joinRequestJSON := `{"room_id":"room1","user_id":"user1","message":"let me in","status":"pending","created_at":"2025-01-01T12:00:00Z"}`
+	decideReq := roomcase.DecideJoinRequestRequest{RoomID: "room1", UserID: "admin", RequesterID: "user1"}
+
+	t.Run("1. 参加のリクエスト", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().RequestToJoin(gomock.Any(), roomcase.RequestToJoinRequest{
+			RoomID:  "room1",
+			UserID:  "user1",
+			Message: "let me in",
+		}).Return(joinRequest, nil)
+
+		c, rec := newContext(http.MethodPost, "", `{"message":"let me in"}`, "user1")
+		assert.NoError(t, handler.RequestToJoin(c))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, joinRequestJSON, rec.Body.String())
+	})
+
+	t.Run("2. 判断待ちの参加リクエストの一覧", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().
+			ListJoinRequests(gomock.Any(), roomcase.ListJoinRequestsRequest{RoomID: "room1", UserID: "admin"}).
+			Return([]*entity.RoomJoinRequest{joinRequest}, nil)
+
+		c, rec := newContext(http.MethodGet, "", "", "admin")
+		assert.NoError(t, handler.GetJoinRequests(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"requests":[`+joinRequestJSON+`]}`, rec.Body.String())
+	})
+
+	t.Run("3. 承認", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().ApproveJoinRequest(gomock.Any(), decideReq).Return(nil)
+
+		c, rec := newContext(http.MethodPost, "/user1/approve", "", "admin")
+		assert.NoError(t, handler.ApproveJoinRequest(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("4. 却下", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().DenyJoinRequest(gomock.Any(), decideReq).Return(nil)
+
+		c, rec := newContext(http.MethodPost, "/user1/deny", "", "admin")
+		assert.NoError(t, handler.DenyJoinRequest(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("5. ユーザーIDがない", func(t *testing.T) {
+		c, _ := newContext(http.MethodPost, "", "", "")
+		err := handler.RequestToJoin(c)
+		assert.Equal(t, http.StatusUnauthorized, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("6. 異常系のステータスコード", func(t *testing.T) {
+		for _, tc := range []struct {
+			err  error
+			code int
+		}{
+			{repository.ErrRoomNotFound, http.StatusNotFound},
+			{entity.ErrRoomBanned, http.StatusForbidden},
+			{roomcase.ErrAlreadyRoomMember, http.StatusConflict},
+			{repository.ErrJoinRequestAlreadyExists, http.StatusConflict},
+			{roomcase.ErrJoinRequestNotRequired, http.StatusBadRequest},
+			{roomcase.ErrJoinRequestMessageTooLong, http.StatusBadRequest},
+		} {
+			mockDeps.RoomUseCase.EXPECT().RequestToJoin(gomock.Any(), gomock.Any()).Return(nil, tc.err)
+
+			c, _ := newContext(http.MethodPost, "", `{}`, "user1")
+			err := handler.RequestToJoin(c)
+			assert.Equal(t, tc.code, err.(*echo.HTTPError).Code, tc.err.Error())
+		}
+	})
+
+	t.Run("7. 判断待ちのリクエストがない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().ApproveJoinRequest(gomock.Any(), decideReq).Return(repository.ErrJoinRequestNotFound)
+
+		c, _ := newContext(http.MethodPost, "/user1/approve", "", "admin")
+		err := handler.ApproveJoinRequest(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+}
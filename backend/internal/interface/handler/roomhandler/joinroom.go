@@ -31,7 +31,7 @@ func (h *RoomHandler) JoinRoom(c echo.Context) error {
 	})
 	if err != nil {
 		h.Logger.Error("Failed to join room", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to join room")
+		return newRoomHTTPError(err, "Failed to join room")
 	}
 
 	h.Logger.Info("Joined room successfully", map[string]any{
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/interface/handler/roomhandler"
 	"go.uber.org/mock/gomock"
 	"github.com/labstack/echo/v4"
@@ -17,6 +18,7 @@ import (
 // 3. user_id が型アサーションに失敗した場合
 // 4. room_id がリクエストパラメータに含まれていない場合
 // 5. 部屋に参加できなかった場合
+// 6. 部屋が存在しない場合
 func TestJoinRoom(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -109,4 +111,23 @@ func TestJoinRoom(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, http.StatusInternalServerError, httpErr.Code)
 	})
+
+	// 6. 部屋が存在しない場合
+	t.Run("部屋が存在しない場合", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().JoinRoom(gomock.Any(), gomock.Any()).Return(repository.ErrRoomNotFound)
+		mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+
+		req := httptest.NewRequest(http.MethodPost, "/rooms/room123/join", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/rooms/:room_id/join")
+		c.SetParamNames("room_id")
+		c.SetParamValues("room123")
+		c.Set("user_id", "user123")
+
+		err := handler.JoinRoom(c)
+		httpErr, ok := err.(*echo.HTTPError)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	})
 }
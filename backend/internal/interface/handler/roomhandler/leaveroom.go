@@ -28,7 +28,7 @@ func (h *RoomHandler) LeaveRoom(c echo.Context) error {
 		UserID: entity.UserID(userID),
 	}); err != nil {
 		h.Logger.Error("Failed to leave room", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to leave room")
+		return newRoomHTTPError(err, "Failed to leave room")
 	}
 
 	h.Logger.Info("Left room successfully", map[string]any{
@@ -6,7 +6,9 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -17,6 +19,7 @@ import (
 // 3. user_id が型アサーションに失敗した場合
 // 4. room_id がリクエストパラメータに含まれていない場合
 // 5. 部屋から退出できなかった場合
+// 6. メンバーでない・オーナーの場合
 func TestLeaveRoom(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -109,4 +112,33 @@ func TestLeaveRoom(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, http.StatusInternalServerError, httpErr.Code)
 	})
+
+	// 6. メンバーでない・オーナーの場合
+	cases := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"メンバーでない場合", repository.ErrNotRoomMember, http.StatusForbidden},
+		{"オーナーの場合", roomcase.ErrOwnerCannotLeave, http.StatusConflict},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDeps.RoomUseCase.EXPECT().LeaveRoom(gomock.Any(), gomock.Any()).Return(tc.err)
+			mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+
+			req := httptest.NewRequest(http.MethodPost, "/rooms/room123/leave", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/rooms/:room_id/leave")
+			c.SetParamNames("room_id")
+			c.SetParamValues("room123")
+			c.Set("user_id", "user123")
+
+			err := handler.LeaveRoom(c)
+			httpErr, ok := err.(*echo.HTTPError)
+			assert.True(t, ok)
+			assert.Equal(t, tc.code, httpErr.Code)
+		})
+	}
 }
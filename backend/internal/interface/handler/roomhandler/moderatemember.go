@@ -0,0 +1,91 @@
+package roomhandler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+type ModerateMemberRequest struct {
+	Reason          string `json:"reason"`           // 理由（任意）
+	DurationSeconds int    `json:"duration_seconds"` // 発言禁止の期間（秒）。mute のみ
+}
+
+// KickMember はユーザーを部屋から退出させ、部屋のコネクションを閉じるハンドラーです。
+func (h *RoomHandler) KickMember(c echo.Context) error {
+	return h.moderateMember(c, entity.RoomModerationKick, h.RoomUseCase.KickMember)
+}
+
+// BanMember はユーザーを部屋から追放するハンドラーです。
+func (h *RoomHandler) BanMember(c echo.Context) error {
+	return h.moderateMember(c, entity.RoomModerationBan, h.RoomUseCase.BanMember)
+}
+
+// UnbanMember は追放を解除するハンドラーです。
+func (h *RoomHandler) UnbanMember(c echo.Context) error {
+	return h.moderateMember(c, entity.RoomModerationUnban, h.RoomUseCase.UnbanMember)
+}
+
+// MuteMember は duration_seconds の間メンバーの発言を禁止するハンドラーです。
+func (h *RoomHandler) MuteMember(c echo.Context) error {
+	return h.moderateMember(c, entity.RoomModerationMute, h.RoomUseCase.MuteMember)
+}
+
+// UnmuteMember は発言の禁止を解除するハンドラーです。
+func (h *RoomHandler) UnmuteMember(c echo.Context) error {
+	return h.moderateMember(c, entity.RoomModerationUnmute, h.RoomUseCase.UnmuteMember)
+}
+
+func (h *RoomHandler) moderateMember(
+	c echo.Context,
+	action entity.RoomModerationActionType,
+	moderate func(context.Context, roomcase.ModerateMemberRequest) error,
+) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+	targetID := c.Param("user_id")
+	if targetID == "" {
+		h.Logger.Error("Target user ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Target user ID is required")
+	}
+
+	var req ModerateMemberRequest
+	if err := c.Bind(&req); err != nil {
+		h.Logger.Error("Failed to bind request", err, req)
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request format")
+	}
+
+	err := moderate(ctx, roomcase.ModerateMemberRequest{
+		RoomID:   entity.RoomID(roomID),
+		UserID:   entity.UserID(userID),
+		TargetID: entity.UserID(targetID),
+		Reason:   req.Reason,
+		Duration: time.Duration(req.DurationSeconds) * time.Second,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to moderate room member", err)
+		return newRoomHTTPError(err, "Failed to moderate room member")
+	}
+
+	h.Logger.Info("Moderated room member successfully", map[string]any{
+		"roomID":   roomID,
+		"targetID": targetID,
+		"action":   action,
+	})
+
+	return c.NoContent(http.StatusNoContent)
+}
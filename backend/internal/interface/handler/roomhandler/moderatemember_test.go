@@ -0,0 +1,107 @@
+package roomhandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. キック
+// 2. 期間を指定して発言禁止
+// 3. 本文のない追放の解除
+// 4. 追放されていないユーザーの追放の解除
+// 5. 自分より上の役割のメンバーはキックできない
+// 6. 自分自身は追放できない
+func TestModerateMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(method, action, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/api/room/room1/members/target/"+action, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id", "user_id")
+		c.SetParamValues("room1", "target")
+		c.Set("user_id", "admin")
+		return c, rec
+	}
+
+	t.Run("キック", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().KickMember(gomock.Any(), roomcase.ModerateMemberRequest{
+			RoomID:   "room1",
+			UserID:   "admin",
+			TargetID: "target",
+			Reason:   "spam",
+		}).Return(nil)
+
+		c, rec := newContext(http.MethodPost, "kick", `{"reason":"spam"}`)
+		assert.NoError(t, handler.KickMember(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("期間を指定して発言禁止", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().MuteMember(gomock.Any(), roomcase.ModerateMemberRequest{
+			RoomID:   "room1",
+			UserID:   "admin",
+			TargetID: "target",
+			Duration: 10 * time.Minute,
+		}).Return(nil)
+
+		c, rec := newContext(http.MethodPost, "mute", `{"duration_seconds":600}`)
+		assert.NoError(t, handler.MuteMember(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("本文のない追放の解除", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UnbanMember(gomock.Any(), roomcase.ModerateMemberRequest{
+			RoomID:   "room1",
+			UserID:   "admin",
+			TargetID: "target",
+		}).Return(nil)
+
+		c, rec := newContext(http.MethodDelete, "ban", "")
+		assert.NoError(t, handler.UnbanMember(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("追放されていないユーザーの追放の解除", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UnbanMember(gomock.Any(), gomock.Any()).Return(repository.ErrBanNotFound)
+
+		c, _ := newContext(http.MethodDelete, "ban", "")
+		err := handler.UnbanMember(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("自分より上の役割のメンバーはキックできない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().KickMember(gomock.Any(), gomock.Any()).Return(entity.ErrInsufficientRoomRole)
+
+		c, _ := newContext(http.MethodPost, "kick", "")
+		err := handler.KickMember(c)
+		assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("自分自身は追放できない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().BanMember(gomock.Any(), gomock.Any()).Return(roomcase.ErrCannotModerateSelf)
+
+		c, _ := newContext(http.MethodPost, "ban", "")
+		err := handler.BanMember(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+}
@@ -0,0 +1,44 @@
+package roomhandler
+
+import (
+	"net/http"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+// RedeemInvite は招待リンクのトークンを使用して部屋に参加するハンドラーです。
+// 期限切れ・利用回数の上限に達した招待の場合は 410 を返します。
+func (h *RoomHandler) RedeemInvite(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	token := c.Param("token")
+	if token == "" {
+		h.Logger.Error("Invite token is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Invite token is required")
+	}
+
+	res, err := h.RoomUseCase.RedeemInvite(ctx, roomcase.RedeemInviteRequest{
+		Token:  entity.InviteToken(token),
+		UserID: entity.UserID(userID),
+	})
+	if err != nil {
+		h.Logger.Error("Failed to redeem invite", err)
+		return newRoomHTTPError(err, "Failed to redeem invite")
+	}
+
+	h.Logger.Info("Joined room by invite successfully", map[string]any{
+		"roomID": res.RoomID,
+		"userID": userID,
+	})
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"room_id": res.RoomID,
+	})
+}
@@ -0,0 +1,68 @@
+package roomhandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系
+// 2. 招待が存在しない
+// 3. 期限切れ・利用回数の上限に達した
+func TestRedeemInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/api/room/join/token1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("token")
+		c.SetParamValues("token1")
+		c.Set("user_id", "guest")
+		return c, rec
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().RedeemInvite(gomock.Any(), roomcase.RedeemInviteRequest{
+			Token:  "token1",
+			UserID: "guest",
+		}).Return(roomcase.RedeemInviteResponse{RoomID: "room1"}, nil)
+
+		c, rec := newContext()
+		assert.NoError(t, handler.RedeemInvite(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"room_id":"room1"}`, rec.Body.String())
+	})
+
+	t.Run("招待が存在しない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().RedeemInvite(gomock.Any(), gomock.Any()).
+			Return(roomcase.RedeemInviteResponse{}, repository.ErrInviteNotFound)
+
+		c, _ := newContext()
+		err := handler.RedeemInvite(c)
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("期限切れ・利用回数の上限に達した", func(t *testing.T) {
+		for _, usecaseErr := range []error{roomcase.ErrInviteExpired, roomcase.ErrInviteExhausted} {
+			mockDeps.RoomUseCase.EXPECT().RedeemInvite(gomock.Any(), gomock.Any()).
+				Return(roomcase.RedeemInviteResponse{}, usecaseErr)
+
+			c, _ := newContext()
+			err := handler.RedeemInvite(c)
+			assert.Equal(t, http.StatusGone, err.(*echo.HTTPError).Code)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package roomhandler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+)
+
+// UpdateRoomRequest は部屋の設定を更新するリクエストです。
+// 省略したフィールドは変更しません。
+type UpdateRoomRequest struct {
+	Name            *string `json:"name"`
+	Description     *string `json:"description"`
+	PinLimit        *int    `json:"pin_limit"`
+	SlowModeSeconds *int    `json:"slow_mode_seconds"` // スローモードの間隔（秒）。0 で無効
+}
+
+// UpdateRoom は部屋名・説明・ピン留めの上限・スローモード・アイコンを更新するハンドラーです。
+// JSON、またはアイコンを含める場合は multipart/form-data（フィールド name, description, pin_limit, slow_mode_seconds, icon）で受け付けます。
+func (h *RoomHandler) UpdateRoom(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		h.Logger.Error("User ID is missing or invalid")
+		return echo.NewHTTPError(http.StatusUnauthorized, "User ID is required")
+	}
+
+	roomID := c.Param("room_id")
+	if roomID == "" {
+		h.Logger.Error("Room ID is missing")
+		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
+	}
+
+	ucReq := roomcase.UpdateRoomSettingsRequest{
+		RoomID: entity.RoomID(roomID),
+		UserID: entity.UserID(userID),
+	}
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		form, err := c.MultipartForm()
+		if err != nil {
+			h.Logger.Error("Failed to parse multipart form", err)
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid form data")
+		}
+		if v, ok := form.Value["name"]; ok && len(v) > 0 {
+			ucReq.Name = &v[0]
+		}
+		if v, ok := form.Value["description"]; ok && len(v) > 0 {
+			ucReq.Description = &v[0]
+		}
+		if v, ok := form.Value["pin_limit"]; ok && len(v) > 0 {
+			pinLimit, err := strconv.Atoi(v[0])
+			if err != nil {
+				h.Logger.Error("Invalid pin_limit", err)
+				return echo.NewHTTPError(http.StatusBadRequest, "pin_limit must be an integer")
+			}
+			ucReq.PinLimit = &pinLimit
+		}
+		if v, ok := form.Value["slow_mode_seconds"]; ok && len(v) > 0 {
+			seconds, err := strconv.Atoi(v[0])
+			if err != nil {
+				h.Logger.Error("Invalid slow_mode_seconds", err)
+				return echo.NewHTTPError(http.StatusBadRequest, "slow_mode_seconds must be an integer")
+			}
+			slowMode := time.Duration(seconds) * time.Second
+			ucReq.SlowMode = &slowMode
+		}
+		if files := form.File["icon"]; len(files) > 0 {
+			ucReq.Icon = files[0]
+		}
+	} else {
+		var req UpdateRoomRequest
+		if err := c.Bind(&req); err != nil {
+			h.Logger.Error("Failed to bind request", err)
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid request")
+		}
+		ucReq.Name = req.Name
+		ucReq.Description = req.Description
+		ucReq.PinLimit = req.PinLimit
+		if req.SlowModeSeconds != nil {
+			slowMode := time.Duration(*req.SlowModeSeconds) * time.Second
+			ucReq.SlowMode = &slowMode
+		}
+	}
+	if ucReq.Name == nil && ucReq.Description == nil && ucReq.PinLimit == nil && ucReq.SlowMode == nil && ucReq.Icon == nil {
+		h.Logger.Error("Nothing to update")
+		return echo.NewHTTPError(http.StatusBadRequest, "name, description, pin_limit, slow_mode_seconds or icon is required")
+	}
+
+	if err := h.RoomUseCase.UpdateRoomSettings(ctx, ucReq); err != nil {
+		h.Logger.Error("Failed to update room", err)
+		return newRoomHTTPError(err, "Failed to update room")
+	}
+
+	h.Logger.Info("Updated room successfully", map[string]any{
+		"roomID": roomID,
+		"userID": userID,
+	})
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "Room updated successfully",
+	})
+}
@@ -0,0 +1,189 @@
+package roomhandler_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/interface/handler/roomhandler"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系: JSON で名前と説明を更新
+// 2. 正常系: multipart でアイコンを更新
+// 3. 更新する項目がない
+// 4. 権限がない
+// 5. アイコンの形式が不正
+// 6. 正常系: JSON でピン留めの上限を更新
+// 7. ピン留めの上限が範囲外
+// 8. multipart のピン留めの上限が整数でない
+// 9. 正常系: JSON でスローモードを無効にする
+// 10. 正常系: multipart でスローモードを更新
+// 11. スローモードの間隔が範囲外
+func TestUpdateRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := roomhandler.NewTestRoomHandler(ctrl)
+	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any()).AnyTimes()
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newContext := func(body *bytes.Buffer, contentType string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPatch, "/api/room/room123", body)
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("room_id")
+		c.SetParamValues("room123")
+		c.Set("user_id", "user123")
+		return c, rec
+	}
+
+	t.Run("1. 正常系: JSON で名前と説明を更新", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UpdateRoomSettings(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, req roomcase.UpdateRoomSettingsRequest) error {
+				assert.Equal(t, entity.RoomID("room123"), req.RoomID)
+				assert.Equal(t, entity.UserID("user123"), req.UserID)
+				require.NotNil(t, req.Name)
+				assert.Equal(t, "New Name", *req.Name)
+				require.NotNil(t, req.Description)
+				assert.Equal(t, "", *req.Description)
+				assert.Nil(t, req.Icon)
+				return nil
+			})
+
+		c, rec := newContext(bytes.NewBufferString(`{"name":"New Name","description":""}`), echo.MIMEApplicationJSON)
+		assert.NoError(t, handler.UpdateRoom(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("2. 正常系: multipart でアイコンを更新", func(t *testing.T) {
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		fw, err := w.CreateFormFile("icon", "icon.png")
+		require.NoError(t, err)
+		_, err = fw.Write([]byte("png data"))
+		require.NoError(t, err)
+		require.NoError(t, w.WriteField("description", "topic"))
+		require.NoError(t, w.Close())
+
+		mockDeps.RoomUseCase.EXPECT().UpdateRoomSettings(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, req roomcase.UpdateRoomSettingsRequest) error {
+				assert.Nil(t, req.Name)
+				require.NotNil(t, req.Description)
+				assert.Equal(t, "topic", *req.Description)
+				require.NotNil(t, req.Icon)
+				assert.Equal(t, "icon.png", req.Icon.Filename)
+				return nil
+			})
+
+		c, rec := newContext(&body, w.FormDataContentType())
+		assert.NoError(t, handler.UpdateRoom(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("3. 更新する項目がない", func(t *testing.T) {
+		c, _ := newContext(bytes.NewBufferString(`{}`), echo.MIMEApplicationJSON)
+		err := handler.UpdateRoom(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("4. 権限がない", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UpdateRoomSettings(gomock.Any(), gomock.Any()).Return(entity.ErrInsufficientRoomRole)
+
+		c, _ := newContext(bytes.NewBufferString(`{"name":"New Name"}`), echo.MIMEApplicationJSON)
+		err := handler.UpdateRoom(c)
+		assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("5. アイコンの形式が不正", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UpdateRoomSettings(gomock.Any(), gomock.Any()).Return(service.ErrInvalidIconType)
+
+		c, _ := newContext(bytes.NewBufferString(`{"name":"New Name"}`), echo.MIMEApplicationJSON)
+		err := handler.UpdateRoom(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("6. 正常系: JSON でピン留めの上限を更新", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UpdateRoomSettings(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, req roomcase.UpdateRoomSettingsRequest) error {
+				assert.Nil(t, req.Name)
+				require.NotNil(t, req.PinLimit)
+				assert.Equal(t, 10, *req.PinLimit)
+				return nil
+			})
+
+		c, rec := newContext(bytes.NewBufferString(`{"pin_limit":10}`), echo.MIMEApplicationJSON)
+		assert.NoError(t, handler.UpdateRoom(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("7. ピン留めの上限が範囲外", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UpdateRoomSettings(gomock.Any(), gomock.Any()).Return(roomcase.ErrInvalidPinLimit)
+
+		c, _ := newContext(bytes.NewBufferString(`{"pin_limit":0}`), echo.MIMEApplicationJSON)
+		err := handler.UpdateRoom(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("8. multipart のピン留めの上限が整数でない", func(t *testing.T) {
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		require.NoError(t, w.WriteField("pin_limit", "ten"))
+		require.NoError(t, w.Close())
+
+		c, _ := newContext(&body, w.FormDataContentType())
+		err := handler.UpdateRoom(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+
+	t.Run("9. 正常系: JSON でスローモードを無効にする", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UpdateRoomSettings(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, req roomcase.UpdateRoomSettingsRequest) error {
+				assert.Nil(t, req.PinLimit)
+				require.NotNil(t, req.SlowMode)
+				assert.Equal(t, time.Duration(0), *req.SlowMode)
+				return nil
+			})
+
+		c, rec := newContext(bytes.NewBufferString(`{"slow_mode_seconds":0}`), echo.MIMEApplicationJSON)
+		assert.NoError(t, handler.UpdateRoom(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("10. 正常系: multipart でスローモードを更新", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UpdateRoomSettings(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, req roomcase.UpdateRoomSettingsRequest) error {
+				require.NotNil(t, req.SlowMode)
+				assert.Equal(t, 30*time.Second, *req.SlowMode)
+				return nil
+			})
+
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		require.NoError(t, w.WriteField("slow_mode_seconds", "30"))
+		require.NoError(t, w.Close())
+
+		c, rec := newContext(&body, w.FormDataContentType())
+		assert.NoError(t, handler.UpdateRoom(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("11. スローモードの間隔が範囲外", func(t *testing.T) {
+		mockDeps.RoomUseCase.EXPECT().UpdateRoomSettings(gomock.Any(), gomock.Any()).Return(roomcase.ErrInvalidSlowMode)
+
+		c, _ := newContext(bytes.NewBufferString(`{"slow_mode_seconds":-1}`), echo.MIMEApplicationJSON)
+		err := handler.UpdateRoom(c)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	})
+}
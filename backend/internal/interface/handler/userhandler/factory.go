@@ -5,19 +5,24 @@ import (
 
 	"example.com/infrahandson/internal/interface/adapter"
 	"example.com/infrahandson/internal/interface/factory"
+	"example.com/infrahandson/internal/usecase/presencecase"
 	"example.com/infrahandson/internal/usecase/usercase"
 )
 
 type NewUserHandlerParams struct {
-	UserUseCase   usercase.UserUseCaseInterface
-	UserIDFactory factory.UserIDFactory
-	Logger        adapter.LoggerAdapter
+	UserUseCase     usercase.UserUseCaseInterface
+	PresenceUseCase presencecase.PresenceUseCaseInterface
+	UserIDFactory   factory.UserIDFactory
+	Logger          adapter.LoggerAdapter
 }
 
 func (p *NewUserHandlerParams) Validate() error {
 	if p.UserUseCase == nil {
 		return errors.New("userUseCase is required")
 	}
+	if p.PresenceUseCase == nil {
+		return errors.New("presenceUseCase is required")
+	}
 	if p.UserIDFactory == nil {
 		return errors.New("userIDFactory is required")
 	}
@@ -33,8 +38,9 @@ func NewUserHandler(params NewUserHandlerParams) UserHandlerInterface {
 	}
 
 	return &UserHandler{
-		UserUseCase:   params.UserUseCase,
-		UserIDFactory: params.UserIDFactory,
-		Logger:        params.Logger,
+		UserUseCase:     params.UserUseCase,
+		PresenceUseCase: params.PresenceUseCase,
+		UserIDFactory:   params.UserIDFactory,
+		Logger:          params.Logger,
 	}
 }
@@ -20,4 +20,10 @@ type UserHandlerInterface interface {
 
 	// GetUserIcon はユーザーのアイコン画像を取得する
 	GetUserIcon(c echo.Context) error
+
+	// GetPresence はユーザーのオンライン状態を取得する
+	GetPresence(c echo.Context) error
+
+	// GetPresences は複数のユーザーのオンライン状態をまとめて取得する
+	GetPresences(c echo.Context) error
 }
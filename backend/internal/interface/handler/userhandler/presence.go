@@ -0,0 +1,80 @@
+package userhandler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/presencecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PresenceResponse struct {
+	UserID     string     `json:"user_id"`
+	Status     string     `json:"status"`                 // online / away / offline
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"` // 一度も接続していない場合は省略
+}
+
+type GetPresencesResponse struct {
+	Presences []PresenceResponse `json:"presences"`
+}
+
+func newPresenceResponse(presence *entity.UserPresence) PresenceResponse {
+	return PresenceResponse{
+		UserID:     string(presence.GetUserID()),
+		Status:     string(presence.GetStatus()),
+		LastSeenAt: presence.GetLastSeenAt(),
+	}
+}
+
+// GetPresence: Retrieves the presence of the user specified by the request parameters.
+func (h *UserHandler) GetPresence(c echo.Context) error {
+	ctx := c.Request().Context()
+	userIDStr := c.Param("user_id")
+	if userIDStr == "" {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": "user_id is required"})
+	}
+
+	presence, err := h.PresenceUseCase.GetPresence(ctx, entity.UserID(userIDStr))
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return c.JSON(http.StatusNotFound, echo.Map{"error": "User not found"})
+	}
+	if err != nil {
+		h.Logger.Error("Failed to get presence", err)
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, newPresenceResponse(presence))
+}
+
+// GetPresences: Retrieves the presences of the users listed in the comma-separated user_ids query,
+// in the requested order. Unknown users are omitted.
+func (h *UserHandler) GetPresences(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var userIDs []entity.UserID
+	for _, id := range strings.Split(c.QueryParam("user_ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			userIDs = append(userIDs, entity.UserID(id))
+		}
+	}
+
+	presences, err := h.PresenceUseCase.GetPresences(ctx, presencecase.GetPresencesRequest{UserIDs: userIDs})
+	if errors.Is(err, presencecase.ErrNoUserIDs) || errors.Is(err, presencecase.ErrTooManyUserIDs) {
+		return c.JSON(http.StatusBadRequest, echo.Map{"error": err.Error()})
+	}
+	if err != nil {
+		h.Logger.Error("Failed to get presences", err)
+		return c.JSON(http.StatusInternalServerError, echo.Map{"error": "Internal server error"})
+	}
+
+	res := GetPresencesResponse{Presences: make([]PresenceResponse, 0, len(presences))}
+	for _, presence := range presences {
+		res.Presences = append(res.Presences, newPresenceResponse(presence))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
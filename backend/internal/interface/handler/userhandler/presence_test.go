@@ -0,0 +1,87 @@
+package userhandler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/interface/handler/userhandler"
+	"example.com/infrahandson/internal/usecase/presencecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. オンライン状態の取得
+// 2. 存在しないユーザー
+// 3. 一括取得
+// 4. 一括取得でユーザーを指定しない
+func TestGetPresence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := userhandler.NewTestUserHandler(ctrl)
+	mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	lastSeenAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	online := entity.NewUserPresence(entity.UserPresenceParams{UserID: "user1", Status: entity.PresenceOnline, LastSeenAt: &lastSeenAt})
+	offline := entity.NewUserPresence(entity.UserPresenceParams{UserID: "user2", Status: entity.PresenceOffline})
+
+	t.Run("オンライン状態の取得", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/user/user1/presence", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues("user1")
+
+		mockDeps.PresenceUseCase.EXPECT().GetPresence(gomock.Any(), entity.UserID("user1")).Return(online, nil)
+
+		require.NoError(t, handler.GetPresence(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"user_id":"user1","status":"online","last_seen_at":"2025-01-01T12:00:00Z"}`, rec.Body.String())
+	})
+
+	t.Run("存在しないユーザー", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/user/unknown/presence", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues("unknown")
+
+		mockDeps.PresenceUseCase.EXPECT().GetPresence(gomock.Any(), entity.UserID("unknown")).Return(nil, repository.ErrUserNotFound)
+
+		require.NoError(t, handler.GetPresence(c))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("一括取得", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/user/presence?user_ids=user1,%20user2,", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockDeps.PresenceUseCase.EXPECT().
+			GetPresences(gomock.Any(), presencecase.GetPresencesRequest{UserIDs: []entity.UserID{"user1", "user2"}}).
+			Return([]*entity.UserPresence{online, offline}, nil)
+
+		require.NoError(t, handler.GetPresences(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"presences":[
+			{"user_id":"user1","status":"online","last_seen_at":"2025-01-01T12:00:00Z"},
+			{"user_id":"user2","status":"offline"}
+		]}`, rec.Body.String())
+	})
+
+	t.Run("一括取得でユーザーを指定しない", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/user/presence", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockDeps.PresenceUseCase.EXPECT().GetPresences(gomock.Any(), presencecase.GetPresencesRequest{}).Return(nil, presencecase.ErrNoUserIDs)
+
+		require.NoError(t, handler.GetPresences(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -3,11 +3,13 @@ package userhandler
 import (
 	"example.com/infrahandson/internal/interface/adapter"
 	"example.com/infrahandson/internal/interface/factory"
+	"example.com/infrahandson/internal/usecase/presencecase"
 	"example.com/infrahandson/internal/usecase/usercase"
 )
 
 type UserHandler struct {
-	UserUseCase   usercase.UserUseCaseInterface
-	UserIDFactory factory.UserIDFactory
-	Logger        adapter.LoggerAdapter
+	UserUseCase     usercase.UserUseCaseInterface
+	PresenceUseCase presencecase.PresenceUseCaseInterface
+	UserIDFactory   factory.UserIDFactory
+	Logger          adapter.LoggerAdapter
 }
@@ -8,6 +8,7 @@ import (
 	"example.com/infrahandson/internal/infrastructure/validator"
 	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
 	mock_factory "example.com/infrahandson/test/mocks/interface/factory"
+	mock_presencecase "example.com/infrahandson/test/mocks/usecase/presencecase"
 	mock_usercase "example.com/infrahandson/test/mocks/usecase/usercase"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/mock/gomock"
@@ -15,9 +16,10 @@ import (
 
 // mockDeps は UserHandler のテストで使用する依存関係モックをまとめた構造体です
 type mockDeps struct {
-	UserUseCase   mock_usercase.MockUserUseCaseInterface
-	UserIDFactory mock_factory.MockUserIDFactory
-	Logger        mock_adapter.MockLoggerAdapter
+	UserUseCase     mock_usercase.MockUserUseCaseInterface
+	PresenceUseCase mock_presencecase.MockPresenceUseCaseInterface
+	UserIDFactory   mock_factory.MockUserIDFactory
+	Logger          mock_adapter.MockLoggerAdapter
 }
 
 // NewTestUserHandler ( ハンドラ, モック依存関係, Echoインスタンス ) を生成する
@@ -25,19 +27,22 @@ func NewTestUserHandler(
 	ctrl *gomock.Controller,
 ) (UserHandlerInterface, mockDeps, *echo.Echo) {
 	mockUserUseCase := mock_usercase.NewMockUserUseCaseInterface(ctrl)
+	mockPresenceUseCase := mock_presencecase.NewMockPresenceUseCaseInterface(ctrl)
 	mockUserIDFactory := mock_factory.NewMockUserIDFactory(ctrl)
 	mockLogger := mock_adapter.NewMockLoggerAdapter(ctrl)
 	params := NewUserHandlerParams{
-		UserUseCase:   mockUserUseCase,
-		UserIDFactory: mockUserIDFactory,
-		Logger:        mockLogger,
+		UserUseCase:     mockUserUseCase,
+		PresenceUseCase: mockPresenceUseCase,
+		UserIDFactory:   mockUserIDFactory,
+		Logger:          mockLogger,
 	}
 	handler := NewUserHandler(params)
 
 	mockDeps := mockDeps{
-		UserUseCase:   *mockUserUseCase,
-		UserIDFactory: *mockUserIDFactory,
-		Logger:        *mockLogger,
+		UserUseCase:     *mockUserUseCase,
+		PresenceUseCase: *mockPresenceUseCase,
+		UserIDFactory:   *mockUserIDFactory,
+		Logger:          *mockLogger,
 	}
 
 	e := echo.New()
@@ -2,9 +2,14 @@ package websockethandler
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"example.com/infrahandson/internal/usecase/presencecase"
 	"example.com/infrahandson/internal/usecase/websocketcase"
 	"github.com/labstack/echo/v4"
 )
@@ -25,6 +30,23 @@ func (h *WebSocketHandler) ConnectToChatRoom(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Room ID is required")
 	}
 
+	// アップグレードした後は HTTP のレスポンスを返せないため、接続できるかを先に確認する
+	err := h.WsUseCase.AuthorizeConnect(ctx, websocketcase.AuthorizeConnectRequest{
+		UserID: entity.UserID(userID),
+		RoomID: entity.RoomID(roomID),
+	})
+	switch {
+	case errors.Is(err, repository.ErrRoomNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "room not found")
+	case errors.Is(err, repository.ErrNotRoomMember):
+		return echo.NewHTTPError(http.StatusForbidden, "not a member of the room")
+	case errors.Is(err, entity.ErrRoomBanned):
+		return echo.NewHTTPError(http.StatusForbidden, "banned from the room")
+	case err != nil:
+		h.Logger.Error("Failed to authorize connection", "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to connect user to room")
+	}
+
 	h.Logger.Info("Upgrading WebSocket connection", "room_id", roomID, "user_id", userID)
 	connRaw, err := h.WsUpgrader.Upgrade(c.Response().Writer, c.Request())
 	if err != nil {
@@ -38,16 +60,38 @@ func (h *WebSocketHandler) ConnectToChatRoom(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create WebSocket connection")
 	}
 
-	if err := h.WsUseCase.ConnectUserToRoom(ctx, websocketcase.ConnectUserToRoomRequest{
+	connRes, err := h.WsUseCase.ConnectUserToRoom(ctx, websocketcase.ConnectUserToRoomRequest{
 		UserID: entity.UserID(userID),
 		RoomID: entity.RoomID(roomID),
 		Conn:   conn,
-	}); err != nil {
+	})
+	if err != nil {
 		h.Logger.Error("Failed to connect user to room", "error", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to connect user to room")
+		// アップグレード済みのため HTTP のエラーは返せない。切断理由を通知して閉じる
+		// 停止処理中は再接続を促し、確認の後に部屋が削除された・退出した・追放された場合は理由を通知する
+		switch {
+		case errors.Is(err, service.ErrManagerShutdown):
+			_ = conn.CloseWithReason(service.WebsocketCloseGoingAway, "server shutting down")
+		case errors.Is(err, repository.ErrRoomNotFound):
+			_ = conn.CloseWithReason(service.WebsocketClosePolicyViolation, "room not found")
+		case errors.Is(err, repository.ErrNotRoomMember):
+			_ = conn.CloseWithReason(service.WebsocketClosePolicyViolation, "not a member of the room")
+		case errors.Is(err, entity.ErrRoomBanned):
+			_ = conn.CloseWithReason(service.WebsocketClosePolicyViolation, "banned from the room")
+		default:
+			_ = conn.Close()
+		}
+		return nil
 	}
 
-	h.Logger.Info("User connected to room", "room_id", roomID, "user_id", userID)
+	clientID := connRes.ClientID
+	h.Logger.Info("User connected to room", "room_id", roomID, "user_id", userID, "client_id", clientID)
+
+	// オンライン状態の更新に失敗してもチャットは続けられるため、接続は維持する
+	presenceReq := presencecase.PresenceRequest{UserID: entity.UserID(userID)}
+	if err := h.PresenceUseCase.Connect(ctx, presenceReq); err != nil {
+		h.Logger.Error("Failed to update presence", "error", err)
+	}
 
 	go func() {
 		h.Logger.Info("Starting message loop", "room_public_id", roomID, "user_id", userID)
@@ -57,28 +101,149 @@ func (h *WebSocketHandler) ConnectToChatRoom(c echo.Context) error {
 		var userID = userID
 		var roomID = roomID
 		defer conn.Close()
+		// 読み取りを終えた理由によらず、このコネクションの分をオフラインにする
+		defer func() {
+			if err := h.PresenceUseCase.Disconnect(wsCtx, presenceReq); err != nil {
+				h.Logger.Error("Failed to update presence", "error", err)
+			}
+		}()
 		for {
-			message, err := conn.ReadMessage()
+			event, err := conn.ReadEvent()
 			if err != nil {
+				// 形式不正・未対応のイベントはエラーイベントを返して読み取りを続ける
+				if code, ok := protocolErrorCode(err); ok {
+					h.Logger.Warn("Invalid event received", "error", err)
+					_ = conn.WriteEvent(entity.NewErrorEvent("", code, err.Error()))
+					continue
+				}
 				h.Logger.Warn("Connection closed or error reading message", "error", err)
+				// 同じユーザーの他の接続には影響させず、この接続だけを切断する
 				_ = h.WsUseCase.DisconnectUser(wsCtx, websocketcase.DisconnectUserRequest{
-					UserID: entity.UserID(userID),
+					ClientID: clientID,
 				})
 				return
 			}
 
-			h.Logger.Info("Message received", "room_public_id", roomID, "user_id", userID)
-			err = h.WsUseCase.SendMessage(wsCtx, websocketcase.SendMessageRequest{
-				RoomID:  entity.RoomID(roomID),
-				Sender:  entity.UserID(userID),
-				Content: message.GetContent(),
-			})
-			if err != nil {
-				h.Logger.Error("connection closed", err)
-				return
+			// 形式が正しいイベントはすべて操作として扱う（ping への応答はブラウザが自動で返すため含めない）
+			if err := h.PresenceUseCase.Heartbeat(wsCtx, presenceReq); err != nil {
+				h.Logger.Error("Failed to update presence", "error", err)
+			}
+
+			switch event.GetType() {
+			case entity.WebsocketEventTypeMessageSend:
+				payload, ok := event.GetPayload().(entity.MessageSendPayload)
+				if !ok {
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeInvalidEvent, "invalid payload"))
+					continue
+				}
+
+				h.Logger.Info("Message received", "room_public_id", roomID, "user_id", userID)
+				res, err := h.WsUseCase.SendMessage(wsCtx, websocketcase.SendMessageRequest{
+					RoomID:        entity.RoomID(roomID),
+					Sender:        entity.UserID(userID),
+					Content:       payload.Content,
+					ParentID:      payload.ParentID,
+					AttachmentIDs: payload.AttachmentIDs,
+				})
+				if errors.Is(err, websocketcase.ErrInvalidParentMessage) || errors.Is(err, websocketcase.ErrInvalidAttachment) {
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeInvalidEvent, err.Error()))
+					continue
+				}
+				// 接続後に部屋を退出した・部屋が削除された場合は送信だけを拒否する
+				if errors.Is(err, repository.ErrNotRoomMember) || errors.Is(err, repository.ErrRoomNotFound) {
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeForbidden, err.Error()))
+					continue
+				}
+				if errors.Is(err, entity.ErrRoomArchived) {
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeRoomArchived, err.Error()))
+					continue
+				}
+				// 発言禁止中は接続を維持したまま送信だけを拒否する（メッセージに期限を含める）
+				if errors.Is(err, entity.ErrUserMuted) {
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeMuted, err.Error()))
+					continue
+				}
+				// スローモード・連投の制限を超えた場合は、再送できる日時を返して接続を維持する
+				var limitErr *websocketcase.RateLimitError
+				if errors.As(err, &limitErr) {
+					_ = conn.WriteEvent(entity.NewRetryableErrorEvent(event.GetID(), entity.WebsocketErrorCodeRateLimited, err.Error(), limitErr.RetryAfter))
+					continue
+				}
+				if err != nil {
+					h.Logger.Error("connection closed", err)
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeInternal, "failed to send message"))
+					return
+				}
+				_ = conn.WriteEvent(entity.NewAckEvent(event.GetID(), entity.AckPayload{
+					MessageID: res.Message.GetID(),
+				}))
+			case entity.WebsocketEventTypeMessageRead:
+				payload, ok := event.GetPayload().(entity.MessageReadPayload)
+				if !ok {
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeInvalidEvent, "invalid payload"))
+					continue
+				}
+
+				err := h.MsgUseCase.MarkAsRead(wsCtx, messagecase.MarkAsReadRequest{
+					RoomID:    entity.RoomID(roomID),
+					MessageID: payload.MessageID,
+					UserID:    entity.UserID(userID),
+				})
+				if errors.Is(err, repository.ErrMessageNotFound) {
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeInvalidEvent, err.Error()))
+					continue
+				}
+				if errors.Is(err, repository.ErrNotRoomMember) || errors.Is(err, repository.ErrRoomNotFound) {
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeForbidden, err.Error()))
+					continue
+				}
+				// 既読の保存に失敗してもチャットは続けられるため、接続は維持する
+				if err != nil {
+					h.Logger.Error("Failed to mark as read", "error", err)
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeInternal, "failed to mark as read"))
+					continue
+				}
+				_ = conn.WriteEvent(entity.NewAckEvent(event.GetID(), entity.AckPayload{}))
+			case entity.WebsocketEventTypeTypingStart, entity.WebsocketEventTypeTypingStop:
+				// 入力中の通知は頻繁に送られるため、成功しても ack は返さない
+				req := websocketcase.TypingRequest{
+					RoomID: entity.RoomID(roomID),
+					UserID: entity.UserID(userID),
+				}
+				var err error
+				if event.GetType() == entity.WebsocketEventTypeTypingStart {
+					err = h.WsUseCase.StartTyping(wsCtx, req)
+				} else {
+					err = h.WsUseCase.StopTyping(wsCtx, req)
+				}
+				if errors.Is(err, repository.ErrNotRoomMember) || errors.Is(err, repository.ErrRoomNotFound) {
+					_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeForbidden, err.Error()))
+					continue
+				}
+				// 入力中の通知に失敗してもチャットは続けられるため、接続は維持する
+				if err != nil {
+					h.Logger.Error("Failed to update typing state", "error", err)
+				}
+			case entity.WebsocketEventTypePresenceHeartbeat:
+				// 操作したことは受信時に記録済み
+			default:
+				_ = conn.WriteEvent(entity.NewErrorEvent(event.GetID(), entity.WebsocketErrorCodeUnsupportedEvent, "unsupported event type"))
 			}
 		}
 	}()
 
 	return nil
 }
+
+// protocolErrorCode は ReadEvent のエラーがプロトコル上のエラー（接続は継続可能）であれば、
+// クライアントに返すエラーコードを返す
+func protocolErrorCode(err error) (entity.WebsocketErrorCode, bool) {
+	switch {
+	case errors.Is(err, service.ErrInvalidEvent):
+		return entity.WebsocketErrorCodeInvalidEvent, true
+	case errors.Is(err, service.ErrUnsupportedEvent):
+		return entity.WebsocketErrorCodeUnsupportedEvent, true
+	default:
+		return "", false
+	}
+}
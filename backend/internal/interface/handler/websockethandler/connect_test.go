@@ -2,6 +2,7 @@ package websockethandler_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"sync"
@@ -9,7 +10,11 @@ import (
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
 	"example.com/infrahandson/internal/interface/handler/websockethandler"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"example.com/infrahandson/internal/usecase/presencecase"
 	"example.com/infrahandson/internal/usecase/websocketcase"
 	mock_service "example.com/infrahandson/test/mocks/domain/service"
 	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
@@ -24,6 +29,11 @@ func TestConnectToChatRoom(t *testing.T) {
 
 	handler, mockDeps, e := websockethandler.NewTestWebsocketHandler(ctrl)
 
+	// オンライン状態の更新は TestConnectToChatRoom_Presence で確認する
+	mockDeps.PresenceUseCase.EXPECT().Connect(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockDeps.PresenceUseCase.EXPECT().Heartbeat(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockDeps.PresenceUseCase.EXPECT().Disconnect(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
 	t.Run("Successful connection and message handling", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/ws/test-room", nil)
 		rec := httptest.NewRecorder()
@@ -45,19 +55,30 @@ func TestConnectToChatRoom(t *testing.T) {
 			Content: "testcontent",
 			SentAt:  time.Now(),
 		})
+		sendEvent := entity.NewWebsocketEvent(entity.WebsocketEventParams{
+			Type:    entity.WebsocketEventTypeMessageSend,
+			ID:      "client-1",
+			Payload: entity.MessageSendPayload{Content: "testcontent"},
+		})
 
 		mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 
+		mockDeps.WsUseCase.EXPECT().AuthorizeConnect(gomock.Any(), gomock.Any()).Return(nil)
 		mockDeps.WsUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(mockConnRaw, nil)
 		mockDeps.WsConnFactory.EXPECT().CreateWebSocketConnection(mockConnRaw).Return(mockConn, nil)
-		mockDeps.WsUseCase.EXPECT().ConnectUserToRoom(gomock.Any(), gomock.Any()).Return(nil)
+		mockDeps.WsUseCase.EXPECT().ConnectUserToRoom(gomock.Any(), gomock.Any()).Return(websocketcase.ConnectUserToRoomResponse{ClientID: "test-client"}, nil)
 
 		gomock.InOrder(
-			mockConn.EXPECT().ReadMessage().Return(testMessage, nil),
-			mockDeps.WsUseCase.EXPECT().SendMessage(gomock.Any(), gomock.Any()).Return(nil),
-			mockConn.EXPECT().ReadMessage().Return(nil, assert.AnError),
+			mockConn.EXPECT().ReadEvent().Return(sendEvent, nil),
+			mockDeps.WsUseCase.EXPECT().SendMessage(gomock.Any(), websocketcase.SendMessageRequest{
+				RoomID:  "test-room",
+				Sender:  "test-user",
+				Content: "testcontent",
+			}).Return(websocketcase.SendMessageResponse{Message: testMessage}, nil),
+			mockConn.EXPECT().WriteEvent(entity.NewAckEvent("client-1", entity.AckPayload{MessageID: "test-message"})).Return(nil),
+			mockConn.EXPECT().ReadEvent().Return(nil, assert.AnError),
 			mockDeps.Logger.EXPECT().Warn(gomock.Any(), gomock.Any()),
-			mockDeps.WsUseCase.EXPECT().DisconnectUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ websocketcase.DisconnectUserRequest) error {
+			mockDeps.WsUseCase.EXPECT().DisconnectUser(gomock.Any(), websocketcase.DisconnectUserRequest{ClientID: "test-client"}).DoAndReturn(func(_ context.Context, _ websocketcase.DisconnectUserRequest) error {
 				wg.Done()
 				return nil
 			}),
@@ -85,6 +106,73 @@ func TestConnectToChatRoom(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 	})
 
+	t.Run("Invalid and unsupported events are answered with error events", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/ws/test-room", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", "test-user")
+		c.SetParamNames("room_id")
+		c.SetParamValues("test-room")
+
+		mockConnRaw := mock_adapter.NewMockConnAdapter(ctrl)
+		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+		mockDeps.Logger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(2)
+
+		mockDeps.WsUseCase.EXPECT().AuthorizeConnect(gomock.Any(), gomock.Any()).Return(nil)
+		mockDeps.WsUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(mockConnRaw, nil)
+		mockDeps.WsConnFactory.EXPECT().CreateWebSocketConnection(mockConnRaw).Return(mockConn, nil)
+		mockDeps.WsUseCase.EXPECT().ConnectUserToRoom(gomock.Any(), gomock.Any()).Return(websocketcase.ConnectUserToRoomResponse{ClientID: "test-client"}, nil)
+
+		unknownEvent := entity.NewWebsocketEvent(entity.WebsocketEventParams{
+			Type: entity.WebsocketEventType("unknown"),
+			ID:   "client-2",
+		})
+
+		gomock.InOrder(
+			mockConn.EXPECT().ReadEvent().Return(nil, fmt.Errorf("%w: broken json", service.ErrInvalidEvent)),
+			mockConn.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(ev *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeError, ev.GetType())
+				assert.Equal(t, entity.WebsocketErrorCodeInvalidEvent, ev.GetPayload().(entity.ErrorPayload).Code)
+				return nil
+			}),
+			mockConn.EXPECT().ReadEvent().Return(unknownEvent, nil),
+			mockConn.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(ev *entity.WebsocketEvent) error {
+				assert.Equal(t, "client-2", ev.GetID())
+				assert.Equal(t, entity.WebsocketErrorCodeUnsupportedEvent, ev.GetPayload().(entity.ErrorPayload).Code)
+				return nil
+			}),
+			mockConn.EXPECT().ReadEvent().Return(nil, assert.AnError),
+			mockDeps.WsUseCase.EXPECT().DisconnectUser(gomock.Any(), websocketcase.DisconnectUserRequest{ClientID: "test-client"}).DoAndReturn(func(_ context.Context, _ websocketcase.DisconnectUserRequest) error {
+				wg.Done()
+				return nil
+			}),
+			mockConn.EXPECT().Close().Return(nil),
+		)
+
+		go func() {
+			err := handler.ConnectToChatRoom(c)
+			assert.NoError(t, err)
+		}()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			// OK
+		case <-time.After(1 * time.Second):
+			t.Fatal("Test timeout: goroutine did not finish")
+		}
+	})
+
 	t.Run("Missing user ID", func(t *testing.T) {
 		e := echo.New()
 
@@ -111,4 +199,382 @@ func TestConnectToChatRoom(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
 	})
+
+	authorizeErrors := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Missing room is rejected before upgrading", repository.ErrRoomNotFound, http.StatusNotFound},
+		{"Non-member is rejected before upgrading", repository.ErrNotRoomMember, http.StatusForbidden},
+		{"Banned user is rejected before upgrading", entity.ErrRoomBanned, http.StatusForbidden},
+	}
+	for _, tt := range authorizeErrors {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/ws/test-room", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", "outsider")
+			c.SetParamNames("room_id")
+			c.SetParamValues("test-room")
+
+			// アップグレードせずに HTTP のエラーを返す
+			mockDeps.WsUseCase.EXPECT().AuthorizeConnect(gomock.Any(), websocketcase.AuthorizeConnectRequest{
+				UserID: "outsider",
+				RoomID: "test-room",
+			}).Return(tt.err)
+
+			err := handler.ConnectToChatRoom(c)
+			assert.Equal(t, tt.code, err.(*echo.HTTPError).Code)
+		})
+	}
+
+	t.Run("User banned after authorization is closed with policy violation", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/ws/test-room", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", "banned-user")
+		c.SetParamNames("room_id")
+		c.SetParamValues("test-room")
+
+		mockConnRaw := mock_adapter.NewMockConnAdapter(ctrl)
+		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
+
+		mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+		mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+		mockDeps.WsUseCase.EXPECT().AuthorizeConnect(gomock.Any(), gomock.Any()).Return(nil)
+		mockDeps.WsUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(mockConnRaw, nil)
+		mockDeps.WsConnFactory.EXPECT().CreateWebSocketConnection(mockConnRaw).Return(mockConn, nil)
+		// 確認とアップグレードの間に追放された
+		mockDeps.WsUseCase.EXPECT().ConnectUserToRoom(gomock.Any(), gomock.Any()).
+			Return(websocketcase.ConnectUserToRoomResponse{}, entity.ErrRoomBanned)
+		mockConn.EXPECT().CloseWithReason(service.WebsocketClosePolicyViolation, "banned from the room").Return(nil)
+
+		// アップグレード済みのため HTTP のエラーは返さない
+		err := handler.ConnectToChatRoom(c)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Muted user keeps the connection and receives a muted error", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/ws/test-room", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", "muted-user")
+		c.SetParamNames("room_id")
+		c.SetParamValues("test-room")
+
+		mockConnRaw := mock_adapter.NewMockConnAdapter(ctrl)
+		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		sendEvent := entity.NewWebsocketEvent(entity.WebsocketEventParams{
+			Type:    entity.WebsocketEventTypeMessageSend,
+			ID:      "client-3",
+			Payload: entity.MessageSendPayload{Content: "hello"},
+		})
+		mutedErr := fmt.Errorf("%w until %s", entity.ErrUserMuted, "2030-01-01T00:00:00Z")
+
+		mockDeps.WsUseCase.EXPECT().AuthorizeConnect(gomock.Any(), gomock.Any()).Return(nil)
+		mockDeps.WsUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(mockConnRaw, nil)
+		mockDeps.WsConnFactory.EXPECT().CreateWebSocketConnection(mockConnRaw).Return(mockConn, nil)
+		mockDeps.WsUseCase.EXPECT().ConnectUserToRoom(gomock.Any(), gomock.Any()).Return(websocketcase.ConnectUserToRoomResponse{ClientID: "muted-client"}, nil)
+
+		gomock.InOrder(
+			mockConn.EXPECT().ReadEvent().Return(sendEvent, nil),
+			mockDeps.WsUseCase.EXPECT().SendMessage(gomock.Any(), gomock.Any()).Return(websocketcase.SendMessageResponse{}, mutedErr),
+			mockConn.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(ev *entity.WebsocketEvent) error {
+				assert.Equal(t, "client-3", ev.GetID())
+				payload := ev.GetPayload().(entity.ErrorPayload)
+				assert.Equal(t, entity.WebsocketErrorCodeMuted, payload.Code)
+				assert.Contains(t, payload.Message, "2030-01-01T00:00:00Z")
+				return nil
+			}),
+			// 接続は維持され、次のイベントを読み込む
+			mockConn.EXPECT().ReadEvent().Return(nil, assert.AnError),
+			mockDeps.Logger.EXPECT().Warn(gomock.Any(), gomock.Any()),
+			mockDeps.WsUseCase.EXPECT().DisconnectUser(gomock.Any(), websocketcase.DisconnectUserRequest{ClientID: "muted-client"}).DoAndReturn(func(_ context.Context, _ websocketcase.DisconnectUserRequest) error {
+				wg.Done()
+				return nil
+			}),
+			mockConn.EXPECT().Close().Return(nil),
+		)
+
+		go func() {
+			err := handler.ConnectToChatRoom(c)
+			assert.NoError(t, err)
+		}()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			// OK
+		case <-time.After(1 * time.Second):
+			t.Fatal("Test timeout: goroutine did not finish")
+		}
+	})
+
+	t.Run("Rate limited sender keeps the connection and receives retry_after", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/ws/test-room", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", "fast-user")
+		c.SetParamNames("room_id")
+		c.SetParamValues("test-room")
+
+		mockConnRaw := mock_adapter.NewMockConnAdapter(ctrl)
+		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		sendEvent := entity.NewWebsocketEvent(entity.WebsocketEventParams{
+			Type:    entity.WebsocketEventTypeMessageSend,
+			ID:      "client-4",
+			Payload: entity.MessageSendPayload{Content: "hello"},
+		})
+		retryAfter := time.Date(2030, 1, 1, 0, 0, 30, 0, time.UTC)
+		limitErr := &websocketcase.RateLimitError{Reason: websocketcase.RateLimitReasonSlowMode, RetryAfter: retryAfter}
+
+		mockDeps.WsUseCase.EXPECT().AuthorizeConnect(gomock.Any(), gomock.Any()).Return(nil)
+		mockDeps.WsUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(mockConnRaw, nil)
+		mockDeps.WsConnFactory.EXPECT().CreateWebSocketConnection(mockConnRaw).Return(mockConn, nil)
+		mockDeps.WsUseCase.EXPECT().ConnectUserToRoom(gomock.Any(), gomock.Any()).Return(websocketcase.ConnectUserToRoomResponse{ClientID: "fast-client"}, nil)
+
+		gomock.InOrder(
+			mockConn.EXPECT().ReadEvent().Return(sendEvent, nil),
+			mockDeps.WsUseCase.EXPECT().SendMessage(gomock.Any(), gomock.Any()).Return(websocketcase.SendMessageResponse{}, limitErr),
+			mockConn.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(ev *entity.WebsocketEvent) error {
+				assert.Equal(t, "client-4", ev.GetID())
+				payload := ev.GetPayload().(entity.ErrorPayload)
+				assert.Equal(t, entity.WebsocketErrorCodeRateLimited, payload.Code)
+				if assert.NotNil(t, payload.RetryAfter) {
+					assert.Equal(t, retryAfter, *payload.RetryAfter)
+				}
+				return nil
+			}),
+			// 接続は維持され、次のイベントを読み込む
+			mockConn.EXPECT().ReadEvent().Return(nil, assert.AnError),
+			mockDeps.Logger.EXPECT().Warn(gomock.Any(), gomock.Any()),
+			mockDeps.WsUseCase.EXPECT().DisconnectUser(gomock.Any(), websocketcase.DisconnectUserRequest{ClientID: "fast-client"}).DoAndReturn(func(_ context.Context, _ websocketcase.DisconnectUserRequest) error {
+				wg.Done()
+				return nil
+			}),
+			mockConn.EXPECT().Close().Return(nil),
+		)
+
+		go func() {
+			err := handler.ConnectToChatRoom(c)
+			assert.NoError(t, err)
+		}()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			// OK
+		case <-time.After(1 * time.Second):
+			t.Fatal("Test timeout: goroutine did not finish")
+		}
+	})
+
+	t.Run("message.read marks the room as read and is acknowledged", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/ws/test-room", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", "reader")
+		c.SetParamNames("room_id")
+		c.SetParamValues("test-room")
+
+		mockConnRaw := mock_adapter.NewMockConnAdapter(ctrl)
+		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		readEvent := func(id string, messageID entity.MessageID) *entity.WebsocketEvent {
+			return entity.NewWebsocketEvent(entity.WebsocketEventParams{
+				Type:    entity.WebsocketEventTypeMessageRead,
+				ID:      id,
+				Payload: entity.MessageReadPayload{MessageID: messageID},
+			})
+		}
+
+		mockDeps.WsUseCase.EXPECT().AuthorizeConnect(gomock.Any(), gomock.Any()).Return(nil)
+		mockDeps.WsUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(mockConnRaw, nil)
+		mockDeps.WsConnFactory.EXPECT().CreateWebSocketConnection(mockConnRaw).Return(mockConn, nil)
+		mockDeps.WsUseCase.EXPECT().ConnectUserToRoom(gomock.Any(), gomock.Any()).Return(websocketcase.ConnectUserToRoomResponse{ClientID: "reader-client"}, nil)
+
+		gomock.InOrder(
+			mockConn.EXPECT().ReadEvent().Return(readEvent("client-5", "msg-1"), nil),
+			mockDeps.MsgUseCase.EXPECT().MarkAsRead(gomock.Any(), messagecase.MarkAsReadRequest{
+				RoomID:    "test-room",
+				MessageID: "msg-1",
+				UserID:    "reader",
+			}).Return(nil),
+			mockConn.EXPECT().WriteEvent(entity.NewAckEvent("client-5", entity.AckPayload{})).Return(nil),
+			// 別の部屋のメッセージは不正なイベントとして扱い、接続は維持する
+			mockConn.EXPECT().ReadEvent().Return(readEvent("client-6", "msg-other"), nil),
+			mockDeps.MsgUseCase.EXPECT().MarkAsRead(gomock.Any(), gomock.Any()).Return(repository.ErrMessageNotFound),
+			mockConn.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(ev *entity.WebsocketEvent) error {
+				assert.Equal(t, "client-6", ev.GetID())
+				assert.Equal(t, entity.WebsocketErrorCodeInvalidEvent, ev.GetPayload().(entity.ErrorPayload).Code)
+				return nil
+			}),
+			mockConn.EXPECT().ReadEvent().Return(nil, assert.AnError),
+			mockDeps.Logger.EXPECT().Warn(gomock.Any(), gomock.Any()),
+			mockDeps.WsUseCase.EXPECT().DisconnectUser(gomock.Any(), websocketcase.DisconnectUserRequest{ClientID: "reader-client"}).DoAndReturn(func(_ context.Context, _ websocketcase.DisconnectUserRequest) error {
+				wg.Done()
+				return nil
+			}),
+			mockConn.EXPECT().Close().Return(nil),
+		)
+
+		go func() {
+			err := handler.ConnectToChatRoom(c)
+			assert.NoError(t, err)
+		}()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			// OK
+		case <-time.After(1 * time.Second):
+			t.Fatal("Test timeout: goroutine did not finish")
+		}
+	})
+	t.Run("typing events update the typing state without ack", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/ws/test-room", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", "typist")
+		c.SetParamNames("room_id")
+		c.SetParamValues("test-room")
+
+		mockConnRaw := mock_adapter.NewMockConnAdapter(ctrl)
+		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		typingEvent := func(eventType entity.WebsocketEventType, id string) *entity.WebsocketEvent {
+			return entity.NewWebsocketEvent(entity.WebsocketEventParams{Type: eventType, ID: id})
+		}
+		typingReq := websocketcase.TypingRequest{RoomID: "test-room", UserID: "typist"}
+
+		mockDeps.WsUseCase.EXPECT().AuthorizeConnect(gomock.Any(), gomock.Any()).Return(nil)
+		mockDeps.WsUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(mockConnRaw, nil)
+		mockDeps.WsConnFactory.EXPECT().CreateWebSocketConnection(mockConnRaw).Return(mockConn, nil)
+		mockDeps.WsUseCase.EXPECT().ConnectUserToRoom(gomock.Any(), gomock.Any()).Return(websocketcase.ConnectUserToRoomResponse{ClientID: "typist-client"}, nil)
+
+		gomock.InOrder(
+			mockConn.EXPECT().ReadEvent().Return(typingEvent(entity.WebsocketEventTypeTypingStart, "client-7"), nil),
+			mockDeps.WsUseCase.EXPECT().StartTyping(gomock.Any(), typingReq).Return(nil),
+			mockConn.EXPECT().ReadEvent().Return(typingEvent(entity.WebsocketEventTypeTypingStop, "client-8"), nil),
+			mockDeps.WsUseCase.EXPECT().StopTyping(gomock.Any(), typingReq).Return(nil),
+			// 接続後に部屋を退出した場合は拒否し、接続は維持する
+			mockConn.EXPECT().ReadEvent().Return(typingEvent(entity.WebsocketEventTypeTypingStart, "client-9"), nil),
+			mockDeps.WsUseCase.EXPECT().StartTyping(gomock.Any(), typingReq).Return(repository.ErrNotRoomMember),
+			mockConn.EXPECT().WriteEvent(gomock.Any()).DoAndReturn(func(ev *entity.WebsocketEvent) error {
+				assert.Equal(t, "client-9", ev.GetID())
+				assert.Equal(t, entity.WebsocketErrorCodeForbidden, ev.GetPayload().(entity.ErrorPayload).Code)
+				return nil
+			}),
+			mockConn.EXPECT().ReadEvent().Return(nil, assert.AnError),
+			mockDeps.Logger.EXPECT().Warn(gomock.Any(), gomock.Any()),
+			mockDeps.WsUseCase.EXPECT().DisconnectUser(gomock.Any(), websocketcase.DisconnectUserRequest{ClientID: "typist-client"}).DoAndReturn(func(_ context.Context, _ websocketcase.DisconnectUserRequest) error {
+				wg.Done()
+				return nil
+			}),
+			mockConn.EXPECT().Close().Return(nil),
+		)
+
+		go func() {
+			err := handler.ConnectToChatRoom(c)
+			assert.NoError(t, err)
+		}()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			// OK
+		case <-time.After(1 * time.Second):
+			t.Fatal("Test timeout: goroutine did not finish")
+		}
+	})
+}
+
+func TestConnectToChatRoom_Presence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockDeps, e := websockethandler.NewTestWebsocketHandler(ctrl)
+
+	req := httptest.NewRequest(http.MethodGet, "/ws/test-room", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", "test-user")
+	c.SetParamNames("room_id")
+	c.SetParamValues("test-room")
+
+	mockConnRaw := mock_adapter.NewMockConnAdapter(ctrl)
+	mockConn := mock_service.NewMockWebSocketConnection(ctrl)
+	presenceReq := presencecase.PresenceRequest{UserID: "test-user"}
+
+	done := make(chan struct{})
+	heartbeatEvent := entity.NewWebsocketEvent(entity.WebsocketEventParams{Type: entity.WebsocketEventTypePresenceHeartbeat})
+
+	mockDeps.Logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDeps.WsUseCase.EXPECT().AuthorizeConnect(gomock.Any(), gomock.Any()).Return(nil)
+	mockDeps.WsUpgrader.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(mockConnRaw, nil)
+	mockDeps.WsConnFactory.EXPECT().CreateWebSocketConnection(mockConnRaw).Return(mockConn, nil)
+	mockDeps.WsUseCase.EXPECT().ConnectUserToRoom(gomock.Any(), gomock.Any()).Return(websocketcase.ConnectUserToRoomResponse{ClientID: "test-client"}, nil)
+
+	gomock.InOrder(
+		mockDeps.PresenceUseCase.EXPECT().Connect(gomock.Any(), presenceReq).Return(nil),
+		// presence.heartbeat には応答しない
+		mockConn.EXPECT().ReadEvent().Return(heartbeatEvent, nil),
+		mockDeps.PresenceUseCase.EXPECT().Heartbeat(gomock.Any(), presenceReq).Return(nil),
+		// 更新に失敗しても接続は維持する
+		mockConn.EXPECT().ReadEvent().Return(heartbeatEvent, nil),
+		mockDeps.PresenceUseCase.EXPECT().Heartbeat(gomock.Any(), presenceReq).Return(assert.AnError),
+		mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any()),
+		mockConn.EXPECT().ReadEvent().Return(nil, assert.AnError),
+		mockDeps.Logger.EXPECT().Warn(gomock.Any(), gomock.Any()),
+		mockDeps.WsUseCase.EXPECT().DisconnectUser(gomock.Any(), websocketcase.DisconnectUserRequest{ClientID: "test-client"}).Return(nil),
+		mockDeps.PresenceUseCase.EXPECT().Disconnect(gomock.Any(), presenceReq).Return(nil),
+		mockConn.EXPECT().Close().DoAndReturn(func() error {
+			close(done)
+			return nil
+		}),
+	)
+
+	assert.NoError(t, handler.ConnectToChatRoom(c))
+
+	select {
+	case <-done:
+		// OK
+	case <-time.After(1 * time.Second):
+		t.Fatal("Test timeout: goroutine did not finish")
+	}
 }
@@ -5,22 +5,32 @@ import (
 
 	"example.com/infrahandson/internal/interface/adapter"
 	"example.com/infrahandson/internal/interface/factory"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"example.com/infrahandson/internal/usecase/presencecase"
 	"example.com/infrahandson/internal/usecase/websocketcase"
 )
 
 type NewWebSocketHandlerParams struct {
-	WsUseCase     websocketcase.WebsocketUseCaseInterface
-	WsUpgrader    adapter.WebSocketUpgraderAdapter
-	WsConnFactory factory.WebSocketConnectionFactory
-	UserIDFactory factory.UserIDFactory
-	RoomIDFactory factory.RoomIDFactory
-	Logger        adapter.LoggerAdapter
+	WsUseCase       websocketcase.WebsocketUseCaseInterface
+	MsgUseCase      messagecase.MessageUseCaseInterface   // message.read の既読の保存に使用する
+	PresenceUseCase presencecase.PresenceUseCaseInterface // 接続・切断・操作によるオンライン状態の更新に使用する
+	WsUpgrader      adapter.WebSocketUpgraderAdapter
+	WsConnFactory   factory.WebSocketConnectionFactory
+	UserIDFactory   factory.UserIDFactory
+	RoomIDFactory   factory.RoomIDFactory
+	Logger          adapter.LoggerAdapter
 }
 
 func (p *NewWebSocketHandlerParams) Validate() error {
 	if p.WsUseCase == nil {
 		return errors.New("websocketUseCase is required")
 	}
+	if p.MsgUseCase == nil {
+		return errors.New("messageUseCase is required")
+	}
+	if p.PresenceUseCase == nil {
+		return errors.New("presenceUseCase is required")
+	}
 	if p.WsUpgrader == nil {
 		return errors.New("websocketUpgrader is required")
 	}
@@ -44,11 +54,13 @@ func NewWebSocketHandler(params NewWebSocketHandlerParams) WebSocketHandlerInter
 		panic(err)
 	}
 	return &WebSocketHandler{
-		WsUseCase:     params.WsUseCase,
-		WsUpgrader:    params.WsUpgrader,
-		WsConnFactory: params.WsConnFactory,
-		UserIDFactory: params.UserIDFactory,
-		RoomIDFactory: params.RoomIDFactory,
-		Logger:        params.Logger,
+		WsUseCase:       params.WsUseCase,
+		MsgUseCase:      params.MsgUseCase,
+		PresenceUseCase: params.PresenceUseCase,
+		WsUpgrader:      params.WsUpgrader,
+		WsConnFactory:   params.WsConnFactory,
+		UserIDFactory:   params.UserIDFactory,
+		RoomIDFactory:   params.RoomIDFactory,
+		Logger:          params.Logger,
 	}
 }
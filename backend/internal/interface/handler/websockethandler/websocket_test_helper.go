@@ -4,44 +4,54 @@ import (
 	"example.com/infrahandson/internal/infrastructure/validator"
 	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
 	mock_factory "example.com/infrahandson/test/mocks/interface/factory"
+	mock_messagecase "example.com/infrahandson/test/mocks/usecase/messagecase"
+	mock_presencecase "example.com/infrahandson/test/mocks/usecase/presencecase"
 	mock_websocketcase "example.com/infrahandson/test/mocks/usecase/websocketcase"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/mock/gomock"
 )
 
 type mockDeps struct {
-	WsUseCase     mock_websocketcase.MockWebsocketUseCaseInterface
-	WsUpgrader    mock_adapter.MockWebSocketUpgraderAdapter
-	WsConnFactory mock_factory.MockWebSocketConnectionFactory
-	UserIDFactory mock_factory.MockUserIDFactory
-	RoomIDFactory mock_factory.MockRoomIDFactory
-	Logger        mock_adapter.MockLoggerAdapter
+	WsUseCase       mock_websocketcase.MockWebsocketUseCaseInterface
+	MsgUseCase      mock_messagecase.MockMessageUseCaseInterface
+	PresenceUseCase mock_presencecase.MockPresenceUseCaseInterface
+	WsUpgrader      mock_adapter.MockWebSocketUpgraderAdapter
+	WsConnFactory   mock_factory.MockWebSocketConnectionFactory
+	UserIDFactory   mock_factory.MockUserIDFactory
+	RoomIDFactory   mock_factory.MockRoomIDFactory
+	Logger          mock_adapter.MockLoggerAdapter
 }
 
 func NewTestWebsocketHandler(
 	ctrl *gomock.Controller,
 ) (WebSocketHandlerInterface, mockDeps, *echo.Echo) {
 	mockWsUseCase := mock_websocketcase.NewMockWebsocketUseCaseInterface(ctrl)
+	mockMsgUseCase := mock_messagecase.NewMockMessageUseCaseInterface(ctrl)
+	mockPresenceUseCase := mock_presencecase.NewMockPresenceUseCaseInterface(ctrl)
 	mockWsUpGrader := mock_adapter.NewMockWebSocketUpgraderAdapter(ctrl)
 	mockWsConnFactory := mock_factory.NewMockWebSocketConnectionFactory(ctrl)
 	mockUserIDFactory := mock_factory.NewMockUserIDFactory(ctrl)
 	mockRoomIDFactory := mock_factory.NewMockRoomIDFactory(ctrl)
 	mockLogger := mock_adapter.NewMockLoggerAdapter(ctrl)
 	mockDeps := mockDeps{
-		WsUseCase:     *mockWsUseCase,
-		WsUpgrader:    *mockWsUpGrader,
-		WsConnFactory: *mockWsConnFactory,
-		UserIDFactory: *mockUserIDFactory,
-		RoomIDFactory: *mockRoomIDFactory,
-		Logger:        *mockLogger,
+		WsUseCase:       *mockWsUseCase,
+		MsgUseCase:      *mockMsgUseCase,
+		PresenceUseCase: *mockPresenceUseCase,
+		WsUpgrader:      *mockWsUpGrader,
+		WsConnFactory:   *mockWsConnFactory,
+		UserIDFactory:   *mockUserIDFactory,
+		RoomIDFactory:   *mockRoomIDFactory,
+		Logger:          *mockLogger,
 	}
 	handler := NewWebSocketHandler(NewWebSocketHandlerParams{
-		WsUseCase:     mockWsUseCase,
-		WsUpgrader:    mockWsUpGrader,
-		WsConnFactory: mockWsConnFactory,
-		UserIDFactory: mockUserIDFactory,
-		RoomIDFactory: mockRoomIDFactory,
-		Logger:        mockLogger,
+		WsUseCase:       mockWsUseCase,
+		MsgUseCase:      mockMsgUseCase,
+		PresenceUseCase: mockPresenceUseCase,
+		WsUpgrader:      mockWsUpGrader,
+		WsConnFactory:   mockWsConnFactory,
+		UserIDFactory:   mockUserIDFactory,
+		RoomIDFactory:   mockRoomIDFactory,
+		Logger:          mockLogger,
 	})
 
 	e := echo.New()
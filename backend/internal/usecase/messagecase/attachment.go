@@ -0,0 +1,165 @@
+package messagecase
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"time"
+	"unicode/utf8"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+)
+
+// maxAttachmentFileNameLength はファイル名として保存する最大の文字数
+const maxAttachmentFileNameLength = 255
+
+// UploadAttachmentRequest構造体: 添付ファイルのアップロードのリクエスト
+type UploadAttachmentRequest struct {
+	RoomID entity.RoomID
+	UserID entity.UserID // アップロードするユーザー（部屋のメンバーのみアップロードできる）
+	File   *multipart.FileHeader
+}
+
+// UploadAttachmentResponse構造体: 添付ファイルのアップロードのレスポンス
+type UploadAttachmentResponse struct {
+	Attachment *entity.Attachment
+}
+
+// UploadAttachment は添付ファイルを保存し、まだメッセージに添付していない状態で記録します。
+// MIME タイプはクライアントが申告した Content-Type ではなく内容から判定します。
+// 返した ID を message.send の attachment_ids に指定すると、アップロードした本人が同じ部屋のメッセージに添付できます。
+// メッセージに添付されないまま残ったファイルは削除しません。
+func (uc *MessageUseCase) UploadAttachment(ctx context.Context, req UploadAttachmentRequest) (UploadAttachmentResponse, error) {
+	if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+		return UploadAttachmentResponse{}, err
+	}
+	if req.File.Size > uc.maxAttachmentSize {
+		return UploadAttachmentResponse{}, service.ErrAttachmentTooLarge
+	}
+
+	data, err := uc.readAttachmentData(req.File)
+	if err != nil {
+		return UploadAttachmentResponse{}, err
+	}
+	if err := service.ValidateAttachmentData(data, uc.maxAttachmentSize); err != nil {
+		return UploadAttachmentResponse{}, err
+	}
+
+	id, err := uc.attachmentIDFactory.NewAttachmentID()
+	if err != nil {
+		return UploadAttachmentResponse{}, err
+	}
+	stored, err := uc.attachmentStore.SaveAttachment(ctx, id, data)
+	if err != nil {
+		return UploadAttachmentResponse{}, err
+	}
+
+	attachment := entity.NewAttachment(entity.AttachmentParams{
+		ID:           id,
+		RoomID:       req.RoomID,
+		UploaderID:   req.UserID,
+		FileName:     data.FileName,
+		MimeType:     data.MimeType,
+		Size:         int64(len(data.Data)),
+		Width:        stored.Width,
+		Height:       stored.Height,
+		HasThumbnail: stored.HasThumbnail,
+		CreatedAt:    time.Now(),
+	})
+	if err := uc.attachmentRepo.CreateAttachment(ctx, attachment); err != nil {
+		// 記録できなかったファイルは参照できないため削除する
+		_ = uc.attachmentStore.DeleteAttachment(ctx, id)
+		return UploadAttachmentResponse{}, err
+	}
+	return UploadAttachmentResponse{Attachment: attachment}, nil
+}
+
+// readAttachmentData はアップロードされたファイルを AttachmentData に変換する
+// 申告されたサイズを信用せず、上限を超えて読み込まないようにする
+func (uc *MessageUseCase) readAttachmentData(fh *multipart.FileHeader) (*service.AttachmentData, error) {
+	file, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, uc.maxAttachmentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	return &service.AttachmentData{
+		Data:     data,
+		FileName: attachmentFileName(fh.Filename),
+		MimeType: service.DetectAttachmentType(data),
+	}, nil
+}
+
+// attachmentFileName は表示用のファイル名を整える（保存先のパスには使わない）
+func attachmentFileName(name string) string {
+	if !utf8.ValidString(name) {
+		name = ""
+	}
+	if utf8.RuneCountInString(name) > maxAttachmentFileNameLength {
+		name = string([]rune(name)[:maxAttachmentFileNameLength])
+	}
+	if name == "" {
+		return "file"
+	}
+	return name
+}
+
+// OpenAttachmentRequest構造体: 添付ファイルのダウンロードのリクエスト
+type OpenAttachmentRequest struct {
+	RoomID       entity.RoomID
+	AttachmentID entity.AttachmentID
+	UserID       entity.UserID // ダウンロードするユーザー（部屋のメンバーのみダウンロードできる）
+	Thumbnail    bool          // true の場合はサムネイルを返す
+}
+
+// OpenAttachmentResponse構造体: 添付ファイルのダウンロードのレスポンス
+type OpenAttachmentResponse struct {
+	Attachment *entity.Attachment
+	Body       io.ReadCloser // 読み終えたら Close すること
+}
+
+// OpenAttachment は部屋のメンバーに添付ファイル（またはサムネイル）を返します。
+// まだメッセージに添付していないファイルはアップロードした本人のみ、
+// 添付したメッセージが削除された場合は誰もダウンロードできず、repository.ErrAttachmentNotFound を返します。
+// サムネイルがない場合は service.ErrAttachmentFileNotFound を返します。
+func (uc *MessageUseCase) OpenAttachment(ctx context.Context, req OpenAttachmentRequest) (OpenAttachmentResponse, error) {
+	if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+		return OpenAttachmentResponse{}, err
+	}
+
+	attachment, err := uc.attachmentRepo.GetAttachmentByID(ctx, req.AttachmentID)
+	if err != nil {
+		return OpenAttachmentResponse{}, err
+	}
+	if attachment.GetRoomID() != req.RoomID {
+		return OpenAttachmentResponse{}, repository.ErrAttachmentNotFound
+	}
+	if !attachment.IsAttached() {
+		if attachment.GetUploaderID() != req.UserID {
+			return OpenAttachmentResponse{}, repository.ErrAttachmentNotFound
+		}
+	} else {
+		msg, err := uc.msgRepo.GetMessageByID(ctx, attachment.GetMessageID())
+		if err != nil {
+			return OpenAttachmentResponse{}, err
+		}
+		if msg.IsDeleted() {
+			return OpenAttachmentResponse{}, repository.ErrAttachmentNotFound
+		}
+	}
+	if req.Thumbnail && !attachment.HasThumbnail() {
+		return OpenAttachmentResponse{}, service.ErrAttachmentFileNotFound
+	}
+
+	body, err := uc.attachmentStore.OpenAttachment(ctx, attachment.GetID(), req.Thumbnail)
+	if err != nil {
+		return OpenAttachmentResponse{}, err
+	}
+	return OpenAttachmentResponse{Attachment: attachment, Body: body}, nil
+}
@@ -0,0 +1,233 @@
+package messagecase_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newAttachmentFileHeader はテスト用のアップロードファイルを作成する
+func newAttachmentFileHeader(t *testing.T, fileName string, content []byte, contentType string) *multipart.FileHeader {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="file"; filename="`+fileName+`"`)
+	header.Set("Content-Type", contentType)
+	fw, err := w.CreatePart(header)
+	require.NoError(t, err)
+	_, err = fw.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	form, err := multipart.NewReader(&b, w.Boundary()).ReadForm(1 << 20)
+	require.NoError(t, err)
+	return form.File["file"][0]
+}
+
+// 1. 正常系：内容から判定した MIME タイプで保存する
+// 2. 異常系：サイズの上限を超える
+// 3. 異常系：許可されていない種類のファイル
+// 4. 異常系：記録に失敗した場合は保存したファイルを削除する
+// 5. 異常系：部屋のメンバーでない
+func TestUploadAttachment(t *testing.T) {
+	ctx := context.Background()
+	roomID := entity.RoomID("room1")
+	userID := entity.UserID("user1")
+
+	t.Run("1. 正常系：内容から判定した MIME タイプで保存する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		content := []byte("hello, world")
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleMember, nil)
+		deps.AttachmentIDFactory.EXPECT().NewAttachmentID().Return(entity.AttachmentID("att1"), nil)
+		deps.AttachmentStore.EXPECT().SaveAttachment(ctx, entity.AttachmentID("att1"), &service.AttachmentData{
+			Data:     content,
+			FileName: "memo.txt",
+			MimeType: "text/plain",
+		}).Return(&service.StoredAttachment{}, nil)
+		deps.AttachmentRepo.EXPECT().CreateAttachment(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, a *entity.Attachment) error {
+				assert.Equal(t, roomID, a.GetRoomID())
+				assert.Equal(t, userID, a.GetUploaderID())
+				assert.False(t, a.IsAttached())
+				return nil
+			})
+
+		// 申告された Content-Type は使わない
+		res, err := uc.UploadAttachment(ctx, messagecase.UploadAttachmentRequest{
+			RoomID: roomID,
+			UserID: userID,
+			File:   newAttachmentFileHeader(t, "memo.txt", content, "text/html"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, entity.AttachmentID("att1"), res.Attachment.GetID())
+		assert.Equal(t, "text/plain", res.Attachment.GetMimeType())
+		assert.Equal(t, int64(len(content)), res.Attachment.GetSize())
+		assert.Equal(t, "/api/room/room1/attachments/att1", res.Attachment.GetDownloadPath())
+	})
+
+	t.Run("2. 異常系：サイズの上限を超える", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleMember, nil)
+
+		_, err := uc.UploadAttachment(ctx, messagecase.UploadAttachmentRequest{
+			RoomID: roomID,
+			UserID: userID,
+			File:   newAttachmentFileHeader(t, "big.txt", []byte(strings.Repeat("a", messagecase.TestMaxAttachmentSize+1)), "text/plain"),
+		})
+		assert.ErrorIs(t, err, service.ErrAttachmentTooLarge)
+	})
+
+	t.Run("3. 異常系：許可されていない種類のファイル", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleMember, nil)
+
+		_, err := uc.UploadAttachment(ctx, messagecase.UploadAttachmentRequest{
+			RoomID: roomID,
+			UserID: userID,
+			File:   newAttachmentFileHeader(t, "page.txt", []byte("<html><script>alert(1)</script></html>"), "text/plain"),
+		})
+		assert.ErrorIs(t, err, service.ErrInvalidAttachmentType)
+	})
+
+	t.Run("4. 異常系：記録に失敗した場合は保存したファイルを削除する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleMember, nil)
+		deps.AttachmentIDFactory.EXPECT().NewAttachmentID().Return(entity.AttachmentID("att1"), nil)
+		deps.AttachmentStore.EXPECT().SaveAttachment(ctx, entity.AttachmentID("att1"), gomock.Any()).Return(&service.StoredAttachment{}, nil)
+		deps.AttachmentRepo.EXPECT().CreateAttachment(ctx, gomock.Any()).Return(assert.AnError)
+		deps.AttachmentStore.EXPECT().DeleteAttachment(ctx, entity.AttachmentID("att1")).Return(nil)
+
+		_, err := uc.UploadAttachment(ctx, messagecase.UploadAttachmentRequest{
+			RoomID: roomID,
+			UserID: userID,
+			File:   newAttachmentFileHeader(t, "memo.txt", []byte("hello"), "text/plain"),
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("5. 異常系：部屋のメンバーでない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		_, err := uc.UploadAttachment(ctx, messagecase.UploadAttachmentRequest{
+			RoomID: roomID,
+			UserID: userID,
+			File:   newAttachmentFileHeader(t, "memo.txt", []byte("hello"), "text/plain"),
+		})
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
+}
+
+// 1. 正常系：添付済みのファイルは部屋のメンバーがダウンロードできる
+// 2. 正常系：未添付のファイルはアップロードした本人がダウンロードできる
+// 3. 異常系：未添付のファイルは本人以外ダウンロードできない
+// 4. 異常系：別の部屋の添付ファイル
+// 5. 異常系：添付したメッセージが削除されている
+// 6. 異常系：サムネイルがない
+func TestOpenAttachment(t *testing.T) {
+	ctx := context.Background()
+	roomID := entity.RoomID("room1")
+	viewerID := entity.UserID("viewer")
+	newAttachment := func(roomID entity.RoomID, messageID entity.MessageID) *entity.Attachment {
+		return entity.NewAttachment(entity.AttachmentParams{
+			ID:         "att1",
+			RoomID:     roomID,
+			UploaderID: "author",
+			MessageID:  messageID,
+			FileName:   "photo.png",
+			MimeType:   "image/png",
+		})
+	}
+
+	t.Run("1. 正常系：添付済みのファイルは部屋のメンバーがダウンロードできる", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		attachment := newAttachment(roomID, "msg1")
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, viewerID).Return(entity.RoomRoleMember, nil)
+		deps.AttachmentRepo.EXPECT().GetAttachmentByID(ctx, entity.AttachmentID("att1")).Return(attachment, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, entity.MessageID("msg1")).Return(newStoredMessage(false), nil)
+		deps.AttachmentStore.EXPECT().OpenAttachment(ctx, entity.AttachmentID("att1"), false).
+			Return(io.NopCloser(strings.NewReader("data")), nil)
+
+		res, err := uc.OpenAttachment(ctx, messagecase.OpenAttachmentRequest{RoomID: roomID, AttachmentID: "att1", UserID: viewerID})
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, attachment, res.Attachment)
+		data, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "data", string(data))
+	})
+
+	t.Run("2. 正常系：未添付のファイルはアップロードした本人がダウンロードできる", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		attachment := entity.NewAttachment(entity.AttachmentParams{ID: "att1", RoomID: roomID, UploaderID: "author", HasThumbnail: true})
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, entity.UserID("author")).Return(entity.RoomRoleMember, nil)
+		deps.AttachmentRepo.EXPECT().GetAttachmentByID(ctx, entity.AttachmentID("att1")).Return(attachment, nil)
+		deps.AttachmentStore.EXPECT().OpenAttachment(ctx, entity.AttachmentID("att1"), true).
+			Return(io.NopCloser(strings.NewReader("thumb")), nil)
+
+		res, err := uc.OpenAttachment(ctx, messagecase.OpenAttachmentRequest{RoomID: roomID, AttachmentID: "att1", UserID: "author", Thumbnail: true})
+		require.NoError(t, err)
+		res.Body.Close()
+	})
+
+	t.Run("3. 異常系：未添付のファイルは本人以外ダウンロードできない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, viewerID).Return(entity.RoomRoleMember, nil)
+		deps.AttachmentRepo.EXPECT().GetAttachmentByID(ctx, entity.AttachmentID("att1")).Return(newAttachment(roomID, ""), nil)
+
+		_, err := uc.OpenAttachment(ctx, messagecase.OpenAttachmentRequest{RoomID: roomID, AttachmentID: "att1", UserID: viewerID})
+		assert.ErrorIs(t, err, repository.ErrAttachmentNotFound)
+	})
+
+	t.Run("4. 異常系：別の部屋の添付ファイル", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, viewerID).Return(entity.RoomRoleMember, nil)
+		deps.AttachmentRepo.EXPECT().GetAttachmentByID(ctx, entity.AttachmentID("att1")).Return(newAttachment("room2", "msg1"), nil)
+
+		_, err := uc.OpenAttachment(ctx, messagecase.OpenAttachmentRequest{RoomID: roomID, AttachmentID: "att1", UserID: viewerID})
+		assert.ErrorIs(t, err, repository.ErrAttachmentNotFound)
+	})
+
+	t.Run("5. 異常系：添付したメッセージが削除されている", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, viewerID).Return(entity.RoomRoleMember, nil)
+		deps.AttachmentRepo.EXPECT().GetAttachmentByID(ctx, entity.AttachmentID("att1")).Return(newAttachment(roomID, "msg1"), nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, entity.MessageID("msg1")).Return(newStoredMessage(true), nil)
+
+		_, err := uc.OpenAttachment(ctx, messagecase.OpenAttachmentRequest{RoomID: roomID, AttachmentID: "att1", UserID: viewerID})
+		assert.ErrorIs(t, err, repository.ErrAttachmentNotFound)
+	})
+
+	t.Run("6. 異常系：サムネイルがない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, viewerID).Return(entity.RoomRoleMember, nil)
+		deps.AttachmentRepo.EXPECT().GetAttachmentByID(ctx, entity.AttachmentID("att1")).Return(newAttachment(roomID, "msg1"), nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, entity.MessageID("msg1")).Return(newStoredMessage(false), nil)
+
+		_, err := uc.OpenAttachment(ctx, messagecase.OpenAttachmentRequest{RoomID: roomID, AttachmentID: "att1", UserID: viewerID, Thumbnail: true})
+		assert.ErrorIs(t, err, service.ErrAttachmentFileNotFound)
+	})
+}
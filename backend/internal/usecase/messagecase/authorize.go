@@ -0,0 +1,27 @@
+package messagecase
+
+import (
+	"context"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// requireMember はユーザーが部屋のメンバーであることを確認します。
+// 部屋が存在しない場合は repository.ErrRoomNotFound、メンバーでない場合は repository.ErrNotRoomMember を返します。
+func (uc *MessageUseCase) requireMember(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	_, err := uc.roomRepo.GetMemberRole(ctx, roomID, userID)
+	return err
+}
+
+// requireRole はユーザーが部屋で required 以上の役割を持つことを確認します。
+// 役割が足りない場合は entity.ErrInsufficientRoomRole を返します。
+func (uc *MessageUseCase) requireRole(ctx context.Context, roomID entity.RoomID, userID entity.UserID, required entity.RoomRole) error {
+	role, err := uc.roomRepo.GetMemberRole(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	if !role.AtLeast(required) {
+		return entity.ErrInsufficientRoomRole
+	}
+	return nil
+}
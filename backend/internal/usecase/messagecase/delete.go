@@ -0,0 +1,41 @@
+package messagecase
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// DeleteMessageRequest構造体: メッセージ削除のリクエスト
+type DeleteMessageRequest struct {
+	RoomID    entity.RoomID
+	MessageID entity.MessageID
+	UserID    entity.UserID // 削除するユーザー（投稿者のみ削除できる）
+}
+
+// DeleteMessage はメッセージを論理削除します。
+// 削除されたメッセージは本文を空にして message.deleted として部屋に配信されます。
+func (uc *MessageUseCase) DeleteMessage(ctx context.Context, req DeleteMessageRequest) error {
+	msg, err := uc.getModifiableMessage(ctx, req.RoomID, req.MessageID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := uc.msgRepo.DeleteMessage(ctx, req.MessageID, now); err != nil {
+		return err
+	}
+	msg.Delete(now)
+
+	if err := uc.msgCache.InvalidateRoom(ctx, req.RoomID); err != nil {
+		return err
+	}
+
+	// 削除は保存済みのため、配信に失敗してもエラーにはしない
+	err = uc.wsManager.BroadcastToRoom(ctx, req.RoomID, entity.NewMessageDeletedEvent(msg))
+	if err != nil {
+		uc.logger.Error("Failed to broadcast message deletion", "error", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package messagecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteMessage(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.DeleteMessageRequest{
+		RoomID:    "room1",
+		MessageID: "msg1",
+		UserID:    "author",
+	}
+
+	t.Run("正常系：本文を消して配信する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.MsgRepo.EXPECT().DeleteMessage(ctx, req.MessageID, gomock.Any()).Return(nil)
+		deps.MsgCache.EXPECT().InvalidateRoom(ctx, req.RoomID).Return(nil)
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entity.RoomID, ev *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeMessageDeleted, ev.GetType())
+				msg := ev.GetPayload().(*entity.Message)
+				assert.True(t, msg.IsDeleted())
+				assert.Empty(t, msg.GetContent())
+				return nil
+			})
+
+		assert.NoError(t, uc.DeleteMessage(ctx, req))
+	})
+
+	t.Run("正常系：配信に失敗しても保存済みの削除は成功として返す", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.MsgRepo.EXPECT().DeleteMessage(ctx, req.MessageID, gomock.Any()).Return(nil)
+		deps.MsgCache.EXPECT().InvalidateRoom(ctx, req.RoomID).Return(nil)
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).Return(errors.New("broadcast failed"))
+		deps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, uc.DeleteMessage(ctx, req))
+	})
+
+	t.Run("異常系：存在しない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(nil, repository.ErrMessageNotFound)
+
+		assert.ErrorIs(t, uc.DeleteMessage(ctx, req), repository.ErrMessageNotFound)
+	})
+
+	t.Run("異常系：投稿者以外", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+
+		other := req
+		other.UserID = "someone-else"
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, other.RoomID, other.UserID).Return(entity.RoomRoleMember, nil)
+		assert.ErrorIs(t, uc.DeleteMessage(ctx, other), messagecase.ErrNotMessageAuthor)
+	})
+
+	t.Run("異常系：削除済み", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(true), nil)
+
+		assert.ErrorIs(t, uc.DeleteMessage(ctx, req), messagecase.ErrMessageDeleted)
+	})
+
+	t.Run("異常系：部屋を退出したユーザー", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		assert.ErrorIs(t, uc.DeleteMessage(ctx, req), repository.ErrNotRoomMember)
+	})
+}
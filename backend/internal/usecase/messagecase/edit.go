@@ -0,0 +1,120 @@
+package messagecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// EditMessageRequest構造体: メッセージ編集のリクエスト
+type EditMessageRequest struct {
+	RoomID    entity.RoomID
+	MessageID entity.MessageID
+	UserID    entity.UserID // 編集するユーザー（投稿者のみ編集できる）
+	Content   string        // 新しい本文
+}
+
+// EditMessageResponse構造体: メッセージ編集の結果
+type EditMessageResponse struct {
+	Message *entity.Message // 編集後のメッセージ
+}
+
+// EditMessage はメッセージを編集し、編集前の本文を履歴に残します。
+// 編集後のメッセージは message.updated として部屋に配信されます。
+func (uc *MessageUseCase) EditMessage(ctx context.Context, req EditMessageRequest) (EditMessageResponse, error) {
+	if strings.TrimSpace(req.Content) == "" {
+		return EditMessageResponse{}, ErrEmptyContent
+	}
+
+	msg, err := uc.getModifiableMessage(ctx, req.RoomID, req.MessageID, req.UserID)
+	if err != nil {
+		return EditMessageResponse{}, err
+	}
+
+	revision := msg.Edit(req.Content, time.Now())
+	if err := uc.msgRepo.UpdateMessage(ctx, msg, revision); err != nil {
+		return EditMessageResponse{}, err
+	}
+
+	if err := uc.msgCache.InvalidateRoom(ctx, req.RoomID); err != nil {
+		return EditMessageResponse{}, err
+	}
+
+	// 編集は保存済みのため、配信に失敗してもエラーにはしない
+	err = uc.wsManager.BroadcastToRoom(ctx, req.RoomID, entity.NewMessageUpdatedEvent(msg))
+	if err != nil {
+		uc.logger.Error("Failed to broadcast message update", "error", err)
+	}
+
+	return EditMessageResponse{Message: msg}, nil
+}
+
+// GetMessageRevisionsRequest構造体: 編集履歴取得のリクエスト
+type GetMessageRevisionsRequest struct {
+	RoomID    entity.RoomID
+	MessageID entity.MessageID
+	UserID    entity.UserID // 閲覧するユーザー（部屋のメンバーのみ閲覧できる）
+}
+
+// GetMessageRevisionsResponse構造体: 編集履歴取得の結果
+type GetMessageRevisionsResponse struct {
+	Revisions []*entity.MessageRevision // 古い順
+}
+
+// GetMessageRevisions はメッセージの編集履歴を取得します。
+func (uc *MessageUseCase) GetMessageRevisions(ctx context.Context, req GetMessageRevisionsRequest) (GetMessageRevisionsResponse, error) {
+	if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+		return GetMessageRevisionsResponse{}, err
+	}
+
+	msg, err := uc.msgRepo.GetMessageByID(ctx, req.MessageID)
+	if err != nil {
+		return GetMessageRevisionsResponse{}, err
+	}
+	if msg.GetRoomID() != req.RoomID {
+		return GetMessageRevisionsResponse{}, repository.ErrMessageNotFound
+	}
+	// 削除済みのメッセージは過去の本文も公開しない
+	if msg.IsDeleted() {
+		return GetMessageRevisionsResponse{}, ErrMessageDeleted
+	}
+
+	revisions, err := uc.msgRepo.GetMessageRevisions(ctx, req.MessageID)
+	if err != nil {
+		return GetMessageRevisionsResponse{}, err
+	}
+
+	return GetMessageRevisionsResponse{Revisions: revisions}, nil
+}
+
+// getModifiableMessage は userID が編集・削除できるメッセージを取得します。
+// 部屋を退出したユーザーは自分のメッセージでも編集・削除できません。
+// 別の部屋のメッセージは存在しないものとして扱います。
+func (uc *MessageUseCase) getModifiableMessage(
+	ctx context.Context,
+	roomID entity.RoomID,
+	messageID entity.MessageID,
+	userID entity.UserID,
+) (*entity.Message, error) {
+	if err := uc.requireMember(ctx, roomID, userID); err != nil {
+		return nil, err
+	}
+
+	msg, err := uc.msgRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.GetRoomID() != roomID {
+		return nil, repository.ErrMessageNotFound
+	}
+	if msg.IsDeleted() {
+		return nil, ErrMessageDeleted
+	}
+	if msg.GetUserID() != userID {
+		return nil, ErrNotMessageAuthor
+	}
+	return msg, nil
+}
@@ -0,0 +1,174 @@
+package messagecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newStoredMessage(deleted bool) *entity.Message {
+	params := entity.MessageParams{
+		ID:      "msg1",
+		RoomID:  "room1",
+		UserID:  "author",
+		Content: "before",
+		SentAt:  time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	if deleted {
+		deletedAt := params.SentAt.Add(time.Minute)
+		params.DeletedAt = &deletedAt
+	}
+	return entity.NewMessage(params)
+}
+
+func TestEditMessage(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.EditMessageRequest{
+		RoomID:    "room1",
+		MessageID: "msg1",
+		UserID:    "author",
+		Content:   "after",
+	}
+
+	t.Run("正常系：履歴を残して配信する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.MsgRepo.EXPECT().UpdateMessage(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, msg *entity.Message, rev *entity.MessageRevision) error {
+				assert.Equal(t, "after", msg.GetContent())
+				assert.NotNil(t, msg.GetEditedAt())
+				assert.Equal(t, entity.MessageID("msg1"), rev.GetMessageID())
+				assert.Equal(t, "before", rev.GetContent())
+				return nil
+			})
+		deps.MsgCache.EXPECT().InvalidateRoom(ctx, req.RoomID).Return(nil)
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entity.RoomID, ev *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeMessageUpdated, ev.GetType())
+				assert.Equal(t, "after", ev.GetPayload().(*entity.Message).GetContent())
+				return nil
+			})
+
+		res, err := uc.EditMessage(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "after", res.Message.GetContent())
+	})
+
+	t.Run("正常系：配信に失敗しても保存済みの編集は成功として返す", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.MsgRepo.EXPECT().UpdateMessage(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		deps.MsgCache.EXPECT().InvalidateRoom(ctx, req.RoomID).Return(nil)
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).Return(errors.New("broadcast failed"))
+		deps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+
+		res, err := uc.EditMessage(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "after", res.Message.GetContent())
+	})
+
+	t.Run("異常系：本文が空", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, _ := messagecase.NewTestMessageUseCase(ctrl)
+
+		empty := req
+		empty.Content = "  "
+		_, err := uc.EditMessage(ctx, empty)
+		assert.ErrorIs(t, err, messagecase.ErrEmptyContent)
+	})
+
+	t.Run("異常系：投稿者以外", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+
+		other := req
+		other.UserID = "someone-else"
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, other.RoomID, other.UserID).Return(entity.RoomRoleMember, nil)
+		_, err := uc.EditMessage(ctx, other)
+		assert.ErrorIs(t, err, messagecase.ErrNotMessageAuthor)
+	})
+
+	t.Run("異常系：削除済み", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(true), nil)
+
+		_, err := uc.EditMessage(ctx, req)
+		assert.ErrorIs(t, err, messagecase.ErrMessageDeleted)
+	})
+
+	t.Run("異常系：別の部屋のメッセージ", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+
+		otherRoom := req
+		otherRoom.RoomID = "room2"
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, otherRoom.RoomID, otherRoom.UserID).Return(entity.RoomRoleMember, nil)
+		_, err := uc.EditMessage(ctx, otherRoom)
+		assert.ErrorIs(t, err, repository.ErrMessageNotFound)
+	})
+
+	t.Run("異常系：保存失敗", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.MsgRepo.EXPECT().UpdateMessage(ctx, gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		_, err := uc.EditMessage(ctx, req)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestGetMessageRevisions(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.GetMessageRevisionsRequest{RoomID: "room1", MessageID: "msg1", UserID: "author"}
+
+	t.Run("正常系", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		revisions := []*entity.MessageRevision{
+			entity.NewMessageRevision(entity.MessageRevisionParams{MessageID: "msg1", Content: "v1"}),
+		}
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.MsgRepo.EXPECT().GetMessageRevisions(ctx, req.MessageID).Return(revisions, nil)
+
+		res, err := uc.GetMessageRevisions(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, revisions, res.Revisions)
+	})
+
+	t.Run("異常系：削除済みのメッセージの履歴は返さない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(true), nil)
+
+		_, err := uc.GetMessageRevisions(ctx, req)
+		assert.ErrorIs(t, err, messagecase.ErrMessageDeleted)
+	})
+}
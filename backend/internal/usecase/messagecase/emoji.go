@@ -0,0 +1,116 @@
+package messagecase
+
+import "unicode/utf8"
+
+// 絵文字の組み立てに使う結合文字
+const (
+	zeroWidthJoiner    = '\u200D' // 複数の絵文字を1つに結合する（👨‍👩‍👧 など）
+	variationSelector  = '\uFE0F' // 直前の文字を絵文字として表示する
+	combiningKeycap    = '\u20E3' // 直前の数字・記号をキーキャップにする（1️⃣ など）
+	cancelTag          = '\U000E007F'
+	regionalIndicatorA = '\U0001F1E6'
+	regionalIndicatorZ = '\U0001F1FF'
+)
+
+// emojiRanges は絵文字として表示される文字を含むブロックです。
+// 肌の色の修飾子と国旗の地域指示子は単独では使えないため、isEmojiBase では除外します。
+var emojiRanges = [][2]rune{
+	{0x00A9, 0x00A9}, // ©
+	{0x00AE, 0x00AE}, // ®
+	{0x203C, 0x203C}, // ‼
+	{0x2049, 0x2049}, // ⁉
+	{0x2122, 0x2122}, // ™
+	{0x2139, 0x2139}, // ℹ
+	{0x2194, 0x21AA}, // 矢印
+	{0x231A, 0x23FF}, // ⌚ ⏰ など
+	{0x24C2, 0x24C2}, // Ⓜ
+	{0x25AA, 0x25FE}, // 幾何学図形
+	{0x2600, 0x27BF}, // その他の記号・装飾記号
+	{0x2934, 0x2935}, // ⤴ ⤵
+	{0x2B05, 0x2B55}, // ⬅ ⭐ ⭕ など
+	{0x3030, 0x3030}, // 〰
+	{0x303D, 0x303D}, // 〽
+	{0x3297, 0x3297}, // ㊗
+	{0x3299, 0x3299}, // ㊙
+	{0x1F000, 0x1FAFF},
+}
+
+// isEmoji は s が1つの絵文字であるかを判定します。
+// 国旗・キーキャップ・肌の色の修飾子・ZWJ で結合された絵文字も1つの絵文字として扱います。
+func isEmoji(s string) bool {
+	if s == "" || len(s) > maxEmojiBytes || !utf8.ValidString(s) {
+		return false
+	}
+	runes := []rune(s)
+
+	// 国旗: 地域指示子の2文字
+	if isRegionalIndicator(runes[0]) {
+		return len(runes) == 2 && isRegionalIndicator(runes[1])
+	}
+
+	// キーキャップ: 数字・#・* + (FE0F) + 20E3
+	if isKeycapBase(runes[0]) {
+		rest := runes[1:]
+		if len(rest) > 0 && rest[0] == variationSelector {
+			rest = rest[1:]
+		}
+		return len(rest) == 1 && rest[0] == combiningKeycap
+	}
+
+	// ZWJ で結合された絵文字の並び
+	expectBase := true
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if expectBase {
+			if !isEmojiBase(r) {
+				return false
+			}
+			expectBase = false
+			continue
+		}
+		switch {
+		case r == zeroWidthJoiner:
+			expectBase = true
+		case r == variationSelector, isSkinToneModifier(r):
+		case isTag(r):
+			// サブディビジョンの旗（🏴 + タグ文字 + 終端タグ）
+			for i < len(runes) && isTag(runes[i]) && runes[i] != cancelTag {
+				i++
+			}
+			if i >= len(runes) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return !expectBase
+}
+
+func isEmojiBase(r rune) bool {
+	if isRegionalIndicator(r) || isSkinToneModifier(r) {
+		return false
+	}
+	for _, rg := range emojiRanges {
+		if rg[0] <= r && r <= rg[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func isRegionalIndicator(r rune) bool {
+	return regionalIndicatorA <= r && r <= regionalIndicatorZ
+}
+
+func isSkinToneModifier(r rune) bool {
+	return 0x1F3FB <= r && r <= 0x1F3FF
+}
+
+func isKeycapBase(r rune) bool {
+	return ('0' <= r && r <= '9') || r == '#' || r == '*'
+}
+
+func isTag(r rune) bool {
+	return 0xE0020 <= r && r <= cancelTag
+}
@@ -0,0 +1,26 @@
+package messagecase
+
+import "errors"
+
+var (
+	// ErrNotMessageAuthor は投稿者以外がメッセージを編集・削除しようとした場合に返されます。
+	ErrNotMessageAuthor = errors.New("only the author can modify the message")
+
+	// ErrMessageDeleted は削除済みのメッセージを編集・削除しようとした場合に返されます。
+	ErrMessageDeleted = errors.New("message is already deleted")
+
+	// ErrEmptyContent は本文が空の場合に返されます。
+	ErrEmptyContent = errors.New("message content is empty")
+
+	// ErrInvalidEmoji はリアクションが1つの絵文字ではない場合に返されます。
+	ErrInvalidEmoji = errors.New("invalid reaction emoji")
+
+	// ErrEmptyQuery は検索語句が空の場合に返されます。
+	ErrEmptyQuery = errors.New("search query is empty")
+
+	// ErrPinLimitReached はピン留めの数が部屋の上限に達している場合に返されます。
+	ErrPinLimitReached = errors.New("room pin limit reached")
+
+	// ErrReadReceiptsDisabled は既読の公開が無効な場合にメンバーの既読位置を取得しようとした場合に返されます。
+	ErrReadReceiptsDisabled = errors.New("read receipts are disabled")
+)
@@ -5,6 +5,8 @@ import (
 
 	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/interface/adapter"
+	"example.com/infrahandson/internal/interface/factory"
 )
 
 type NewMessageUseCaseParams struct {
@@ -12,6 +14,26 @@ type NewMessageUseCaseParams struct {
 	MsgCache service.MessageCacheService
 	RoomRepo repository.RoomRepository
 	UserRepo repository.UserRepository
+	// ReactionRepo はメッセージのリアクションの追加・集計に使用する
+	ReactionRepo repository.ReactionRepository
+	// PinRepo はメッセージのピン留めに使用する
+	PinRepo repository.PinRepository
+	// ReadStateRepo はユーザーの部屋での既読位置の保存に使用する
+	ReadStateRepo repository.RoomReadStateRepository
+	// MentionRepo はメンションされたメッセージの一覧の取得に使用する
+	MentionRepo repository.MentionRepository
+	// WsManager は編集・削除を部屋に配信するために使用する
+	WsManager service.WebsocketManager
+	// Logger はコミット後の配信の失敗など、呼び出し元に返さないエラーの記録に使用する
+	Logger adapter.LoggerAdapter
+	// ReadReceipts が true の場合、既読位置を部屋の他のメンバーに配信・公開する
+	ReadReceipts bool
+	// AttachmentRepo・AttachmentStore・AttachmentIDFactory は添付ファイルのアップロード・ダウンロードに使用する
+	AttachmentRepo      repository.AttachmentRepository
+	AttachmentStore     service.AttachmentStoreService
+	AttachmentIDFactory factory.AttachmentIDFactory
+	// MaxAttachmentSize は添付ファイル1つあたりの最大バイト数
+	MaxAttachmentSize int64
 }
 
 func (p *NewMessageUseCaseParams) Validate() error {
@@ -27,6 +49,36 @@ func (p *NewMessageUseCaseParams) Validate() error {
 	if p.UserRepo == nil {
 		return errors.New("UserRepo is required")
 	}
+	if p.ReactionRepo == nil {
+		return errors.New("ReactionRepo is required")
+	}
+	if p.PinRepo == nil {
+		return errors.New("PinRepo is required")
+	}
+	if p.ReadStateRepo == nil {
+		return errors.New("ReadStateRepo is required")
+	}
+	if p.MentionRepo == nil {
+		return errors.New("MentionRepo is required")
+	}
+	if p.WsManager == nil {
+		return errors.New("WsManager is required")
+	}
+	if p.Logger == nil {
+		return errors.New("Logger is required")
+	}
+	if p.AttachmentRepo == nil {
+		return errors.New("AttachmentRepo is required")
+	}
+	if p.AttachmentStore == nil {
+		return errors.New("AttachmentStore is required")
+	}
+	if p.AttachmentIDFactory == nil {
+		return errors.New("AttachmentIDFactory is required")
+	}
+	if p.MaxAttachmentSize <= 0 {
+		return errors.New("MaxAttachmentSize must be positive")
+	}
 	return nil
 }
 
@@ -35,9 +87,21 @@ func NewMessageUseCase(params NewMessageUseCaseParams) *MessageUseCase {
 		panic(err)
 	}
 	return &MessageUseCase{
-		msgRepo:  params.MsgRepo,
-		msgCache: params.MsgCache,
-		roomRepo: params.RoomRepo,
-		userRepo: params.UserRepo,
+		msgRepo:       params.MsgRepo,
+		msgCache:      params.MsgCache,
+		roomRepo:      params.RoomRepo,
+		userRepo:      params.UserRepo,
+		reactionRepo:  params.ReactionRepo,
+		pinRepo:       params.PinRepo,
+		readStateRepo: params.ReadStateRepo,
+		mentionRepo:   params.MentionRepo,
+		wsManager:     params.WsManager,
+		logger:        params.Logger,
+		readReceipts:  params.ReadReceipts,
+
+		attachmentRepo:      params.AttachmentRepo,
+		attachmentStore:     params.AttachmentStore,
+		attachmentIDFactory: params.AttachmentIDFactory,
+		maxAttachmentSize:   params.MaxAttachmentSize,
 	}
 }
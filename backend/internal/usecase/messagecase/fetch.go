@@ -9,6 +9,7 @@ import (
 
 type GetMessageHistoryInRoomRequest struct {
 	RoomID       entity.RoomID
+	UserID       entity.UserID // 閲覧するユーザー（部屋のメンバーのみ閲覧できる）
 	Limit        int
 	BeforeSentAt time.Time
 }
@@ -17,9 +18,19 @@ type GetMessageHistoryInRoomResponse struct {
 	Messages         []*entity.Message
 	NextBeforeSentAt time.Time
 	HasNext          bool
+	// ThreadSummaries は返信があるメッセージの返信の要約（キーは親メッセージのID）
+	ThreadSummaries map[entity.MessageID]*entity.ThreadSummary
+	// Reactions はリアクションがあるメッセージの絵文字ごとの集計（キーはメッセージのID）
+	Reactions map[entity.MessageID][]*entity.ReactionSummary
+	// Attachments は添付ファイルがあるメッセージの添付ファイル（キーはメッセージのID、削除されたメッセージは含まない）
+	Attachments map[entity.MessageID][]*entity.Attachment
 }
 
 func (uc *MessageUseCase) GetMessageHistoryInRoom(ctx context.Context, req GetMessageHistoryInRoomRequest) (GetMessageHistoryInRoomResponse, error) {
+	if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+		return GetMessageHistoryInRoomResponse{}, err
+	}
+
 	// まずはキャッシュからの取得を試みる
 	messages, err := uc.msgCache.GetRecentMessages(ctx, req.RoomID)
 	if err != nil {
@@ -43,10 +54,25 @@ func (uc *MessageUseCase) GetMessageHistoryInRoom(ctx context.Context, req GetMe
 
 		// キャッシュが使えるか判定（キャッシュの最新が BeforeSentAt よりも古い）
 		if latest.Before(req.BeforeSentAt) {
+			summaries, err := uc.getThreadSummaries(ctx, messages)
+			if err != nil {
+				return GetMessageHistoryInRoomResponse{}, err
+			}
+			reactions, err := uc.getReactionSummaries(ctx, messages, req.UserID)
+			if err != nil {
+				return GetMessageHistoryInRoomResponse{}, err
+			}
+			attachments, err := uc.getAttachments(ctx, messages)
+			if err != nil {
+				return GetMessageHistoryInRoomResponse{}, err
+			}
 			return GetMessageHistoryInRoomResponse{
 				Messages:         messages,
 				NextBeforeSentAt: earliest,
 				HasNext:          len(messages) >= req.Limit,
+				ThreadSummaries:  summaries,
+				Reactions:        reactions,
+				Attachments:      attachments,
 			}, nil
 		}
 	}
@@ -62,9 +88,70 @@ func (uc *MessageUseCase) GetMessageHistoryInRoom(ctx context.Context, req GetMe
 		return GetMessageHistoryInRoomResponse{}, err
 	}
 
+	summaries, err := uc.getThreadSummaries(ctx, messages)
+	if err != nil {
+		return GetMessageHistoryInRoomResponse{}, err
+	}
+	reactions, err := uc.getReactionSummaries(ctx, messages, req.UserID)
+	if err != nil {
+		return GetMessageHistoryInRoomResponse{}, err
+	}
+	attachments, err := uc.getAttachments(ctx, messages)
+	if err != nil {
+		return GetMessageHistoryInRoomResponse{}, err
+	}
+
 	return GetMessageHistoryInRoomResponse{
 		Messages:         messages,
 		NextBeforeSentAt: nextBeforeSentAt,
 		HasNext:          hasNext,
+		ThreadSummaries:  summaries,
+		Reactions:        reactions,
+		Attachments:      attachments,
 	}, nil
 }
+
+// getThreadSummaries はメッセージごとの返信の要約を取得する
+// 返信数は頻繁に変わるため、キャッシュからメッセージを返す場合も毎回取得する
+func (uc *MessageUseCase) getThreadSummaries(ctx context.Context, messages []*entity.Message) (map[entity.MessageID]*entity.ThreadSummary, error) {
+	if len(messages) == 0 {
+		return map[entity.MessageID]*entity.ThreadSummary{}, nil
+	}
+	return uc.msgRepo.GetThreadSummaries(ctx, messageIDs(messages))
+}
+
+// getReactionSummaries はメッセージごとのリアクションの集計を取得する
+// 集計は閲覧するユーザーごとに異なるため、キャッシュせず毎回取得する
+func (uc *MessageUseCase) getReactionSummaries(
+	ctx context.Context,
+	messages []*entity.Message,
+	viewerID entity.UserID,
+) (map[entity.MessageID][]*entity.ReactionSummary, error) {
+	if len(messages) == 0 {
+		return map[entity.MessageID][]*entity.ReactionSummary{}, nil
+	}
+	return uc.reactionRepo.GetReactionSummaries(ctx, messageIDs(messages), viewerID)
+}
+
+// getAttachments はメッセージごとの添付ファイルを取得する
+// 削除されたメッセージの添付ファイルは返さない
+func (uc *MessageUseCase) getAttachments(ctx context.Context, messages []*entity.Message) (map[entity.MessageID][]*entity.Attachment, error) {
+	ids := make([]entity.MessageID, 0, len(messages))
+	for _, msg := range messages {
+		if !msg.IsDeleted() {
+			ids = append(ids, msg.GetID())
+		}
+	}
+	if len(ids) == 0 {
+		return map[entity.MessageID][]*entity.Attachment{}, nil
+	}
+	return uc.attachmentRepo.GetAttachmentsByMessageIDs(ctx, ids)
+}
+
+func messageIDs(messages []*entity.Message) []entity.MessageID {
+	ids := make([]entity.MessageID, len(messages))
+	for i, msg := range messages {
+		ids[i] = msg.GetID()
+	}
+	return ids
+}
@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/domain/service"
 	"example.com/infrahandson/internal/usecase/messagecase"
 	mock_repository "example.com/infrahandson/test/mocks/domain/repository"
 	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
+	mock_factory "example.com/infrahandson/test/mocks/interface/factory"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -20,6 +23,7 @@ import (
 // 2. DBから取得される正常系
 // 3. DBから取得されるが、エラーになる
 // 4. キャッシュから取得されるが、エラーになる
+// 5. 部屋のメンバーでない
 
 func TestGetMessageHistoryInRoom(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -52,12 +56,26 @@ func TestGetMessageHistoryInRoom(t *testing.T) {
 	mockRoomRepo := mock_repository.NewMockRoomRepository(ctrl)
 	mockUserRepo := mock_repository.NewMockUserRepository(ctrl)
 	mockMsgCache := mock_service.NewMockMessageCacheService(ctrl)
+	mockReactionRepo := mock_repository.NewMockReactionRepository(ctrl)
+	mockWsManager := mock_service.NewMockWebsocketManager(ctrl)
+	mockAttachmentRepo := mock_repository.NewMockAttachmentRepository(ctrl)
 
 	params := messagecase.NewMessageUseCaseParams{
-		MsgRepo:  mockMsgRepo,
-		MsgCache: mockMsgCache,
-		RoomRepo: mockRoomRepo,
-		UserRepo: mockUserRepo,
+		MsgRepo:       mockMsgRepo,
+		MsgCache:      mockMsgCache,
+		RoomRepo:      mockRoomRepo,
+		UserRepo:      mockUserRepo,
+		ReactionRepo:  mockReactionRepo,
+		PinRepo:       mock_repository.NewMockPinRepository(ctrl),
+		ReadStateRepo: mock_repository.NewMockRoomReadStateRepository(ctrl),
+		MentionRepo:   mock_repository.NewMockMentionRepository(ctrl),
+		WsManager:     mockWsManager,
+		Logger:        mock_adapter.NewMockLoggerAdapter(ctrl),
+
+		AttachmentRepo:      mockAttachmentRepo,
+		AttachmentStore:     mock_service.NewMockAttachmentStoreService(ctrl),
+		AttachmentIDFactory: mock_factory.NewMockAttachmentIDFactory(ctrl),
+		MaxAttachmentSize:   1024,
 	}
 	messageUseCase := messagecase.NewMessageUseCase(params)
 
@@ -77,12 +95,32 @@ func TestGetMessageHistoryInRoom(t *testing.T) {
 			}),
 		}
 
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, gomock.Any()).Return(entity.RoomRoleMember, nil)
 		mockMsgCache.EXPECT().
 			GetRecentMessages(context.Background(), roomID).
 			Return(cachedMessages, nil)
+		summaries := map[entity.MessageID]*entity.ThreadSummary{
+			"msg2": entity.NewThreadSummary(entity.ThreadSummaryParams{ReplyCount: 2}),
+		}
+		mockMsgRepo.EXPECT().
+			GetThreadSummaries(context.Background(), []entity.MessageID{"msg1", "msg2"}).
+			Return(summaries, nil)
+		reactions := map[entity.MessageID][]*entity.ReactionSummary{
+			"msg1": {entity.NewReactionSummary(entity.ReactionSummaryParams{Emoji: "👍", Count: 1, Reacted: true})},
+		}
+		mockReactionRepo.EXPECT().
+			GetReactionSummaries(context.Background(), []entity.MessageID{"msg1", "msg2"}, entity.UserID("user1")).
+			Return(reactions, nil)
+		attachments := map[entity.MessageID][]*entity.Attachment{
+			"msg2": {entity.NewAttachment(entity.AttachmentParams{ID: "att1", RoomID: roomID, MessageID: "msg2"})},
+		}
+		mockAttachmentRepo.EXPECT().
+			GetAttachmentsByMessageIDs(context.Background(), []entity.MessageID{"msg1", "msg2"}).
+			Return(attachments, nil)
 
 		req := messagecase.GetMessageHistoryInRoomRequest{
 			RoomID:       roomID,
+			UserID:       "user1",
 			Limit:        service.DefaultRecentMessageLimit(),
 			BeforeSentAt: time.Date(2023, 1, 1, 13, 0, 0, 0, time.UTC), // キャッシュより新しい
 		}
@@ -92,6 +130,9 @@ func TestGetMessageHistoryInRoom(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, cachedMessages, resp.Messages)
 		assert.False(t, resp.HasNext)
+		assert.Equal(t, summaries, resp.ThreadSummaries)
+		assert.Equal(t, reactions, resp.Reactions)
+		assert.Equal(t, attachments, resp.Attachments)
 	})
 
 	t.Run("2. DBからの取得が行われる正常系", func(t *testing.T) {
@@ -105,12 +146,22 @@ func TestGetMessageHistoryInRoom(t *testing.T) {
 			}),
 		}
 
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, gomock.Any()).Return(entity.RoomRoleMember, nil)
 		mockMsgCache.EXPECT().
 			GetRecentMessages(context.Background(), roomID).
 			Return(cachedMessages, nil)
 		mockMsgRepo.EXPECT().
 			GetMessageHistoryInRoom(context.Background(), roomID, defaultLimit, beforeSentAt).
 			Return(messages, nextBeforeSentAt, hasNext, nil)
+		mockMsgRepo.EXPECT().
+			GetThreadSummaries(context.Background(), []entity.MessageID{"msg1", "msg2"}).
+			Return(map[entity.MessageID]*entity.ThreadSummary{}, nil)
+		mockReactionRepo.EXPECT().
+			GetReactionSummaries(context.Background(), []entity.MessageID{"msg1", "msg2"}, entity.UserID("")).
+			Return(map[entity.MessageID][]*entity.ReactionSummary{}, nil)
+		mockAttachmentRepo.EXPECT().
+			GetAttachmentsByMessageIDs(context.Background(), []entity.MessageID{"msg1", "msg2"}).
+			Return(map[entity.MessageID][]*entity.Attachment{}, nil)
 
 		req := messagecase.GetMessageHistoryInRoomRequest{
 			RoomID:       roomID,
@@ -137,6 +188,7 @@ func TestGetMessageHistoryInRoom(t *testing.T) {
 				SentAt:  beforeSentAt.Add(1 * time.Minute), // リクエストよりも新しい
 			}),
 		}
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, gomock.Any()).Return(entity.RoomRoleMember, nil)
 		mockMsgCache.EXPECT().
 			GetRecentMessages(context.Background(), roomID).
 			Return(cachedMessages, nil)
@@ -159,6 +211,7 @@ func TestGetMessageHistoryInRoom(t *testing.T) {
 	})
 
 	t.Run("4. キャッシュエラー", func(t *testing.T) {
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, gomock.Any()).Return(entity.RoomRoleMember, nil)
 		mockMsgCache.EXPECT().
 			GetRecentMessages(context.Background(), roomID).
 			Return(nil, errors.New("cache error"))
@@ -174,4 +227,21 @@ func TestGetMessageHistoryInRoom(t *testing.T) {
 		assert.Error(t, err)
 		assert.Empty(t, resp.Messages)
 	})
+
+	t.Run("5. 部屋のメンバーでない", func(t *testing.T) {
+		mockRoomRepo.EXPECT().
+			GetMemberRole(context.Background(), roomID, entity.UserID("outsider")).
+			Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		req := messagecase.GetMessageHistoryInRoomRequest{
+			RoomID:       roomID,
+			UserID:       "outsider",
+			Limit:        defaultLimit,
+			BeforeSentAt: beforeSentAt,
+		}
+
+		_, err := messageUseCase.GetMessageHistoryInRoom(context.Background(), req)
+
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
 }
@@ -1,10 +1,59 @@
 package messagecase
 
-import "context"
+import (
+	"context"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
 
 type MessageUseCaseInterface interface {
 	// GetMessageHisotyroInRoom: 一定数のメッセージ履歴を取得する
 	GetMessageHistoryInRoom(ctx context.Context, req GetMessageHistoryInRoomRequest) (GetMessageHistoryInRoomResponse, error)
+
+	// SearchMessages: 参加している部屋のメッセージを本文で検索する(search.go)
+	SearchMessages(ctx context.Context, req SearchMessagesRequest) (SearchMessagesResponse, error)
+
+	// GetThread: スレッドの親メッセージと返信を取得する(thread.go)
+	GetThread(ctx context.Context, req GetThreadRequest) (GetThreadResponse, error)
+
+	// EditMessage: 投稿者がメッセージを編集する(edit.go)
+	EditMessage(ctx context.Context, req EditMessageRequest) (EditMessageResponse, error)
+
+	// GetMessageRevisions: メッセージの編集履歴を取得する(edit.go)
+	GetMessageRevisions(ctx context.Context, req GetMessageRevisionsRequest) (GetMessageRevisionsResponse, error)
+
+	// DeleteMessage: 投稿者がメッセージを削除する(delete.go)
+	DeleteMessage(ctx context.Context, req DeleteMessageRequest) error
+
+	// AddReaction: メッセージにリアクションを追加する(react.go)
+	AddReaction(ctx context.Context, req ReactionRequest) (ReactionResponse, error)
+
+	// RemoveReaction: メッセージのリアクションを取り消す(react.go)
+	RemoveReaction(ctx context.Context, req ReactionRequest) (ReactionResponse, error)
+
+	// PinMessage: 管理者がメッセージを部屋にピン留めする(pin.go)
+	PinMessage(ctx context.Context, req PinRequest) (*entity.PinnedMessage, error)
+
+	// UnpinMessage: 管理者がメッセージのピン留めを外す(pin.go)
+	UnpinMessage(ctx context.Context, req PinRequest) error
+
+	// GetPinnedMessages: 部屋にピン留めされているメッセージを取得する(pin.go)
+	GetPinnedMessages(ctx context.Context, req GetPinnedMessagesRequest) (GetPinnedMessagesResponse, error)
+
+	// MarkAsRead: 部屋のメッセージを指定したメッセージまで既読にする(read.go)
+	MarkAsRead(ctx context.Context, req MarkAsReadRequest) error
+
+	// GetReadReceipts: 部屋のメンバーの既読位置を取得する(read.go)
+	GetReadReceipts(ctx context.Context, req GetReadReceiptsRequest) (GetReadReceiptsResponse, error)
+
+	// ListMentions: 自分宛てのメンションを既読・未読の状態とともに取得する(mention.go)
+	ListMentions(ctx context.Context, req ListMentionsRequest) (ListMentionsResponse, error)
+
+	// UploadAttachment: メッセージに添付するファイルをアップロードする(attachment.go)
+	UploadAttachment(ctx context.Context, req UploadAttachmentRequest) (UploadAttachmentResponse, error)
+
+	// OpenAttachment: 添付ファイルまたはサムネイルを読み出す(attachment.go)
+	OpenAttachment(ctx context.Context, req OpenAttachmentRequest) (OpenAttachmentResponse, error)
 }
 
 // Note: メッセージ作成はWebsocketのUseCase内で行われる（チャット通信との同期）
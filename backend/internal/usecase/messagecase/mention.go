@@ -0,0 +1,53 @@
+package messagecase
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// メンション一覧で一度に取得できる最大件数
+const maxMentionListLimit = 100
+
+// ListMentionsRequest構造体: 自分宛てのメンション一覧のリクエスト
+type ListMentionsRequest struct {
+	UserID       entity.UserID
+	UnreadOnly   bool // 未読のメンションのみを取得する
+	Limit        int  // 1〜100 の範囲に丸める
+	BeforeSentAt time.Time
+}
+
+// ListMentionsResponse構造体: 自分宛てのメンション一覧
+type ListMentionsResponse struct {
+	Mentions         []*entity.MentionedMessage // メッセージの新しい順
+	NextBeforeSentAt time.Time
+	HasNext          bool
+}
+
+// ListMentions は自分宛てのメンションを既読・未読の状態とともに取得します。
+// 既読・未読は部屋の既読位置から判定するため、メンションごとに既読にする操作はありません。
+// 削除されたメッセージと、すでに退出した部屋のメンションは含みません。
+func (uc *MessageUseCase) ListMentions(ctx context.Context, req ListMentionsRequest) (ListMentionsResponse, error) {
+	limit := min(max(req.Limit, 1), maxMentionListLimit)
+
+	mentions, hasNext, err := uc.mentionRepo.ListMentions(ctx, repository.MentionListQuery{
+		UserID:       req.UserID,
+		UnreadOnly:   req.UnreadOnly,
+		BeforeSentAt: req.BeforeSentAt,
+		Limit:        limit,
+	})
+	if err != nil {
+		return ListMentionsResponse{}, err
+	}
+
+	res := ListMentionsResponse{
+		Mentions: mentions,
+		HasNext:  hasNext,
+	}
+	if hasNext {
+		res.NextBeforeSentAt = mentions[len(mentions)-1].GetMessage().GetSentAt()
+	}
+	return res, nil
+}
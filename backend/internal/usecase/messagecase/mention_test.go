@@ -0,0 +1,94 @@
+package messagecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系：次のページがある場合は最後のメッセージの送信日時を返す
+// 2. 正常系：次のページがない場合
+// 3. 正常系：取得件数を上限に丸める
+// 4. 異常系：メンションの取得に失敗
+func TestListMentions(t *testing.T) {
+	ctx := context.Background()
+	userID := entity.UserID("reader")
+	before := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	newMentioned := func(id entity.MessageID, sentAt time.Time, read bool) *entity.MentionedMessage {
+		msg := entity.NewMessage(entity.MessageParams{ID: id, RoomID: "room1", UserID: "author", Content: "@reader hi", SentAt: sentAt})
+		mention := entity.NewMention(entity.MentionParams{
+			MessageID: id,
+			RoomID:    "room1",
+			UserID:    userID,
+			Kind:      entity.MentionKindUser,
+			CreatedAt: sentAt,
+		})
+		return entity.NewMentionedMessage(mention, msg, read)
+	}
+
+	t.Run("1. 正常系：次のページがある場合は最後のメッセージの送信日時を返す", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		newer := newMentioned("msg2", before.Add(-time.Minute), false)
+		older := newMentioned("msg1", before.Add(-time.Hour), true)
+		deps.MentionRepo.EXPECT().ListMentions(ctx, repository.MentionListQuery{
+			UserID:       userID,
+			UnreadOnly:   true,
+			BeforeSentAt: before,
+			Limit:        2,
+		}).Return([]*entity.MentionedMessage{newer, older}, true, nil)
+
+		res, err := uc.ListMentions(ctx, messagecase.ListMentionsRequest{
+			UserID:       userID,
+			UnreadOnly:   true,
+			Limit:        2,
+			BeforeSentAt: before,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*entity.MentionedMessage{newer, older}, res.Mentions)
+		assert.True(t, res.HasNext)
+		assert.Equal(t, older.GetMessage().GetSentAt(), res.NextBeforeSentAt)
+	})
+
+	t.Run("2. 正常系：次のページがない場合", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.MentionRepo.EXPECT().ListMentions(ctx, gomock.Any()).Return(nil, false, nil)
+
+		res, err := uc.ListMentions(ctx, messagecase.ListMentionsRequest{UserID: userID, Limit: 20, BeforeSentAt: before})
+		require.NoError(t, err)
+		assert.Empty(t, res.Mentions)
+		assert.False(t, res.HasNext)
+		assert.True(t, res.NextBeforeSentAt.IsZero())
+	})
+
+	t.Run("3. 正常系：取得件数を上限に丸める", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.MentionRepo.EXPECT().ListMentions(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, q repository.MentionListQuery) ([]*entity.MentionedMessage, bool, error) {
+				assert.Equal(t, 100, q.Limit)
+				return nil, false, nil
+			})
+
+		_, err := uc.ListMentions(ctx, messagecase.ListMentionsRequest{UserID: userID, Limit: 1000, BeforeSentAt: before})
+		assert.NoError(t, err)
+	})
+
+	t.Run("4. 異常系：メンションの取得に失敗", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.MentionRepo.EXPECT().ListMentions(ctx, gomock.Any()).Return(nil, false, assert.AnError)
+
+		_, err := uc.ListMentions(ctx, messagecase.ListMentionsRequest{UserID: userID, Limit: 20, BeforeSentAt: before})
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,70 @@
+package messagecase
+
+import (
+	mock_repository "example.com/infrahandson/test/mocks/domain/repository"
+	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
+	mock_factory "example.com/infrahandson/test/mocks/interface/factory"
+	"go.uber.org/mock/gomock"
+)
+
+// TestMaxAttachmentSize は NewTestMessageUseCase で設定する添付ファイルの最大バイト数
+const TestMaxAttachmentSize = 1024
+
+type mockDeps struct {
+	MsgRepo       *mock_repository.MockMessageRepository
+	MsgCache      *mock_service.MockMessageCacheService
+	RoomRepo      *mock_repository.MockRoomRepository
+	UserRepo      *mock_repository.MockUserRepository
+	ReactionRepo  *mock_repository.MockReactionRepository
+	PinRepo       *mock_repository.MockPinRepository
+	ReadStateRepo *mock_repository.MockRoomReadStateRepository
+	MentionRepo   *mock_repository.MockMentionRepository
+	WsManager     *mock_service.MockWebsocketManager
+	Logger        *mock_adapter.MockLoggerAdapter
+
+	AttachmentRepo      *mock_repository.MockAttachmentRepository
+	AttachmentStore     *mock_service.MockAttachmentStoreService
+	AttachmentIDFactory *mock_factory.MockAttachmentIDFactory
+}
+
+func NewTestMessageUseCase(
+	ctrl *gomock.Controller,
+) (MessageUseCaseInterface, mockDeps) {
+	deps := mockDeps{
+		MsgRepo:       mock_repository.NewMockMessageRepository(ctrl),
+		MsgCache:      mock_service.NewMockMessageCacheService(ctrl),
+		RoomRepo:      mock_repository.NewMockRoomRepository(ctrl),
+		UserRepo:      mock_repository.NewMockUserRepository(ctrl),
+		ReactionRepo:  mock_repository.NewMockReactionRepository(ctrl),
+		PinRepo:       mock_repository.NewMockPinRepository(ctrl),
+		ReadStateRepo: mock_repository.NewMockRoomReadStateRepository(ctrl),
+		MentionRepo:   mock_repository.NewMockMentionRepository(ctrl),
+		WsManager:     mock_service.NewMockWebsocketManager(ctrl),
+		Logger:        mock_adapter.NewMockLoggerAdapter(ctrl),
+
+		AttachmentRepo:      mock_repository.NewMockAttachmentRepository(ctrl),
+		AttachmentStore:     mock_service.NewMockAttachmentStoreService(ctrl),
+		AttachmentIDFactory: mock_factory.NewMockAttachmentIDFactory(ctrl),
+	}
+	useCase := NewMessageUseCase(NewMessageUseCaseParams{
+		MsgRepo:       deps.MsgRepo,
+		MsgCache:      deps.MsgCache,
+		RoomRepo:      deps.RoomRepo,
+		UserRepo:      deps.UserRepo,
+		ReactionRepo:  deps.ReactionRepo,
+		PinRepo:       deps.PinRepo,
+		ReadStateRepo: deps.ReadStateRepo,
+		MentionRepo:   deps.MentionRepo,
+		WsManager:     deps.WsManager,
+		Logger:        deps.Logger,
+		ReadReceipts:  true,
+
+		AttachmentRepo:      deps.AttachmentRepo,
+		AttachmentStore:     deps.AttachmentStore,
+		AttachmentIDFactory: deps.AttachmentIDFactory,
+		MaxAttachmentSize:   TestMaxAttachmentSize,
+	})
+
+	return useCase, deps
+}
@@ -3,11 +3,25 @@ package messagecase
 import (
 	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/interface/adapter"
+	"example.com/infrahandson/internal/interface/factory"
 )
 
 type MessageUseCase struct {
-	msgRepo  repository.MessageRepository
-	msgCache service.MessageCacheService
-	roomRepo repository.RoomRepository
-	userRepo repository.UserRepository
+	msgRepo       repository.MessageRepository
+	msgCache      service.MessageCacheService
+	roomRepo      repository.RoomRepository
+	userRepo      repository.UserRepository
+	reactionRepo  repository.ReactionRepository
+	pinRepo       repository.PinRepository
+	readStateRepo repository.RoomReadStateRepository
+	mentionRepo   repository.MentionRepository
+	wsManager     service.WebsocketManager
+	logger        adapter.LoggerAdapter
+	readReceipts  bool
+
+	attachmentRepo      repository.AttachmentRepository
+	attachmentStore     service.AttachmentStoreService
+	attachmentIDFactory factory.AttachmentIDFactory
+	maxAttachmentSize   int64
 }
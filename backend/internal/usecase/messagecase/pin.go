@@ -0,0 +1,108 @@
+package messagecase
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// PinRequest構造体: メッセージのピン留め・ピン留め解除のリクエスト
+type PinRequest struct {
+	RoomID    entity.RoomID
+	MessageID entity.MessageID
+	UserID    entity.UserID // 操作を行うユーザー（管理者以上）
+}
+
+type GetPinnedMessagesRequest struct {
+	RoomID entity.RoomID
+	UserID entity.UserID // 閲覧するユーザー（部屋のメンバーのみ閲覧できる）
+}
+
+type GetPinnedMessagesResponse struct {
+	Pins []*entity.PinnedMessage // ピン留めした日時の新しい順
+}
+
+// PinMessage はメッセージを部屋にピン留めし、message.pinned を部屋に配信します。
+// ピン留めの数が部屋の上限に達している場合は ErrPinLimitReached を返します。
+func (uc *MessageUseCase) PinMessage(ctx context.Context, req PinRequest) (*entity.PinnedMessage, error) {
+	if err := uc.requireRole(ctx, req.RoomID, req.UserID, entity.RoomRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	msg, err := uc.msgRepo.GetMessageByID(ctx, req.MessageID)
+	if err != nil {
+		return nil, err
+	}
+	// 別の部屋のメッセージは存在しないものとして扱う
+	if msg.GetRoomID() != req.RoomID {
+		return nil, repository.ErrMessageNotFound
+	}
+	if msg.IsDeleted() {
+		return nil, ErrMessageDeleted
+	}
+
+	room, err := uc.roomRepo.GetRoomByID(ctx, req.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	count, err := uc.pinRepo.CountPins(ctx, req.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= room.GetPinLimit() {
+		return nil, ErrPinLimitReached
+	}
+
+	pin := entity.NewPin(entity.PinParams{
+		RoomID:    req.RoomID,
+		MessageID: req.MessageID,
+		PinnedBy:  req.UserID,
+		PinnedAt:  time.Now(),
+	})
+	if err := uc.pinRepo.SavePin(ctx, pin); err != nil {
+		return nil, err
+	}
+
+	// ピン留めは保存済みのため、配信に失敗してもエラーにはしない
+	pinned := entity.NewPinnedMessage(pin, msg)
+	if err := uc.wsManager.BroadcastToRoom(ctx, req.RoomID, entity.NewMessagePinnedEvent(pinned)); err != nil {
+		uc.logger.Error("Failed to broadcast message pin", "error", err)
+	}
+	return pinned, nil
+}
+
+// UnpinMessage はメッセージのピン留めを外し、message.unpinned を部屋に配信します。
+func (uc *MessageUseCase) UnpinMessage(ctx context.Context, req PinRequest) error {
+	if err := uc.requireRole(ctx, req.RoomID, req.UserID, entity.RoomRoleAdmin); err != nil {
+		return err
+	}
+
+	if err := uc.pinRepo.DeletePin(ctx, req.RoomID, req.MessageID); err != nil {
+		return err
+	}
+
+	// ピン留めの解除は保存済みのため、配信に失敗してもエラーにはしない
+	err := uc.wsManager.BroadcastToRoom(ctx, req.RoomID, entity.NewMessageUnpinnedEvent(entity.MessageUnpinnedPayload{
+		MessageID:  req.MessageID,
+		UnpinnedBy: req.UserID,
+	}))
+	if err != nil {
+		uc.logger.Error("Failed to broadcast message unpin", "error", err)
+	}
+	return nil
+}
+
+// GetPinnedMessages は部屋にピン留めされているメッセージを取得します。
+func (uc *MessageUseCase) GetPinnedMessages(ctx context.Context, req GetPinnedMessagesRequest) (GetPinnedMessagesResponse, error) {
+	if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+		return GetPinnedMessagesResponse{}, err
+	}
+
+	pins, err := uc.pinRepo.ListPinnedMessages(ctx, req.RoomID)
+	if err != nil {
+		return GetPinnedMessagesResponse{}, err
+	}
+	return GetPinnedMessagesResponse{Pins: pins}, nil
+}
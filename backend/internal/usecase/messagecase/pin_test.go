@@ -0,0 +1,199 @@
+package messagecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPinMessage(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.PinRequest{
+		RoomID:    "room1",
+		MessageID: "msg1",
+		UserID:    "admin",
+	}
+	room := entity.NewRoom(entity.RoomParams{ID: "room1", Name: "Room", PinLimit: 2})
+
+	t.Run("正常系", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.RoomRepo.EXPECT().GetRoomByID(ctx, req.RoomID).Return(room, nil)
+		deps.PinRepo.EXPECT().CountPins(ctx, req.RoomID).Return(1, nil)
+		deps.PinRepo.EXPECT().SavePin(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, p *entity.Pin) error {
+			assert.Equal(t, req.RoomID, p.GetRoomID())
+			assert.Equal(t, req.MessageID, p.GetMessageID())
+			assert.Equal(t, req.UserID, p.GetPinnedBy())
+			return nil
+		})
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entity.RoomID, e *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeMessagePinned, e.GetType())
+				payload, ok := e.GetPayload().(entity.MessagePinnedPayload)
+				require.True(t, ok)
+				assert.Equal(t, req.MessageID, payload.Message.GetID())
+				assert.Equal(t, "before", payload.Message.GetContent())
+				assert.Equal(t, req.UserID, payload.PinnedBy)
+				return nil
+			})
+
+		pinned, err := uc.PinMessage(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, req.MessageID, pinned.GetMessage().GetID())
+		assert.Equal(t, req.UserID, pinned.GetPin().GetPinnedBy())
+	})
+
+	t.Run("正常系：配信に失敗しても保存済みのピン留めは成功として返す", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.RoomRepo.EXPECT().GetRoomByID(ctx, req.RoomID).Return(room, nil)
+		deps.PinRepo.EXPECT().CountPins(ctx, req.RoomID).Return(1, nil)
+		deps.PinRepo.EXPECT().SavePin(ctx, gomock.Any()).Return(nil)
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).Return(errors.New("broadcast failed"))
+		deps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+
+		pinned, err := uc.PinMessage(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, req.MessageID, pinned.GetMessage().GetID())
+	})
+
+	t.Run("異常系：一般メンバーはピン留めできない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		_, err := uc.PinMessage(ctx, req)
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
+
+	t.Run("異常系：別の部屋のメッセージ", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+
+		otherRoom := req
+		otherRoom.RoomID = "room2"
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, otherRoom.RoomID, otherRoom.UserID).Return(entity.RoomRoleOwner, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+
+		_, err := uc.PinMessage(ctx, otherRoom)
+		assert.ErrorIs(t, err, repository.ErrMessageNotFound)
+	})
+
+	t.Run("異常系：削除済みのメッセージ", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(true), nil)
+
+		_, err := uc.PinMessage(ctx, req)
+		assert.ErrorIs(t, err, messagecase.ErrMessageDeleted)
+	})
+
+	t.Run("異常系：ピン留めの上限に達している", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.RoomRepo.EXPECT().GetRoomByID(ctx, req.RoomID).Return(room, nil)
+		deps.PinRepo.EXPECT().CountPins(ctx, req.RoomID).Return(2, nil)
+
+		_, err := uc.PinMessage(ctx, req)
+		assert.ErrorIs(t, err, messagecase.ErrPinLimitReached)
+	})
+
+	t.Run("異常系：すでにピン留めされている", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.RoomRepo.EXPECT().GetRoomByID(ctx, req.RoomID).Return(room, nil)
+		deps.PinRepo.EXPECT().CountPins(ctx, req.RoomID).Return(1, nil)
+		deps.PinRepo.EXPECT().SavePin(ctx, gomock.Any()).Return(repository.ErrAlreadyPinned)
+
+		_, err := uc.PinMessage(ctx, req)
+		assert.ErrorIs(t, err, repository.ErrAlreadyPinned)
+	})
+}
+
+func TestUnpinMessage(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.PinRequest{
+		RoomID:    "room1",
+		MessageID: "msg1",
+		UserID:    "admin",
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		deps.PinRepo.EXPECT().DeletePin(ctx, req.RoomID, req.MessageID).Return(nil)
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entity.RoomID, e *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeMessageUnpinned, e.GetType())
+				assert.Equal(t, entity.MessageUnpinnedPayload{MessageID: "msg1", UnpinnedBy: "admin"}, e.GetPayload())
+				return nil
+			})
+
+		assert.NoError(t, uc.UnpinMessage(ctx, req))
+	})
+
+	t.Run("正常系：配信に失敗しても解除済みのピン留めは成功として返す", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		deps.PinRepo.EXPECT().DeletePin(ctx, req.RoomID, req.MessageID).Return(nil)
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).Return(errors.New("broadcast failed"))
+		deps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+
+		assert.NoError(t, uc.UnpinMessage(ctx, req))
+	})
+
+	t.Run("異常系：ピン留めされていない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		deps.PinRepo.EXPECT().DeletePin(ctx, req.RoomID, req.MessageID).Return(repository.ErrPinNotFound)
+
+		assert.ErrorIs(t, uc.UnpinMessage(ctx, req), repository.ErrPinNotFound)
+	})
+}
+
+func TestGetPinnedMessages(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.GetPinnedMessagesRequest{RoomID: "room1", UserID: "user1"}
+
+	t.Run("正常系", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		pins := []*entity.PinnedMessage{
+			entity.NewPinnedMessage(entity.NewPin(entity.PinParams{RoomID: "room1", MessageID: "msg1", PinnedBy: "admin"}), newStoredMessage(false)),
+		}
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+		deps.PinRepo.EXPECT().ListPinnedMessages(ctx, req.RoomID).Return(pins, nil)
+
+		res, err := uc.GetPinnedMessages(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, pins, res.Pins)
+	})
+
+	t.Run("異常系：メンバー以外は閲覧できない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		_, err := uc.GetPinnedMessages(ctx, req)
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
+}
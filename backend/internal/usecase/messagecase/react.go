@@ -0,0 +1,109 @@
+package messagecase
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// maxEmojiBytes はリアクションの絵文字の最大バイト数
+// 肌の色や ZWJ で結合された絵文字も収まる長さにしている
+const maxEmojiBytes = 64
+
+// ReactionRequest構造体: リアクションの追加・取り消しのリクエスト
+type ReactionRequest struct {
+	RoomID    entity.RoomID
+	MessageID entity.MessageID
+	UserID    entity.UserID // リアクションするユーザー
+	Emoji     string
+}
+
+// ReactionResponse構造体: リアクションの追加・取り消しの結果
+type ReactionResponse struct {
+	Count int // 変更後のその絵文字のリアクション数
+}
+
+// AddReaction はメッセージにリアクションを追加します。
+// 既にリアクションしている場合も成功として扱い、reaction.added を部屋に配信します。
+func (uc *MessageUseCase) AddReaction(ctx context.Context, req ReactionRequest) (ReactionResponse, error) {
+	if err := uc.validateReaction(ctx, req); err != nil {
+		return ReactionResponse{}, err
+	}
+
+	reaction := entity.NewReaction(entity.ReactionParams{
+		MessageID: req.MessageID,
+		UserID:    req.UserID,
+		Emoji:     req.Emoji,
+		CreatedAt: time.Now(),
+	})
+	if err := uc.reactionRepo.AddReaction(ctx, reaction); err != nil {
+		return ReactionResponse{}, err
+	}
+
+	return uc.broadcastReaction(ctx, req, entity.NewReactionAddedEvent)
+}
+
+// RemoveReaction はメッセージのリアクションを取り消します。
+// リアクションしていない場合も成功として扱い、reaction.removed を部屋に配信します。
+func (uc *MessageUseCase) RemoveReaction(ctx context.Context, req ReactionRequest) (ReactionResponse, error) {
+	if err := uc.validateReaction(ctx, req); err != nil {
+		return ReactionResponse{}, err
+	}
+
+	if err := uc.reactionRepo.RemoveReaction(ctx, req.MessageID, req.UserID, req.Emoji); err != nil {
+		return ReactionResponse{}, err
+	}
+
+	return uc.broadcastReaction(ctx, req, entity.NewReactionRemovedEvent)
+}
+
+// validateReaction は絵文字・部屋のメンバーであること・リアクション対象のメッセージを検証します。
+// 別の部屋のメッセージは存在しないものとして扱います。
+func (uc *MessageUseCase) validateReaction(ctx context.Context, req ReactionRequest) error {
+	if !isEmoji(req.Emoji) {
+		return ErrInvalidEmoji
+	}
+
+	if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+		return err
+	}
+
+	msg, err := uc.msgRepo.GetMessageByID(ctx, req.MessageID)
+	if err != nil {
+		return err
+	}
+	if msg.GetRoomID() != req.RoomID {
+		return repository.ErrMessageNotFound
+	}
+	if msg.IsDeleted() {
+		return ErrMessageDeleted
+	}
+	return nil
+}
+
+// broadcastReaction は変更後のリアクション数を集計して部屋に配信します。
+// リアクションは保存済みのため、配信に失敗してもエラーにはしません。
+func (uc *MessageUseCase) broadcastReaction(
+	ctx context.Context,
+	req ReactionRequest,
+	newEvent func(entity.ReactionPayload) *entity.WebsocketEvent,
+) (ReactionResponse, error) {
+	count, err := uc.reactionRepo.CountReactions(ctx, req.MessageID, req.Emoji)
+	if err != nil {
+		return ReactionResponse{}, err
+	}
+
+	err = uc.wsManager.BroadcastToRoom(ctx, req.RoomID, newEvent(entity.ReactionPayload{
+		MessageID: req.MessageID,
+		UserID:    req.UserID,
+		Emoji:     req.Emoji,
+		Count:     count,
+	}))
+	if err != nil {
+		uc.logger.Error("Failed to broadcast reaction", "error", err)
+	}
+
+	return ReactionResponse{Count: count}, nil
+}
@@ -0,0 +1,150 @@
+package messagecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddReaction(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.ReactionRequest{
+		RoomID:    "room1",
+		MessageID: "msg1",
+		UserID:    "user1",
+		Emoji:     "👍",
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.ReactionRepo.EXPECT().AddReaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, r *entity.Reaction) error {
+			assert.Equal(t, req.MessageID, r.GetMessageID())
+			assert.Equal(t, req.UserID, r.GetUserID())
+			assert.Equal(t, req.Emoji, r.GetEmoji())
+			return nil
+		})
+		deps.ReactionRepo.EXPECT().CountReactions(ctx, req.MessageID, req.Emoji).Return(3, nil)
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entity.RoomID, e *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeReactionAdded, e.GetType())
+				assert.Equal(t, entity.ReactionPayload{MessageID: "msg1", UserID: "user1", Emoji: "👍", Count: 3}, e.GetPayload())
+				return nil
+			})
+
+		res, err := uc.AddReaction(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, 3, res.Count)
+	})
+
+	t.Run("正常系：配信に失敗しても保存済みのリアクションは成功として返す", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.ReactionRepo.EXPECT().AddReaction(ctx, gomock.Any()).Return(nil)
+		deps.ReactionRepo.EXPECT().CountReactions(ctx, req.MessageID, req.Emoji).Return(3, nil)
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).Return(errors.New("broadcast failed"))
+		deps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+
+		res, err := uc.AddReaction(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, 3, res.Count)
+	})
+
+	t.Run("正常系：国旗・キーキャップ・修飾子・結合された絵文字", func(t *testing.T) {
+		for _, emoji := range []string{"❤️", "🇯🇵", "1️⃣", "#⃣", "👍🏽", "👩‍💻", "👨‍👩‍👧‍👦", "🏳️‍🌈", "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F"} {
+			ctrl := gomock.NewController(t)
+			uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+			deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+			deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+			deps.ReactionRepo.EXPECT().AddReaction(ctx, gomock.Any()).Return(nil)
+			deps.ReactionRepo.EXPECT().CountReactions(ctx, req.MessageID, emoji).Return(1, nil)
+			deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).Return(nil)
+
+			valid := req
+			valid.Emoji = emoji
+			_, err := uc.AddReaction(ctx, valid)
+			assert.NoError(t, err, emoji)
+		}
+	})
+
+	t.Run("異常系：不正な絵文字", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, _ := messagecase.NewTestMessageUseCase(ctrl)
+
+		for _, emoji := range []string{
+			"", "  ", "a", "ok", "👍👍", "👍 ", "🇯", "🇯🇵🇺", "1", "\u200D👍", "👍\u200D", "🏻",
+			strings.Repeat("👍\u200D", 16) + "👍",
+		} {
+			invalid := req
+			invalid.Emoji = emoji
+			_, err := uc.AddReaction(ctx, invalid)
+			assert.ErrorIs(t, err, messagecase.ErrInvalidEmoji)
+		}
+	})
+
+	t.Run("異常系：別の部屋のメッセージ", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+
+		otherRoom := req
+		otherRoom.RoomID = "room2"
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, otherRoom.RoomID, otherRoom.UserID).Return(entity.RoomRoleMember, nil)
+		_, err := uc.AddReaction(ctx, otherRoom)
+		assert.ErrorIs(t, err, repository.ErrMessageNotFound)
+	})
+
+	t.Run("異常系：削除済みのメッセージ", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(true), nil)
+
+		_, err := uc.AddReaction(ctx, req)
+		assert.ErrorIs(t, err, messagecase.ErrMessageDeleted)
+	})
+}
+
+func TestRemoveReaction(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.ReactionRequest{
+		RoomID:    "room1",
+		MessageID: "msg1",
+		UserID:    "user1",
+		Emoji:     "👍",
+	}
+
+	ctrl := gomock.NewController(t)
+	uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+	deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+	deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+	deps.ReactionRepo.EXPECT().RemoveReaction(ctx, req.MessageID, req.UserID, req.Emoji).Return(nil)
+	deps.ReactionRepo.EXPECT().CountReactions(ctx, req.MessageID, req.Emoji).Return(0, nil)
+	deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ entity.RoomID, e *entity.WebsocketEvent) error {
+			assert.Equal(t, entity.WebsocketEventTypeReactionRemoved, e.GetType())
+			assert.Equal(t, entity.ReactionPayload{MessageID: "msg1", UserID: "user1", Emoji: "👍", Count: 0}, e.GetPayload())
+			return nil
+		})
+
+	res, err := uc.RemoveReaction(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 0, res.Count)
+}
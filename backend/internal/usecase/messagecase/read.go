@@ -0,0 +1,77 @@
+package messagecase
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// MarkAsReadRequest構造体: 部屋のメッセージを既読にするリクエスト
+type MarkAsReadRequest struct {
+	RoomID    entity.RoomID
+	MessageID entity.MessageID // 最後に読んだメッセージ
+	UserID    entity.UserID    // 既読にするユーザー（部屋のメンバーのみ）
+}
+
+type GetReadReceiptsRequest struct {
+	RoomID entity.RoomID
+	UserID entity.UserID // 閲覧するユーザー（部屋のメンバーのみ閲覧できる）
+}
+
+type GetReadReceiptsResponse struct {
+	ReadStates []*entity.RoomReadState // 既読にした日時の新しい順
+}
+
+// MarkAsRead は部屋のメッセージを指定したメッセージまで既読にします。
+// 既読位置より前のメッセージを指定した場合は何もしません。
+// 既読位置が進んだ場合、既読の配信が有効であれば read.updated を部屋に配信します。
+func (uc *MessageUseCase) MarkAsRead(ctx context.Context, req MarkAsReadRequest) error {
+	if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+		return err
+	}
+
+	msg, err := uc.msgRepo.GetMessageByID(ctx, req.MessageID)
+	if err != nil {
+		return err
+	}
+	// 別の部屋のメッセージは存在しないものとして扱う
+	if msg.GetRoomID() != req.RoomID {
+		return repository.ErrMessageNotFound
+	}
+
+	state := entity.NewRoomReadState(entity.RoomReadStateParams{
+		RoomID:            req.RoomID,
+		UserID:            req.UserID,
+		LastReadMessageID: msg.GetID(),
+		LastReadAt:        msg.GetSentAt(),
+		UpdatedAt:         time.Now(),
+	})
+	advanced, err := uc.readStateRepo.SaveReadState(ctx, state)
+	if err != nil {
+		return err
+	}
+	if !advanced || !uc.readReceipts {
+		return nil
+	}
+
+	return uc.wsManager.BroadcastToRoom(ctx, req.RoomID, entity.NewReadUpdatedEvent(state))
+}
+
+// GetReadReceipts は部屋のメンバーがどのメッセージまで読んだかを取得します。
+// 既読の公開が無効な場合は ErrReadReceiptsDisabled を返します。
+func (uc *MessageUseCase) GetReadReceipts(ctx context.Context, req GetReadReceiptsRequest) (GetReadReceiptsResponse, error) {
+	if !uc.readReceipts {
+		return GetReadReceiptsResponse{}, ErrReadReceiptsDisabled
+	}
+	if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+		return GetReadReceiptsResponse{}, err
+	}
+
+	states, err := uc.readStateRepo.ListReadStates(ctx, req.RoomID)
+	if err != nil {
+		return GetReadReceiptsResponse{}, err
+	}
+	return GetReadReceiptsResponse{ReadStates: states}, nil
+}
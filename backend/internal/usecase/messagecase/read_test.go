@@ -0,0 +1,168 @@
+package messagecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	mock_repository "example.com/infrahandson/test/mocks/domain/repository"
+	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
+	mock_factory "example.com/infrahandson/test/mocks/interface/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newReadReceiptsDisabledUseCase は既読の配信・公開を無効にした MessageUseCase を生成します。
+func newReadReceiptsDisabledUseCase(ctrl *gomock.Controller) (
+	messagecase.MessageUseCaseInterface,
+	*mock_repository.MockRoomRepository,
+	*mock_repository.MockMessageRepository,
+	*mock_repository.MockRoomReadStateRepository,
+) {
+	roomRepo := mock_repository.NewMockRoomRepository(ctrl)
+	msgRepo := mock_repository.NewMockMessageRepository(ctrl)
+	readStateRepo := mock_repository.NewMockRoomReadStateRepository(ctrl)
+	uc := messagecase.NewMessageUseCase(messagecase.NewMessageUseCaseParams{
+		MsgRepo:       msgRepo,
+		MsgCache:      mock_service.NewMockMessageCacheService(ctrl),
+		RoomRepo:      roomRepo,
+		UserRepo:      mock_repository.NewMockUserRepository(ctrl),
+		ReactionRepo:  mock_repository.NewMockReactionRepository(ctrl),
+		PinRepo:       mock_repository.NewMockPinRepository(ctrl),
+		ReadStateRepo: readStateRepo,
+		MentionRepo:   mock_repository.NewMockMentionRepository(ctrl),
+		WsManager:     mock_service.NewMockWebsocketManager(ctrl),
+		Logger:        mock_adapter.NewMockLoggerAdapter(ctrl),
+		ReadReceipts:  false,
+
+		AttachmentRepo:      mock_repository.NewMockAttachmentRepository(ctrl),
+		AttachmentStore:     mock_service.NewMockAttachmentStoreService(ctrl),
+		AttachmentIDFactory: mock_factory.NewMockAttachmentIDFactory(ctrl),
+		MaxAttachmentSize:   1024,
+	})
+	return uc, roomRepo, msgRepo, readStateRepo
+}
+
+// 1. 正常系：既読位置が進み、read.updated を配信する
+// 2. 正常系：既読位置が進まない場合は配信しない
+// 3. 正常系：既読の配信が無効な場合は配信しない
+// 4. 異常系：部屋のメンバーでない
+// 5. 異常系：別の部屋のメッセージ
+func TestMarkAsRead(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.MarkAsReadRequest{
+		RoomID:    "room1",
+		MessageID: "msg1",
+		UserID:    "reader",
+	}
+
+	t.Run("1. 正常系：既読位置が進み、read.updated を配信する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.ReadStateRepo.EXPECT().SaveReadState(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, s *entity.RoomReadState) (bool, error) {
+			assert.Equal(t, req.RoomID, s.GetRoomID())
+			assert.Equal(t, req.UserID, s.GetUserID())
+			assert.Equal(t, req.MessageID, s.GetLastReadMessageID())
+			// 未読件数はメッセージの送信日時を基準に数える
+			assert.True(t, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC).Equal(s.GetLastReadAt()))
+			return true, nil
+		})
+		deps.WsManager.EXPECT().BroadcastToRoom(ctx, req.RoomID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entity.RoomID, e *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeReadUpdated, e.GetType())
+				payload, ok := e.GetPayload().(entity.ReadUpdatedPayload)
+				require.True(t, ok)
+				assert.Equal(t, req.UserID, payload.UserID)
+				assert.Equal(t, req.MessageID, payload.MessageID)
+				return nil
+			})
+
+		assert.NoError(t, uc.MarkAsRead(ctx, req))
+	})
+
+	t.Run("2. 正常系：既読位置が進まない場合は配信しない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		deps.ReadStateRepo.EXPECT().SaveReadState(ctx, gomock.Any()).Return(false, nil)
+
+		assert.NoError(t, uc.MarkAsRead(ctx, req))
+	})
+
+	t.Run("3. 正常系：既読の配信が無効な場合は配信しない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, roomRepo, msgRepo, readStateRepo := newReadReceiptsDisabledUseCase(ctrl)
+		roomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+		msgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+		readStateRepo.EXPECT().SaveReadState(ctx, gomock.Any()).Return(true, nil)
+
+		assert.NoError(t, uc.MarkAsRead(ctx, req))
+	})
+
+	t.Run("4. 異常系：部屋のメンバーでない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		assert.ErrorIs(t, uc.MarkAsRead(ctx, req), repository.ErrNotRoomMember)
+	})
+
+	t.Run("5. 異常系：別の部屋のメッセージ", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+
+		otherRoom := req
+		otherRoom.RoomID = "room2"
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, otherRoom.RoomID, otherRoom.UserID).Return(entity.RoomRoleMember, nil)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.MessageID).Return(newStoredMessage(false), nil)
+
+		assert.ErrorIs(t, uc.MarkAsRead(ctx, otherRoom), repository.ErrMessageNotFound)
+	})
+}
+
+// 1. 正常系
+// 2. 異常系：部屋のメンバーでない
+// 3. 異常系：既読の公開が無効
+func TestGetReadReceipts(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.GetReadReceiptsRequest{RoomID: "room1", UserID: "reader"}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		states := []*entity.RoomReadState{
+			entity.NewRoomReadState(entity.RoomReadStateParams{RoomID: "room1", UserID: "author", LastReadMessageID: "msg1"}),
+		}
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+		deps.ReadStateRepo.EXPECT().ListReadStates(ctx, req.RoomID).Return(states, nil)
+
+		res, err := uc.GetReadReceipts(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, states, res.ReadStates)
+	})
+
+	t.Run("2. 異常系：部屋のメンバーでない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		_, err := uc.GetReadReceipts(ctx, req)
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
+
+	t.Run("3. 異常系：既読の公開が無効", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, _, _, _ := newReadReceiptsDisabledUseCase(ctrl)
+
+		_, err := uc.GetReadReceipts(ctx, req)
+		assert.ErrorIs(t, err, messagecase.ErrReadReceiptsDisabled)
+	})
+}
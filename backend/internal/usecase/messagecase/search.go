@@ -0,0 +1,143 @@
+package messagecase
+
+import (
+	"context"
+	"html"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// スニペットとして本文を切り出す長さ（文字数）
+const (
+	snippetLength = 80 // スニペット全体の長さ
+	snippetLead   = 20 // 最初に一致した位置より前に含める長さ
+)
+
+// SearchMessagesRequest構造体: メッセージ検索のリクエスト
+type SearchMessagesRequest struct {
+	UserID       entity.UserID // 検索するユーザー（参加している部屋のみが対象）
+	Query        string        // 空白区切りの語句（すべてを含むメッセージを検索する）
+	RoomID       entity.RoomID // 指定した場合はその部屋のみを検索する
+	Limit        int
+	BeforeSentAt time.Time
+}
+
+// SearchResult構造体: 検索に一致したメッセージ
+type SearchResult struct {
+	Message *entity.Message
+	// Snippet は本文の一致した箇所の周辺です。
+	// HTMLエスケープ済みで、一致した語句は <mark> で囲まれています。
+	Snippet string
+}
+
+// SearchMessagesResponse構造体: メッセージ検索の結果
+type SearchMessagesResponse struct {
+	Results          []*SearchResult // 新しい順
+	NextBeforeSentAt time.Time
+	HasNext          bool
+}
+
+// SearchMessages は参加している部屋のメッセージを本文で検索します。
+func (uc *MessageUseCase) SearchMessages(ctx context.Context, req SearchMessagesRequest) (SearchMessagesResponse, error) {
+	terms := strings.Fields(req.Query)
+	if len(terms) == 0 {
+		return SearchMessagesResponse{}, ErrEmptyQuery
+	}
+	// 部屋を指定した場合は、参加していない部屋を空の結果ではなくエラーとして扱う
+	if req.RoomID != "" {
+		if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+			return SearchMessagesResponse{}, err
+		}
+	}
+
+	messages, nextBeforeSentAt, hasNext, err := uc.msgRepo.SearchMessages(
+		ctx,
+		req.UserID,
+		req.Query,
+		req.RoomID,
+		req.Limit,
+		req.BeforeSentAt,
+	)
+	if err != nil {
+		return SearchMessagesResponse{}, err
+	}
+
+	results := make([]*SearchResult, len(messages))
+	for i, msg := range messages {
+		results[i] = &SearchResult{
+			Message: msg,
+			Snippet: buildSnippet(msg.GetContent(), terms),
+		}
+	}
+
+	return SearchMessagesResponse{
+		Results:          results,
+		NextBeforeSentAt: nextBeforeSentAt,
+		HasNext:          hasNext,
+	}, nil
+}
+
+// buildSnippet は本文から最初に一致した箇所の周辺を切り出し、一致した語句を <mark> で囲みます。
+// 語句の一致は大文字小文字を区別しません。
+func buildSnippet(content string, terms []string) string {
+	text := []rune(content)
+	lower := make([]rune, len(text))
+	for i, r := range text {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// 一致した範囲 [start, end) を集めて、重なるものをまとめる
+	type span struct{ start, end int }
+	var spans []span
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(term))
+		for i := 0; i+len(needle) <= len(lower); i++ {
+			if string(lower[i:i+len(needle)]) == string(needle) {
+				spans = append(spans, span{i, i + len(needle)})
+			}
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	var merged []span
+	for _, s := range spans {
+		if n := len(merged); n > 0 && s.start <= merged[n-1].end {
+			merged[n-1].end = max(merged[n-1].end, s.end)
+			continue
+		}
+		merged = append(merged, s)
+	}
+
+	// 最初に一致した箇所が先頭付近に来るように切り出す範囲を決める
+	from := 0
+	if len(merged) > 0 {
+		from = max(merged[0].start-snippetLead, 0)
+	}
+	to := min(from+snippetLength, len(text))
+	from = max(to-snippetLength, 0)
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	pos := from
+	for _, s := range merged {
+		start, end := max(s.start, from), min(s.end, to)
+		if start >= end {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(text[pos:start])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(text[start:end])))
+		b.WriteString("</mark>")
+		pos = end
+	}
+	b.WriteString(html.EscapeString(string(text[pos:to])))
+	if to < len(text) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
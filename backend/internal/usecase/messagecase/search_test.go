@@ -0,0 +1,77 @@
+package messagecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchMessages(t *testing.T) {
+	ctx := context.Background()
+	before := time.Date(2023, 1, 1, 13, 0, 0, 0, time.UTC)
+
+	search := func(t *testing.T, query, content string) string {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+
+		msg := entity.NewMessage(entity.MessageParams{ID: "msg1", RoomID: "room1", Content: content})
+		next := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+		deps.MsgRepo.EXPECT().
+			SearchMessages(ctx, entity.UserID("user1"), query, entity.RoomID(""), 10, before).
+			Return([]*entity.Message{msg}, next, true, nil)
+
+		res, err := uc.SearchMessages(ctx, messagecase.SearchMessagesRequest{
+			UserID:       "user1",
+			Query:        query,
+			Limit:        10,
+			BeforeSentAt: before,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, msg, res.Results[0].Message)
+		assert.Equal(t, next, res.NextBeforeSentAt)
+		assert.True(t, res.HasNext)
+		return res.Results[0].Snippet
+	}
+
+	t.Run("一致した語句を大文字小文字を区別せずに強調する", func(t *testing.T) {
+		got := search(t, "go 会議", "Go の会議と GO の勉強会")
+		assert.Equal(t, "<mark>Go</mark> の<mark>会議</mark>と <mark>GO</mark> の勉強会", got)
+	})
+
+	t.Run("本文はHTMLエスケープされる", func(t *testing.T) {
+		got := search(t, "<b>", `<b>bold</b> & "quote"`)
+		assert.Equal(t, "<mark>&lt;b&gt;</mark>bold&lt;/b&gt; &amp; &#34;quote&#34;", got)
+	})
+
+	t.Run("長い本文は一致した箇所の周辺を切り出す", func(t *testing.T) {
+		content := strings.Repeat("あ", 100) + "会議" + strings.Repeat("い", 100)
+		got := search(t, "会議", content)
+		assert.Equal(t, "…"+strings.Repeat("あ", 20)+"<mark>会議</mark>"+strings.Repeat("い", 58)+"…", got)
+	})
+
+	t.Run("異常系：検索語句が空", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, _ := messagecase.NewTestMessageUseCase(ctrl)
+
+		_, err := uc.SearchMessages(ctx, messagecase.SearchMessagesRequest{UserID: "user1", Query: "  "})
+		assert.ErrorIs(t, err, messagecase.ErrEmptyQuery)
+	})
+
+	t.Run("異常系：参加していない部屋を指定", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, entity.RoomID("room2"), entity.UserID("user1")).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		_, err := uc.SearchMessages(ctx, messagecase.SearchMessagesRequest{UserID: "user1", Query: "会議", RoomID: "room2"})
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
+}
@@ -0,0 +1,64 @@
+package messagecase
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// スレッドの返信を一度に取得できる最大件数
+const maxThreadLimit = 100
+
+type GetThreadRequest struct {
+	RoomID      entity.RoomID
+	ParentID    entity.MessageID
+	UserID      entity.UserID // 閲覧するユーザー（部屋のメンバーのみ閲覧できる）
+	Limit       int           // 1〜100 の範囲に丸める
+	AfterSentAt time.Time     // この時刻より後の返信を取得する（ゼロ値の場合は最初から）
+}
+
+type GetThreadResponse struct {
+	Parent          *entity.Message   // 親メッセージ（削除済みの場合は本文が空）
+	Replies         []*entity.Message // 古い順
+	NextAfterSentAt time.Time
+	HasNext         bool
+	// Reactions は親メッセージと返信の絵文字ごとのリアクションの集計（キーはメッセージのID）
+	Reactions map[entity.MessageID][]*entity.ReactionSummary
+}
+
+// GetThread は親メッセージとその返信を取得します。
+func (uc *MessageUseCase) GetThread(ctx context.Context, req GetThreadRequest) (GetThreadResponse, error) {
+	if err := uc.requireMember(ctx, req.RoomID, req.UserID); err != nil {
+		return GetThreadResponse{}, err
+	}
+
+	parent, err := uc.msgRepo.GetMessageByID(ctx, req.ParentID)
+	if err != nil {
+		return GetThreadResponse{}, err
+	}
+	// 別の部屋のメッセージや返信はスレッドの親として扱わない
+	if parent.GetRoomID() != req.RoomID || parent.IsReply() {
+		return GetThreadResponse{}, repository.ErrMessageNotFound
+	}
+
+	limit := min(max(req.Limit, 1), maxThreadLimit)
+	replies, nextAfterSentAt, hasNext, err := uc.msgRepo.GetThread(ctx, req.ParentID, limit, req.AfterSentAt)
+	if err != nil {
+		return GetThreadResponse{}, err
+	}
+
+	reactions, err := uc.getReactionSummaries(ctx, append([]*entity.Message{parent}, replies...), req.UserID)
+	if err != nil {
+		return GetThreadResponse{}, err
+	}
+
+	return GetThreadResponse{
+		Parent:          parent,
+		Replies:         replies,
+		NextAfterSentAt: nextAfterSentAt,
+		HasNext:         hasNext,
+		Reactions:       reactions,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package messagecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/messagecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetThread(t *testing.T) {
+	ctx := context.Background()
+	req := messagecase.GetThreadRequest{
+		RoomID:   "room1",
+		ParentID: "msg1",
+		UserID:   "user1",
+		Limit:    10,
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		parent := newStoredMessage(false)
+		replies := []*entity.Message{
+			entity.NewMessage(entity.MessageParams{ID: "reply1", RoomID: "room1", ParentID: "msg1", Content: "re"}),
+		}
+		next := time.Date(2023, 1, 1, 12, 1, 0, 0, time.UTC)
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.ParentID).Return(parent, nil)
+		deps.MsgRepo.EXPECT().GetThread(ctx, req.ParentID, req.Limit, req.AfterSentAt).Return(replies, next, false, nil)
+		reactions := map[entity.MessageID][]*entity.ReactionSummary{
+			"reply1": {entity.NewReactionSummary(entity.ReactionSummaryParams{Emoji: "🎉", Count: 2})},
+		}
+		deps.ReactionRepo.EXPECT().
+			GetReactionSummaries(ctx, []entity.MessageID{"msg1", "reply1"}, req.UserID).
+			Return(reactions, nil)
+
+		res, err := uc.GetThread(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, parent, res.Parent)
+		assert.Equal(t, replies, res.Replies)
+		assert.Equal(t, next, res.NextAfterSentAt)
+		assert.False(t, res.HasNext)
+		assert.Equal(t, reactions, res.Reactions)
+	})
+
+	t.Run("正常系：取得件数を1〜100に丸める", func(t *testing.T) {
+		for _, tc := range []struct{ limit, want int }{{0, 1}, {-5, 1}, {1000, 100}} {
+			ctrl := gomock.NewController(t)
+			uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+			deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+			deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.ParentID).Return(newStoredMessage(false), nil)
+			deps.MsgRepo.EXPECT().GetThread(ctx, req.ParentID, tc.want, req.AfterSentAt).Return(nil, time.Time{}, false, nil)
+			deps.ReactionRepo.EXPECT().GetReactionSummaries(ctx, gomock.Any(), req.UserID).Return(nil, nil)
+
+			r := req
+			r.Limit = tc.limit
+			_, err := uc.GetThread(ctx, r)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("異常系：別の部屋のメッセージ", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.ParentID).Return(newStoredMessage(false), nil)
+
+		otherRoom := req
+		otherRoom.RoomID = "room2"
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, otherRoom.RoomID, otherRoom.UserID).Return(entity.RoomRoleMember, nil)
+		_, err := uc.GetThread(ctx, otherRoom)
+		assert.ErrorIs(t, err, repository.ErrMessageNotFound)
+	})
+
+	t.Run("異常系：返信はスレッドの親にならない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc, deps := messagecase.NewTestMessageUseCase(ctrl)
+		deps.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		reply := entity.NewMessage(entity.MessageParams{ID: "msg1", RoomID: "room1", ParentID: "msg0"})
+		deps.MsgRepo.EXPECT().GetMessageByID(ctx, req.ParentID).Return(reply, nil)
+
+		_, err := uc.GetThread(ctx, req)
+		assert.ErrorIs(t, err, repository.ErrMessageNotFound)
+	})
+}
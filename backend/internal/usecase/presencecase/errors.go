@@ -0,0 +1,15 @@
+package presencecase
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxPresenceUserIDs は GetPresences で一度に取得できるユーザー数の上限
+const MaxPresenceUserIDs = 100
+
+// ErrNoUserIDs は GetPresences にユーザーを指定しなかった場合に返されます。
+var ErrNoUserIDs = errors.New("user ids are required")
+
+// ErrTooManyUserIDs は GetPresences に MaxPresenceUserIDs を超えるユーザーを指定した場合に返されます。
+var ErrTooManyUserIDs = fmt.Errorf("too many user ids (max %d)", MaxPresenceUserIDs)
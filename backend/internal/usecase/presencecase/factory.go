@@ -0,0 +1,49 @@
+package presencecase
+
+import (
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+)
+
+type NewPresenceUseCaseParams struct {
+	UserRepo         repository.UserRepository
+	RoomRepo         repository.RoomRepository
+	WebsocketManager service.WebsocketManager
+	// AwayTimeout は操作がない場合に離席中として扱うまでの時間
+	AwayTimeout time.Duration
+}
+
+func (p *NewPresenceUseCaseParams) Validate() error {
+	if p.UserRepo == nil {
+		return errors.New("UserRepo is required")
+	}
+	if p.RoomRepo == nil {
+		return errors.New("RoomRepo is required")
+	}
+	if p.WebsocketManager == nil {
+		return errors.New("WebsocketManager is required")
+	}
+	if p.AwayTimeout <= 0 {
+		return errors.New("AwayTimeout must be positive")
+	}
+	return nil
+}
+
+func NewPresenceUseCase(params NewPresenceUseCaseParams) PresenceUseCaseInterface {
+	// Paramsのバリデーションを行う
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+
+	return &PresenceUseCase{
+		userRepo:         params.UserRepo,
+		roomRepo:         params.RoomRepo,
+		websocketManager: params.WebsocketManager,
+		awayTimeout:      params.AwayTimeout,
+		awayTimers:       make(map[entity.UserID]*awayTimer),
+	}
+}
@@ -0,0 +1,87 @@
+package presencecase
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// GetPresence オンライン状態の取得
+// 存在しないユーザーの場合は repository.ErrUserNotFound を返します。
+func (p *PresenceUseCase) GetPresence(ctx context.Context, id entity.UserID) (*entity.UserPresence, error) {
+	user, err := p.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return p.presenceOf(ctx, user)
+}
+
+// GetPresencesRequest構造体: 複数のユーザーのオンライン状態を取得するリクエスト
+type GetPresencesRequest struct {
+	UserIDs []entity.UserID // 1件以上 MaxPresenceUserIDs 件以下
+}
+
+// GetPresences オンライン状態の一括取得
+// メンバーの一覧に表示するために使用し、UserIDs の順に返します。
+// 存在しないユーザーと重複したユーザーは結果に含めません。
+func (p *PresenceUseCase) GetPresences(ctx context.Context, req GetPresencesRequest) ([]*entity.UserPresence, error) {
+	if len(req.UserIDs) == 0 {
+		return nil, ErrNoUserIDs
+	}
+	if len(req.UserIDs) > MaxPresenceUserIDs {
+		return nil, ErrTooManyUserIDs
+	}
+
+	users, err := p.userRepo.GetUsersByIDs(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	usersByID := make(map[entity.UserID]*entity.User, len(users))
+	for _, user := range users {
+		usersByID[user.GetID()] = user
+	}
+
+	presences := make([]*entity.UserPresence, 0, len(users))
+	for _, id := range req.UserIDs {
+		user, ok := usersByID[id]
+		if !ok {
+			continue
+		}
+		// 重複したユーザーは最初の1件だけを返す
+		delete(usersByID, id)
+		presence, err := p.presenceOf(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		presences = append(presences, presence)
+	}
+	return presences, nil
+}
+
+// presenceOf は接続中であれば WebsocketManager から求めた状態から、そうでなければ保存した日時からオンライン状態を作る
+func (p *PresenceUseCase) presenceOf(ctx context.Context, user *entity.User) (*entity.UserPresence, error) {
+	activity, err := p.websocketManager.GetUserActivity(ctx, user.GetID())
+	if err != nil {
+		return nil, err
+	}
+	if activity.Connections == 0 {
+		return entity.NewUserPresence(entity.UserPresenceParams{
+			UserID:     user.GetID(),
+			Status:     entity.PresenceOffline,
+			LastSeenAt: user.GetLastSeenAt(),
+		}), nil
+	}
+
+	status := activity.PresenceStatus(time.Now(), p.awayTimeout)
+	// 接続した直後でまだ操作を記録していない場合は保存した日時を返す
+	lastSeenAt := user.GetLastSeenAt()
+	if !activity.LastActiveAt.IsZero() {
+		lastSeenAt = &activity.LastActiveAt
+	}
+	return entity.NewUserPresence(entity.UserPresenceParams{
+		UserID:     user.GetID(),
+		Status:     status,
+		LastSeenAt: lastSeenAt,
+	}), nil
+}
@@ -0,0 +1,87 @@
+package presencecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/presencecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 接続していないユーザーは保存した日時を返す
+// 2. 存在しないユーザー
+// 3. 一括取得は指定した順に返し、存在しないユーザーと重複を除く
+// 4. 一括取得の件数の検証
+func TestGetPresence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	lastSeenAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("接続していないユーザーは保存した日時を返す", func(t *testing.T) {
+		useCase, mocks := presencecase.NewTestPresenceUseCase(ctrl)
+		stubActivity(mocks.WebsocketManager, "user1")
+
+		mocks.UserRepo.EXPECT().GetUserByID(ctx, entity.UserID("user1")).Return(entity.NewUser(entity.UserParams{
+			ID:         "user1",
+			LastSeenAt: &lastSeenAt,
+		}), nil)
+
+		presence, err := useCase.GetPresence(ctx, "user1")
+		require.NoError(t, err)
+		assert.Equal(t, entity.PresenceOffline, presence.GetStatus())
+		assert.Equal(t, &lastSeenAt, presence.GetLastSeenAt())
+	})
+
+	t.Run("存在しないユーザー", func(t *testing.T) {
+		useCase, mocks := presencecase.NewTestPresenceUseCase(ctrl)
+
+		mocks.UserRepo.EXPECT().GetUserByID(ctx, entity.UserID("unknown")).Return(nil, repository.ErrUserNotFound)
+
+		_, err := useCase.GetPresence(ctx, "unknown")
+		assert.ErrorIs(t, err, repository.ErrUserNotFound)
+	})
+
+	t.Run("一括取得は指定した順に返し、存在しないユーザーと重複を除く", func(t *testing.T) {
+		useCase, mocks := presencecase.NewTestPresenceUseCase(ctrl)
+		stubActivity(mocks.WebsocketManager, "user1")
+		stubActivity(mocks.WebsocketManager, "user2").setConnections(1)
+
+		mocks.UserRepo.EXPECT().UpdateLastSeen(ctx, entity.UserID("user2"), gomock.Any()).Return(nil)
+		mocks.RoomRepo.EXPECT().GetRoomIDsByMember(ctx, entity.UserID("user2")).Return([]entity.RoomID{}, nil)
+		require.NoError(t, useCase.Connect(ctx, presencecase.PresenceRequest{UserID: "user2"}))
+
+		userIDs := []entity.UserID{"user2", "unknown", "user1", "user2"}
+		mocks.UserRepo.EXPECT().GetUsersByIDs(ctx, userIDs).Return([]*entity.User{
+			entity.NewUser(entity.UserParams{ID: "user1", LastSeenAt: &lastSeenAt}),
+			entity.NewUser(entity.UserParams{ID: "user2"}),
+		}, nil)
+
+		presences, err := useCase.GetPresences(ctx, presencecase.GetPresencesRequest{UserIDs: userIDs})
+		require.NoError(t, err)
+		require.Len(t, presences, 2)
+		assert.Equal(t, entity.UserID("user2"), presences[0].GetUserID())
+		assert.Equal(t, entity.PresenceOnline, presences[0].GetStatus())
+		assert.NotNil(t, presences[0].GetLastSeenAt())
+		assert.Equal(t, entity.UserID("user1"), presences[1].GetUserID())
+		assert.Equal(t, entity.PresenceOffline, presences[1].GetStatus())
+		assert.Equal(t, &lastSeenAt, presences[1].GetLastSeenAt())
+	})
+
+	t.Run("一括取得の件数の検証", func(t *testing.T) {
+		useCase, _ := presencecase.NewTestPresenceUseCase(ctrl)
+
+		_, err := useCase.GetPresences(ctx, presencecase.GetPresencesRequest{})
+		assert.ErrorIs(t, err, presencecase.ErrNoUserIDs)
+
+		tooMany := make([]entity.UserID, presencecase.MaxPresenceUserIDs+1)
+		_, err = useCase.GetPresences(ctx, presencecase.GetPresencesRequest{UserIDs: tooMany})
+		assert.ErrorIs(t, err, presencecase.ErrTooManyUserIDs)
+	})
+}
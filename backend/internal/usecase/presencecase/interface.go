@@ -0,0 +1,25 @@
+package presencecase
+
+import (
+	"context"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// PresenceUseCaseInterface: ユーザーのオンライン状態に関するユースケースを管理するインターフェース
+type PresenceUseCaseInterface interface {
+	// Connect: ユーザーのコネクションが増えたことを記録する
+	Connect(ctx context.Context, req PresenceRequest) error
+
+	// Disconnect: ユーザーのコネクションが減ったことを記録する
+	Disconnect(ctx context.Context, req PresenceRequest) error
+
+	// Heartbeat: ユーザーが操作していることを記録する
+	Heartbeat(ctx context.Context, req PresenceRequest) error
+
+	// GetPresence: ユーザーのオンライン状態を取得する
+	GetPresence(ctx context.Context, id entity.UserID) (*entity.UserPresence, error)
+
+	// GetPresences: 複数のユーザーのオンライン状態をまとめて取得する
+	GetPresences(ctx context.Context, req GetPresencesRequest) ([]*entity.UserPresence, error)
+}
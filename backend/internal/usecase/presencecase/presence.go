@@ -0,0 +1,164 @@
+package presencecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// PresenceRequest構造体: オンライン状態を更新するリクエスト
+type PresenceRequest struct {
+	UserID entity.UserID
+}
+
+// Connect 接続
+// WebsocketManager にコネクションを登録した後に呼び出します。
+// 最初のコネクションであるか離席中であった場合は、online を参加している部屋に配信します。
+func (p *PresenceUseCase) Connect(ctx context.Context, req PresenceRequest) error {
+	now := time.Now()
+
+	activity, err := p.websocketManager.GetUserActivity(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+	// 登録済みのこのコネクションを含むため、1件であれば最初のコネクション
+	changed := activity.Connections <= 1 || p.isAway(activity, now)
+	if err := p.touch(ctx, req.UserID, now); err != nil {
+		return err
+	}
+
+	if err := p.userRepo.UpdateLastSeen(ctx, req.UserID, now); err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	return p.broadcast(ctx, req.UserID, entity.PresenceOnline, now)
+}
+
+// Disconnect 切断
+// WebsocketManager からコネクションを登録解除した後に呼び出します。
+// 最後のコネクションであった場合は、最後にオンラインだった日時を保存して offline を参加している部屋に配信します。
+func (p *PresenceUseCase) Disconnect(ctx context.Context, req PresenceRequest) error {
+	now := time.Now()
+
+	activity, err := p.websocketManager.GetUserActivity(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+	if activity.Connections > 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	if entry, ok := p.awayTimers[req.UserID]; ok {
+		entry.timer.Stop()
+		delete(p.awayTimers, req.UserID)
+	}
+	p.mu.Unlock()
+
+	// 離席中に切断した場合は、最後に操作した日時を最後にオンラインだった日時とする
+	lastSeenAt := now
+	if p.isAway(activity, now) {
+		lastSeenAt = activity.LastActiveAt
+	}
+
+	if err := p.userRepo.UpdateLastSeen(ctx, req.UserID, lastSeenAt); err != nil {
+		return err
+	}
+	return p.broadcast(ctx, req.UserID, entity.PresenceOffline, lastSeenAt)
+}
+
+// Heartbeat 操作
+// 離席中であった場合は、online を参加している部屋に配信します。
+// 接続していないユーザーの場合は何もしません。
+func (p *PresenceUseCase) Heartbeat(ctx context.Context, req PresenceRequest) error {
+	now := time.Now()
+
+	activity, err := p.websocketManager.GetUserActivity(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+	if activity.Connections == 0 {
+		return nil
+	}
+	changed := p.isAway(activity, now)
+	if err := p.touch(ctx, req.UserID, now); err != nil {
+		return err
+	}
+
+	if !changed {
+		return nil
+	}
+	return p.broadcast(ctx, req.UserID, entity.PresenceOnline, now)
+}
+
+// isAway は接続中のユーザーが AwayTimeout を過ぎても操作していないかを判定する
+func (p *PresenceUseCase) isAway(activity entity.UserActivity, now time.Time) bool {
+	return activity.PresenceStatus(now, p.awayTimeout) == entity.PresenceAway
+}
+
+// touch は操作した日時を WebsocketManager に記録し、離席にするタイマーを作り直す
+func (p *PresenceUseCase) touch(ctx context.Context, userID entity.UserID, now time.Time) error {
+	if err := p.websocketManager.TouchUser(ctx, userID, now); err != nil {
+		return err
+	}
+
+	entry := &awayTimer{}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if prev, ok := p.awayTimers[userID]; ok {
+		prev.timer.Stop()
+	}
+	entry.timer = time.AfterFunc(p.awayTimeout, func() {
+		p.expire(userID, entry)
+	})
+	p.awayTimers[userID] = entry
+	return nil
+}
+
+// expire は操作がないまま AwayTimeout を過ぎたユーザーを離席中にする
+// 最後に操作した日時を最後にオンラインだった日時として保存する
+func (p *PresenceUseCase) expire(userID entity.UserID, entry *awayTimer) {
+	p.mu.Lock()
+	// 期限の直前に操作した・切断した場合は何もしない
+	if p.awayTimers[userID] != entry {
+		p.mu.Unlock()
+		return
+	}
+	delete(p.awayTimers, userID)
+	p.mu.Unlock()
+
+	ctx := context.Background()
+	activity, err := p.websocketManager.GetUserActivity(ctx, userID)
+	// 他のノードで操作した場合は、そのノードのタイマーが離席中にする
+	if err != nil || !p.isAway(activity, time.Now()) {
+		return
+	}
+	_ = p.userRepo.UpdateLastSeen(ctx, userID, activity.LastActiveAt)
+	_ = p.broadcast(ctx, userID, entity.PresenceAway, activity.LastActiveAt)
+}
+
+// broadcast はオンライン状態の変化をユーザーが参加しているすべての部屋に配信する
+// 一部の部屋への配信に失敗しても残りの部屋には配信し、失敗をまとめて返す
+func (p *PresenceUseCase) broadcast(ctx context.Context, userID entity.UserID, status entity.PresenceStatus, lastSeenAt time.Time) error {
+	roomIDs, err := p.roomRepo.GetRoomIDsByMember(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	event := entity.NewPresenceUpdatedEvent(entity.NewUserPresence(entity.UserPresenceParams{
+		UserID:     userID,
+		Status:     status,
+		LastSeenAt: &lastSeenAt,
+	}))
+	var errs []error
+	for _, roomID := range roomIDs {
+		if err := p.websocketManager.BroadcastToRoom(ctx, roomID, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
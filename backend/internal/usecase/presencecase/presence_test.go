@@ -0,0 +1,213 @@
+package presencecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/presencecase"
+	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// presenceStatusOf はイベントのオンライン状態を取り出す
+func presenceStatusOf(t *testing.T, event *entity.WebsocketEvent) entity.PresenceStatus {
+	t.Helper()
+	require.Equal(t, entity.WebsocketEventTypePresenceUpdated, event.GetType())
+	return event.GetPayload().(entity.PresenceUpdatedPayload).Status
+}
+
+// activityStub はモックの WebsocketManager が返すユーザーのコネクション数と最後に操作した日時
+type activityStub struct {
+	mu       sync.Mutex
+	activity entity.UserActivity
+}
+
+// stubActivity は WebsocketManager の GetUserActivity / TouchUser を activityStub で応答させる
+func stubActivity(m *mock_service.MockWebsocketManager, userID entity.UserID) *activityStub {
+	s := &activityStub{}
+	m.EXPECT().GetUserActivity(gomock.Any(), userID).DoAndReturn(
+		func(context.Context, entity.UserID) (entity.UserActivity, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return s.activity, nil
+		}).AnyTimes()
+	m.EXPECT().TouchUser(gomock.Any(), userID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ entity.UserID, at time.Time) error {
+			s.touch(at)
+			return nil
+		}).AnyTimes()
+	return s
+}
+
+// setConnections は WebsocketManager へのコネクションの登録・登録解除を再現する
+func (s *activityStub) setConnections(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.activity.Connections = n
+}
+
+// touch は操作した日時を記録する（他のノードでの操作の再現にも使う）
+func (s *activityStub) touch(at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if at.After(s.activity.LastActiveAt) {
+		s.activity.LastActiveAt = at
+	}
+}
+
+// 1. 最初の接続と最後の切断だけを配信する
+// 2. 操作がなければ離席中になり、操作するとオンラインに戻る
+// 3. 他のノードで操作している間は離席中にしない
+// 4. 接続していないユーザーの操作は無視する
+// 5. 最後にオンラインだった日時の保存に失敗
+// 6. 一部の部屋への配信に失敗しても残りの部屋に配信する
+func TestPresence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userID := entity.UserID("user123")
+	req := presencecase.PresenceRequest{UserID: userID}
+	roomIDs := []entity.RoomID{"room1", "room2"}
+
+	t.Run("最初の接続と最後の切断だけを配信する", func(t *testing.T) {
+		useCase, mocks := presencecase.NewTestPresenceUseCase(ctrl)
+		activity := stubActivity(mocks.WebsocketManager, userID)
+
+		var statuses []entity.PresenceStatus
+		record := func(_ context.Context, _ entity.RoomID, event *entity.WebsocketEvent) error {
+			statuses = append(statuses, presenceStatusOf(t, event))
+			return nil
+		}
+		mocks.UserRepo.EXPECT().UpdateLastSeen(ctx, userID, gomock.Any()).Return(nil).Times(3)
+		mocks.RoomRepo.EXPECT().GetRoomIDsByMember(ctx, userID).Return(roomIDs, nil).Times(2)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, gomock.Any(), gomock.Any()).DoAndReturn(record).Times(4)
+
+		// 2つ目のタブ・端末の接続と、1つ目の切断は配信しない
+		activity.setConnections(1)
+		assert.NoError(t, useCase.Connect(ctx, req))
+		activity.setConnections(2)
+		assert.NoError(t, useCase.Connect(ctx, req))
+		activity.setConnections(1)
+		assert.NoError(t, useCase.Disconnect(ctx, req))
+
+		mocks.UserRepo.EXPECT().GetUserByID(ctx, userID).Return(entity.NewUser(entity.UserParams{ID: userID}), nil)
+		presence, err := useCase.GetPresence(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.PresenceOnline, presence.GetStatus())
+
+		activity.setConnections(0)
+		assert.NoError(t, useCase.Disconnect(ctx, req))
+
+		assert.Equal(t, []entity.PresenceStatus{
+			entity.PresenceOnline, entity.PresenceOnline,
+			entity.PresenceOffline, entity.PresenceOffline,
+		}, statuses)
+	})
+
+	t.Run("操作がなければ離席中になり、操作するとオンラインに戻る", func(t *testing.T) {
+		useCase, mocks := presencecase.NewTestPresenceUseCaseWithAwayTimeout(ctrl, 20*time.Millisecond)
+		activity := stubActivity(mocks.WebsocketManager, userID)
+
+		// 期限が短いため、操作の後にもう一度離席中になる場合がある
+		events := make(chan entity.PresenceStatus, 10)
+		mocks.UserRepo.EXPECT().UpdateLastSeen(gomock.Any(), userID, gomock.Any()).Return(nil).AnyTimes()
+		mocks.RoomRepo.EXPECT().GetRoomIDsByMember(gomock.Any(), userID).Return(roomIDs[:1], nil).AnyTimes()
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(gomock.Any(), entity.RoomID("room1"), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entity.RoomID, event *entity.WebsocketEvent) error {
+				events <- presenceStatusOf(t, event)
+				return nil
+			}).AnyTimes()
+
+		activity.setConnections(1)
+		require.NoError(t, useCase.Connect(ctx, req))
+		assert.Equal(t, entity.PresenceOnline, <-events)
+
+		select {
+		case status := <-events:
+			assert.Equal(t, entity.PresenceAway, status)
+		case <-time.After(1 * time.Second):
+			t.Fatal("user did not become away")
+		}
+
+		require.NoError(t, useCase.Heartbeat(ctx, req))
+		assert.Equal(t, entity.PresenceOnline, <-events)
+		// 離席にするタイマーを止めるため切断する
+		activity.setConnections(0)
+		require.NoError(t, useCase.Disconnect(ctx, req))
+	})
+
+	t.Run("他のノードで操作している間は離席中にしない", func(t *testing.T) {
+		useCase, mocks := presencecase.NewTestPresenceUseCaseWithAwayTimeout(ctrl, 200*time.Millisecond)
+		activity := stubActivity(mocks.WebsocketManager, userID)
+
+		events := make(chan entity.PresenceStatus, 10)
+		mocks.UserRepo.EXPECT().UpdateLastSeen(gomock.Any(), userID, gomock.Any()).Return(nil).AnyTimes()
+		mocks.RoomRepo.EXPECT().GetRoomIDsByMember(gomock.Any(), userID).Return(roomIDs[:1], nil).AnyTimes()
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(gomock.Any(), entity.RoomID("room1"), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entity.RoomID, event *entity.WebsocketEvent) error {
+				events <- presenceStatusOf(t, event)
+				return nil
+			}).AnyTimes()
+
+		activity.setConnections(1)
+		require.NoError(t, useCase.Connect(ctx, req))
+		assert.Equal(t, entity.PresenceOnline, <-events)
+
+		// このノードのタイマーが切れる前に、他のノードでの操作が共有される
+		time.Sleep(100 * time.Millisecond)
+		activity.touch(time.Now())
+
+		// このノードのタイマーが切れた後も、他のノードでの操作から AwayTimeout が過ぎるまでは離席中にしない
+		select {
+		case status := <-events:
+			t.Fatalf("unexpected presence %s", status)
+		case <-time.After(140 * time.Millisecond):
+		}
+
+		mocks.UserRepo.EXPECT().GetUserByID(ctx, userID).Return(entity.NewUser(entity.UserParams{ID: userID}), nil)
+		presence, err := useCase.GetPresence(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.PresenceOnline, presence.GetStatus())
+
+		activity.setConnections(0)
+		require.NoError(t, useCase.Disconnect(ctx, req))
+	})
+
+	t.Run("接続していないユーザーの操作は無視する", func(t *testing.T) {
+		useCase, mocks := presencecase.NewTestPresenceUseCase(ctrl)
+		mocks.WebsocketManager.EXPECT().GetUserActivity(ctx, userID).Return(entity.UserActivity{}, nil)
+
+		assert.NoError(t, useCase.Heartbeat(ctx, req))
+	})
+
+	t.Run("最後にオンラインだった日時の保存に失敗", func(t *testing.T) {
+		useCase, mocks := presencecase.NewTestPresenceUseCase(ctrl)
+		stubActivity(mocks.WebsocketManager, userID).setConnections(1)
+
+		mocks.UserRepo.EXPECT().UpdateLastSeen(ctx, userID, gomock.Any()).Return(assert.AnError)
+
+		err := useCase.Connect(ctx, req)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("一部の部屋への配信に失敗しても残りの部屋に配信する", func(t *testing.T) {
+		useCase, mocks := presencecase.NewTestPresenceUseCase(ctrl)
+		stubActivity(mocks.WebsocketManager, userID).setConnections(1)
+
+		mocks.UserRepo.EXPECT().UpdateLastSeen(ctx, userID, gomock.Any()).Return(nil)
+		mocks.RoomRepo.EXPECT().GetRoomIDsByMember(ctx, userID).Return(roomIDs, nil)
+		gomock.InOrder(
+			mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, entity.RoomID("room1"), gomock.Any()).Return(assert.AnError),
+			mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, entity.RoomID("room2"), gomock.Any()).Return(nil),
+		)
+
+		err := useCase.Connect(ctx, req)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,48 @@
+package presencecase
+
+import (
+	"time"
+
+	mock_repository "example.com/infrahandson/test/mocks/domain/repository"
+	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	"go.uber.org/mock/gomock"
+)
+
+type mockDeps struct {
+	UserRepo         *mock_repository.MockUserRepository
+	RoomRepo         *mock_repository.MockRoomRepository
+	WebsocketManager *mock_service.MockWebsocketManager
+}
+
+func NewTestPresenceUseCase(
+	ctrl *gomock.Controller,
+) (PresenceUseCaseInterface, mockDeps) {
+	// テスト中に離席中にならないよう、十分に長くする
+	return NewTestPresenceUseCaseWithAwayTimeout(ctrl, time.Hour)
+}
+
+// NewTestPresenceUseCaseWithAwayTimeout は離席中にするまでの時間を設定したユースケースを生成します。
+func NewTestPresenceUseCaseWithAwayTimeout(
+	ctrl *gomock.Controller,
+	awayTimeout time.Duration,
+) (PresenceUseCaseInterface, mockDeps) {
+	// モックの作成
+	mockUserRepo := mock_repository.NewMockUserRepository(ctrl)
+	mockRoomRepo := mock_repository.NewMockRoomRepository(ctrl)
+	mockWebsocketManager := mock_service.NewMockWebsocketManager(ctrl)
+
+	params := NewPresenceUseCaseParams{
+		UserRepo:         mockUserRepo,
+		RoomRepo:         mockRoomRepo,
+		WebsocketManager: mockWebsocketManager,
+		AwayTimeout:      awayTimeout,
+	}
+	useCase := NewPresenceUseCase(params)
+
+	// モックをテスト内で使いたいため構造体で返す
+	return useCase, mockDeps{
+		UserRepo:         mockUserRepo,
+		RoomRepo:         mockRoomRepo,
+		WebsocketManager: mockWebsocketManager,
+	}
+}
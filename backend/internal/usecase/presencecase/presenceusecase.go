@@ -0,0 +1,27 @@
+package presencecase
+
+import (
+	"sync"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+)
+
+// PresenceUseCase はユーザーのオンライン状態を管理します。
+// 接続中かどうかと最後に操作した日時は WebsocketManager から求め（複数ノードの場合はバックプレーンで共有される）、
+// 最後にオンラインだった日時だけを永続化します。
+type PresenceUseCase struct {
+	userRepo         repository.UserRepository
+	roomRepo         repository.RoomRepository
+	websocketManager service.WebsocketManager
+	awayTimeout      time.Duration
+	mu               sync.Mutex
+	awayTimers       map[entity.UserID]*awayTimer // このプロセスで操作を受けたユーザーの、離席を配信するタイマー
+}
+
+// awayTimer は操作がないまま AwayTimeout を過ぎたユーザーを離席中にするタイマー
+type awayTimer struct {
+	timer *time.Timer
+}
@@ -0,0 +1,21 @@
+package roomcase
+
+import (
+	"context"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// authorize はユーザーが部屋で required 以上の役割を持つかを確認し、役割を返します。
+// 部屋が存在しない場合は repository.ErrRoomNotFound、メンバーでない場合は repository.ErrNotRoomMember、
+// 権限が足りない場合は entity.ErrInsufficientRoomRole を返します。
+func (r *RoomUseCase) authorize(ctx context.Context, roomID entity.RoomID, userID entity.UserID, required entity.RoomRole) (entity.RoomRole, error) {
+	role, err := r.roomRepo.GetMemberRole(ctx, roomID, userID)
+	if err != nil {
+		return "", err
+	}
+	if !role.AtLeast(required) {
+		return role, entity.ErrInsufficientRoomRole
+	}
+	return role, nil
+}
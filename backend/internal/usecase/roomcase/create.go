@@ -2,13 +2,16 @@ package roomcase
 
 import (
 	"context"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 )
 
 // CreateRoomRequest構造体: 部屋作成リクエストのデータ
 type CreateRoomRequest struct {
-	Name string `json:"name"` // 部屋名
+	Name       string                `json:"name"`       // 部屋名
+	CreatorID  entity.UserID         `json:"creator_id"` // 作成者。オーナーとして部屋に参加する
+	Visibility entity.RoomVisibility `json:"visibility"` // 公開設定。省略時は公開
 }
 
 // CreateRoomResponse構造体: 部屋作成レスポンスのデータ
@@ -18,17 +21,24 @@ type CreateRoomResponse struct {
 
 // CreateRoom: 新しい部屋を作成
 func (r *RoomUseCase) CreateRoom(ctx context.Context, req CreateRoomRequest) (CreateRoomResponse, error) {
+	if req.Visibility != "" && !req.Visibility.IsValid() {
+		return CreateRoomResponse{nil}, ErrInvalidVisibility
+	}
+
 	id, err := r.roomIDFactory.NewRoomID()
 	if err != nil {
 		return CreateRoomResponse{nil}, err
 	}
 
 	room := entity.NewRoom(entity.RoomParams{
-		ID:      id,
-		Name:    req.Name,
-		Members: []entity.UserID{},
+		ID:         id,
+		Name:       req.Name,
+		Visibility: req.Visibility,
+		CreatedAt:  time.Now(),
+		Members:    []entity.UserID{},
 	})
-	savedRoomID, err := r.roomRepo.SaveRoom(ctx, room)
+	// 作成者のいない部屋が残らないよう、部屋とオーナーの参加を同時に保存する
+	savedRoomID, err := r.roomRepo.SaveRoomWithOwner(ctx, room, req.CreatorID)
 	if err != nil {
 		return CreateRoomResponse{nil}, err
 	}
@@ -14,7 +14,7 @@ import (
 // TestCreateRoom: CreateRoomのテスト
 // 1. 正常系: 部屋が正常に作成されることを確認
 // 2. NewRoomID失敗: RoomIDの生成に失敗した場合、エラーが返されることを確認
-// 3. SaveRoom失敗: 部屋の保存に失敗した場合、エラーが返されることを確認
+// 3. SaveRoomWithOwner失敗: 部屋と作成者の参加の保存に失敗した場合、エラーが返されることを確認
 // 4. GetRoomByID失敗: 部屋の取得に失敗した場合、エラーが返されることを確認
 
 func TestCreateRoom(t *testing.T) {
@@ -25,12 +25,13 @@ func TestCreateRoom(t *testing.T) {
 
 	t.Run("1. 正常系", func(t *testing.T) {
 		req := roomcase.CreateRoomRequest{
-			Name: "Test Room",
+			Name:      "Test Room",
+			CreatorID: "creator",
 		}
 		roomID := entity.RoomID("public_room_1")
 
 		mockDeps.RoomIDFactory.EXPECT().NewRoomID().Return(roomID, nil)
-		mockDeps.RoomRepo.EXPECT().SaveRoom(context.Background(), gomock.Any()).Return(roomID, nil)
+		mockDeps.RoomRepo.EXPECT().SaveRoomWithOwner(context.Background(), gomock.Any(), entity.UserID("creator")).Return(roomID, nil)
 		mockDeps.RoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{
 			ID:      roomID,
 			Name:    req.Name,
@@ -45,7 +46,8 @@ func TestCreateRoom(t *testing.T) {
 
 	t.Run("2. RoomID生成失敗時", func(t *testing.T) {
 		req := roomcase.CreateRoomRequest{
-			Name: "Test Room",
+			Name:      "Test Room",
+			CreatorID: "creator",
 		}
 		expectedErr := errors.New("failed to generate room ID")
 		roomID := entity.RoomID("public_room_1")
@@ -59,14 +61,15 @@ func TestCreateRoom(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 	})
 
-	t.Run("3. SaveRoom失敗", func(t *testing.T) {
+	t.Run("3. SaveRoomWithOwner失敗", func(t *testing.T) {
 		req := roomcase.CreateRoomRequest{
-			Name: "Test Room",
+			Name:      "Test Room",
+			CreatorID: "creator",
 		}
 		publicID := entity.RoomID("public_room_1")
 		expectedErr := errors.New("failed to save room")
 		mockDeps.RoomIDFactory.EXPECT().NewRoomID().Return(publicID, nil)
-		mockDeps.RoomRepo.EXPECT().SaveRoom(context.Background(), gomock.Any()).Return(publicID, expectedErr)
+		mockDeps.RoomRepo.EXPECT().SaveRoomWithOwner(context.Background(), gomock.Any(), entity.UserID("creator")).Return(publicID, expectedErr)
 		resp, err := roomUseCase.CreateRoom(context.Background(), req)
 		assert.Error(t, err)
 		assert.Nil(t, resp.Room)
@@ -75,13 +78,14 @@ func TestCreateRoom(t *testing.T) {
 
 	t.Run("4. GetRoomByID失敗", func(t *testing.T) {
 		req := roomcase.CreateRoomRequest{
-			Name: "Test Room",
+			Name:      "Test Room",
+			CreatorID: "creator",
 		}
 		publicID := entity.RoomID("public_room_1")
 		expectedErr := errors.New("failed to get room by ID")
 
 		mockDeps.RoomIDFactory.EXPECT().NewRoomID().Return(publicID, nil)
-		mockDeps.RoomRepo.EXPECT().SaveRoom(context.Background(), gomock.Any()).Return(publicID, nil)
+		mockDeps.RoomRepo.EXPECT().SaveRoomWithOwner(context.Background(), gomock.Any(), entity.UserID("creator")).Return(publicID, nil)
 		mockDeps.RoomRepo.EXPECT().GetRoomByID(context.Background(), publicID).Return(nil, expectedErr)
 
 		resp, err := roomUseCase.CreateRoom(context.Background(), req)
@@ -90,4 +94,5 @@ func TestCreateRoom(t *testing.T) {
 		assert.Nil(t, resp.Room)
 		assert.Equal(t, expectedErr, err)
 	})
+
 }
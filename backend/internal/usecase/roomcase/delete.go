@@ -2,20 +2,53 @@ package roomcase
 
 import (
 	"context"
+	"errors"
 
 	"example.com/infrahandson/internal/domain/entity"
 )
 
+// RoomDeletedCloseReason は部屋の削除時にコネクションを閉じる際の切断理由
+const RoomDeletedCloseReason = "room deleted"
+
 // DeleteRoomRequest構造体: 部屋を削除するリクエスト
 type DeleteRoomRequest struct {
 	RoomID entity.RoomID `json:"room_id"` // 部屋の公開ID
+	UserID entity.UserID `json:"user_id"` // 削除を行うユーザー
 }
 
-// DeleteRoom: 部屋を削除
+// DeleteRoom: 部屋を削除（オーナーのみ）
+// メンバー・メッセージ・招待はリポジトリが部屋と同じトランザクションで削除する
+// 削除後、部屋の最近のメッセージのキャッシュを破棄し、部屋に接続中のコネクションをすべて閉じる
+// 部屋にアップロードされた添付ファイル（メッセージに添付していないものを含む）もストレージから削除する
 func (r *RoomUseCase) DeleteRoom(ctx context.Context, req DeleteRoomRequest) error {
-	err := r.roomRepo.DeleteRoom(ctx, req.RoomID)
+	if _, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleOwner); err != nil {
+		return err
+	}
+
+	// 添付ファイルの情報は部屋と一緒に削除されるため、先にIDを取得しておく
+	attachmentIDs, err := r.attachmentRepo.GetAttachmentIDsByRoomID(ctx, req.RoomID)
+	if err != nil {
+		return err
+	}
+
+	err = r.roomRepo.DeleteRoom(ctx, req.RoomID)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	// 部屋はすでに削除されているため、片方が失敗してももう片方は行う
+	cacheErr := r.msgCache.InvalidateRoom(ctx, req.RoomID)
+	closeErr := r.wsManager.CloseRoom(ctx, req.RoomID, RoomDeletedCloseReason)
+	r.deleteAttachmentFiles(ctx, attachmentIDs)
+	return errors.Join(cacheErr, closeErr)
+}
+
+// deleteAttachmentFiles は削除した部屋の添付ファイルをストレージから削除する
+// 参照されなくなったファイルが残るだけのため、失敗しても記録するだけで残りのファイルの削除を続ける
+func (r *RoomUseCase) deleteAttachmentFiles(ctx context.Context, ids []entity.AttachmentID) {
+	for _, id := range ids {
+		if err := r.attachmentStore.DeleteAttachment(ctx, id); err != nil {
+			r.logger.Error("Failed to delete attachment file", "attachment_id", id, "error", err)
+		}
+	}
 }
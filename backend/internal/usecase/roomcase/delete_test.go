@@ -5,38 +5,105 @@ import (
 	"testing"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/usecase/roomcase"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
 
 // 1. 正常系
-// 2. DeleteRoom失敗
+// 2. キャッシュの破棄に失敗してもコネクションは閉じる
+// 3. 添付ファイルの削除に失敗しても残りのファイルを削除して成功する
+// 4. DeleteRoom失敗
+// 5. オーナー以外
+// 6. メンバーでない
 
 func TestDeleteRoom(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	userID := entity.UserID("user_1")
 
 	t.Run("正常系", func(t *testing.T) {
 		roomID := entity.RoomID("public_room_1")
 
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleOwner, nil)
+		mockDeps.AttachmentRepo.EXPECT().GetAttachmentIDsByRoomID(context.Background(), roomID).Return([]entity.AttachmentID{"att_1", "att_2"}, nil)
+		deleteRoom := mockDeps.RoomRepo.EXPECT().DeleteRoom(context.Background(), roomID).Return(nil)
+		mockDeps.MsgCache.EXPECT().InvalidateRoom(context.Background(), roomID).Return(nil)
+		mockDeps.WsManager.EXPECT().CloseRoom(context.Background(), roomID, "room deleted").Return(nil)
+		// 添付ファイルは部屋を削除した後に削除する
+		mockDeps.AttachmentStore.EXPECT().DeleteAttachment(context.Background(), entity.AttachmentID("att_1")).Return(nil).After(deleteRoom)
+		mockDeps.AttachmentStore.EXPECT().DeleteAttachment(context.Background(), entity.AttachmentID("att_2")).Return(nil).After(deleteRoom)
+
+		err := roomUseCase.DeleteRoom(context.Background(), roomcase.DeleteRoomRequest{RoomID: roomID, UserID: userID})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("キャッシュの破棄に失敗してもコネクションは閉じる", func(t *testing.T) {
+		roomID := entity.RoomID("public_room_1")
+
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleOwner, nil)
+		mockDeps.AttachmentRepo.EXPECT().GetAttachmentIDsByRoomID(context.Background(), roomID).Return(nil, nil)
+		mockDeps.RoomRepo.EXPECT().DeleteRoom(context.Background(), roomID).Return(nil)
+		mockDeps.MsgCache.EXPECT().InvalidateRoom(context.Background(), roomID).Return(assert.AnError)
+		mockDeps.WsManager.EXPECT().CloseRoom(context.Background(), roomID, "room deleted").Return(nil)
+
+		err := roomUseCase.DeleteRoom(context.Background(), roomcase.DeleteRoomRequest{RoomID: roomID, UserID: userID})
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("添付ファイルの削除に失敗しても残りのファイルを削除して成功する", func(t *testing.T) {
+		roomID := entity.RoomID("public_room_1")
+
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleOwner, nil)
+		mockDeps.AttachmentRepo.EXPECT().GetAttachmentIDsByRoomID(context.Background(), roomID).Return([]entity.AttachmentID{"att_1", "att_2"}, nil)
 		mockDeps.RoomRepo.EXPECT().DeleteRoom(context.Background(), roomID).Return(nil)
+		mockDeps.MsgCache.EXPECT().InvalidateRoom(context.Background(), roomID).Return(nil)
+		mockDeps.WsManager.EXPECT().CloseRoom(context.Background(), roomID, "room deleted").Return(nil)
+		mockDeps.AttachmentStore.EXPECT().DeleteAttachment(context.Background(), entity.AttachmentID("att_1")).Return(assert.AnError)
+		mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+		mockDeps.AttachmentStore.EXPECT().DeleteAttachment(context.Background(), entity.AttachmentID("att_2")).Return(nil)
 
-		err := roomUseCase.DeleteRoom(context.Background(), roomcase.DeleteRoomRequest{RoomID: roomID})
+		err := roomUseCase.DeleteRoom(context.Background(), roomcase.DeleteRoomRequest{RoomID: roomID, UserID: userID})
 
 		assert.NoError(t, err)
 	})
 
 	t.Run("DeleteRoom失敗", func(t *testing.T) {
-		roomID := entity.RoomID("nonexistent_room")
+		roomID := entity.RoomID("public_room_1")
 
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleOwner, nil)
+		// 部屋を削除できなかった場合は添付ファイルも削除しない
+		mockDeps.AttachmentRepo.EXPECT().GetAttachmentIDsByRoomID(context.Background(), roomID).Return([]entity.AttachmentID{"att_1"}, nil)
 		mockDeps.RoomRepo.EXPECT().DeleteRoom(context.Background(), roomID).Return(assert.AnError)
 
-		err := roomUseCase.DeleteRoom(context.Background(), roomcase.DeleteRoomRequest{RoomID: roomID})
+		err := roomUseCase.DeleteRoom(context.Background(), roomcase.DeleteRoomRequest{RoomID: roomID, UserID: userID})
 
 		assert.Error(t, err)
 		assert.Equal(t, assert.AnError, err)
 	})
+
+	t.Run("オーナー以外", func(t *testing.T) {
+		roomID := entity.RoomID("public_room_1")
+
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleAdmin, nil)
+
+		err := roomUseCase.DeleteRoom(context.Background(), roomcase.DeleteRoomRequest{RoomID: roomID, UserID: userID})
+
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
+
+	t.Run("メンバーでない", func(t *testing.T) {
+		roomID := entity.RoomID("public_room_1")
+
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		err := roomUseCase.DeleteRoom(context.Background(), roomcase.DeleteRoomRequest{RoomID: roomID, UserID: userID})
+
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
 }
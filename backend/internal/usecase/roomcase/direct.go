@@ -0,0 +1,85 @@
+package roomcase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// OpenDirectRoomRequest構造体: ダイレクトメッセージを開くリクエスト
+type OpenDirectRoomRequest struct {
+	UserID entity.UserID `json:"user_id"` // 開くユーザー
+	PeerID entity.UserID `json:"peer_id"` // 相手のユーザー
+}
+
+// OpenDirectRoomResponse構造体: ダイレクトメッセージを開いた結果
+type OpenDirectRoomResponse struct {
+	Room    *entity.Room `json:"room"`    // 2人のダイレクトメッセージの部屋（メンバーは2人）
+	Created bool         `json:"created"` // 新しく作成した場合は true
+}
+
+// OpenDirectRoom: 相手とのダイレクトメッセージの部屋を返す
+// すでに部屋がある場合はその部屋を返し、ない場合は2人をメンバーとして作成する
+func (r *RoomUseCase) OpenDirectRoom(ctx context.Context, req OpenDirectRoomRequest) (OpenDirectRoomResponse, error) {
+	if req.UserID == req.PeerID {
+		return OpenDirectRoomResponse{}, ErrDirectRoomWithSelf
+	}
+
+	room, err := r.roomRepo.GetDirectRoom(ctx, req.UserID, req.PeerID)
+	if err == nil {
+		if err := r.rejoinDirectRoom(ctx, room, req.UserID); err != nil {
+			return OpenDirectRoomResponse{}, err
+		}
+		return OpenDirectRoomResponse{Room: room}, nil
+	}
+	if !errors.Is(err, repository.ErrRoomNotFound) {
+		return OpenDirectRoomResponse{}, err
+	}
+
+	if _, err := r.userRepo.GetUserByID(ctx, req.PeerID); err != nil {
+		return OpenDirectRoomResponse{}, err
+	}
+
+	id, err := r.roomIDFactory.NewRoomID()
+	if err != nil {
+		return OpenDirectRoomResponse{}, err
+	}
+	// 同時に開かれた場合は、先に作成された部屋の ID が返る
+	savedRoomID, err := r.roomRepo.SaveDirectRoom(ctx, entity.NewDirectRoom(id, req.UserID, req.PeerID, time.Now()), req.UserID, req.PeerID)
+	if err != nil {
+		return OpenDirectRoomResponse{}, err
+	}
+	room, err = r.roomRepo.GetDirectRoom(ctx, req.UserID, req.PeerID)
+	if err != nil {
+		return OpenDirectRoomResponse{}, err
+	}
+
+	return OpenDirectRoomResponse{Room: room, Created: savedRoomID == id}, nil
+}
+
+// rejoinDirectRoom は退出していたダイレクトメッセージの部屋に、開いたユーザーを再び参加させる
+func (r *RoomUseCase) rejoinDirectRoom(ctx context.Context, room *entity.Room, userID entity.UserID) error {
+	for _, memberID := range room.GetMembers() {
+		if memberID == userID {
+			return nil
+		}
+	}
+	return r.roomRepo.AddMemberToRoom(ctx, room.GetID(), userID, entity.RoomRoleMember)
+}
+
+// GetDirectRoomsRequest構造体: ダイレクトメッセージの一覧を取得するリクエスト
+type GetDirectRoomsRequest struct {
+	UserID entity.UserID `json:"user_id"`
+}
+
+// GetDirectRooms: 参加しているダイレクトメッセージの部屋を取得
+func (r *RoomUseCase) GetDirectRooms(ctx context.Context, req GetDirectRoomsRequest) ([]*entity.Room, error) {
+	rooms, err := r.roomRepo.GetDirectRooms(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
@@ -0,0 +1,122 @@
+package roomcase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 部屋がない場合は作成する
+// 2. すでに部屋がある場合はその部屋を返す
+// 3. 退出していた場合は再び参加する
+// 4. 同時に作成された場合は先に作成された部屋を返す
+// 5. 自分自身とは開けない
+// 6. 相手のユーザーが存在しない
+func TestOpenDirectRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	userID := entity.UserID("user_1")
+	peerID := entity.UserID("user_2")
+	req := roomcase.OpenDirectRoomRequest{UserID: userID, PeerID: peerID}
+	directRoom := entity.NewDirectRoom("dm_1", userID, peerID, time.Time{})
+
+	t.Run("1. 部屋がない場合は作成する", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetDirectRoom(ctx, userID, peerID).Return(nil, repository.ErrRoomNotFound)
+		mockDeps.UserRepo.EXPECT().GetUserByID(ctx, peerID).Return(entity.NewUser(entity.UserParams{ID: peerID}), nil)
+		mockDeps.RoomIDFactory.EXPECT().NewRoomID().Return(entity.RoomID("dm_1"), nil)
+		mockDeps.RoomRepo.EXPECT().SaveDirectRoom(ctx, gomock.Any(), userID, peerID).
+			DoAndReturn(func(_ context.Context, room *entity.Room, _, _ entity.UserID) (entity.RoomID, error) {
+				assert.True(t, room.IsDirect())
+				assert.True(t, room.IsPrivate())
+				return room.GetID(), nil
+			})
+		mockDeps.RoomRepo.EXPECT().GetDirectRoom(ctx, userID, peerID).Return(directRoom, nil)
+
+		resp, err := roomUseCase.OpenDirectRoom(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, directRoom, resp.Room)
+		assert.True(t, resp.Created)
+	})
+
+	t.Run("2. すでに部屋がある場合はその部屋を返す", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetDirectRoom(ctx, userID, peerID).Return(directRoom, nil)
+
+		resp, err := roomUseCase.OpenDirectRoom(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, directRoom, resp.Room)
+		assert.False(t, resp.Created)
+	})
+
+	t.Run("3. 退出していた場合は再び参加する", func(t *testing.T) {
+		leftRoom := entity.NewRoom(entity.RoomParams{
+			ID:         "dm_1",
+			Kind:       entity.RoomKindDirect,
+			Visibility: entity.RoomVisibilityPrivate,
+			Members:    []entity.UserID{peerID},
+		})
+		mockDeps.RoomRepo.EXPECT().GetDirectRoom(ctx, userID, peerID).Return(leftRoom, nil)
+		mockDeps.RoomRepo.EXPECT().AddMemberToRoom(ctx, entity.RoomID("dm_1"), userID, entity.RoomRoleMember).Return(nil)
+
+		resp, err := roomUseCase.OpenDirectRoom(ctx, req)
+
+		require.NoError(t, err)
+		assert.False(t, resp.Created)
+	})
+
+	t.Run("4. 同時に作成された場合は先に作成された部屋を返す", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetDirectRoom(ctx, userID, peerID).Return(nil, repository.ErrRoomNotFound)
+		mockDeps.UserRepo.EXPECT().GetUserByID(ctx, peerID).Return(entity.NewUser(entity.UserParams{ID: peerID}), nil)
+		mockDeps.RoomIDFactory.EXPECT().NewRoomID().Return(entity.RoomID("dm_2"), nil)
+		mockDeps.RoomRepo.EXPECT().SaveDirectRoom(ctx, gomock.Any(), userID, peerID).Return(entity.RoomID("dm_1"), nil)
+		mockDeps.RoomRepo.EXPECT().GetDirectRoom(ctx, userID, peerID).Return(directRoom, nil)
+
+		resp, err := roomUseCase.OpenDirectRoom(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, directRoom, resp.Room)
+		assert.False(t, resp.Created)
+	})
+
+	t.Run("5. 自分自身とは開けない", func(t *testing.T) {
+		_, err := roomUseCase.OpenDirectRoom(ctx, roomcase.OpenDirectRoomRequest{UserID: userID, PeerID: userID})
+
+		assert.ErrorIs(t, err, roomcase.ErrDirectRoomWithSelf)
+	})
+
+	t.Run("6. 相手のユーザーが存在しない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetDirectRoom(ctx, userID, peerID).Return(nil, repository.ErrRoomNotFound)
+		mockDeps.UserRepo.EXPECT().GetUserByID(ctx, peerID).Return(nil, repository.ErrUserNotFound)
+
+		_, err := roomUseCase.OpenDirectRoom(ctx, req)
+
+		assert.ErrorIs(t, err, repository.ErrUserNotFound)
+	})
+}
+
+func TestGetDirectRooms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	rooms := []*entity.Room{entity.NewDirectRoom("dm_1", "user_1", "user_2", time.Time{})}
+
+	mockDeps.RoomRepo.EXPECT().GetDirectRooms(ctx, entity.UserID("user_1")).Return(rooms, nil)
+
+	result, err := roomUseCase.GetDirectRooms(ctx, roomcase.GetDirectRoomsRequest{UserID: "user_1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, rooms, result)
+}
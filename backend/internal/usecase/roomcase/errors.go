@@ -0,0 +1,61 @@
+package roomcase
+
+import "errors"
+
+var (
+	// ErrOwnerCannotLeave はオーナーが部屋から退出しようとした場合に返されます。
+	// オーナーがいない部屋を作らないため、オーナーは退出ではなく部屋の削除を行います。
+	ErrOwnerCannotLeave = errors.New("room owner cannot leave the room")
+
+	// ErrInvalidVisibility は部屋の公開設定が public / private 以外の場合に返されます。
+	ErrInvalidVisibility = errors.New("invalid room visibility")
+
+	// ErrInvalidInvite は招待の有効期間・利用回数の上限が正の値でない場合に返されます。
+	ErrInvalidInvite = errors.New("invite must have a positive lifetime and max uses")
+
+	// ErrInviteExpired は有効期限が切れた招待を利用しようとした場合に返されます。
+	ErrInviteExpired = errors.New("invite has expired")
+
+	// ErrInviteExhausted は利用回数の上限に達した招待を利用しようとした場合に返されます。
+	ErrInviteExhausted = errors.New("invite has reached its max uses")
+
+	// ErrDirectRoomWithSelf は自分自身とのダイレクトメッセージを開こうとした場合に返されます。
+	ErrDirectRoomWithSelf = errors.New("cannot open a direct message with yourself")
+
+	// ErrInvalidRoomName は部屋名が空の場合に返されます。
+	ErrInvalidRoomName = errors.New("room name must not be empty")
+
+	// ErrDescriptionTooLong は部屋の説明が MaxRoomDescriptionLength 文字を超える場合に返されます。
+	ErrDescriptionTooLong = errors.New("room description is too long")
+
+	// ErrInvalidPinLimit はピン留めの上限が 1 から MaxRoomPinLimit の範囲外の場合に返されます。
+	ErrInvalidPinLimit = errors.New("invalid pin limit")
+
+	// ErrInvalidSlowMode はスローモードの間隔が 0 から MaxSlowModeInterval の範囲外、または秒単位でない場合に返されます。
+	ErrInvalidSlowMode = errors.New("invalid slow mode interval")
+
+	// ErrInvalidSort は部屋の一覧の並び順が定義済みのもの以外の場合に返されます。
+	ErrInvalidSort = errors.New("invalid room sort")
+
+	// ErrInvalidCursor は一覧のカーソルが不正な場合、または並び順と一致しない場合に返されます。
+	ErrInvalidCursor = errors.New("invalid cursor")
+
+	// ErrCannotModerateSelf は自分自身をキック・追放・発言禁止しようとした場合に返されます。
+	ErrCannotModerateSelf = errors.New("cannot moderate yourself")
+
+	// ErrModerationReasonTooLong はモデレーションの理由が MaxModerationReasonLength 文字を超える場合に返されます。
+	ErrModerationReasonTooLong = errors.New("moderation reason is too long")
+
+	// ErrInvalidMuteDuration は発言禁止の期間が正の値でない場合、または MaxMuteDuration を超える場合に返されます。
+	ErrInvalidMuteDuration = errors.New("invalid mute duration")
+
+	// ErrAlreadyRoomMember はすでにメンバーであるユーザーが参加をリクエストした場合に返されます。
+	ErrAlreadyRoomMember = errors.New("user is already a member of the room")
+
+	// ErrJoinRequestNotRequired は公開の部屋に参加をリクエストした場合に返されます。
+	// 公開の部屋にはリクエストせずに参加できます。
+	ErrJoinRequestNotRequired = errors.New("join request is not required for public rooms")
+
+	// ErrJoinRequestMessageTooLong は参加リクエストのメッセージが MaxJoinRequestMessageLength 文字を超える場合に返されます。
+	ErrJoinRequestMessageTooLong = errors.New("join request message is too long")
+)
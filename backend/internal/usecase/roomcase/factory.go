@@ -2,15 +2,33 @@ package roomcase
 
 import (
 	"errors"
+	"time"
 
 	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/interface/adapter"
 	"example.com/infrahandson/internal/interface/factory"
 )
 
 type NewRoomUseCaseParams struct {
-	RoomRepo      repository.RoomRepository
-	UserRepo      repository.UserRepository
-	RoomIDFactory factory.RoomIDFactory
+	RoomRepo           repository.RoomRepository
+	UserRepo           repository.UserRepository
+	InviteRepo         repository.RoomInviteRepository
+	ModerationRepo     repository.RoomModerationRepository
+	JoinRequestRepo    repository.RoomJoinRequestRepository
+	RoomIDFactory      factory.RoomIDFactory
+	InviteTokenFactory factory.InviteTokenFactory
+	IconSvc            service.IconStoreService
+	// MsgCache と WsManager は部屋の削除時にキャッシュの破棄とコネクションの切断に使用する
+	MsgCache  service.MessageCacheService
+	WsManager service.WebsocketManager
+	// AttachmentRepo と AttachmentStore は部屋の削除時に添付ファイルを削除するために使用する
+	AttachmentRepo  repository.AttachmentRepository
+	AttachmentStore service.AttachmentStoreService
+	// Logger はコミット後の通知の失敗など、呼び出し元に返さないエラーの記録に使用する
+	Logger adapter.LoggerAdapter
+	// AwayTimeout はメンバーの一覧で、操作がない場合に離席中として扱うまでの時間
+	AwayTimeout time.Duration
 }
 
 func (p NewRoomUseCaseParams) Validate() error {
@@ -20,9 +38,42 @@ func (p NewRoomUseCaseParams) Validate() error {
 	if p.UserRepo == nil {
 		return errors.New("UserRepo is required")
 	}
+	if p.InviteRepo == nil {
+		return errors.New("InviteRepo is required")
+	}
+	if p.ModerationRepo == nil {
+		return errors.New("ModerationRepo is required")
+	}
+	if p.JoinRequestRepo == nil {
+		return errors.New("JoinRequestRepo is required")
+	}
 	if p.RoomIDFactory == nil {
 		return errors.New("RoomIDFactory is required")
 	}
+	if p.InviteTokenFactory == nil {
+		return errors.New("InviteTokenFactory is required")
+	}
+	if p.IconSvc == nil {
+		return errors.New("IconSvc is required")
+	}
+	if p.MsgCache == nil {
+		return errors.New("MsgCache is required")
+	}
+	if p.WsManager == nil {
+		return errors.New("WsManager is required")
+	}
+	if p.AttachmentRepo == nil {
+		return errors.New("AttachmentRepo is required")
+	}
+	if p.AttachmentStore == nil {
+		return errors.New("AttachmentStore is required")
+	}
+	if p.Logger == nil {
+		return errors.New("Logger is required")
+	}
+	if p.AwayTimeout <= 0 {
+		return errors.New("AwayTimeout must be positive")
+	}
 	return nil
 }
 
@@ -33,8 +84,19 @@ func NewRoomUseCase(p NewRoomUseCaseParams) *RoomUseCase {
 	}
 
 	return &RoomUseCase{
-		roomRepo:      p.RoomRepo,
-		userRepo:      p.UserRepo,
-		roomIDFactory: p.RoomIDFactory,
+		roomRepo:           p.RoomRepo,
+		userRepo:           p.UserRepo,
+		inviteRepo:         p.InviteRepo,
+		moderationRepo:     p.ModerationRepo,
+		joinRequestRepo:    p.JoinRequestRepo,
+		roomIDFactory:      p.RoomIDFactory,
+		inviteTokenFactory: p.InviteTokenFactory,
+		iconSvc:            p.IconSvc,
+		msgCache:           p.MsgCache,
+		wsManager:          p.WsManager,
+		attachmentRepo:     p.AttachmentRepo,
+		attachmentStore:    p.AttachmentStore,
+		logger:             p.Logger,
+		awayTimeout:        p.AwayTimeout,
 	}
 }
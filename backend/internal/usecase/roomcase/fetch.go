@@ -5,11 +5,13 @@ import (
 	"errors"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 )
 
 // GetRoomByIDParams構造体: 公開IDで部屋を取得するためのパラメータ
 type GetRoomByIDRequest struct {
-	ID entity.RoomID `json:"id"`
+	ID     entity.RoomID `json:"id"`
+	UserID entity.UserID `json:"user_id"` // 取得するユーザー（非公開の部屋はメンバーのみ取得できる）
 }
 
 // GetRoomByIDResponse構造体: 公開IDで部屋を取得した結果
@@ -18,20 +20,47 @@ type GetRoomByIDResponse struct {
 }
 
 // GetRoomByID: 公開IDを使用して部屋を取得
+// 非公開の部屋はメンバー以外には存在しないものとして扱う
 func (r *RoomUseCase) GetRoomByID(ctx context.Context, req GetRoomByIDRequest) (GetRoomByIDResponse, error) {
-	room, err := r.roomRepo.GetRoomByID(ctx, req.ID)
+	room, err := r.getVisibleRoom(ctx, req.ID, req.UserID)
 	if err != nil {
 		return GetRoomByIDResponse{}, err
 	}
+	return GetRoomByIDResponse{Room: room}, nil
+}
+
+// getVisibleRoom はユーザーから見える部屋を取得します。
+// 非公開の部屋にユーザーが参加していない場合は repository.ErrRoomNotFound を返します。
+func (r *RoomUseCase) getVisibleRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (*entity.Room, error) {
+	room, err := r.roomRepo.GetRoomByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
 	if room == nil {
-		return GetRoomByIDResponse{}, errors.New("room not found")
+		return nil, errors.New("room not found")
 	}
-	return GetRoomByIDResponse{Room: room}, nil
+	if !room.IsPrivate() {
+		return room, nil
+	}
+
+	_, err = r.roomRepo.GetMemberRole(ctx, roomID, userID)
+	if errors.Is(err, repository.ErrNotRoomMember) {
+		return nil, repository.ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+// GetAllRoomsRequest構造体: 部屋の一覧を取得するリクエスト
+type GetAllRoomsRequest struct {
+	UserID entity.UserID `json:"user_id"` // 取得するユーザー（非公開の部屋は参加しているもののみ含める）
 }
 
-// GetAllRooms: 全ての部屋を取得
-func (r *RoomUseCase) GetAllRooms(ctx context.Context) ([]*entity.Room, error) {
-	rooms, err := r.roomRepo.GetAllRooms(ctx)
+// GetAllRooms: 公開されている部屋と、参加している非公開の部屋を取得
+func (r *RoomUseCase) GetAllRooms(ctx context.Context, req GetAllRoomsRequest) ([]*entity.Room, error) {
+	rooms, err := r.roomRepo.GetAllRooms(ctx, req.UserID)
 	if err != nil {
 		return nil, err
 	}
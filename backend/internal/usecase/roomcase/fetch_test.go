@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/usecase/roomcase"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -15,6 +16,8 @@ import (
 // 1. 正常系
 // 2. GetRoomByID（Repo）エラー
 // 3. GetRoomByID（Repo）の返答がnil
+// 4. 非公開の部屋（メンバー）
+// 5. 非公開の部屋（メンバー以外）
 
 func TestGetRoomByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -60,6 +63,34 @@ func TestGetRoomByID(t *testing.T) {
 		assert.Nil(t, resp.Room)
 		assert.Equal(t, "room not found", err.Error())
 	})
+
+	privateRoom := entity.NewRoom(entity.RoomParams{
+		ID:         "private_room_1",
+		Name:       "Private Room",
+		Visibility: entity.RoomVisibilityPrivate,
+	})
+
+	t.Run("4.非公開の部屋（メンバー）", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(context.Background(), privateRoom.GetID()).Return(privateRoom, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(context.Background(), privateRoom.GetID(), entity.UserID("user_1")).
+			Return(entity.RoomRoleMember, nil)
+
+		resp, err := roomUseCase.GetRoomByID(context.Background(), roomcase.GetRoomByIDRequest{ID: privateRoom.GetID(), UserID: "user_1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, privateRoom, resp.Room)
+	})
+
+	t.Run("5.非公開の部屋（メンバー以外）", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(context.Background(), privateRoom.GetID()).Return(privateRoom, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(context.Background(), privateRoom.GetID(), entity.UserID("user_2")).
+			Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		resp, err := roomUseCase.GetRoomByID(context.Background(), roomcase.GetRoomByIDRequest{ID: privateRoom.GetID(), UserID: "user_2"})
+
+		assert.ErrorIs(t, err, repository.ErrRoomNotFound)
+		assert.Nil(t, resp.Room)
+	})
 }
 
 // 1. 正常系
@@ -77,9 +108,9 @@ func TestGetAllRooms(t *testing.T) {
 			entity.NewRoom(entity.RoomParams{ID: "room2", Name: "Room2"}),
 		}
 
-		mockDeps.RoomRepo.EXPECT().GetAllRooms(context.Background()).Return(rooms, nil)
+		mockDeps.RoomRepo.EXPECT().GetAllRooms(context.Background(), entity.UserID("user_1")).Return(rooms, nil)
 
-		result, err := roomUseCase.GetAllRooms(context.Background())
+		result, err := roomUseCase.GetAllRooms(context.Background(), roomcase.GetAllRoomsRequest{UserID: "user_1"})
 
 		assert.NoError(t, err)
 		assert.Equal(t, rooms, result)
@@ -88,9 +119,9 @@ func TestGetAllRooms(t *testing.T) {
 	t.Run("2.GetAllRooms（Repo）エラー", func(t *testing.T) {
 		expectedErr := errors.New("failed to fetch rooms")
 
-		mockDeps.RoomRepo.EXPECT().GetAllRooms(context.Background()).Return(nil, expectedErr)
+		mockDeps.RoomRepo.EXPECT().GetAllRooms(context.Background(), entity.UserID("user_1")).Return(nil, expectedErr)
 
-		result, err := roomUseCase.GetAllRooms(context.Background())
+		result, err := roomUseCase.GetAllRooms(context.Background(), roomcase.GetAllRoomsRequest{UserID: "user_1"})
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
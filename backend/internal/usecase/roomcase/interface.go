@@ -14,11 +14,32 @@ type RoomUseCaseInterface interface {
 	// DeleteRoom は部屋を削除する(delete.go)
 	DeleteRoom(ctx context.Context, req DeleteRoomRequest) error
 
+	// UpdateRoomName は部屋名を更新する(update.go)
+	UpdateRoomName(ctx context.Context, req UpdateRoomNameRequest) error
+
+	// UpdateRoomSettings は部屋名・説明・アイコンを更新する(settings.go)
+	UpdateRoomSettings(ctx context.Context, req UpdateRoomSettingsRequest) error
+
+	// ArchiveRoom は部屋をアーカイブする(settings.go)
+	ArchiveRoom(ctx context.Context, req ArchiveRoomRequest) error
+
+	// UnarchiveRoom は部屋のアーカイブを解除する(settings.go)
+	UnarchiveRoom(ctx context.Context, req ArchiveRoomRequest) error
+
+	// GetRoomIconPath は部屋のアイコンのパスを取得する(settings.go)
+	GetRoomIconPath(ctx context.Context, req GetRoomIconPathRequest) (string, error)
+
 	// GetRoomByID は公開IDを使用して部屋を取得する(get.go)
 	GetRoomByID(ctx context.Context, params GetRoomByIDRequest) (GetRoomByIDResponse, error)
 
-	// GetAllRooms は全ての部屋を取得する(get.go)
-	GetAllRooms(ctx context.Context) ([]*entity.Room, error)
+	// GetAllRooms は公開されている部屋と参加している非公開の部屋を取得する(get.go)
+	GetAllRooms(ctx context.Context, req GetAllRoomsRequest) ([]*entity.Room, error)
+
+	// ListRooms は部屋の一覧をメンバー数と最新のメッセージ付きでページ単位で取得する(list.go)
+	ListRooms(ctx context.Context, req ListRoomsRequest) (ListRoomsResponse, error)
+
+	// ListRoomMembers は部屋のメンバーを役割と接続状態付きでページ単位で取得する(members.go)
+	ListRoomMembers(ctx context.Context, req ListRoomMembersRequest) (ListRoomMembersResponse, error)
 
 	// GetUsersInRoom は部屋内のユーザーを取得する(get.go)
 	GetUsersInRoom(ctx context.Context, req GetUsersInRoomRequest) (GetUsersInRoomResponse, error)
@@ -28,4 +49,43 @@ type RoomUseCaseInterface interface {
 
 	// LeaveRoom は部屋からユーザーを退出させる(membership.go)
 	LeaveRoom(ctx context.Context, req LeaveRoomRequest) error
+
+	// KickMember はユーザーを部屋から退出させ、部屋のコネクションを閉じる(moderation.go)
+	KickMember(ctx context.Context, req ModerateMemberRequest) error
+
+	// BanMember はユーザーを部屋から追放する(moderation.go)
+	BanMember(ctx context.Context, req ModerateMemberRequest) error
+
+	// UnbanMember は追放を解除する(moderation.go)
+	UnbanMember(ctx context.Context, req ModerateMemberRequest) error
+
+	// MuteMember は期間を指定してメンバーの発言を禁止する(moderation.go)
+	MuteMember(ctx context.Context, req ModerateMemberRequest) error
+
+	// UnmuteMember は発言の禁止を解除する(moderation.go)
+	UnmuteMember(ctx context.Context, req ModerateMemberRequest) error
+
+	// RequestToJoin は非公開の部屋への参加をリクエストする(joinrequest.go)
+	RequestToJoin(ctx context.Context, req RequestToJoinRequest) (*entity.RoomJoinRequest, error)
+
+	// ListJoinRequests は部屋の判断待ちの参加リクエストを取得する(joinrequest.go)
+	ListJoinRequests(ctx context.Context, req ListJoinRequestsRequest) ([]*entity.RoomJoinRequest, error)
+
+	// ApproveJoinRequest は参加リクエストを承認し、リクエストしたユーザーをメンバーに追加する(joinrequest.go)
+	ApproveJoinRequest(ctx context.Context, req DecideJoinRequestRequest) error
+
+	// DenyJoinRequest は参加リクエストを却下する(joinrequest.go)
+	DenyJoinRequest(ctx context.Context, req DecideJoinRequestRequest) error
+
+	// CreateInvite は部屋への招待リンクを作成する(invite.go)
+	CreateInvite(ctx context.Context, req CreateInviteRequest) (CreateInviteResponse, error)
+
+	// RedeemInvite は招待リンクを使用して部屋に参加する(invite.go)
+	RedeemInvite(ctx context.Context, req RedeemInviteRequest) (RedeemInviteResponse, error)
+
+	// OpenDirectRoom は相手とのダイレクトメッセージの部屋を取得または作成する(direct.go)
+	OpenDirectRoom(ctx context.Context, req OpenDirectRoomRequest) (OpenDirectRoomResponse, error)
+
+	// GetDirectRooms は参加しているダイレクトメッセージの部屋を取得する(direct.go)
+	GetDirectRooms(ctx context.Context, req GetDirectRoomsRequest) ([]*entity.Room, error)
 }
@@ -0,0 +1,105 @@
+package roomcase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// CreateInviteRequest構造体: 招待リンクを作成するリクエスト
+type CreateInviteRequest struct {
+	RoomID    entity.RoomID `json:"room_id"`    // 招待する部屋
+	UserID    entity.UserID `json:"user_id"`    // 招待を作成するユーザー
+	MaxUses   int           `json:"max_uses"`   // 利用できる回数の上限
+	ExpiresIn time.Duration `json:"expires_in"` // 作成してから有効な期間
+}
+
+// CreateInviteResponse構造体: 招待リンク作成の結果
+type CreateInviteResponse struct {
+	Invite *entity.RoomInvite `json:"invite"`
+}
+
+// CreateInvite: 部屋への招待リンクを作成（オーナーのみ）
+func (r *RoomUseCase) CreateInvite(ctx context.Context, req CreateInviteRequest) (CreateInviteResponse, error) {
+	if req.MaxUses < 1 || req.ExpiresIn <= 0 {
+		return CreateInviteResponse{}, ErrInvalidInvite
+	}
+	if _, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleOwner); err != nil {
+		return CreateInviteResponse{}, err
+	}
+
+	token, err := r.inviteTokenFactory.NewInviteToken()
+	if err != nil {
+		return CreateInviteResponse{}, err
+	}
+
+	now := time.Now()
+	invite := entity.NewRoomInvite(entity.RoomInviteParams{
+		Token:     token,
+		RoomID:    req.RoomID,
+		CreatedBy: req.UserID,
+		MaxUses:   req.MaxUses,
+		ExpiresAt: now.Add(req.ExpiresIn),
+		CreatedAt: now,
+	})
+	if err := r.inviteRepo.SaveInvite(ctx, invite); err != nil {
+		return CreateInviteResponse{}, err
+	}
+
+	return CreateInviteResponse{Invite: invite}, nil
+}
+
+// RedeemInviteRequest構造体: 招待リンクを使用するリクエスト
+type RedeemInviteRequest struct {
+	Token  entity.InviteToken `json:"token"`
+	UserID entity.UserID      `json:"user_id"` // 参加するユーザー
+}
+
+// RedeemInviteResponse構造体: 招待リンクを使用した結果
+type RedeemInviteResponse struct {
+	RoomID entity.RoomID `json:"room_id"` // 参加した部屋
+}
+
+// RedeemInvite: 招待リンクを使用して部屋に一般メンバーとして参加
+// 公開・非公開に関わらず参加でき、すでにメンバーの場合は利用回数を消費せずに成功とする
+func (r *RoomUseCase) RedeemInvite(ctx context.Context, req RedeemInviteRequest) (RedeemInviteResponse, error) {
+	invite, err := r.inviteRepo.GetInviteByToken(ctx, req.Token)
+	if err != nil {
+		return RedeemInviteResponse{}, err
+	}
+	roomID := invite.GetRoomID()
+
+	_, err = r.roomRepo.GetMemberRole(ctx, roomID, req.UserID)
+	if err == nil {
+		return RedeemInviteResponse{RoomID: roomID}, nil
+	}
+	if !errors.Is(err, repository.ErrNotRoomMember) {
+		return RedeemInviteResponse{}, err
+	}
+	if err := r.checkNotBanned(ctx, roomID, req.UserID); err != nil {
+		return RedeemInviteResponse{}, err
+	}
+
+	now := time.Now()
+	if invite.IsExpired(now) {
+		return RedeemInviteResponse{}, ErrInviteExpired
+	}
+	if invite.IsExhausted() {
+		return RedeemInviteResponse{}, ErrInviteExhausted
+	}
+
+	// 利用回数の加算と参加は同時に行い、参加できなかった場合に利用回数だけが減らないようにする
+	// 取得してから利用するまでの間に他のユーザーが上限まで利用した場合もここで弾かれる
+	err = r.inviteRepo.RedeemInvite(ctx, req.Token, req.UserID, now)
+	if errors.Is(err, repository.ErrInviteUnavailable) {
+		return RedeemInviteResponse{}, ErrInviteExhausted
+	}
+	if err != nil {
+		return RedeemInviteResponse{}, err
+	}
+
+	return RedeemInviteResponse{RoomID: roomID}, nil
+}
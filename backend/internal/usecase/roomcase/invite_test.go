@@ -0,0 +1,169 @@
+package roomcase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系
+// 2. 有効期間・利用回数が不正
+// 3. オーナー以外は作成できない
+func TestCreateInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	userID := entity.UserID("owner")
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleOwner, nil)
+		mockDeps.InviteTokenFactory.EXPECT().NewInviteToken().Return(entity.InviteToken("token1"), nil)
+		mockDeps.InviteRepo.EXPECT().SaveInvite(ctx, gomock.Any()).Return(nil)
+
+		before := time.Now()
+		resp, err := roomUseCase.CreateInvite(ctx, roomcase.CreateInviteRequest{
+			RoomID:    roomID,
+			UserID:    userID,
+			MaxUses:   5,
+			ExpiresIn: time.Hour,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.InviteToken("token1"), resp.Invite.GetToken())
+		assert.Equal(t, roomID, resp.Invite.GetRoomID())
+		assert.Equal(t, 5, resp.Invite.GetMaxUses())
+		assert.False(t, resp.Invite.GetExpiresAt().Before(before.Add(time.Hour)))
+	})
+
+	t.Run("2. 有効期間・利用回数が不正", func(t *testing.T) {
+		for _, req := range []roomcase.CreateInviteRequest{
+			{RoomID: roomID, UserID: userID, MaxUses: 0, ExpiresIn: time.Hour},
+			{RoomID: roomID, UserID: userID, MaxUses: 1, ExpiresIn: 0},
+		} {
+			_, err := roomUseCase.CreateInvite(ctx, req)
+			assert.ErrorIs(t, err, roomcase.ErrInvalidInvite)
+		}
+	})
+
+	t.Run("3. オーナー以外は作成できない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, entity.UserID("admin")).Return(entity.RoomRoleAdmin, nil)
+
+		_, err := roomUseCase.CreateInvite(ctx, roomcase.CreateInviteRequest{
+			RoomID:    roomID,
+			UserID:    "admin",
+			MaxUses:   1,
+			ExpiresIn: time.Hour,
+		})
+
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
+}
+
+// 1. 正常系
+// 2. すでにメンバーの場合は利用回数を消費しない
+// 3. 招待が存在しない
+// 4. 期限切れ
+// 5. 利用回数の上限に達している
+// 6. 同時に利用され上限に達した
+// 7. BANされている
+func TestRedeemInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	userID := entity.UserID("guest")
+	newInvite := func(uses int, expiresAt time.Time) *entity.RoomInvite {
+		return entity.NewRoomInvite(entity.RoomInviteParams{
+			Token:     "token1",
+			RoomID:    roomID,
+			CreatedBy: "owner",
+			MaxUses:   2,
+			Uses:      uses,
+			ExpiresAt: expiresAt,
+		})
+	}
+	req := roomcase.RedeemInviteRequest{Token: "token1", UserID: userID}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.InviteRepo.EXPECT().GetInviteByToken(ctx, req.Token).Return(newInvite(1, time.Now().Add(time.Hour)), nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, userID).Return(false, nil)
+		mockDeps.InviteRepo.EXPECT().RedeemInvite(ctx, req.Token, userID, gomock.Any()).Return(nil)
+
+		resp, err := roomUseCase.RedeemInvite(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, roomID, resp.RoomID)
+	})
+
+	t.Run("2. すでにメンバーの場合は利用回数を消費しない", func(t *testing.T) {
+		mockDeps.InviteRepo.EXPECT().GetInviteByToken(ctx, req.Token).Return(newInvite(2, time.Now().Add(-time.Hour)), nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleMember, nil)
+
+		resp, err := roomUseCase.RedeemInvite(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, roomID, resp.RoomID)
+	})
+
+	t.Run("3. 招待が存在しない", func(t *testing.T) {
+		mockDeps.InviteRepo.EXPECT().GetInviteByToken(ctx, req.Token).Return(nil, repository.ErrInviteNotFound)
+
+		_, err := roomUseCase.RedeemInvite(ctx, req)
+
+		assert.ErrorIs(t, err, repository.ErrInviteNotFound)
+	})
+
+	t.Run("4. 期限切れ", func(t *testing.T) {
+		mockDeps.InviteRepo.EXPECT().GetInviteByToken(ctx, req.Token).Return(newInvite(0, time.Now().Add(-time.Second)), nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, userID).Return(false, nil)
+
+		_, err := roomUseCase.RedeemInvite(ctx, req)
+
+		assert.ErrorIs(t, err, roomcase.ErrInviteExpired)
+	})
+
+	t.Run("5. 利用回数の上限に達している", func(t *testing.T) {
+		mockDeps.InviteRepo.EXPECT().GetInviteByToken(ctx, req.Token).Return(newInvite(2, time.Now().Add(time.Hour)), nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, userID).Return(false, nil)
+
+		_, err := roomUseCase.RedeemInvite(ctx, req)
+
+		assert.ErrorIs(t, err, roomcase.ErrInviteExhausted)
+	})
+
+	t.Run("6. 同時に利用され上限に達した", func(t *testing.T) {
+		mockDeps.InviteRepo.EXPECT().GetInviteByToken(ctx, req.Token).Return(newInvite(1, time.Now().Add(time.Hour)), nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, userID).Return(false, nil)
+		mockDeps.InviteRepo.EXPECT().RedeemInvite(ctx, req.Token, userID, gomock.Any()).Return(repository.ErrInviteUnavailable)
+
+		_, err := roomUseCase.RedeemInvite(ctx, req)
+
+		assert.ErrorIs(t, err, roomcase.ErrInviteExhausted)
+	})
+
+	t.Run("7. BANされている場合は利用回数を消費しない", func(t *testing.T) {
+		mockDeps.InviteRepo.EXPECT().GetInviteByToken(ctx, req.Token).Return(newInvite(1, time.Now().Add(time.Hour)), nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, userID).Return(true, nil)
+
+		_, err := roomUseCase.RedeemInvite(ctx, req)
+
+		assert.ErrorIs(t, err, entity.ErrRoomBanned)
+	})
+}
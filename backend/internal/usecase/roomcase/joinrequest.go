@@ -0,0 +1,141 @@
+package roomcase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// MaxJoinRequestMessageLength は参加リクエストのメッセージの最大文字数
+const MaxJoinRequestMessageLength = 500
+
+// RequestToJoinRequest構造体: 非公開の部屋への参加をリクエストするリクエスト
+type RequestToJoinRequest struct {
+	RoomID  entity.RoomID `json:"room_id"` // 部屋の公開ID
+	UserID  entity.UserID `json:"user_id"` // 参加をリクエストするユーザー
+	Message string        `json:"message"` // 管理者へのメッセージ（任意）
+}
+
+// RequestToJoin: 非公開の部屋への参加をリクエストする
+// 公開の部屋には JoinRoom で参加できるため、リクエストは受け付けない
+// 却下された後は再度リクエストできる
+func (r *RoomUseCase) RequestToJoin(ctx context.Context, req RequestToJoinRequest) (*entity.RoomJoinRequest, error) {
+	if utf8.RuneCountInString(req.Message) > MaxJoinRequestMessageLength {
+		return nil, ErrJoinRequestMessageTooLong
+	}
+
+	_, err := r.roomRepo.GetMemberRole(ctx, req.RoomID, req.UserID)
+	if err == nil {
+		return nil, ErrAlreadyRoomMember
+	}
+	if !errors.Is(err, repository.ErrNotRoomMember) {
+		return nil, err
+	}
+
+	room, err := r.roomRepo.GetRoomByID(ctx, req.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.IsDirect() {
+		// ダイレクトメッセージの部屋には参加できない
+		return nil, repository.ErrRoomNotFound
+	}
+	if !room.IsPrivate() {
+		return nil, ErrJoinRequestNotRequired
+	}
+	if err := r.checkNotBanned(ctx, req.RoomID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	joinRequest := entity.NewRoomJoinRequest(entity.RoomJoinRequestParams{
+		RoomID:    req.RoomID,
+		UserID:    req.UserID,
+		Message:   req.Message,
+		Status:    entity.RoomJoinRequestPending,
+		CreatedAt: time.Now(),
+	})
+	if err := r.joinRequestRepo.SaveJoinRequest(ctx, joinRequest); err != nil {
+		return nil, err
+	}
+
+	return joinRequest, nil
+}
+
+// ListJoinRequestsRequest構造体: 部屋の参加リクエストを取得するリクエスト
+type ListJoinRequestsRequest struct {
+	RoomID entity.RoomID `json:"room_id"` // 部屋の公開ID
+	UserID entity.UserID `json:"user_id"` // 取得するユーザー（管理者以上）
+}
+
+// ListJoinRequests: 部屋の判断待ちの参加リクエストを、リクエストした日時の古い順に取得する（管理者以上）
+func (r *RoomUseCase) ListJoinRequests(ctx context.Context, req ListJoinRequestsRequest) ([]*entity.RoomJoinRequest, error) {
+	if _, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return r.joinRequestRepo.ListPendingJoinRequests(ctx, req.RoomID)
+}
+
+// DecideJoinRequestRequest構造体: 参加リクエストを承認・却下するリクエスト
+type DecideJoinRequestRequest struct {
+	RoomID      entity.RoomID `json:"room_id"`      // 部屋の公開ID
+	UserID      entity.UserID `json:"user_id"`      // 操作を行うユーザー（管理者以上）
+	RequesterID entity.UserID `json:"requester_id"` // 参加をリクエストしたユーザー
+}
+
+// ApproveJoinRequest: 参加リクエストを承認し、リクエストしたユーザーを一般メンバーとして追加する（管理者以上）
+// リクエストした後に追放されたユーザーは承認できない
+// 承認したことはリクエストしたユーザーのコネクションに通知する
+func (r *RoomUseCase) ApproveJoinRequest(ctx context.Context, req DecideJoinRequestRequest) error {
+	if _, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleAdmin); err != nil {
+		return err
+	}
+	if err := r.checkNotBanned(ctx, req.RoomID, req.RequesterID); err != nil {
+		return err
+	}
+
+	// 判断待ちのリクエストでなくなった時点で、同時に承認・却下された場合もここで弾かれる
+	err := r.joinRequestRepo.DecideJoinRequest(ctx, req.RoomID, req.RequesterID, entity.RoomJoinRequestApproved, req.UserID, time.Now())
+	if err != nil {
+		return err
+	}
+	// 追加に失敗した場合は、再度承認できるようにリクエストを判断待ちに戻す
+	if err := r.roomRepo.AddMemberToRoom(ctx, req.RoomID, req.RequesterID, entity.RoomRoleMember); err != nil {
+		if reopenErr := r.joinRequestRepo.ReopenJoinRequest(ctx, req.RoomID, req.RequesterID); reopenErr != nil {
+			return errors.Join(err, reopenErr)
+		}
+		return err
+	}
+
+	r.notifyJoinRequestDecided(ctx, req, entity.RoomJoinRequestApproved)
+	return nil
+}
+
+// DenyJoinRequest: 参加リクエストを却下する（管理者以上）
+// 却下したことはリクエストしたユーザーのコネクションに通知する
+func (r *RoomUseCase) DenyJoinRequest(ctx context.Context, req DecideJoinRequestRequest) error {
+	if _, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleAdmin); err != nil {
+		return err
+	}
+
+	err := r.joinRequestRepo.DecideJoinRequest(ctx, req.RoomID, req.RequesterID, entity.RoomJoinRequestDenied, req.UserID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	r.notifyJoinRequestDecided(ctx, req, entity.RoomJoinRequestDenied)
+	return nil
+}
+
+// notifyJoinRequestDecided: 判断結果をリクエストしたユーザーのコネクションに通知する
+// 判断はすでに反映されているため、通知に失敗しても記録するだけにする
+func (r *RoomUseCase) notifyJoinRequestDecided(ctx context.Context, req DecideJoinRequestRequest, status entity.RoomJoinRequestStatus) {
+	event := entity.NewJoinRequestDecidedEvent(req.RoomID, status, req.UserID)
+	if err := r.wsManager.SendToUser(ctx, req.RequesterID, event); err != nil {
+		r.logger.Error("Failed to notify join request decision", "error", err)
+	}
+}
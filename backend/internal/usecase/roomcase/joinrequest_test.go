@@ -0,0 +1,283 @@
+package roomcase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系：判断待ちのリクエストを保存する
+// 2. すでにメンバー
+// 3. 公開の部屋にはリクエストできない
+// 4. ダイレクトメッセージの部屋は存在しない部屋として扱う
+// 5. 追放されている
+// 6. 判断待ちのリクエストがすでにある
+// 7. メッセージが長すぎる
+func TestRequestToJoin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	req := roomcase.RequestToJoinRequest{RoomID: roomID, UserID: "user", Message: "let me in"}
+	privateRoom := entity.NewRoom(entity.RoomParams{ID: roomID, Visibility: entity.RoomVisibilityPrivate})
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(privateRoom, nil)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, req.UserID).Return(false, nil)
+		mockDeps.JoinRequestRepo.EXPECT().SaveJoinRequest(ctx, gomock.Any()).Return(nil)
+
+		joinRequest, err := roomUseCase.RequestToJoin(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, roomID, joinRequest.GetRoomID())
+		assert.Equal(t, req.UserID, joinRequest.GetUserID())
+		assert.Equal(t, "let me in", joinRequest.GetMessage())
+		assert.True(t, joinRequest.IsPending())
+		assert.False(t, joinRequest.GetCreatedAt().IsZero())
+	})
+
+	t.Run("2. すでにメンバー", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		_, err := roomUseCase.RequestToJoin(ctx, req)
+
+		assert.ErrorIs(t, err, roomcase.ErrAlreadyRoomMember)
+	})
+
+	t.Run("3. 公開の部屋", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+
+		_, err := roomUseCase.RequestToJoin(ctx, req)
+
+		assert.ErrorIs(t, err, roomcase.ErrJoinRequestNotRequired)
+	})
+
+	t.Run("4. ダイレクトメッセージの部屋", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{
+			ID:         roomID,
+			Kind:       entity.RoomKindDirect,
+			Visibility: entity.RoomVisibilityPrivate,
+		}), nil)
+
+		_, err := roomUseCase.RequestToJoin(ctx, req)
+
+		assert.ErrorIs(t, err, repository.ErrRoomNotFound)
+	})
+
+	t.Run("5. 追放されている", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(privateRoom, nil)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, req.UserID).Return(true, nil)
+
+		_, err := roomUseCase.RequestToJoin(ctx, req)
+
+		assert.ErrorIs(t, err, entity.ErrRoomBanned)
+	})
+
+	t.Run("6. 判断待ちのリクエストがすでにある", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(privateRoom, nil)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, req.UserID).Return(false, nil)
+		mockDeps.JoinRequestRepo.EXPECT().SaveJoinRequest(ctx, gomock.Any()).Return(repository.ErrJoinRequestAlreadyExists)
+
+		_, err := roomUseCase.RequestToJoin(ctx, req)
+
+		assert.ErrorIs(t, err, repository.ErrJoinRequestAlreadyExists)
+	})
+
+	t.Run("7. メッセージが長すぎる", func(t *testing.T) {
+		_, err := roomUseCase.RequestToJoin(ctx, roomcase.RequestToJoinRequest{
+			RoomID:  roomID,
+			UserID:  "user",
+			Message: strings.Repeat("あ", roomcase.MaxJoinRequestMessageLength+1),
+		})
+
+		assert.ErrorIs(t, err, roomcase.ErrJoinRequestMessageTooLong)
+	})
+}
+
+// 1. 正常系
+// 2. 一般メンバーは取得できない
+func TestListJoinRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	req := roomcase.ListJoinRequestsRequest{RoomID: roomID, UserID: "admin"}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		requests := []*entity.RoomJoinRequest{
+			entity.NewRoomJoinRequest(entity.RoomJoinRequestParams{RoomID: roomID, UserID: "user"}),
+		}
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.JoinRequestRepo.EXPECT().ListPendingJoinRequests(ctx, roomID).Return(requests, nil)
+
+		got, err := roomUseCase.ListJoinRequests(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, requests, got)
+	})
+
+	t.Run("2. 一般メンバーは取得できない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		_, err := roomUseCase.ListJoinRequests(ctx, req)
+
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
+}
+
+// 1. 正常系：承認してメンバーに追加し、リクエストしたユーザーに通知する
+// 2. 一般メンバーは承認できない
+// 3. リクエストした後に追放された
+// 4. 判断待ちのリクエストがない
+// 5. 却下の正常系：リクエストしたユーザーに通知する
+// 6. 却下で判断待ちのリクエストがない
+func TestDecideJoinRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	req := roomcase.DecideJoinRequestRequest{RoomID: roomID, UserID: "admin", RequesterID: "user"}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, req.RequesterID).Return(false, nil)
+		mockDeps.JoinRequestRepo.EXPECT().
+			DecideJoinRequest(ctx, roomID, req.RequesterID, entity.RoomJoinRequestApproved, req.UserID, gomock.Any()).
+			Return(nil)
+		mockDeps.RoomRepo.EXPECT().AddMemberToRoom(ctx, roomID, req.RequesterID, entity.RoomRoleMember).Return(nil)
+		mockDeps.WsManager.EXPECT().SendToUser(ctx, req.RequesterID, gomock.Any()).DoAndReturn(func(_ context.Context, _ entity.UserID, event *entity.WebsocketEvent) error {
+			assert.Equal(t, entity.WebsocketEventTypeJoinRequestDecided, event.GetType())
+			assert.Equal(t, entity.JoinRequestDecidedPayload{
+				RoomID:    roomID,
+				Status:    entity.RoomJoinRequestApproved,
+				DecidedBy: req.UserID,
+			}, event.GetPayload())
+			return nil
+		})
+
+		err := roomUseCase.ApproveJoinRequest(ctx, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("2. 一般メンバーは承認できない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		err := roomUseCase.ApproveJoinRequest(ctx, req)
+
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
+
+	t.Run("3. 追放されている", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, req.RequesterID).Return(true, nil)
+
+		err := roomUseCase.ApproveJoinRequest(ctx, req)
+
+		assert.ErrorIs(t, err, entity.ErrRoomBanned)
+	})
+
+	t.Run("4. 判断待ちのリクエストがない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, req.RequesterID).Return(false, nil)
+		mockDeps.JoinRequestRepo.EXPECT().
+			DecideJoinRequest(ctx, roomID, req.RequesterID, entity.RoomJoinRequestApproved, req.UserID, gomock.Any()).
+			Return(repository.ErrJoinRequestNotFound)
+
+		err := roomUseCase.ApproveJoinRequest(ctx, req)
+
+		assert.ErrorIs(t, err, repository.ErrJoinRequestNotFound)
+	})
+
+	t.Run("5. 却下の正常系", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.JoinRequestRepo.EXPECT().
+			DecideJoinRequest(ctx, roomID, req.RequesterID, entity.RoomJoinRequestDenied, req.UserID, gomock.Any()).
+			Return(nil)
+		mockDeps.WsManager.EXPECT().SendToUser(ctx, req.RequesterID, gomock.Any()).DoAndReturn(func(_ context.Context, _ entity.UserID, event *entity.WebsocketEvent) error {
+			assert.Equal(t, entity.JoinRequestDecidedPayload{
+				RoomID:    roomID,
+				Status:    entity.RoomJoinRequestDenied,
+				DecidedBy: req.UserID,
+			}, event.GetPayload())
+			return nil
+		})
+
+		err := roomUseCase.DenyJoinRequest(ctx, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("6. 却下で判断待ちのリクエストがない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.JoinRequestRepo.EXPECT().
+			DecideJoinRequest(ctx, roomID, req.RequesterID, entity.RoomJoinRequestDenied, req.UserID, gomock.Any()).
+			Return(repository.ErrJoinRequestNotFound)
+
+		err := roomUseCase.DenyJoinRequest(ctx, req)
+
+		assert.ErrorIs(t, err, repository.ErrJoinRequestNotFound)
+	})
+
+	t.Run("7. メンバーへの追加に失敗した場合は判断待ちに戻す", func(t *testing.T) {
+		addErr := errors.New("add member failed")
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, req.RequesterID).Return(false, nil)
+		mockDeps.JoinRequestRepo.EXPECT().
+			DecideJoinRequest(ctx, roomID, req.RequesterID, entity.RoomJoinRequestApproved, req.UserID, gomock.Any()).
+			Return(nil)
+		mockDeps.RoomRepo.EXPECT().AddMemberToRoom(ctx, roomID, req.RequesterID, entity.RoomRoleMember).Return(addErr)
+		mockDeps.JoinRequestRepo.EXPECT().ReopenJoinRequest(ctx, roomID, req.RequesterID).Return(nil)
+
+		err := roomUseCase.ApproveJoinRequest(ctx, req)
+
+		assert.ErrorIs(t, err, addErr)
+	})
+
+	t.Run("8. 通知に失敗しても承認は成功する", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.ModerationRepo.EXPECT().IsBanned(ctx, roomID, req.RequesterID).Return(false, nil)
+		mockDeps.JoinRequestRepo.EXPECT().
+			DecideJoinRequest(ctx, roomID, req.RequesterID, entity.RoomJoinRequestApproved, req.UserID, gomock.Any()).
+			Return(nil)
+		mockDeps.RoomRepo.EXPECT().AddMemberToRoom(ctx, roomID, req.RequesterID, entity.RoomRoleMember).Return(nil)
+		mockDeps.WsManager.EXPECT().SendToUser(ctx, req.RequesterID, gomock.Any()).Return(errors.New("send failed"))
+		mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+
+		err := roomUseCase.ApproveJoinRequest(ctx, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("9. 通知に失敗しても却下は成功する", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.JoinRequestRepo.EXPECT().
+			DecideJoinRequest(ctx, roomID, req.RequesterID, entity.RoomJoinRequestDenied, req.UserID, gomock.Any()).
+			Return(nil)
+		mockDeps.WsManager.EXPECT().SendToUser(ctx, req.RequesterID, gomock.Any()).Return(errors.New("send failed"))
+		mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+
+		err := roomUseCase.DenyJoinRequest(ctx, req)
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,142 @@
+package roomcase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// 部屋の一覧の取得件数
+const (
+	DefaultRoomListLimit = 20  // 指定がない場合
+	MaxRoomListLimit     = 100 // 指定できる上限（超えた場合は上限に丸める）
+)
+
+// ListRoomsRequest構造体: 部屋の一覧をページ単位で取得するリクエスト
+type ListRoomsRequest struct {
+	UserID entity.UserID       // 取得するユーザー（非公開の部屋は参加しているもののみ含める）
+	Query  string              // 部屋名の部分一致（空の場合は絞り込まない）
+	Sort   repository.RoomSort // 空の場合は名前順
+	Limit  int                 // 0 以下の場合は DefaultRoomListLimit
+	Cursor string              // 前のページの NextCursor（空の場合は先頭から）
+}
+
+// ListRoomsResponse構造体: 部屋の一覧の1ページ
+type ListRoomsResponse struct {
+	Rooms      []*entity.RoomSummary
+	NextCursor string // HasNext が false の場合は空
+	HasNext    bool
+}
+
+// roomListCursor は ListRoomsResponse.NextCursor の中身です。
+// 並び順を含め、別の並び順のカーソルを受け付けないようにします。
+type roomListCursor struct {
+	Sort           repository.RoomSort `json:"s"`
+	RoomID         entity.RoomID       `json:"id"`
+	Name           string              `json:"n,omitempty"`
+	CreatedAt      time.Time           `json:"c,omitempty"`
+	MemberCount    int                 `json:"m,omitempty"`
+	LastActivityAt time.Time           `json:"a,omitempty"`
+}
+
+// ListRooms: 公開されている部屋と参加している非公開の部屋を、メンバー数と最新のメッセージ付きで取得
+func (r *RoomUseCase) ListRooms(ctx context.Context, req ListRoomsRequest) (ListRoomsResponse, error) {
+	sort := req.Sort
+	if sort == "" {
+		sort = repository.RoomSortName
+	}
+	if !sort.IsValid() {
+		return ListRoomsResponse{}, ErrInvalidSort
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultRoomListLimit
+	}
+	if limit > MaxRoomListLimit {
+		limit = MaxRoomListLimit
+	}
+
+	var after *repository.RoomListCursor
+	if req.Cursor != "" {
+		c, err := decodeRoomListCursor(req.Cursor, sort)
+		if err != nil {
+			return ListRoomsResponse{}, err
+		}
+		after = c
+	}
+
+	summaries, hasNext, err := r.roomRepo.ListRoomSummaries(ctx, repository.RoomListQuery{
+		ViewerID: req.UserID,
+		NameLike: req.Query,
+		Sort:     sort,
+		Limit:    limit,
+		After:    after,
+	})
+	if err != nil {
+		return ListRoomsResponse{}, err
+	}
+
+	res := ListRoomsResponse{Rooms: summaries, HasNext: hasNext}
+	if hasNext && len(summaries) > 0 {
+		res.NextCursor = encodeRoomListCursor(sort, summaries[len(summaries)-1])
+	}
+	return res, nil
+}
+
+func encodeRoomListCursor(sort repository.RoomSort, last *entity.RoomSummary) string {
+	room := last.GetRoom()
+	c := roomListCursor{Sort: sort, RoomID: room.GetID()}
+	// 並び順の比較に使う値のみを含める
+	switch sort {
+	case repository.RoomSortName:
+		c.Name = room.GetName()
+	case repository.RoomSortCreatedAt:
+		c.CreatedAt = room.GetCreatedAt()
+	case repository.RoomSortMemberCount:
+		c.MemberCount = last.GetMemberCount()
+	case repository.RoomSortLastActivity:
+		c.LastActivityAt = last.GetLastActivityAt()
+	}
+	return encodeCursor(c)
+}
+
+func decodeRoomListCursor(s string, sort repository.RoomSort) (*repository.RoomListCursor, error) {
+	var c roomListCursor
+	if err := decodeCursor(s, &c); err != nil {
+		return nil, err
+	}
+	if c.Sort != sort || c.RoomID == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &repository.RoomListCursor{
+		RoomID:         c.RoomID,
+		Name:           c.Name,
+		CreatedAt:      c.CreatedAt,
+		MemberCount:    c.MemberCount,
+		LastActivityAt: c.LastActivityAt,
+	}, nil
+}
+
+// encodeCursor はカーソルの値をクライアントに渡す文字列にします。
+// クライアントは中身を解釈せず、そのまま次のリクエストに指定します。
+func encodeCursor(v any) string {
+	b, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor は encodeCursor で作成した文字列を v に読み込みます。
+func decodeCursor(s string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package roomcase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 既定の並び順と件数で取得する
+// 2. 続きがある場合は次のカーソルを返し、そのカーソルで続きを取得できる
+// 3. 件数の上限を超える指定は上限に丸める
+// 4. 不正な並び順
+// 5. 不正なカーソル
+// 6. 並び順と一致しないカーソル
+// 7. ListRoomSummaries（Repo）エラー
+
+func TestListRooms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	userID := entity.UserID("user_1")
+
+	newSummary := func(id entity.RoomID, memberCount int, createdAt time.Time) *entity.RoomSummary {
+		return entity.NewRoomSummary(entity.RoomSummaryParams{
+			Room: entity.NewRoom(entity.RoomParams{
+				ID:        id,
+				Name:      "room " + string(id),
+				CreatedAt: createdAt,
+			}),
+			MemberCount: memberCount,
+		})
+	}
+
+	t.Run("1.既定の並び順と件数で取得する", func(t *testing.T) {
+		summaries := []*entity.RoomSummary{newSummary("room_1", 1, time.Now())}
+		mockDeps.RoomRepo.EXPECT().ListRoomSummaries(ctx, repository.RoomListQuery{
+			ViewerID: userID,
+			NameLike: "go",
+			Sort:     repository.RoomSortName,
+			Limit:    roomcase.DefaultRoomListLimit,
+		}).Return(summaries, false, nil)
+
+		res, err := roomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{UserID: userID, Query: "go"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, summaries, res.Rooms)
+		assert.False(t, res.HasNext)
+		assert.Empty(t, res.NextCursor)
+	})
+
+	t.Run("2.続きがある場合は次のカーソルを返す", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockDeps.RoomRepo.EXPECT().ListRoomSummaries(ctx, gomock.Any()).Return(
+			[]*entity.RoomSummary{newSummary("room_1", 5, createdAt), newSummary("room_2", 3, createdAt)}, true, nil)
+
+		res, err := roomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{
+			UserID: userID,
+			Sort:   repository.RoomSortMemberCount,
+			Limit:  2,
+		})
+		assert.NoError(t, err)
+		assert.True(t, res.HasNext)
+		assert.NotEmpty(t, res.NextCursor)
+
+		mockDeps.RoomRepo.EXPECT().ListRoomSummaries(ctx, repository.RoomListQuery{
+			ViewerID: userID,
+			Sort:     repository.RoomSortMemberCount,
+			Limit:    2,
+			After:    &repository.RoomListCursor{RoomID: "room_2", MemberCount: 3},
+		}).Return([]*entity.RoomSummary{}, false, nil)
+
+		_, err = roomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{
+			UserID: userID,
+			Sort:   repository.RoomSortMemberCount,
+			Limit:  2,
+			Cursor: res.NextCursor,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("3.件数の上限を超える指定は上限に丸める", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().ListRoomSummaries(ctx, repository.RoomListQuery{
+			ViewerID: userID,
+			Sort:     repository.RoomSortCreatedAt,
+			Limit:    roomcase.MaxRoomListLimit,
+		}).Return([]*entity.RoomSummary{}, false, nil)
+
+		_, err := roomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{
+			UserID: userID,
+			Sort:   repository.RoomSortCreatedAt,
+			Limit:  roomcase.MaxRoomListLimit + 1,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("4.不正な並び順", func(t *testing.T) {
+		_, err := roomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{UserID: userID, Sort: "popular"})
+		assert.ErrorIs(t, err, roomcase.ErrInvalidSort)
+	})
+
+	t.Run("5.不正なカーソル", func(t *testing.T) {
+		_, err := roomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{UserID: userID, Cursor: "!!!"})
+		assert.ErrorIs(t, err, roomcase.ErrInvalidCursor)
+	})
+
+	t.Run("6.並び順と一致しないカーソル", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().ListRoomSummaries(ctx, gomock.Any()).Return(
+			[]*entity.RoomSummary{newSummary("room_1", 1, time.Now())}, true, nil)
+		res, err := roomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{UserID: userID, Limit: 1})
+		assert.NoError(t, err)
+
+		_, err = roomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{
+			UserID: userID,
+			Sort:   repository.RoomSortLastActivity,
+			Cursor: res.NextCursor,
+		})
+		assert.ErrorIs(t, err, roomcase.ErrInvalidCursor)
+	})
+
+	t.Run("7.ListRoomSummaries（Repo）エラー", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().ListRoomSummaries(ctx, gomock.Any()).Return(nil, false, assert.AnError)
+
+		_, err := roomUseCase.ListRooms(ctx, roomcase.ListRoomsRequest{UserID: userID})
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,98 @@
+package roomcase
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// 部屋のメンバーの一覧の取得件数
+const (
+	DefaultRoomMemberListLimit = 50  // 指定がない場合
+	MaxRoomMemberListLimit     = 200 // 指定できる上限（超えた場合は上限に丸める）
+)
+
+// ListRoomMembersRequest構造体: 部屋のメンバーをページ単位で取得するリクエスト
+type ListRoomMembersRequest struct {
+	RoomID entity.RoomID
+	UserID entity.UserID // 取得するユーザー（非公開の部屋はメンバーのみ取得できる）
+	Query  string        // 名前の部分一致（空の場合は絞り込まない）
+	Limit  int           // 0 以下の場合は DefaultRoomMemberListLimit
+	Cursor string        // 前のページの NextCursor（空の場合は先頭から）
+}
+
+// RoomMemberStatus構造体: 部屋のメンバーと現在のオンライン状態
+type RoomMemberStatus struct {
+	Member *entity.RoomMember
+	Status entity.PresenceStatus // GET /api/user/:id/presence と同じく、WebsocketManager のコネクションと最後に操作した日時から求める
+}
+
+// ListRoomMembersResponse構造体: 部屋のメンバーの一覧の1ページ（名前順）
+type ListRoomMembersResponse struct {
+	Members    []*RoomMemberStatus
+	NextCursor string // HasNext が false の場合は空
+	HasNext    bool
+}
+
+// roomMemberCursor は ListRoomMembersResponse.NextCursor の中身です。
+type roomMemberCursor struct {
+	Name   string        `json:"n"`
+	UserID entity.UserID `json:"id"`
+}
+
+// ListRoomMembers: 部屋のメンバーを、役割とオンライン状態付きで名前順に取得
+func (r *RoomUseCase) ListRoomMembers(ctx context.Context, req ListRoomMembersRequest) (ListRoomMembersResponse, error) {
+	if _, err := r.getVisibleRoom(ctx, req.RoomID, req.UserID); err != nil {
+		return ListRoomMembersResponse{}, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultRoomMemberListLimit
+	}
+	if limit > MaxRoomMemberListLimit {
+		limit = MaxRoomMemberListLimit
+	}
+
+	var after *repository.RoomMemberCursor
+	if req.Cursor != "" {
+		var c roomMemberCursor
+		if err := decodeCursor(req.Cursor, &c); err != nil {
+			return ListRoomMembersResponse{}, err
+		}
+		if c.UserID == "" {
+			return ListRoomMembersResponse{}, ErrInvalidCursor
+		}
+		after = &repository.RoomMemberCursor{Name: c.Name, UserID: c.UserID}
+	}
+
+	members, hasNext, err := r.roomRepo.ListRoomMembers(ctx, repository.RoomMemberListQuery{
+		RoomID:   req.RoomID,
+		NameLike: req.Query,
+		Limit:    limit,
+		After:    after,
+	})
+	if err != nil {
+		return ListRoomMembersResponse{}, err
+	}
+
+	// 複数ノードの場合は他のノードのコネクションも含めて判定する
+	now := time.Now()
+	statuses := make([]*RoomMemberStatus, 0, len(members))
+	for _, member := range members {
+		activity, err := r.wsManager.GetUserActivity(ctx, member.GetUser().GetID())
+		if err != nil {
+			return ListRoomMembersResponse{}, err
+		}
+		statuses = append(statuses, &RoomMemberStatus{Member: member, Status: activity.PresenceStatus(now, r.awayTimeout)})
+	}
+
+	res := ListRoomMembersResponse{Members: statuses, HasNext: hasNext}
+	if hasNext && len(members) > 0 {
+		last := members[len(members)-1].GetUser()
+		res.NextCursor = encodeCursor(roomMemberCursor{Name: last.GetName(), UserID: last.GetID()})
+	}
+	return res, nil
+}
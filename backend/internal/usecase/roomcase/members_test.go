@@ -0,0 +1,105 @@
+package roomcase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. メンバーをオンライン状態付きで取得し、続きのカーソルで次のページを取得する
+// 2. 非公開の部屋（メンバー以外）
+// 3. 不正なカーソル
+// 4. ListRoomMembers（Repo）エラー
+
+func TestListRoomMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	userID := entity.UserID("user_1")
+	publicRoom := entity.NewRoom(entity.RoomParams{ID: roomID, Name: "Test Room"})
+
+	newMember := func(id entity.UserID, name string) *entity.RoomMember {
+		return entity.NewRoomMember(entity.RoomMemberParams{
+			User: entity.NewUser(entity.UserParams{ID: id, Name: name}),
+			Role: entity.RoomRoleMember,
+		})
+	}
+
+	t.Run("1.メンバーをオンライン状態付きで取得する", func(t *testing.T) {
+		alice := newMember("user_2", "alice")
+		bob := newMember("user_3", "bob")
+		carol := newMember("user_4", "carol")
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(publicRoom, nil)
+		mockDeps.RoomRepo.EXPECT().ListRoomMembers(ctx, repository.RoomMemberListQuery{
+			RoomID:   roomID,
+			NameLike: "b",
+			Limit:    roomcase.DefaultRoomMemberListLimit,
+		}).Return([]*entity.RoomMember{alice, bob, carol}, true, nil)
+		mockDeps.WsManager.EXPECT().GetUserActivity(ctx, entity.UserID("user_2")).
+			Return(entity.UserActivity{Connections: 1, LastActiveAt: time.Now()}, nil)
+		mockDeps.WsManager.EXPECT().GetUserActivity(ctx, entity.UserID("user_3")).
+			Return(entity.UserActivity{Connections: 2, LastActiveAt: time.Now().Add(-time.Hour)}, nil)
+		mockDeps.WsManager.EXPECT().GetUserActivity(ctx, entity.UserID("user_4")).
+			Return(entity.UserActivity{LastActiveAt: time.Now().Add(-time.Minute)}, nil)
+
+		res, err := roomUseCase.ListRoomMembers(ctx, roomcase.ListRoomMembersRequest{RoomID: roomID, UserID: userID, Query: "b"})
+		assert.NoError(t, err)
+		assert.Len(t, res.Members, 3)
+		assert.Equal(t, alice, res.Members[0].Member)
+		assert.Equal(t, entity.PresenceOnline, res.Members[0].Status)
+		assert.Equal(t, entity.PresenceAway, res.Members[1].Status)
+		assert.Equal(t, entity.PresenceOffline, res.Members[2].Status)
+		assert.True(t, res.HasNext)
+
+		// 続きは最後のメンバーの後から取得する
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(publicRoom, nil)
+		mockDeps.RoomRepo.EXPECT().ListRoomMembers(ctx, repository.RoomMemberListQuery{
+			RoomID: roomID,
+			Limit:  roomcase.MaxRoomMemberListLimit,
+			After:  &repository.RoomMemberCursor{Name: "carol", UserID: "user_4"},
+		}).Return([]*entity.RoomMember{}, false, nil)
+
+		res, err = roomUseCase.ListRoomMembers(ctx, roomcase.ListRoomMembersRequest{
+			RoomID: roomID,
+			UserID: userID,
+			Limit:  roomcase.MaxRoomMemberListLimit + 1,
+			Cursor: res.NextCursor,
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Members)
+		assert.Empty(t, res.NextCursor)
+	})
+
+	t.Run("2.非公開の部屋（メンバー以外）", func(t *testing.T) {
+		privateRoom := entity.NewRoom(entity.RoomParams{ID: roomID, Name: "Secret", Visibility: entity.RoomVisibilityPrivate})
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(privateRoom, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		_, err := roomUseCase.ListRoomMembers(ctx, roomcase.ListRoomMembersRequest{RoomID: roomID, UserID: userID})
+		assert.ErrorIs(t, err, repository.ErrRoomNotFound)
+	})
+
+	t.Run("3.不正なカーソル", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(publicRoom, nil)
+
+		_, err := roomUseCase.ListRoomMembers(ctx, roomcase.ListRoomMembersRequest{RoomID: roomID, UserID: userID, Cursor: "e30"})
+		assert.ErrorIs(t, err, roomcase.ErrInvalidCursor)
+	})
+
+	t.Run("4.ListRoomMembers（Repo）エラー", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(publicRoom, nil)
+		mockDeps.RoomRepo.EXPECT().ListRoomMembers(ctx, gomock.Any()).Return(nil, false, assert.AnError)
+
+		_, err := roomUseCase.ListRoomMembers(ctx, roomcase.ListRoomMembersRequest{RoomID: roomID, UserID: userID})
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
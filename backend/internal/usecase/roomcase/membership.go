@@ -2,8 +2,10 @@ package roomcase
 
 import (
 	"context"
+	"errors"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 )
 
 // JoinRoomRequest構造体: 部屋に参加するリクエスト
@@ -12,9 +14,31 @@ type JoinRoomRequest struct {
 	UserID entity.UserID `json:"user_id"` // 参加するユーザー
 }
 
-// JoinRoom: 部屋にユーザーを参加させる
+// JoinRoom: 部屋にユーザーを一般メンバーとして参加させる
+// すでにメンバーの場合は役割を変えずに成功とする
+// 非公開の部屋には招待リンク（RedeemInvite）または参加リクエストの承認（ApproveJoinRequest）によってのみ参加できる
 func (r *RoomUseCase) JoinRoom(ctx context.Context, req JoinRoomRequest) error {
-	err := r.roomRepo.AddMemberToRoom(ctx, req.RoomID, req.UserID)
+	_, err := r.roomRepo.GetMemberRole(ctx, req.RoomID, req.UserID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, repository.ErrNotRoomMember) {
+		return err
+	}
+
+	room, err := r.roomRepo.GetRoomByID(ctx, req.RoomID)
+	if err != nil {
+		return err
+	}
+	if room.IsPrivate() {
+		// 非公開の部屋の存在を知られないよう、存在しない部屋と同じエラーを返す
+		return repository.ErrRoomNotFound
+	}
+	if err := r.checkNotBanned(ctx, req.RoomID, req.UserID); err != nil {
+		return err
+	}
+
+	err = r.roomRepo.AddMemberToRoom(ctx, req.RoomID, req.UserID, entity.RoomRoleMember)
 	if err != nil {
 		return err
 	}
@@ -28,9 +52,17 @@ type LeaveRoomRequest struct {
 	UserID entity.UserID `json:"user_id"` // 退出するユーザーID
 }
 
-// LeaveRoom: 部屋からユーザーを退出させる
+// LeaveRoom: 部屋からユーザーを退出させる（オーナーは退出できない）
 func (r *RoomUseCase) LeaveRoom(ctx context.Context, req LeaveRoomRequest) error {
-	err := r.roomRepo.RemoveMemberFromRoom(ctx, req.RoomID, req.UserID)
+	role, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleMember)
+	if err != nil {
+		return err
+	}
+	if role == entity.RoomRoleOwner {
+		return ErrOwnerCannotLeave
+	}
+
+	err = r.roomRepo.RemoveMemberFromRoom(ctx, req.RoomID, req.UserID)
 	if err != nil {
 		return err
 	}
@@ -3,10 +3,14 @@ package roomcase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/usecase/roomcase"
 	mock_repository "example.com/infrahandson/test/mocks/domain/repository"
+	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
 	mock_factory "example.com/infrahandson/test/mocks/interface/factory"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -14,17 +18,33 @@ import (
 
 // 1.正常系のテスト
 // 2.AddMemberToRoom（Repo）のエラー
+// 3.すでにメンバーの場合は何もしない
+// 4.部屋が存在しない場合
+// 5.非公開の部屋には参加できない
+// 6.BANされている場合は参加できない
 func TestJoinRoom(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRoomRepo := mock_repository.NewMockRoomRepository(ctrl)
 	mockUserRepo := mock_repository.NewMockUserRepository(ctrl)
+	mockModerationRepo := mock_repository.NewMockRoomModerationRepository(ctrl)
 	mockRoomIDFactory := mock_factory.NewMockRoomIDFactory(ctrl)
 	params := roomcase.NewRoomUseCaseParams{
-		RoomRepo:      mockRoomRepo,
-		UserRepo:      mockUserRepo,
-		RoomIDFactory: mockRoomIDFactory,
+		RoomRepo:           mockRoomRepo,
+		UserRepo:           mockUserRepo,
+		InviteRepo:         mock_repository.NewMockRoomInviteRepository(ctrl),
+		ModerationRepo:     mockModerationRepo,
+		JoinRequestRepo:    mock_repository.NewMockRoomJoinRequestRepository(ctrl),
+		RoomIDFactory:      mockRoomIDFactory,
+		InviteTokenFactory: mock_factory.NewMockInviteTokenFactory(ctrl),
+		IconSvc:            mock_service.NewMockIconStoreService(ctrl),
+		MsgCache:           mock_service.NewMockMessageCacheService(ctrl),
+		WsManager:          mock_service.NewMockWebsocketManager(ctrl),
+		AttachmentRepo:     mock_repository.NewMockAttachmentRepository(ctrl),
+		AttachmentStore:    mock_service.NewMockAttachmentStoreService(ctrl),
+		Logger:             mock_adapter.NewMockLoggerAdapter(ctrl),
+		AwayTimeout:        5 * time.Minute,
 	}
 	roomUseCase := roomcase.NewRoomUseCase(params)
 
@@ -32,7 +52,10 @@ func TestJoinRoom(t *testing.T) {
 		roomID := entity.RoomID("room_1")
 		userID := entity.UserID("test_user")
 
-		mockRoomRepo.EXPECT().AddMemberToRoom(context.Background(), roomID, userID).Return(nil)
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockRoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mockModerationRepo.EXPECT().IsBanned(context.Background(), roomID, userID).Return(false, nil)
+		mockRoomRepo.EXPECT().AddMemberToRoom(context.Background(), roomID, userID, entity.RoomRoleMember).Return(nil)
 
 		err := roomUseCase.JoinRoom(context.Background(), roomcase.JoinRoomRequest{
 			RoomID: roomID,
@@ -47,7 +70,10 @@ func TestJoinRoom(t *testing.T) {
 		userID := entity.UserID("test_user")
 		expectedErr := assert.AnError
 
-		mockRoomRepo.EXPECT().AddMemberToRoom(context.Background(), roomID, userID).Return(expectedErr)
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockRoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mockModerationRepo.EXPECT().IsBanned(context.Background(), roomID, userID).Return(false, nil)
+		mockRoomRepo.EXPECT().AddMemberToRoom(context.Background(), roomID, userID, entity.RoomRoleMember).Return(expectedErr)
 
 		err := roomUseCase.JoinRoom(context.Background(), roomcase.JoinRoomRequest{
 			RoomID: roomID,
@@ -57,10 +83,73 @@ func TestJoinRoom(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("すでにメンバーの場合", func(t *testing.T) {
+		roomID := entity.RoomID("room_1")
+		userID := entity.UserID("test_user")
+
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleOwner, nil)
+
+		err := roomUseCase.JoinRoom(context.Background(), roomcase.JoinRoomRequest{
+			RoomID: roomID,
+			UserID: userID,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("部屋が存在しない場合", func(t *testing.T) {
+		roomID := entity.RoomID("missing")
+		userID := entity.UserID("test_user")
+
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRole(""), repository.ErrRoomNotFound)
+
+		err := roomUseCase.JoinRoom(context.Background(), roomcase.JoinRoomRequest{
+			RoomID: roomID,
+			UserID: userID,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrRoomNotFound)
+	})
+
+	t.Run("非公開の部屋の場合", func(t *testing.T) {
+		roomID := entity.RoomID("private_room")
+		userID := entity.UserID("test_user")
+
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockRoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).
+			Return(entity.NewRoom(entity.RoomParams{ID: roomID, Visibility: entity.RoomVisibilityPrivate}), nil)
+
+		err := roomUseCase.JoinRoom(context.Background(), roomcase.JoinRoomRequest{
+			RoomID: roomID,
+			UserID: userID,
+		})
+
+		// 非公開の部屋は存在しない部屋と同じように扱う
+		assert.ErrorIs(t, err, repository.ErrRoomNotFound)
+	})
+
+	t.Run("BANされている場合", func(t *testing.T) {
+		roomID := entity.RoomID("room_1")
+		userID := entity.UserID("banned_user")
+
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockRoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mockModerationRepo.EXPECT().IsBanned(context.Background(), roomID, userID).Return(true, nil)
+
+		err := roomUseCase.JoinRoom(context.Background(), roomcase.JoinRoomRequest{
+			RoomID: roomID,
+			UserID: userID,
+		})
+
+		assert.ErrorIs(t, err, entity.ErrRoomBanned)
+	})
 }
 
 // 1.正常系のテスト
 // 2.RemoveMemberFromRoom（Repo）のエラー
+// 3.メンバーでない場合
+// 4.オーナーは退出できない
 func TestLeaveRoom(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -69,9 +158,20 @@ func TestLeaveRoom(t *testing.T) {
 	mockUserRepo := mock_repository.NewMockUserRepository(ctrl)
 	mockRoomIDFactory := mock_factory.NewMockRoomIDFactory(ctrl)
 	params := roomcase.NewRoomUseCaseParams{
-		RoomRepo:      mockRoomRepo,
-		UserRepo:      mockUserRepo,
-		RoomIDFactory: mockRoomIDFactory,
+		RoomRepo:           mockRoomRepo,
+		UserRepo:           mockUserRepo,
+		InviteRepo:         mock_repository.NewMockRoomInviteRepository(ctrl),
+		ModerationRepo:     mock_repository.NewMockRoomModerationRepository(ctrl),
+		JoinRequestRepo:    mock_repository.NewMockRoomJoinRequestRepository(ctrl),
+		RoomIDFactory:      mockRoomIDFactory,
+		InviteTokenFactory: mock_factory.NewMockInviteTokenFactory(ctrl),
+		IconSvc:            mock_service.NewMockIconStoreService(ctrl),
+		MsgCache:           mock_service.NewMockMessageCacheService(ctrl),
+		WsManager:          mock_service.NewMockWebsocketManager(ctrl),
+		AttachmentRepo:     mock_repository.NewMockAttachmentRepository(ctrl),
+		AttachmentStore:    mock_service.NewMockAttachmentStoreService(ctrl),
+		Logger:             mock_adapter.NewMockLoggerAdapter(ctrl),
+		AwayTimeout:        5 * time.Minute,
 	}
 	roomUseCase := roomcase.NewRoomUseCase(params)
 
@@ -79,6 +179,7 @@ func TestLeaveRoom(t *testing.T) {
 		roomID := entity.RoomID("room_1")
 		userID := entity.UserID("test_user")
 
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil)
 		mockRoomRepo.EXPECT().RemoveMemberFromRoom(context.Background(), roomID, userID).Return(nil)
 
 		err := roomUseCase.LeaveRoom(context.Background(), roomcase.LeaveRoomRequest{
@@ -94,6 +195,7 @@ func TestLeaveRoom(t *testing.T) {
 		userID := entity.UserID("test_user")
 		expectedErr := assert.AnError
 
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleAdmin, nil)
 		mockRoomRepo.EXPECT().RemoveMemberFromRoom(context.Background(), roomID, userID).Return(expectedErr)
 
 		err := roomUseCase.LeaveRoom(context.Background(), roomcase.LeaveRoomRequest{
@@ -104,4 +206,32 @@ func TestLeaveRoom(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("メンバーでない場合", func(t *testing.T) {
+		roomID := entity.RoomID("room_1")
+		userID := entity.UserID("test_user")
+
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		err := roomUseCase.LeaveRoom(context.Background(), roomcase.LeaveRoomRequest{
+			RoomID: roomID,
+			UserID: userID,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
+
+	t.Run("オーナーは退出できない", func(t *testing.T) {
+		roomID := entity.RoomID("room_1")
+		userID := entity.UserID("test_user")
+
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleOwner, nil)
+
+		err := roomUseCase.LeaveRoom(context.Background(), roomcase.LeaveRoomRequest{
+			RoomID: roomID,
+			UserID: userID,
+		})
+
+		assert.ErrorIs(t, err, roomcase.ErrOwnerCannotLeave)
+	})
 }
@@ -0,0 +1,189 @@
+package roomcase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+)
+
+// MaxModerationReasonLength はモデレーションの理由の最大文字数
+const MaxModerationReasonLength = 500
+
+// MaxMuteDuration は発言禁止の期間の上限
+const MaxMuteDuration = 30 * 24 * time.Hour
+
+// キック・追放によりコネクションを閉じる際の切断理由
+const (
+	KickedCloseReason = "kicked"
+	BannedCloseReason = "banned"
+)
+
+// ModerateMemberRequest構造体: 部屋のユーザーをキック・追放・発言禁止するリクエスト
+type ModerateMemberRequest struct {
+	RoomID   entity.RoomID `json:"room_id"`   // 部屋の公開ID
+	UserID   entity.UserID `json:"user_id"`   // 操作を行うユーザー（管理者以上）
+	TargetID entity.UserID `json:"target_id"` // 対象のユーザー
+	Reason   string        `json:"reason"`    // 理由（任意）
+	// Duration は発言禁止の期間（MuteMember のみ）
+	Duration time.Duration `json:"duration"`
+}
+
+// KickMember: ユーザーを部屋から退出させ、部屋のコネクションを閉じる（管理者以上）
+// 再参加は制限しない
+func (r *RoomUseCase) KickMember(ctx context.Context, req ModerateMemberRequest) error {
+	if err := r.authorizeModeration(ctx, req, true); err != nil {
+		return err
+	}
+
+	if err := r.roomRepo.RemoveMemberFromRoom(ctx, req.RoomID, req.TargetID); err != nil {
+		return err
+	}
+
+	return r.recordModeration(ctx, req, entity.RoomModerationKick, nil)
+}
+
+// BanMember: ユーザーを部屋から追放し、部屋のコネクションを閉じる（管理者以上）
+// 追放されたユーザーは参加・接続できなくなる。メンバーでないユーザーも追放できる
+func (r *RoomUseCase) BanMember(ctx context.Context, req ModerateMemberRequest) error {
+	if err := r.authorizeModeration(ctx, req, false); err != nil {
+		return err
+	}
+
+	// 追放と同時に部屋から退出させる
+	if err := r.moderationRepo.SaveBan(ctx, req.RoomID, req.TargetID, time.Now()); err != nil {
+		return err
+	}
+
+	return r.recordModeration(ctx, req, entity.RoomModerationBan, nil)
+}
+
+// UnbanMember: 追放を解除する（管理者以上）
+// 解除後も自動では参加させず、ユーザー自身が参加し直す
+func (r *RoomUseCase) UnbanMember(ctx context.Context, req ModerateMemberRequest) error {
+	if err := r.authorizeModeration(ctx, req, false); err != nil {
+		return err
+	}
+
+	if err := r.moderationRepo.DeleteBan(ctx, req.RoomID, req.TargetID); err != nil {
+		return err
+	}
+
+	return r.recordModeration(ctx, req, entity.RoomModerationUnban, nil)
+}
+
+// MuteMember: Duration の間、メンバーの発言を禁止する（管理者以上）
+// 接続は維持したまま、メッセージの送信のみを拒否する。すでに禁止されている場合は期限を上書きする
+func (r *RoomUseCase) MuteMember(ctx context.Context, req ModerateMemberRequest) error {
+	if req.Duration <= 0 || req.Duration > MaxMuteDuration {
+		return ErrInvalidMuteDuration
+	}
+	if err := r.authorizeModeration(ctx, req, true); err != nil {
+		return err
+	}
+
+	until := time.Now().Add(req.Duration)
+	if err := r.moderationRepo.SaveMute(ctx, req.RoomID, req.TargetID, until); err != nil {
+		return err
+	}
+
+	return r.recordModeration(ctx, req, entity.RoomModerationMute, &until)
+}
+
+// UnmuteMember: 発言の禁止を期限前に解除する（管理者以上）
+func (r *RoomUseCase) UnmuteMember(ctx context.Context, req ModerateMemberRequest) error {
+	if err := r.authorizeModeration(ctx, req, false); err != nil {
+		return err
+	}
+
+	if err := r.moderationRepo.DeleteMute(ctx, req.RoomID, req.TargetID); err != nil {
+		return err
+	}
+
+	return r.recordModeration(ctx, req, entity.RoomModerationUnmute, nil)
+}
+
+// authorizeModeration は操作を行うユーザーが管理者以上で、対象のユーザーより上の役割を持つかを確認します。
+// requireMember が true の場合は、対象のユーザーがメンバーでなければ repository.ErrNotRoomMember を返します。
+func (r *RoomUseCase) authorizeModeration(ctx context.Context, req ModerateMemberRequest, requireMember bool) error {
+	if utf8.RuneCountInString(req.Reason) > MaxModerationReasonLength {
+		return ErrModerationReasonTooLong
+	}
+	if req.UserID == req.TargetID {
+		return ErrCannotModerateSelf
+	}
+
+	role, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	targetRole, err := r.roomRepo.GetMemberRole(ctx, req.RoomID, req.TargetID)
+	if errors.Is(err, repository.ErrNotRoomMember) && !requireMember {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// 管理者同士やオーナーに対しては操作できない
+	if !role.Outranks(targetRole) {
+		return entity.ErrInsufficientRoomRole
+	}
+	return nil
+}
+
+// recordModeration はモデレーションを記録して部屋に通知します。
+// キック・追放の場合は、対象のユーザーの部屋のコネクションも閉じます。
+// 通知と切断の失敗はログに記録し、呼び出し元には返しません。
+func (r *RoomUseCase) recordModeration(
+	ctx context.Context,
+	req ModerateMemberRequest,
+	actionType entity.RoomModerationActionType,
+	expiresAt *time.Time,
+) error {
+	action := entity.NewRoomModerationAction(entity.RoomModerationActionParams{
+		RoomID:    req.RoomID,
+		ActorID:   req.UserID,
+		TargetID:  req.TargetID,
+		Action:    actionType,
+		Reason:    req.Reason,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	})
+	if err := r.moderationRepo.SaveAction(ctx, action); err != nil {
+		return err
+	}
+
+	// 操作はすでに反映されているため、通知と切断に失敗しても記録するだけで成功として返す
+	if err := r.wsManager.BroadcastToRoom(ctx, req.RoomID, entity.NewRoomModerationEvent(action)); err != nil {
+		r.logger.Error("Failed to broadcast moderation action", "error", err)
+	}
+	var closeReason string
+	switch actionType {
+	case entity.RoomModerationKick:
+		closeReason = KickedCloseReason
+	case entity.RoomModerationBan:
+		closeReason = BannedCloseReason
+	default:
+		return nil
+	}
+	if err := r.wsManager.DisconnectUser(ctx, req.RoomID, req.TargetID, closeReason); err != nil {
+		r.logger.Error("Failed to disconnect moderated user", "error", err)
+	}
+	return nil
+}
+
+// checkNotBanned はユーザーが部屋から追放されている場合に entity.ErrRoomBanned を返します。
+func (r *RoomUseCase) checkNotBanned(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	banned, err := r.moderationRepo.IsBanned(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return entity.ErrRoomBanned
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package roomcase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 正常系：退出させて通知し、コネクションを閉じる
+// 2. 管理者は管理者をキックできない
+// 3. 一般メンバーはキックできない
+// 4. 自分自身はキックできない
+// 5. 対象がメンバーでない
+// 6. 理由が長すぎる
+func TestKickMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	req := roomcase.ModerateMemberRequest{RoomID: roomID, UserID: "admin", TargetID: "target", Reason: "spam"}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRoleMember, nil)
+		mockDeps.RoomRepo.EXPECT().RemoveMemberFromRoom(ctx, roomID, req.TargetID).Return(nil)
+		mockDeps.ModerationRepo.EXPECT().SaveAction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, action *entity.RoomModerationAction) error {
+			assert.Equal(t, entity.RoomModerationKick, action.GetAction())
+			assert.Equal(t, req.UserID, action.GetActorID())
+			assert.Equal(t, req.TargetID, action.GetTargetID())
+			assert.Equal(t, "spam", action.GetReason())
+			assert.Nil(t, action.GetExpiresAt())
+			return nil
+		})
+		mockDeps.WsManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).DoAndReturn(func(_ context.Context, _ entity.RoomID, event *entity.WebsocketEvent) error {
+			assert.Equal(t, entity.WebsocketEventTypeRoomModeration, event.GetType())
+			return nil
+		})
+		mockDeps.WsManager.EXPECT().DisconnectUser(ctx, roomID, req.TargetID, roomcase.KickedCloseReason).Return(nil)
+
+		err := roomUseCase.KickMember(ctx, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("2. 管理者は管理者をキックできない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRoleAdmin, nil)
+
+		err := roomUseCase.KickMember(ctx, req)
+
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
+
+	t.Run("3. 一般メンバーはキックできない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		err := roomUseCase.KickMember(ctx, req)
+
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
+
+	t.Run("4. 自分自身はキックできない", func(t *testing.T) {
+		err := roomUseCase.KickMember(ctx, roomcase.ModerateMemberRequest{RoomID: roomID, UserID: "admin", TargetID: "admin"})
+
+		assert.ErrorIs(t, err, roomcase.ErrCannotModerateSelf)
+	})
+
+	t.Run("5. 対象がメンバーでない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleOwner, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		err := roomUseCase.KickMember(ctx, req)
+
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
+
+	t.Run("6. 理由が長すぎる", func(t *testing.T) {
+		long := req
+		long.Reason = strings.Repeat("あ", roomcase.MaxModerationReasonLength+1)
+
+		err := roomUseCase.KickMember(ctx, long)
+
+		assert.ErrorIs(t, err, roomcase.ErrModerationReasonTooLong)
+	})
+}
+
+// 1. 正常系：追放して退出させ、コネクションを閉じる
+// 2. メンバーでないユーザーも追放できる
+// 3. 通知に失敗しても切断は行い、追放は成功として返す
+func TestBanMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	req := roomcase.ModerateMemberRequest{RoomID: roomID, UserID: "owner", TargetID: "target"}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleOwner, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.ModerationRepo.EXPECT().SaveBan(ctx, roomID, req.TargetID, gomock.Any()).Return(nil)
+		mockDeps.ModerationRepo.EXPECT().SaveAction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, action *entity.RoomModerationAction) error {
+			assert.Equal(t, entity.RoomModerationBan, action.GetAction())
+			return nil
+		})
+		mockDeps.WsManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+		mockDeps.WsManager.EXPECT().DisconnectUser(ctx, roomID, req.TargetID, roomcase.BannedCloseReason).Return(nil)
+
+		err := roomUseCase.BanMember(ctx, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("2. メンバーでないユーザーも追放できる", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleOwner, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.ModerationRepo.EXPECT().SaveBan(ctx, roomID, req.TargetID, gomock.Any()).Return(nil)
+		mockDeps.ModerationRepo.EXPECT().SaveAction(ctx, gomock.Any()).Return(nil)
+		mockDeps.WsManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+		mockDeps.WsManager.EXPECT().DisconnectUser(ctx, roomID, req.TargetID, roomcase.BannedCloseReason).Return(nil)
+
+		err := roomUseCase.BanMember(ctx, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("3. 通知に失敗しても切断は行い、追放は成功として返す", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleOwner, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRoleMember, nil)
+		mockDeps.ModerationRepo.EXPECT().SaveBan(ctx, roomID, req.TargetID, gomock.Any()).Return(nil)
+		mockDeps.ModerationRepo.EXPECT().SaveAction(ctx, gomock.Any()).Return(nil)
+		mockDeps.WsManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(assert.AnError)
+		mockDeps.Logger.EXPECT().Error(gomock.Any(), gomock.Any())
+		mockDeps.WsManager.EXPECT().DisconnectUser(ctx, roomID, req.TargetID, roomcase.BannedCloseReason).Return(nil)
+
+		err := roomUseCase.BanMember(ctx, req)
+
+		assert.NoError(t, err)
+	})
+}
+
+// 1. 正常系
+// 2. 追放されていない
+func TestUnbanMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	req := roomcase.ModerateMemberRequest{RoomID: roomID, UserID: "admin", TargetID: "target"}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.ModerationRepo.EXPECT().DeleteBan(ctx, roomID, req.TargetID).Return(nil)
+		mockDeps.ModerationRepo.EXPECT().SaveAction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, action *entity.RoomModerationAction) error {
+			assert.Equal(t, entity.RoomModerationUnban, action.GetAction())
+			return nil
+		})
+		mockDeps.WsManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+
+		err := roomUseCase.UnbanMember(ctx, req)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("2. 追放されていない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		mockDeps.ModerationRepo.EXPECT().DeleteBan(ctx, roomID, req.TargetID).Return(repository.ErrBanNotFound)
+
+		err := roomUseCase.UnbanMember(ctx, req)
+
+		assert.ErrorIs(t, err, repository.ErrBanNotFound)
+	})
+}
+
+// 1. 正常系：期限を記録して通知する（コネクションは閉じない）
+// 2. 期間が不正
+// 3. 発言禁止の解除
+func TestMuteMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	req := roomcase.ModerateMemberRequest{RoomID: roomID, UserID: "admin", TargetID: "target", Duration: 10 * time.Minute}
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		var until time.Time
+		before := time.Now()
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRoleMember, nil)
+		mockDeps.ModerationRepo.EXPECT().SaveMute(ctx, roomID, req.TargetID, gomock.Any()).DoAndReturn(func(_ context.Context, _ entity.RoomID, _ entity.UserID, t time.Time) error {
+			until = t
+			return nil
+		})
+		mockDeps.ModerationRepo.EXPECT().SaveAction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, action *entity.RoomModerationAction) error {
+			assert.Equal(t, entity.RoomModerationMute, action.GetAction())
+			require.NotNil(t, action.GetExpiresAt())
+			assert.Equal(t, until, *action.GetExpiresAt())
+			return nil
+		})
+		mockDeps.WsManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+
+		err := roomUseCase.MuteMember(ctx, req)
+
+		require.NoError(t, err)
+		assert.False(t, until.Before(before.Add(req.Duration)))
+	})
+
+	t.Run("2. 期間が不正", func(t *testing.T) {
+		for _, d := range []time.Duration{0, -time.Minute, roomcase.MaxMuteDuration + time.Second} {
+			invalid := req
+			invalid.Duration = d
+
+			err := roomUseCase.MuteMember(ctx, invalid)
+
+			assert.ErrorIs(t, err, roomcase.ErrInvalidMuteDuration)
+		}
+	})
+
+	t.Run("3. 発言禁止の解除", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.UserID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, req.TargetID).Return(entity.RoomRoleMember, nil)
+		mockDeps.ModerationRepo.EXPECT().DeleteMute(ctx, roomID, req.TargetID).Return(nil)
+		mockDeps.ModerationRepo.EXPECT().SaveAction(ctx, gomock.Any()).Return(nil)
+		mockDeps.WsManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+
+		err := roomUseCase.UnmuteMember(ctx, req)
+
+		assert.NoError(t, err)
+	})
+}
@@ -1,15 +1,29 @@
 package roomcase
 
 import (
+	"time"
+
 	mock_repository "example.com/infrahandson/test/mocks/domain/repository"
+	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
 	mock_factory "example.com/infrahandson/test/mocks/interface/factory"
 	"go.uber.org/mock/gomock"
 )
 
 type mockDeps struct {
-	RoomRepo      *mock_repository.MockRoomRepository
-	UserRepo      *mock_repository.MockUserRepository
-	RoomIDFactory *mock_factory.MockRoomIDFactory
+	RoomRepo           *mock_repository.MockRoomRepository
+	UserRepo           *mock_repository.MockUserRepository
+	InviteRepo         *mock_repository.MockRoomInviteRepository
+	ModerationRepo     *mock_repository.MockRoomModerationRepository
+	JoinRequestRepo    *mock_repository.MockRoomJoinRequestRepository
+	RoomIDFactory      *mock_factory.MockRoomIDFactory
+	InviteTokenFactory *mock_factory.MockInviteTokenFactory
+	IconSvc            *mock_service.MockIconStoreService
+	MsgCache           *mock_service.MockMessageCacheService
+	WsManager          *mock_service.MockWebsocketManager
+	AttachmentRepo     *mock_repository.MockAttachmentRepository
+	AttachmentStore    *mock_service.MockAttachmentStoreService
+	Logger             *mock_adapter.MockLoggerAdapter
 }
 
 func NewTestRoomUseCase(
@@ -17,17 +31,48 @@ func NewTestRoomUseCase(
 ) (RoomUseCaseInterface, mockDeps) {
 	mockUserRepo := mock_repository.NewMockUserRepository(ctrl)
 	mockRoomRepo := mock_repository.NewMockRoomRepository(ctrl)
+	mockInviteRepo := mock_repository.NewMockRoomInviteRepository(ctrl)
+	mockModerationRepo := mock_repository.NewMockRoomModerationRepository(ctrl)
+	mockJoinRequestRepo := mock_repository.NewMockRoomJoinRequestRepository(ctrl)
 	mockRoomIDFactory := mock_factory.NewMockRoomIDFactory(ctrl)
+	mockInviteTokenFactory := mock_factory.NewMockInviteTokenFactory(ctrl)
+	mockIconSvc := mock_service.NewMockIconStoreService(ctrl)
+	mockMsgCache := mock_service.NewMockMessageCacheService(ctrl)
+	mockWsManager := mock_service.NewMockWebsocketManager(ctrl)
+	mockAttachmentRepo := mock_repository.NewMockAttachmentRepository(ctrl)
+	mockAttachmentStore := mock_service.NewMockAttachmentStoreService(ctrl)
+	mockLogger := mock_adapter.NewMockLoggerAdapter(ctrl)
 	params := NewRoomUseCaseParams{
-		RoomRepo:      mockRoomRepo,
-		UserRepo:      mockUserRepo,
-		RoomIDFactory: mockRoomIDFactory,
+		RoomRepo:           mockRoomRepo,
+		UserRepo:           mockUserRepo,
+		InviteRepo:         mockInviteRepo,
+		ModerationRepo:     mockModerationRepo,
+		JoinRequestRepo:    mockJoinRequestRepo,
+		RoomIDFactory:      mockRoomIDFactory,
+		InviteTokenFactory: mockInviteTokenFactory,
+		IconSvc:            mockIconSvc,
+		MsgCache:           mockMsgCache,
+		WsManager:          mockWsManager,
+		AttachmentRepo:     mockAttachmentRepo,
+		AttachmentStore:    mockAttachmentStore,
+		Logger:             mockLogger,
+		AwayTimeout:        5 * time.Minute,
 	}
 	useCase := NewRoomUseCase(params)
 
 	return useCase, mockDeps{
-		RoomRepo:      mockRoomRepo,
-		UserRepo:      mockUserRepo,
-		RoomIDFactory: mockRoomIDFactory,
+		RoomRepo:           mockRoomRepo,
+		UserRepo:           mockUserRepo,
+		InviteRepo:         mockInviteRepo,
+		ModerationRepo:     mockModerationRepo,
+		JoinRequestRepo:    mockJoinRequestRepo,
+		RoomIDFactory:      mockRoomIDFactory,
+		InviteTokenFactory: mockInviteTokenFactory,
+		IconSvc:            mockIconSvc,
+		MsgCache:           mockMsgCache,
+		WsManager:          mockWsManager,
+		AttachmentRepo:     mockAttachmentRepo,
+		AttachmentStore:    mockAttachmentStore,
+		Logger:             mockLogger,
 	}
 }
@@ -2,13 +2,28 @@
 package roomcase
 
 import (
+	"time"
+
 	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/interface/adapter"
 	"example.com/infrahandson/internal/interface/factory"
 )
 
 // RoomUseCase構造体: 部屋に関するユースケースを管理
 type RoomUseCase struct {
-	roomRepo      repository.RoomRepository
-	userRepo      repository.UserRepository
-	roomIDFactory factory.RoomIDFactory
+	roomRepo           repository.RoomRepository
+	userRepo           repository.UserRepository
+	inviteRepo         repository.RoomInviteRepository
+	moderationRepo     repository.RoomModerationRepository
+	joinRequestRepo    repository.RoomJoinRequestRepository
+	roomIDFactory      factory.RoomIDFactory
+	inviteTokenFactory factory.InviteTokenFactory
+	iconSvc            service.IconStoreService
+	msgCache           service.MessageCacheService
+	wsManager          service.WebsocketManager
+	attachmentRepo     repository.AttachmentRepository
+	attachmentStore    service.AttachmentStoreService
+	logger             adapter.LoggerAdapter
+	awayTimeout        time.Duration
 }
@@ -0,0 +1,151 @@
+package roomcase
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
+)
+
+// MaxRoomDescriptionLength は部屋の説明の最大文字数
+const MaxRoomDescriptionLength = 1000
+
+// MaxRoomPinLimit は部屋に設定できるピン留めの上限の最大値
+const MaxRoomPinLimit = 100
+
+// MaxSlowModeInterval は部屋に設定できるスローモードの間隔の最大値
+const MaxSlowModeInterval = 6 * time.Hour
+
+// UpdateRoomSettingsRequest構造体: 部屋の設定を更新するリクエスト
+// nil のフィールドは変更しない
+type UpdateRoomSettingsRequest struct {
+	RoomID      entity.RoomID
+	UserID      entity.UserID         // 更新を行うユーザー
+	Name        *string               // 新しい部屋名
+	Description *string               // 新しい説明（トピック）。空文字で削除
+	PinLimit    *int                  // 新しいピン留めの上限（1〜MaxRoomPinLimit）
+	SlowMode    *time.Duration        // 新しいスローモードの間隔（秒単位、0〜MaxSlowModeInterval）。0 で無効
+	Icon        *multipart.FileHeader // 新しいアイコン
+}
+
+// UpdateRoomSettings: 部屋名・説明・ピン留めの上限・スローモード・アイコンを更新（管理者以上）
+// 上限を現在のピン留め数より小さくしても既存のピン留めは外さず、新しいピン留めのみを制限する
+// 入力をすべて検証してから更新するため、検証エラーの場合は何も変更されない
+func (r *RoomUseCase) UpdateRoomSettings(ctx context.Context, req UpdateRoomSettingsRequest) error {
+	if _, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleAdmin); err != nil {
+		return err
+	}
+
+	var name string
+	if req.Name != nil {
+		name = strings.TrimSpace(*req.Name)
+		if name == "" {
+			return ErrInvalidRoomName
+		}
+	}
+	if req.Description != nil && utf8.RuneCountInString(*req.Description) > MaxRoomDescriptionLength {
+		return ErrDescriptionTooLong
+	}
+	if req.PinLimit != nil && (*req.PinLimit < 1 || *req.PinLimit > MaxRoomPinLimit) {
+		return ErrInvalidPinLimit
+	}
+	if req.SlowMode != nil && (*req.SlowMode < 0 || *req.SlowMode > MaxSlowModeInterval || *req.SlowMode%time.Second != 0) {
+		return ErrInvalidSlowMode
+	}
+	var iconData *service.IconData
+	if req.Icon != nil {
+		var err error
+		iconData, err = readIconData(req.Icon)
+		if err != nil {
+			return err
+		}
+		if err := service.ValidateIconData(iconData); err != nil {
+			return err
+		}
+	}
+
+	if req.Name != nil {
+		if err := r.roomRepo.UpdateRoomName(ctx, req.RoomID, name); err != nil {
+			return err
+		}
+	}
+	if req.Description != nil {
+		if err := r.roomRepo.UpdateRoomDescription(ctx, req.RoomID, *req.Description); err != nil {
+			return err
+		}
+	}
+	if req.PinLimit != nil {
+		if err := r.roomRepo.UpdateRoomPinLimit(ctx, req.RoomID, *req.PinLimit); err != nil {
+			return err
+		}
+	}
+	if req.SlowMode != nil {
+		if err := r.roomRepo.UpdateRoomSlowMode(ctx, req.RoomID, *req.SlowMode); err != nil {
+			return err
+		}
+	}
+	if iconData != nil {
+		if err := r.iconSvc.SaveRoomIcon(ctx, iconData, req.RoomID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readIconData はアップロードされたファイルを IconData に変換する
+func readIconData(fh *multipart.FileHeader) (*service.IconData, error) {
+	file, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	return service.NewIconData(data, int64(len(data)), fh.Header.Get("Content-Type")), nil
+}
+
+// ArchiveRoomRequest構造体: 部屋をアーカイブ・アーカイブ解除するリクエスト
+type ArchiveRoomRequest struct {
+	RoomID entity.RoomID
+	UserID entity.UserID // 操作を行うユーザー
+}
+
+// ArchiveRoom: 部屋をアーカイブ（オーナーのみ）
+// アーカイブされた部屋の履歴は閲覧できるが、新しいメッセージは送信できない
+func (r *RoomUseCase) ArchiveRoom(ctx context.Context, req ArchiveRoomRequest) error {
+	if _, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleOwner); err != nil {
+		return err
+	}
+	now := time.Now()
+	return r.roomRepo.SetRoomArchived(ctx, req.RoomID, &now)
+}
+
+// UnarchiveRoom: 部屋のアーカイブを解除（オーナーのみ）
+func (r *RoomUseCase) UnarchiveRoom(ctx context.Context, req ArchiveRoomRequest) error {
+	if _, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleOwner); err != nil {
+		return err
+	}
+	return r.roomRepo.SetRoomArchived(ctx, req.RoomID, nil)
+}
+
+// GetRoomIconPathRequest構造体: 部屋のアイコンのパスを取得するリクエスト
+type GetRoomIconPathRequest struct {
+	RoomID entity.RoomID
+	UserID entity.UserID // 取得するユーザー（非公開の部屋はメンバーのみ取得できる）
+}
+
+// GetRoomIconPath: 部屋のアイコンにアクセスするためのパスを取得
+func (r *RoomUseCase) GetRoomIconPath(ctx context.Context, req GetRoomIconPathRequest) (string, error) {
+	if _, err := r.getVisibleRoom(ctx, req.RoomID, req.UserID); err != nil {
+		return "", err
+	}
+	return r.iconSvc.GetRoomIconPath(ctx, req.RoomID)
+}
@@ -0,0 +1,227 @@
+package roomcase_test
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/domain/service"
+	"example.com/infrahandson/internal/usecase/roomcase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newIconFileHeader はテスト用のアップロードファイルを作成する
+func newIconFileHeader(t *testing.T, content []byte, contentType string) *multipart.FileHeader {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="icon"; filename="icon.png"`)
+	header.Set("Content-Type", contentType)
+	fw, err := w.CreatePart(header)
+	require.NoError(t, err)
+	_, err = fw.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	form, err := multipart.NewReader(&b, w.Boundary()).ReadForm(1 << 20)
+	require.NoError(t, err)
+	return form.File["icon"][0]
+}
+
+// 1. 正常系: 名前・説明・アイコンを更新
+// 2. 指定したフィールドのみ更新
+// 3. 名前が空
+// 4. 説明が長すぎる
+// 5. アイコンの形式が不正
+// 6. 一般メンバーは更新できない
+// 7. ピン留めの上限を更新する
+// 8. ピン留めの上限が範囲外
+// 9. スローモードを更新する
+// 10. スローモードの間隔が不正
+func TestUpdateRoomSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	userID := entity.UserID("admin")
+
+	t.Run("1. 正常系: 名前・説明・アイコンを更新", func(t *testing.T) {
+		name := "  New Name  "
+		description := "雑談用の部屋です"
+		icon := newIconFileHeader(t, []byte("png data"), "image/png")
+
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.RoomRepo.EXPECT().UpdateRoomName(ctx, roomID, "New Name").Return(nil)
+		mockDeps.RoomRepo.EXPECT().UpdateRoomDescription(ctx, roomID, description).Return(nil)
+		mockDeps.IconSvc.EXPECT().SaveRoomIcon(ctx, gomock.Any(), roomID).
+			DoAndReturn(func(_ context.Context, iconData *service.IconData, _ entity.RoomID) error {
+				assert.Equal(t, []byte("png data"), iconData.Icon)
+				assert.Equal(t, "image/png", iconData.MimeType)
+				return nil
+			})
+
+		err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{
+			RoomID:      roomID,
+			UserID:      userID,
+			Name:        &name,
+			Description: &description,
+			Icon:        icon,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("2. 指定したフィールドのみ更新", func(t *testing.T) {
+		description := ""
+
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleOwner, nil)
+		mockDeps.RoomRepo.EXPECT().UpdateRoomDescription(ctx, roomID, "").Return(nil)
+
+		err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{
+			RoomID:      roomID,
+			UserID:      userID,
+			Description: &description,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("3. 名前が空", func(t *testing.T) {
+		name := "   "
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleAdmin, nil)
+
+		err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{RoomID: roomID, UserID: userID, Name: &name})
+		assert.ErrorIs(t, err, roomcase.ErrInvalidRoomName)
+	})
+
+	t.Run("4. 説明が長すぎる", func(t *testing.T) {
+		name := "New Name"
+		description := strings.Repeat("あ", roomcase.MaxRoomDescriptionLength+1)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleAdmin, nil)
+
+		// 名前も更新されない
+		err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{RoomID: roomID, UserID: userID, Name: &name, Description: &description})
+		assert.ErrorIs(t, err, roomcase.ErrDescriptionTooLong)
+	})
+
+	t.Run("5. アイコンの形式が不正", func(t *testing.T) {
+		name := "New Name"
+		icon := newIconFileHeader(t, []byte("gif data"), "image/gif")
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleAdmin, nil)
+
+		err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{RoomID: roomID, UserID: userID, Name: &name, Icon: icon})
+		assert.ErrorIs(t, err, service.ErrInvalidIconType)
+	})
+
+	t.Run("6. 一般メンバーは更新できない", func(t *testing.T) {
+		name := "New Name"
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleMember, nil)
+
+		err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{RoomID: roomID, UserID: userID, Name: &name})
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
+
+	t.Run("7. ピン留めの上限を更新する", func(t *testing.T) {
+		pinLimit := 10
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleAdmin, nil)
+		mockDeps.RoomRepo.EXPECT().UpdateRoomPinLimit(ctx, roomID, 10).Return(nil)
+
+		err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{RoomID: roomID, UserID: userID, PinLimit: &pinLimit})
+		assert.NoError(t, err)
+	})
+
+	t.Run("8. ピン留めの上限が範囲外", func(t *testing.T) {
+		for _, pinLimit := range []int{0, roomcase.MaxRoomPinLimit + 1} {
+			mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleAdmin, nil)
+
+			err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{RoomID: roomID, UserID: userID, PinLimit: &pinLimit})
+			assert.ErrorIs(t, err, roomcase.ErrInvalidPinLimit)
+		}
+	})
+
+	t.Run("9. スローモードを更新する", func(t *testing.T) {
+		for _, slowMode := range []time.Duration{30 * time.Second, 0} {
+			mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleAdmin, nil)
+			mockDeps.RoomRepo.EXPECT().UpdateRoomSlowMode(ctx, roomID, slowMode).Return(nil)
+
+			err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{RoomID: roomID, UserID: userID, SlowMode: &slowMode})
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("10. スローモードの間隔が不正", func(t *testing.T) {
+		for _, slowMode := range []time.Duration{-time.Second, roomcase.MaxSlowModeInterval + time.Second, 1500 * time.Millisecond} {
+			mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleAdmin, nil)
+
+			err := roomUseCase.UpdateRoomSettings(ctx, roomcase.UpdateRoomSettingsRequest{RoomID: roomID, UserID: userID, SlowMode: &slowMode})
+			assert.ErrorIs(t, err, roomcase.ErrInvalidSlowMode)
+		}
+	})
+}
+
+// 1. オーナーはアーカイブ・解除できる
+// 2. 管理者はアーカイブできない
+func TestArchiveRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	userID := entity.UserID("owner")
+	req := roomcase.ArchiveRoomRequest{RoomID: roomID, UserID: userID}
+
+	t.Run("1. オーナーはアーカイブ・解除できる", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleOwner, nil).Times(2)
+		mockDeps.RoomRepo.EXPECT().SetRoomArchived(ctx, roomID, gomock.Not(gomock.Nil())).Return(nil)
+		mockDeps.RoomRepo.EXPECT().SetRoomArchived(ctx, roomID, gomock.Nil()).Return(nil)
+
+		require.NoError(t, roomUseCase.ArchiveRoom(ctx, req))
+		require.NoError(t, roomUseCase.UnarchiveRoom(ctx, req))
+	})
+
+	t.Run("2. 管理者はアーカイブできない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRoleAdmin, nil)
+
+		err := roomUseCase.ArchiveRoom(ctx, req)
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
+}
+
+// 1. 正常系
+// 2. 非公開の部屋はメンバー以外には見えない
+func TestGetRoomIconPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomUseCase, mockDeps := roomcase.NewTestRoomUseCase(ctrl)
+	ctx := context.Background()
+	roomID := entity.RoomID("room_1")
+	userID := entity.UserID("user_1")
+
+	t.Run("1. 正常系", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mockDeps.IconSvc.EXPECT().GetRoomIconPath(ctx, roomID).Return("/icons/rooms/room_1.webp", nil)
+
+		path, err := roomUseCase.GetRoomIconPath(ctx, roomcase.GetRoomIconPathRequest{RoomID: roomID, UserID: userID})
+		require.NoError(t, err)
+		assert.Equal(t, "/icons/rooms/room_1.webp", path)
+	})
+
+	t.Run("2. 非公開の部屋はメンバー以外には見えない", func(t *testing.T) {
+		mockDeps.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).
+			Return(entity.NewRoom(entity.RoomParams{ID: roomID, Visibility: entity.RoomVisibilityPrivate}), nil)
+		mockDeps.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		_, err := roomUseCase.GetRoomIconPath(ctx, roomcase.GetRoomIconPathRequest{RoomID: roomID, UserID: userID})
+		assert.ErrorIs(t, err, repository.ErrRoomNotFound)
+	})
+}
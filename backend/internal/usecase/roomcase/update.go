@@ -9,11 +9,16 @@ import (
 // UpdateRoomNameRequest構造体: 部屋名を更新するリクエスト
 type UpdateRoomNameRequest struct {
 	RoomID  entity.RoomID `json:"room_id"`
+	UserID  entity.UserID `json:"user_id"`  // 更新を行うユーザー
 	NewName string        `json:"new_name"` // 新しい部屋名
 }
 
-// UpdateRoomName: 部屋名を更新
+// UpdateRoomName: 部屋名を更新（管理者以上）
 func (r *RoomUseCase) UpdateRoomName(ctx context.Context, req UpdateRoomNameRequest) error {
+	if _, err := r.authorize(ctx, req.RoomID, req.UserID, entity.RoomRoleAdmin); err != nil {
+		return err
+	}
+
 	err := r.roomRepo.UpdateRoomName(ctx, req.RoomID, req.NewName)
 	if err != nil {
 		return err
@@ -3,10 +3,13 @@ package roomcase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
 	"example.com/infrahandson/internal/usecase/roomcase"
 	mock_repository "example.com/infrahandson/test/mocks/domain/repository"
+	mock_service "example.com/infrahandson/test/mocks/domain/service"
+	mock_adapter "example.com/infrahandson/test/mocks/interface/adapter"
 	mock_factory "example.com/infrahandson/test/mocks/interface/factory"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -20,19 +23,32 @@ func TestUpdateRoomName(t *testing.T) {
 	mockUserRepo := mock_repository.NewMockUserRepository(ctrl)
 	mockRoomIDFactory := mock_factory.NewMockRoomIDFactory(ctrl)
 	params := roomcase.NewRoomUseCaseParams{
-		RoomRepo:      mockRoomRepo,
-		UserRepo:      mockUserRepo,
-		RoomIDFactory: mockRoomIDFactory,
+		RoomRepo:           mockRoomRepo,
+		UserRepo:           mockUserRepo,
+		InviteRepo:         mock_repository.NewMockRoomInviteRepository(ctrl),
+		ModerationRepo:     mock_repository.NewMockRoomModerationRepository(ctrl),
+		JoinRequestRepo:    mock_repository.NewMockRoomJoinRequestRepository(ctrl),
+		RoomIDFactory:      mockRoomIDFactory,
+		InviteTokenFactory: mock_factory.NewMockInviteTokenFactory(ctrl),
+		IconSvc:            mock_service.NewMockIconStoreService(ctrl),
+		MsgCache:           mock_service.NewMockMessageCacheService(ctrl),
+		WsManager:          mock_service.NewMockWebsocketManager(ctrl),
+		AttachmentRepo:     mock_repository.NewMockAttachmentRepository(ctrl),
+		AttachmentStore:    mock_service.NewMockAttachmentStoreService(ctrl),
+		Logger:             mock_adapter.NewMockLoggerAdapter(ctrl),
+		AwayTimeout:        5 * time.Minute,
 	}
 	roomUseCase := roomcase.NewRoomUseCase(params)
+	userID := entity.UserID("user_1")
 
 	t.Run("正常系", func(t *testing.T) {
 		roomID := entity.RoomID("public_room_1")
 		newName := "Updated Room Name"
 
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleAdmin, nil)
 		mockRoomRepo.EXPECT().UpdateRoomName(context.Background(), roomID, newName).Return(nil)
 
-		err := roomUseCase.UpdateRoomName(context.Background(), roomcase.UpdateRoomNameRequest{RoomID: roomID, NewName: newName})
+		err := roomUseCase.UpdateRoomName(context.Background(), roomcase.UpdateRoomNameRequest{RoomID: roomID, UserID: userID, NewName: newName})
 
 		assert.NoError(t, err)
 	})
@@ -42,11 +58,22 @@ func TestUpdateRoomName(t *testing.T) {
 		newName := "Updated Room Name"
 		expectedErr := assert.AnError
 
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleOwner, nil)
 		mockRoomRepo.EXPECT().UpdateRoomName(context.Background(), roomID, newName).Return(expectedErr)
 
-		err := roomUseCase.UpdateRoomName(context.Background(), roomcase.UpdateRoomNameRequest{RoomID: roomID, NewName: newName})
+		err := roomUseCase.UpdateRoomName(context.Background(), roomcase.UpdateRoomNameRequest{RoomID: roomID, UserID: userID, NewName: newName})
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("一般メンバーは更新できない", func(t *testing.T) {
+		roomID := entity.RoomID("public_room_1")
+
+		mockRoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil)
+
+		err := roomUseCase.UpdateRoomName(context.Background(), roomcase.UpdateRoomNameRequest{RoomID: roomID, UserID: userID, NewName: "x"})
+
+		assert.ErrorIs(t, err, entity.ErrInsufficientRoomRole)
+	})
 }
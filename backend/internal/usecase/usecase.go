@@ -3,6 +3,7 @@ package usecase
 
 import (
 	"example.com/infrahandson/internal/usecase/messagecase"
+	"example.com/infrahandson/internal/usecase/presencecase"
 	"example.com/infrahandson/internal/usecase/roomcase"
 	"example.com/infrahandson/internal/usecase/usercase"
 	"example.com/infrahandson/internal/usecase/websocketcase"
@@ -15,4 +16,5 @@ type UseCase struct {
 	RoomUseCase      roomcase.RoomUseCaseInterface
 	MessageUseCase   messagecase.MessageUseCaseInterface
 	WebsocketUseCase websocketcase.WebsocketUseCaseInterface
+	PresenceUseCase  presencecase.PresenceUseCaseInterface
 }
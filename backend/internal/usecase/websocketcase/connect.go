@@ -14,16 +14,50 @@ type ConnectUserToRoomRequest struct {
 	Conn   service.WebSocketConnection
 }
 
+// ConnectUserToRoomResponse構造体: 接続・参加処理の結果
+type ConnectUserToRoomResponse struct {
+	ClientID entity.WsClientID // この接続を識別するID（切断時に指定する）
+}
+
+// AuthorizeConnectRequest構造体: 接続できるかを確認するリクエスト
+type AuthorizeConnectRequest struct {
+	UserID entity.UserID
+	RoomID entity.RoomID
+}
+
+// AuthorizeConnect 接続の確認
+// WebSocket にアップグレードする前に呼び出し、接続できない場合は HTTP のエラーとして返せるようにします。
+// 部屋が存在しない場合は repository.ErrRoomNotFound、メンバーでない場合は repository.ErrNotRoomMember、
+// 追放されている場合は entity.ErrRoomBanned を返します。
+func (w *WebsocketUseCase) AuthorizeConnect(ctx context.Context, req AuthorizeConnectRequest) error {
+	banned, err := w.moderationRepo.IsBanned(ctx, req.RoomID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return entity.ErrRoomBanned
+	}
+
+	_, err = w.roomRepo.GetMemberRole(ctx, req.RoomID, req.UserID)
+	return err
+}
+
 // ConnectUserToRoom 接続・参加処理
-func (w *WebsocketUseCase) ConnectUserToRoom(ctx context.Context, req ConnectUserToRoomRequest) error {
+// AuthorizeConnect の確認の後に追放・退出された場合に備えて、登録の前にもう一度確認します。
+// 接続できない場合は AuthorizeConnect と同じエラーを返します。
+func (w *WebsocketUseCase) ConnectUserToRoom(ctx context.Context, req ConnectUserToRoomRequest) (ConnectUserToRoomResponse, error) {
 	user, err := w.userRepo.GetUserByID(ctx, req.UserID)
 	if err != nil {
-		return err
+		return ConnectUserToRoomResponse{}, err
+	}
+
+	if err := w.AuthorizeConnect(ctx, AuthorizeConnectRequest{UserID: user.GetID(), RoomID: req.RoomID}); err != nil {
+		return ConnectUserToRoomResponse{}, err
 	}
 
 	id, err := w.clientIDFactory.NewWsClientID()
 	if err != nil {
-		return err
+		return ConnectUserToRoomResponse{}, err
 	}
 
 	client := entity.NewWebsocketClient(entity.WebsocketClientParams{
@@ -34,13 +68,15 @@ func (w *WebsocketUseCase) ConnectUserToRoom(ctx context.Context, req ConnectUse
 
 	err = w.wsClientRepo.CreateClient(ctx, client)
 	if err != nil {
-		return err
+		return ConnectUserToRoomResponse{}, err
 	}
 
-	err = w.websocketManager.Register(ctx, req.Conn, req.UserID, req.RoomID)
+	err = w.websocketManager.Register(ctx, client, req.Conn)
 	if err != nil {
-		return err
+		// 停止処理中などで登録できなかった場合は、作成したクライアントを残さない
+		_ = w.wsClientRepo.DeleteClient(ctx, id)
+		return ConnectUserToRoomResponse{}, err
 	}
 
-	return nil
+	return ConnectUserToRoomResponse{ClientID: id}, nil
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/usecase/websocketcase"
 	mock_service "example.com/infrahandson/test/mocks/domain/service"
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,8 @@ import (
 
 // 正常系
 // 異常系：ユーザ取得失敗
+// 異常系：部屋のメンバーでない
+// 異常系：部屋から追放されている
 // 異常系：クライアントID生成失敗
 // 異常系：クライアント作成失敗
 // 異常系：WebSocket登録失敗
@@ -41,9 +44,17 @@ func TestConnectUserToRoom(t *testing.T) {
 	t.Run("正常系", func(t *testing.T) {
 		// モックの期待値設定
 		mocks.UserRepo.EXPECT().GetUserByID(context.Background(), userID).Return(testUser, nil)
+		mocks.ModerationRepo.EXPECT().IsBanned(context.Background(), roomID, userID).Return(false, nil)
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil)
 		mocks.ClientIDFactory.EXPECT().NewWsClientID().Return(clientID, nil)
 		mocks.WsClientRepo.EXPECT().CreateClient(context.Background(), gomock.Any()).Return(nil)
-		mocks.WebsocketManager.EXPECT().Register(context.Background(), mockConn, userID, roomID).Return(nil)
+		mocks.WebsocketManager.EXPECT().Register(context.Background(), gomock.Any(), mockConn).DoAndReturn(
+			func(_ context.Context, client *entity.WebsocketClient, _ any) error {
+				assert.Equal(t, clientID, client.GetID())
+				assert.Equal(t, userID, client.GetUserID())
+				assert.Equal(t, roomID, client.GetRoomID())
+				return nil
+			})
 
 		// テスト実行
 		request := websocketcase.ConnectUserToRoomRequest{
@@ -51,10 +62,11 @@ func TestConnectUserToRoom(t *testing.T) {
 			RoomID: roomID,
 			Conn:   mockConn,
 		}
-		err := useCase.ConnectUserToRoom(context.Background(), request)
+		res, err := useCase.ConnectUserToRoom(context.Background(), request)
 
 		// 検証
 		assert.NoError(t, err)
+		assert.Equal(t, clientID, res.ClientID)
 	})
 
 	t.Run("異常系：ユーザ取得失敗", func(t *testing.T) {
@@ -66,15 +78,50 @@ func TestConnectUserToRoom(t *testing.T) {
 			RoomID: roomID,
 			Conn:   mockConn,
 		}
-		err := useCase.ConnectUserToRoom(context.Background(), request)
+		_, err := useCase.ConnectUserToRoom(context.Background(), request)
 
 		// 検証
 		assert.Error(t, err)
 	})
 
+	t.Run("異常系：部屋のメンバーでない", func(t *testing.T) {
+		// モックの期待値設定
+		mocks.UserRepo.EXPECT().GetUserByID(context.Background(), userID).Return(testUser, nil)
+		mocks.ModerationRepo.EXPECT().IsBanned(context.Background(), roomID, userID).Return(false, nil)
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+		// テスト実行
+		request := websocketcase.ConnectUserToRoomRequest{
+			UserID: userID,
+			RoomID: roomID,
+			Conn:   mockConn,
+		}
+		_, err := useCase.ConnectUserToRoom(context.Background(), request)
+
+		// 検証
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
+
+	t.Run("異常系：部屋から追放されている", func(t *testing.T) {
+		// モックの期待値設定
+		mocks.UserRepo.EXPECT().GetUserByID(context.Background(), userID).Return(testUser, nil)
+		mocks.ModerationRepo.EXPECT().IsBanned(context.Background(), roomID, userID).Return(true, nil)
+		// テスト実行
+		request := websocketcase.ConnectUserToRoomRequest{
+			UserID: userID,
+			RoomID: roomID,
+			Conn:   mockConn,
+		}
+		_, err := useCase.ConnectUserToRoom(context.Background(), request)
+
+		// 検証
+		assert.ErrorIs(t, err, entity.ErrRoomBanned)
+	})
+
 	t.Run("異常系：クライアントID生成失敗", func(t *testing.T) {
 		// モックの期待値設定
 		mocks.UserRepo.EXPECT().GetUserByID(context.Background(), userID).Return(testUser, nil)
+		mocks.ModerationRepo.EXPECT().IsBanned(context.Background(), roomID, userID).Return(false, nil)
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil)
 		mocks.ClientIDFactory.EXPECT().NewWsClientID().Return(entity.WsClientID(""), assert.AnError)
 		// テスト実行
 		request := websocketcase.ConnectUserToRoomRequest{
@@ -82,7 +129,7 @@ func TestConnectUserToRoom(t *testing.T) {
 			RoomID: roomID,
 			Conn:   mockConn,
 		}
-		err := useCase.ConnectUserToRoom(context.Background(), request)
+		_, err := useCase.ConnectUserToRoom(context.Background(), request)
 
 		// 検証
 		assert.Error(t, err)
@@ -91,6 +138,8 @@ func TestConnectUserToRoom(t *testing.T) {
 	t.Run("異常系：クライアント作成失敗", func(t *testing.T) {
 		// モックの期待値設定
 		mocks.UserRepo.EXPECT().GetUserByID(context.Background(), userID).Return(testUser, nil)
+		mocks.ModerationRepo.EXPECT().IsBanned(context.Background(), roomID, userID).Return(false, nil)
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil)
 		mocks.ClientIDFactory.EXPECT().NewWsClientID().Return(clientID, nil)
 		mocks.WsClientRepo.EXPECT().CreateClient(context.Background(), gomock.Any()).Return(assert.AnError)
 		// テスト実行
@@ -99,7 +148,7 @@ func TestConnectUserToRoom(t *testing.T) {
 			RoomID: roomID,
 			Conn:   mockConn,
 		}
-		err := useCase.ConnectUserToRoom(context.Background(), request)
+		_, err := useCase.ConnectUserToRoom(context.Background(), request)
 
 		// 検証
 		assert.Error(t, err)
@@ -108,18 +157,62 @@ func TestConnectUserToRoom(t *testing.T) {
 	t.Run("異常系：WebSocket登録失敗", func(t *testing.T) {
 		// モックの期待値設定
 		mocks.UserRepo.EXPECT().GetUserByID(context.Background(), userID).Return(testUser, nil)
+		mocks.ModerationRepo.EXPECT().IsBanned(context.Background(), roomID, userID).Return(false, nil)
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil)
 		mocks.ClientIDFactory.EXPECT().NewWsClientID().Return(clientID, nil)
 		mocks.WsClientRepo.EXPECT().CreateClient(context.Background(), gomock.Any()).Return(nil)
-		mocks.WebsocketManager.EXPECT().Register(context.Background(), mockConn, userID, roomID).Return(assert.AnError)
+		mocks.WebsocketManager.EXPECT().Register(context.Background(), gomock.Any(), mockConn).Return(assert.AnError)
+		mocks.WsClientRepo.EXPECT().DeleteClient(context.Background(), clientID).Return(nil)
 		// テスト実行
 		request := websocketcase.ConnectUserToRoomRequest{
 			UserID: userID,
 			RoomID: roomID,
 			Conn:   mockConn,
 		}
-		err := useCase.ConnectUserToRoom(context.Background(), request)
+		_, err := useCase.ConnectUserToRoom(context.Background(), request)
 
 		// 検証
 		assert.Error(t, err)
 	})
 }
+
+// 正常系
+// 異常系：部屋が存在しない
+// 異常系：部屋のメンバーでない
+// 異常系：部屋から追放されている
+
+func TestAuthorizeConnect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+	ctx := context.Background()
+	req := websocketcase.AuthorizeConnectRequest{UserID: "user123", RoomID: "room123"}
+
+	t.Run("正常系", func(t *testing.T) {
+		mocks.ModerationRepo.EXPECT().IsBanned(ctx, req.RoomID, req.UserID).Return(false, nil)
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRoleMember, nil)
+
+		assert.NoError(t, useCase.AuthorizeConnect(ctx, req))
+	})
+
+	t.Run("異常系：部屋が存在しない", func(t *testing.T) {
+		mocks.ModerationRepo.EXPECT().IsBanned(ctx, req.RoomID, req.UserID).Return(false, nil)
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRole(""), repository.ErrRoomNotFound)
+
+		assert.ErrorIs(t, useCase.AuthorizeConnect(ctx, req), repository.ErrRoomNotFound)
+	})
+
+	t.Run("異常系：部屋のメンバーでない", func(t *testing.T) {
+		mocks.ModerationRepo.EXPECT().IsBanned(ctx, req.RoomID, req.UserID).Return(false, nil)
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, req.RoomID, req.UserID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		assert.ErrorIs(t, useCase.AuthorizeConnect(ctx, req), repository.ErrNotRoomMember)
+	})
+
+	t.Run("異常系：部屋から追放されている", func(t *testing.T) {
+		mocks.ModerationRepo.EXPECT().IsBanned(ctx, req.RoomID, req.UserID).Return(true, nil)
+
+		assert.ErrorIs(t, useCase.AuthorizeConnect(ctx, req), entity.ErrRoomBanned)
+	})
+}
@@ -2,36 +2,37 @@ package websocketcase
 
 import (
 	"context"
+	"errors"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
 )
 
 // DisconnectUserRequest構造体: 切断処理リクエスト
+// 同じユーザーの他の接続には影響しない
 type DisconnectUserRequest struct {
-	UserID entity.UserID
+	ClientID entity.WsClientID
 }
 
 // DisconnectUser 切断処理
 func (w *WebsocketUseCase) DisconnectUser(ctx context.Context, req DisconnectUserRequest) error {
-	conn, err := w.websocketManager.GetConnectionByUserID(ctx, req.UserID)
+	client, err := w.wsClientRepo.GetClientByID(ctx, req.ClientID)
 	if err != nil {
 		return err
 	}
 
-	user, err := w.wsClientRepo.GetClientsByUserID(ctx, req.UserID)
-	if err != nil {
-		return err
-	}
-
-	err = w.websocketManager.Unregister(ctx, conn)
-	if err != nil {
+	// 送信に失敗したコネクションはマネージャーから自動的に登録解除されているため、
+	// その場合もクライアント情報の削除は行う
+	err = w.websocketManager.Unregister(ctx, client.GetID())
+	if err != nil && !errors.Is(err, service.ErrConnectionNotFound) {
 		return err
 	}
 
-	err = w.wsClientRepo.DeleteClient(ctx, user.GetID())
+	err = w.wsClientRepo.DeleteClient(ctx, client.GetID())
 	if err != nil {
 		return err
 	}
 
-	return nil
+	// typing.stop を送らずに切断した場合に備えて、入力を終了させる
+	return w.stopTyping(ctx, typingKey{roomID: client.GetRoomID(), userID: client.GetUserID()})
 }
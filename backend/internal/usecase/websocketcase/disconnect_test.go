@@ -5,17 +5,17 @@ import (
 	"testing"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/service"
 	"example.com/infrahandson/internal/usecase/websocketcase"
-	mock_service "example.com/infrahandson/test/mocks/domain/service"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
 
 // 1. 正常系
-// 2.GetConnectionByUserID失敗
-// 3.GetClientsByUserID失敗
-// 4.Unregister失敗
-// 5. DeleteClient失敗
+// 2. GetClientByID失敗
+// 3. Unregister失敗
+// 4. DeleteClient失敗
+// 5. コネクションが登録解除済み
 
 func TestDisconnectUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -23,96 +23,71 @@ func TestDisconnectUser(t *testing.T) {
 
 	useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
 
+	clientID := entity.WsClientID("client123")
+	mockClient := entity.NewWebsocketClient(entity.WebsocketClientParams{
+		ID:     clientID,
+		UserID: entity.UserID("user123"),
+		RoomID: entity.RoomID("room123"),
+	})
+
 	t.Run("正常系", func(t *testing.T) {
-		userID := entity.UserID("user123")
-		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
-		mockClient := entity.NewWebsocketClient(entity.WebsocketClientParams{
-			ID:     entity.WsClientID("client123"),
-			UserID: userID,
-			RoomID: entity.RoomID("room123"),
-		})
-
-		mocks.WebsocketManager.EXPECT().GetConnectionByUserID(context.Background(), userID).Return(mockConn, nil)
-		mocks.WsClientRepo.EXPECT().GetClientsByUserID(context.Background(), userID).Return(mockClient, nil)
-		mocks.WebsocketManager.EXPECT().Unregister(context.Background(), mockConn).Return(nil)
-		mocks.WsClientRepo.EXPECT().DeleteClient(context.Background(), mockClient.GetID()).Return(nil)
-
-		request := websocketcase.DisconnectUserRequest{UserID: userID}
+		mocks.WsClientRepo.EXPECT().GetClientByID(context.Background(), clientID).Return(mockClient, nil)
+		mocks.WebsocketManager.EXPECT().Unregister(context.Background(), clientID).Return(nil)
+		mocks.WsClientRepo.EXPECT().DeleteClient(context.Background(), clientID).Return(nil)
+
+		request := websocketcase.DisconnectUserRequest{ClientID: clientID}
 		err := useCase.DisconnectUser(context.Background(), request)
 
 		assert.NoError(t, err)
 	})
 
-	t.Run("GetConnectionByUserID失敗", func(t *testing.T) {
-		userID := entity.UserID("user123")
+	t.Run("GetClientByID失敗", func(t *testing.T) {
 		expectedErr := assert.AnError
 
-		mocks.WebsocketManager.EXPECT().GetConnectionByUserID(context.Background(), userID).Return(nil, expectedErr)
+		mocks.WsClientRepo.EXPECT().GetClientByID(context.Background(), clientID).Return(nil, expectedErr)
 
-		request := websocketcase.DisconnectUserRequest{UserID: userID}
+		request := websocketcase.DisconnectUserRequest{ClientID: clientID}
 		err := useCase.DisconnectUser(context.Background(), request)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 	})
 
-	t.Run("GetClientsByUserID失敗", func(t *testing.T) {
-		userID := entity.UserID("user123")
+	t.Run("Unregister失敗", func(t *testing.T) {
 		expectedErr := assert.AnError
 
-		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
-		mocks.WebsocketManager.EXPECT().GetConnectionByUserID(context.Background(), userID).Return(mockConn, nil)
-		mocks.WsClientRepo.EXPECT().GetClientsByUserID(context.Background(), userID).Return(nil, expectedErr)
+		mocks.WsClientRepo.EXPECT().GetClientByID(context.Background(), clientID).Return(mockClient, nil)
+		mocks.WebsocketManager.EXPECT().Unregister(context.Background(), clientID).Return(expectedErr)
 
-		request := websocketcase.DisconnectUserRequest{UserID: userID}
+		request := websocketcase.DisconnectUserRequest{ClientID: clientID}
 		err := useCase.DisconnectUser(context.Background(), request)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 	})
 
-	t.Run("Unregister失敗", func(t *testing.T) {
-		userID := entity.UserID("user123")
+	t.Run("DeleteClient失敗", func(t *testing.T) {
 		expectedErr := assert.AnError
 
-		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
-		mockClient := entity.NewWebsocketClient(entity.WebsocketClientParams{
-			ID:     entity.WsClientID("client123"),
-			UserID: userID,
-			RoomID: entity.RoomID("room123"),
-		})
-
-		mocks.WebsocketManager.EXPECT().GetConnectionByUserID(context.Background(), userID).Return(mockConn, nil)
-		mocks.WsClientRepo.EXPECT().GetClientsByUserID(context.Background(), userID).Return(mockClient, nil)
-		mocks.WebsocketManager.EXPECT().Unregister(context.Background(), mockConn).Return(expectedErr)
+		mocks.WsClientRepo.EXPECT().GetClientByID(context.Background(), clientID).Return(mockClient, nil)
+		mocks.WebsocketManager.EXPECT().Unregister(context.Background(), clientID).Return(nil)
+		mocks.WsClientRepo.EXPECT().DeleteClient(context.Background(), clientID).Return(expectedErr)
 
-		request := websocketcase.DisconnectUserRequest{UserID: userID}
+		request := websocketcase.DisconnectUserRequest{ClientID: clientID}
 		err := useCase.DisconnectUser(context.Background(), request)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 	})
 
-	t.Run("DeleteClient失敗", func(t *testing.T) {
-		userID := entity.UserID("user123")
-		expectedErr := assert.AnError
-
-		mockConn := mock_service.NewMockWebSocketConnection(ctrl)
-		mockClient := entity.NewWebsocketClient(entity.WebsocketClientParams{
-			ID:     entity.WsClientID("client123"),
-			UserID: userID,
-			RoomID: entity.RoomID("room123"),
-		})
-
-		mocks.WebsocketManager.EXPECT().GetConnectionByUserID(context.Background(), userID).Return(mockConn, nil)
-		mocks.WsClientRepo.EXPECT().GetClientsByUserID(context.Background(), userID).Return(mockClient, nil)
-		mocks.WebsocketManager.EXPECT().Unregister(context.Background(), mockConn).Return(nil)
-		mocks.WsClientRepo.EXPECT().DeleteClient(context.Background(), mockClient.GetID()).Return(expectedErr)
+	t.Run("コネクションが登録解除済み", func(t *testing.T) {
+		mocks.WsClientRepo.EXPECT().GetClientByID(context.Background(), clientID).Return(mockClient, nil)
+		mocks.WebsocketManager.EXPECT().Unregister(context.Background(), clientID).Return(service.ErrConnectionNotFound)
+		mocks.WsClientRepo.EXPECT().DeleteClient(context.Background(), clientID).Return(nil)
 
-		request := websocketcase.DisconnectUserRequest{UserID: userID}
+		request := websocketcase.DisconnectUserRequest{ClientID: clientID}
 		err := useCase.DisconnectUser(context.Background(), request)
 
-		assert.Error(t, err)
-		assert.Equal(t, expectedErr, err)
+		assert.NoError(t, err)
 	})
 }
@@ -0,0 +1,41 @@
+package websocketcase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidParentMessage は返信先のメッセージが存在しない・別の部屋にある・返信できない場合に返されます。
+// スレッドは1階層のみで、返信への返信はできません。
+var ErrInvalidParentMessage = errors.New("invalid parent message")
+
+// ErrInvalidAttachment は添付ファイルが存在しない・別の部屋や別のユーザーがアップロードした・添付済み・多すぎる場合に返されます。
+var ErrInvalidAttachment = errors.New("invalid attachment")
+
+// ErrRateLimited はスローモード・連投の制限によりメッセージを送信できない場合に返されます。
+// 再送できる日時は RateLimitError から取得します。
+var ErrRateLimited = errors.New("message rate limited")
+
+// RateLimitReason は送信を制限した理由
+type RateLimitReason string
+
+const (
+	RateLimitReasonSlowMode RateLimitReason = "slow_mode" // 部屋のスローモードの間隔が経過していない
+	RateLimitReasonBurst    RateLimitReason = "burst"     // 短い期間に送信できるメッセージ数を超えた
+)
+
+// RateLimitError は送信を制限した理由と再送できる日時を持つエラーです。
+// errors.Is(err, ErrRateLimited) で判定できます。
+type RateLimitError struct {
+	Reason     RateLimitReason
+	RetryAfter time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s (%s) until %s", ErrRateLimited, e.Reason, e.RetryAfter.UTC().Format(time.RFC3339))
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
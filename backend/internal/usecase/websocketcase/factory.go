@@ -2,6 +2,7 @@ package websocketcase
 
 import (
 	"errors"
+	"time"
 
 	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/domain/service"
@@ -11,12 +12,19 @@ import (
 type NewWebsocketUseCaseParams struct {
 	UserRepo         repository.UserRepository
 	RoomRepo         repository.RoomRepository
+	ModerationRepo   repository.RoomModerationRepository
 	MsgRepo          repository.MessageRepository
+	AttachmentRepo   repository.AttachmentRepository
 	MsgCache         service.MessageCacheService
 	WsClientRepo     repository.WebsocketClientRepository
 	WebsocketManager service.WebsocketManager
 	MsgIDFactory     factory.MessageIDFactory
 	ClientIDFactory  factory.WsClientIDFactory
+	// BurstLimit はユーザーが BurstWindow の間に1つの部屋へ送信できるメッセージ数（0 の場合は制限しない）
+	BurstLimit  int
+	BurstWindow time.Duration
+	// TypingTimeout は typing.start の送り直しがない場合に入力を終了したものとして扱うまでの時間
+	TypingTimeout time.Duration
 }
 
 func (p *NewWebsocketUseCaseParams) Validate() error {
@@ -26,9 +34,15 @@ func (p *NewWebsocketUseCaseParams) Validate() error {
 	if p.RoomRepo == nil {
 		return errors.New("RoomRepo is required")
 	}
+	if p.ModerationRepo == nil {
+		return errors.New("ModerationRepo is required")
+	}
 	if p.MsgRepo == nil {
 		return errors.New("MsgRepo is required")
 	}
+	if p.AttachmentRepo == nil {
+		return errors.New("AttachmentRepo is required")
+	}
 	if p.MsgCache == nil {
 		return errors.New("MsgCache is required")
 	}
@@ -44,6 +58,15 @@ func (p *NewWebsocketUseCaseParams) Validate() error {
 	if p.ClientIDFactory == nil {
 		return errors.New("ClientIDFactory is required")
 	}
+	if p.BurstLimit < 0 {
+		return errors.New("BurstLimit must not be negative")
+	}
+	if p.BurstLimit > 0 && p.BurstWindow <= 0 {
+		return errors.New("BurstWindow must be positive when BurstLimit is set")
+	}
+	if p.TypingTimeout <= 0 {
+		return errors.New("TypingTimeout must be positive")
+	}
 	return nil
 }
 
@@ -56,11 +79,18 @@ func NewWebsocketUseCase(params NewWebsocketUseCaseParams) WebsocketUseCaseInter
 	return &WebsocketUseCase{
 		userRepo:         params.UserRepo,
 		roomRepo:         params.RoomRepo,
+		moderationRepo:   params.ModerationRepo,
 		msgRepo:          params.MsgRepo,
+		attachmentRepo:   params.AttachmentRepo,
 		msgCache:         params.MsgCache,
 		wsClientRepo:     params.WsClientRepo,
 		websocketManager: params.WebsocketManager,
 		msgIDFactory:     params.MsgIDFactory,
 		clientIDFactory:  params.ClientIDFactory,
+		burstLimit:       params.BurstLimit,
+		burstWindow:      params.BurstWindow,
+		typingTimeout:    params.TypingTimeout,
+		typingTimers:     make(map[typingKey]*typingEntry),
+		senderLocks:      make(map[senderKey]*senderLock),
 	}
 }
@@ -3,12 +3,21 @@ package websocketcase
 import "context"
 
 type WebsocketUseCaseInterface interface {
+	// AuthorizeConnect: 接続の確認
+	AuthorizeConnect(ctx context.Context, req AuthorizeConnectRequest) error
+
 	// ConnectUserToRoom: 接続・参加処理
-	ConnectUserToRoom(ctx context.Context, req ConnectUserToRoomRequest) error
+	ConnectUserToRoom(ctx context.Context, req ConnectUserToRoomRequest) (ConnectUserToRoomResponse, error)
 
 	// SendMessage: メッセージ送信
-	SendMessage(ctx context.Context, req SendMessageRequest) error
+	SendMessage(ctx context.Context, req SendMessageRequest) (SendMessageResponse, error)
 
 	// DisconnectUser: 切断処理
 	DisconnectUser(ctx context.Context, req DisconnectUserRequest) error
+
+	// StartTyping: 入力開始
+	StartTyping(ctx context.Context, req TypingRequest) error
+
+	// StopTyping: 入力終了
+	StopTyping(ctx context.Context, req TypingRequest) error
 }
@@ -0,0 +1,83 @@
+package websocketcase
+
+import (
+	"context"
+	"slices"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// resolveMentions はメッセージの本文からメンションを読み取り、対象のメンバーごとのメンションを返します。
+// メンションはメッセージと同じトランザクションで保存するため、ここでは保存しません。
+// - @ユーザー名 は部屋のメンバーのうち名前が完全に一致するユーザー（同じ名前のメンバーはすべて）が対象です。
+// - @here は部屋に接続しているメンバーが対象です。接続はこのプロセスの WebsocketClientRepository で判定します。
+// - @room は部屋のメンバー全員が対象です。
+// 送信者自身は対象にしません。メンションをユーザーIDの順に返します。
+func (w *WebsocketUseCase) resolveMentions(ctx context.Context, msg *entity.Message) ([]*entity.Mention, error) {
+	targets := entity.ParseMentions(msg.GetContent())
+	if targets.IsEmpty() {
+		return nil, nil
+	}
+	roomID := msg.GetRoomID()
+
+	// 同じユーザーが複数の方法で対象になった場合は user > here > room の順に優先するため、優先度の低い順に上書きする
+	kinds := make(map[entity.UserID]entity.MentionKind)
+	if targets.Room {
+		userIDs, err := w.roomRepo.GetMemberIDs(ctx, roomID)
+		if err != nil {
+			return nil, err
+		}
+		for _, userID := range userIDs {
+			kinds[userID] = entity.MentionKindRoom
+		}
+	}
+	if targets.Here {
+		clients, err := w.wsClientRepo.GetClientsByRoomID(ctx, roomID)
+		if err != nil {
+			return nil, err
+		}
+		for _, client := range clients {
+			kinds[client.GetUserID()] = entity.MentionKindHere
+		}
+	}
+	if len(targets.Names) > 0 {
+		userIDs, err := w.roomRepo.GetMemberIDsByNames(ctx, roomID, targets.Names)
+		if err != nil {
+			return nil, err
+		}
+		for _, userID := range userIDs {
+			kinds[userID] = entity.MentionKindUser
+		}
+	}
+	delete(kinds, msg.GetUserID())
+	if len(kinds) == 0 {
+		return nil, nil
+	}
+
+	userIDs := make([]entity.UserID, 0, len(kinds))
+	for userID := range kinds {
+		userIDs = append(userIDs, userID)
+	}
+	slices.Sort(userIDs)
+
+	mentions := make([]*entity.Mention, len(userIDs))
+	for i, userID := range userIDs {
+		mentions[i] = entity.NewMention(entity.MentionParams{
+			MessageID: msg.GetID(),
+			RoomID:    roomID,
+			UserID:    userID,
+			Kind:      kinds[userID],
+			CreatedAt: msg.GetSentAt(),
+		})
+	}
+	return mentions, nil
+}
+
+// notifyMentions はメンションされたユーザーのすべてのコネクションに mention.created を送信します。
+// 別の部屋に接続しているユーザーにも届きます。
+// メッセージは送信済みのため、通知に失敗したユーザーがいても他のユーザーへの通知を続け、エラーにしない
+func (w *WebsocketUseCase) notifyMentions(ctx context.Context, msg *entity.Message, mentions []*entity.Mention) {
+	for _, mention := range mentions {
+		_ = w.websocketManager.SendToUser(ctx, mention.GetUserID(), entity.NewMentionCreatedEvent(mention, msg))
+	}
+}
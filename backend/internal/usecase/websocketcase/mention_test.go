@@ -0,0 +1,169 @@
+package websocketcase_test
+
+import (
+	"context"
+	"testing"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/websocketcase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// ParseMentions は行頭・空白の直後の @ のみをメンションとして読み取る
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		content string
+		want    entity.MentionTargets
+	}{
+		{"hello", entity.MentionTargets{}},
+		{"@alice hi", entity.MentionTargets{Names: []string{"alice"}}},
+		{"hi @alice, @bob! and @alice again", entity.MentionTargets{Names: []string{"alice", "bob"}}},
+		{"(cc @alice)", entity.MentionTargets{Names: []string{"alice"}}},
+		{"mail alice@example.com", entity.MentionTargets{}},
+		{"@ alone", entity.MentionTargets{}},
+		{"@here please look", entity.MentionTargets{Here: true}},
+		{"@room: meeting\n@here", entity.MentionTargets{Here: true, Room: true}},
+		{"@たなか さん", entity.MentionTargets{Names: []string{"たなか"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.content, func(t *testing.T) {
+			assert.Equal(t, tt.want, entity.ParseMentions(tt.content))
+		})
+	}
+}
+
+// 1. 正常系：@ユーザー名・@here・@room のメンションを保存し、メンションされたユーザーに通知する
+// 2. 正常系：メンションが自分だけの場合は保存しない
+// 3. 正常系：通知に失敗しても送信は成功する
+// 4. 異常系：メッセージとメンションの保存に失敗
+func TestSendMessage_Mentions(t *testing.T) {
+	ctx := context.Background()
+	roomID := entity.RoomID("room123")
+	senderID := entity.UserID("user123")
+	messageID := entity.MessageID("msg123")
+
+	t.Run("1. 正常系：@ユーザー名・@here・@room のメンションを保存し、メンションされたユーザーに通知する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(messageID, nil)
+		// alice は @alice と @room、bob は @here と @room、carol は @room のみで対象になる
+		mocks.RoomRepo.EXPECT().GetMemberIDs(ctx, roomID).Return([]entity.UserID{"alice", "bob", "carol", senderID}, nil)
+		mocks.WsClientRepo.EXPECT().GetClientsByRoomID(ctx, roomID).Return([]*entity.WebsocketClient{
+			entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "c1", UserID: "bob", RoomID: roomID}),
+			entity.NewWebsocketClient(entity.WebsocketClientParams{ID: "c2", UserID: senderID, RoomID: roomID}),
+		}, nil)
+		mocks.RoomRepo.EXPECT().GetMemberIDsByNames(ctx, roomID, []string{"alice", "user123"}).Return([]entity.UserID{"alice", senderID}, nil)
+
+		// メンションはメッセージと一緒に保存する
+		var saved []*entity.Mention
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *entity.Message, mentions []*entity.Mention) error {
+				saved = mentions
+				return nil
+			})
+		mocks.MsgCache.EXPECT().AddMessage(ctx, roomID, gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+		notified := map[entity.UserID]entity.MentionKind{}
+		mocks.WebsocketManager.EXPECT().SendToUser(ctx, gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+			func(_ context.Context, userID entity.UserID, event *entity.WebsocketEvent) error {
+				assert.Equal(t, entity.WebsocketEventTypeMentionCreated, event.GetType())
+				payload := event.GetPayload().(entity.MentionCreatedPayload)
+				assert.Equal(t, messageID, payload.Message.GetID())
+				notified[userID] = payload.Kind
+				return nil
+			})
+
+		res, err := useCase.SendMessage(ctx, websocketcase.SendMessageRequest{
+			RoomID:  roomID,
+			Sender:  senderID,
+			Content: "@room @here @alice @user123 please check",
+		})
+		require.NoError(t, err)
+
+		require.Len(t, saved, 3)
+		want := map[entity.UserID]entity.MentionKind{
+			"alice": entity.MentionKindUser,
+			"bob":   entity.MentionKindHere,
+			"carol": entity.MentionKindRoom,
+		}
+		for i, userID := range []entity.UserID{"alice", "bob", "carol"} {
+			assert.Equal(t, userID, saved[i].GetUserID())
+			assert.Equal(t, want[userID], saved[i].GetKind())
+			assert.Equal(t, messageID, saved[i].GetMessageID())
+			assert.Equal(t, roomID, saved[i].GetRoomID())
+			assert.Equal(t, res.Message.GetSentAt(), saved[i].GetCreatedAt())
+		}
+		assert.Equal(t, want, notified)
+	})
+
+	t.Run("2. 正常系：メンションが自分だけの場合は保存しない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(messageID, nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Len(0)).Return(nil)
+		mocks.RoomRepo.EXPECT().GetMemberIDsByNames(ctx, roomID, []string{"user123", "nobody"}).Return([]entity.UserID{senderID}, nil)
+		mocks.MsgCache.EXPECT().AddMessage(ctx, roomID, gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+
+		_, err := useCase.SendMessage(ctx, websocketcase.SendMessageRequest{
+			RoomID:  roomID,
+			Sender:  senderID,
+			Content: "note to @user123 and @nobody",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("3. 正常系：通知に失敗しても送信は成功する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(messageID, nil)
+		mocks.RoomRepo.EXPECT().GetMemberIDsByNames(ctx, roomID, []string{"alice", "bob"}).Return([]entity.UserID{"alice", "bob"}, nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Len(2)).Return(nil)
+		mocks.MsgCache.EXPECT().AddMessage(ctx, roomID, gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+		// 1人目への通知に失敗しても2人目に通知する
+		mocks.WebsocketManager.EXPECT().SendToUser(ctx, entity.UserID("alice"), gomock.Any()).Return(assert.AnError)
+		mocks.WebsocketManager.EXPECT().SendToUser(ctx, entity.UserID("bob"), gomock.Any()).Return(nil)
+
+		_, err := useCase.SendMessage(ctx, websocketcase.SendMessageRequest{
+			RoomID:  roomID,
+			Sender:  senderID,
+			Content: "@alice @bob",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("4. 異常系：メッセージとメンションの保存に失敗", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(messageID, nil)
+		mocks.RoomRepo.EXPECT().GetMemberIDs(ctx, roomID).Return([]entity.UserID{"alice"}, nil)
+		// メッセージも保存されないため、キャッシュ・配信・通知はしない
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Len(1)).Return(assert.AnError)
+
+		_, err := useCase.SendMessage(ctx, websocketcase.SendMessageRequest{
+			RoomID:  roomID,
+			Sender:  senderID,
+			Content: "@room",
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
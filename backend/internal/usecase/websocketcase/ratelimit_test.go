@@ -0,0 +1,189 @@
+package websocketcase_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/usecase/websocketcase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSendMessage_RateLimit(t *testing.T) {
+	ctx := context.Background()
+	roomID := entity.RoomID("room123")
+	senderID := entity.UserID("user123")
+	request := websocketcase.SendMessageRequest{
+		RoomID:  roomID,
+		Sender:  senderID,
+		Content: "Hello, World!",
+	}
+	slowRoom := entity.NewRoom(entity.RoomParams{ID: roomID, SlowMode: 30 * time.Second})
+
+	t.Run("異常系：スローモードの間隔が経過していない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+		lastSentAt := time.Now().Add(-10 * time.Second)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(slowRoom, nil)
+		mocks.MsgRepo.EXPECT().GetUserSentTimesSince(ctx, roomID, senderID, gomock.Any(), 1).Return([]time.Time{lastSentAt}, nil)
+
+		_, err := useCase.SendMessage(ctx, request)
+
+		assert.ErrorIs(t, err, websocketcase.ErrRateLimited)
+		var limitErr *websocketcase.RateLimitError
+		require.True(t, errors.As(err, &limitErr))
+		assert.Equal(t, websocketcase.RateLimitReasonSlowMode, limitErr.Reason)
+		assert.Equal(t, lastSentAt.Add(30*time.Second), limitErr.RetryAfter)
+	})
+
+	t.Run("正常系：スローモードの間隔が経過している", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(slowRoom, nil)
+		mocks.MsgRepo.EXPECT().GetUserSentTimesSince(ctx, roomID, senderID, gomock.Any(), 1).Return(nil, nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID("msg123"), nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		mocks.MsgCache.EXPECT().AddMessage(ctx, roomID, gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+
+		_, err := useCase.SendMessage(ctx, request)
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常系：管理者はスローモードの対象外", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleAdmin, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(slowRoom, nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID("msg123"), nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		mocks.MsgCache.EXPECT().AddMessage(ctx, roomID, gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+
+		_, err := useCase.SendMessage(ctx, request)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系：連投の制限を超えた", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCaseWithBurstLimit(ctrl, 3, 10*time.Second)
+		now := time.Now()
+		sentTimes := []time.Time{now.Add(-time.Second), now.Add(-2 * time.Second), now.Add(-4 * time.Second)}
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleAdmin, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(slowRoom, nil)
+		mocks.MsgRepo.EXPECT().GetUserSentTimesSince(ctx, roomID, senderID, gomock.Any(), 3).Return(sentTimes, nil)
+
+		_, err := useCase.SendMessage(ctx, request)
+
+		var limitErr *websocketcase.RateLimitError
+		require.True(t, errors.As(err, &limitErr))
+		assert.Equal(t, websocketcase.RateLimitReasonBurst, limitErr.Reason)
+		// 期間内の最も古い送信が期間外になる日時
+		assert.Equal(t, sentTimes[2].Add(10*time.Second), limitErr.RetryAfter)
+	})
+
+	t.Run("正常系：連投の上限未満", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCaseWithBurstLimit(ctrl, 3, 10*time.Second)
+		now := time.Now()
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mocks.MsgRepo.EXPECT().GetUserSentTimesSince(ctx, roomID, senderID, gomock.Any(), 3).
+			Return([]time.Time{now.Add(-time.Second), now.Add(-2 * time.Second)}, nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID("msg123"), nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		mocks.MsgCache.EXPECT().AddMessage(ctx, roomID, gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+
+		_, err := useCase.SendMessage(ctx, request)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系：両方の制限を超えた場合は再送できる日時が遅い方を返す", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCaseWithBurstLimit(ctrl, 2, 10*time.Second)
+		now := time.Now()
+		sentTimes := []time.Time{now.Add(-5 * time.Second), now.Add(-8 * time.Second)}
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(slowRoom, nil)
+		mocks.MsgRepo.EXPECT().GetUserSentTimesSince(ctx, roomID, senderID, gomock.Any(), 2).Return(sentTimes, nil)
+
+		_, err := useCase.SendMessage(ctx, request)
+
+		var limitErr *websocketcase.RateLimitError
+		require.True(t, errors.As(err, &limitErr))
+		assert.Equal(t, websocketcase.RateLimitReasonSlowMode, limitErr.Reason)
+		assert.Equal(t, sentTimes[0].Add(30*time.Second), limitErr.RetryAfter)
+	})
+
+	t.Run("異常系：同時に送信しても制限を超えない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		// 保存したメッセージの送信日時を、以降の確認で返す
+		var mu sync.Mutex
+		var sentTimes []time.Time
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil).Times(2)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil).Times(2)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(slowRoom, nil).Times(2)
+		mocks.MsgRepo.EXPECT().GetUserSentTimesSince(ctx, roomID, senderID, gomock.Any(), 1).DoAndReturn(
+			func(context.Context, entity.RoomID, entity.UserID, time.Time, int) ([]time.Time, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				return append([]time.Time(nil), sentTimes...), nil
+			}).Times(2)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID("msg123"), nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, msg *entity.Message, _ []*entity.Mention) error {
+				// 確認から保存までの間に、もう一方の送信が確認できる時間を空ける
+				time.Sleep(20 * time.Millisecond)
+				mu.Lock()
+				defer mu.Unlock()
+				sentTimes = append(sentTimes, msg.GetSentAt())
+				return nil
+			})
+		mocks.MsgCache.EXPECT().AddMessage(ctx, roomID, gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+
+		errs := make([]error, 2)
+		var wg sync.WaitGroup
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = useCase.SendMessage(ctx, request)
+			}()
+		}
+		wg.Wait()
+
+		// 一方だけが送信でき、もう一方はスローモードで拒否される
+		var limited int
+		for _, err := range errs {
+			if errors.Is(err, websocketcase.ErrRateLimited) {
+				limited++
+			} else {
+				assert.NoError(t, err)
+			}
+		}
+		assert.Equal(t, 1, limited)
+	})
+}
@@ -2,9 +2,13 @@ package websocketcase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 )
 
 // SendMessageRequest構造体: メッセージ送信リクエスト
@@ -12,35 +16,248 @@ type SendMessageRequest struct {
 	RoomID  entity.RoomID
 	Sender  entity.UserID
 	Content string
+	// ParentID はスレッドに返信する場合の返信先のメッセージID（省略時は部屋への投稿）
+	ParentID entity.MessageID
+	// AttachmentIDs は添付する、送信者がこの部屋にアップロードしたファイル（省略時は添付しない）
+	AttachmentIDs []entity.AttachmentID
+}
+
+// SendMessageResponse構造体: メッセージ送信結果
+type SendMessageResponse struct {
+	Message *entity.Message // 作成したメッセージ
 }
 
 // SendMessage メッセージ送信
-func (w *WebsocketUseCase) SendMessage(ctx context.Context, req SendMessageRequest) error {
+// 接続後に部屋を退出した場合に備えて、送信のたびにメンバーであることを確認します。
+// 発言を禁止されている場合は期限を含めた entity.ErrUserMuted を返します（接続は維持します）。
+// スローモード・連投の制限を超えた場合は、再送できる日時を含めた *RateLimitError を返します。
+// 添付ファイルが不正な場合は ErrInvalidAttachment を返します。
+// 本文の @ユーザー名・@here・@room はメンションとして保存し、メンションされたユーザーに mention.created を送信します。
+func (w *WebsocketUseCase) SendMessage(ctx context.Context, req SendMessageRequest) (SendMessageResponse, error) {
+	role, err := w.roomRepo.GetMemberRole(ctx, req.RoomID, req.Sender)
+	if err != nil {
+		return SendMessageResponse{}, err
+	}
+
+	mutedUntil, err := w.moderationRepo.GetMutedUntil(ctx, req.RoomID, req.Sender)
+	if err != nil {
+		return SendMessageResponse{}, err
+	}
+	if mutedUntil != nil && mutedUntil.After(time.Now()) {
+		return SendMessageResponse{}, fmt.Errorf("%w until %s", entity.ErrUserMuted, mutedUntil.UTC().Format(time.RFC3339))
+	}
+
+	// アーカイブされた部屋は閲覧のみ可能
+	room, err := w.roomRepo.GetRoomByID(ctx, req.RoomID)
+	if err != nil {
+		return SendMessageResponse{}, err
+	}
+	if room.IsArchived() {
+		return SendMessageResponse{}, entity.ErrRoomArchived
+	}
+
+	msg, mentions, err := w.createMessage(ctx, req, room, role)
+	if err != nil {
+		return SendMessageResponse{}, err
+	}
+
+	// スレッドの返信は部屋のメッセージ一覧に含めないため、キャッシュしない
+	if !msg.IsReply() {
+		if err := w.msgCache.AddMessage(ctx, req.RoomID, msg); err != nil {
+			return SendMessageResponse{}, err
+		}
+	}
+
+	err = w.websocketManager.BroadcastToRoom(ctx, req.RoomID, entity.NewMessageCreatedEvent(msg))
+	if err != nil {
+		return SendMessageResponse{}, err
+	}
+
+	w.notifyMentions(ctx, msg, mentions)
+
+	// 送信したら入力は終了している
+	// メッセージは送信済みのため、入力終了の配信に失敗してもエラーにしない
+	_ = w.stopTyping(ctx, typingKey{roomID: req.RoomID, userID: req.Sender})
+
+	return SendMessageResponse{Message: msg}, nil
+}
+
+// createMessage はレート制限を確認してからメッセージを保存する
+// 確認から保存までを部屋とユーザーの組ごとに直列化し、同時に送信されたメッセージが
+// 同じ送信履歴で確認されて制限を超えることを防ぐ（複数ノードの場合はノードごとの直列化になる）
+func (w *WebsocketUseCase) createMessage(ctx context.Context, req SendMessageRequest, room *entity.Room, role entity.RoomRole) (*entity.Message, []*entity.Mention, error) {
+	unlock := w.lockSender(senderKey{roomID: req.RoomID, userID: req.Sender})
+	defer unlock()
+
+	if err := w.checkRateLimit(ctx, room, req.Sender, role, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	if req.ParentID != "" {
+		if err := w.validateParentMessage(ctx, req.RoomID, req.ParentID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	attachments, err := w.getAttachableAttachments(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	id, err := w.msgIDFactory.NewMessageID()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	msg := entity.NewMessage(entity.MessageParams{
-		ID:      id,
-		RoomID:  req.RoomID,
-		UserID:  req.Sender,
-		Content: req.Content,
-		SentAt:  time.Now(),
+		ID:       id,
+		RoomID:   req.RoomID,
+		UserID:   req.Sender,
+		ParentID: req.ParentID,
+		Content:  req.Content,
+		SentAt:   time.Now(),
+		// 配信する message.created に添付ファイルを含める
+		Attachments: attachments,
 	})
 
-	if err := w.msgRepo.CreateMessage(ctx, msg); err != nil {
-		return err
+	mentions, err := w.resolveMentions(ctx, msg)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if err := w.msgCache.AddMessage(ctx, req.RoomID, msg); err != nil {
+	// 添付ファイルはメッセージと同じトランザクションで添付済みにする
+	// 確認後に同じファイルが別のメッセージに添付された場合は、このメッセージを保存せずに失敗する
+	err = w.msgRepo.CreateMessageWithMentions(ctx, msg, mentions)
+	if errors.Is(err, repository.ErrAttachmentAlreadyAttached) {
+		return nil, nil, ErrInvalidAttachment
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, attachment := range attachments {
+		attachment.AttachTo(id)
+	}
+	return msg, mentions, nil
+}
+
+// senderKey は送信を直列化するキー（部屋とユーザーの組）
+type senderKey struct {
+	roomID entity.RoomID
+	userID entity.UserID
+}
+
+// senderLock は送信中の部屋とユーザーの組のロック
+type senderLock struct {
+	mu   sync.Mutex
+	refs int // ロックを保持・待機している送信の数（0 になったら削除する）
+}
+
+// lockSender は部屋とユーザーの組のロックを取得し、解放する関数を返す
+func (w *WebsocketUseCase) lockSender(key senderKey) func() {
+	w.senderMu.Lock()
+	lock, ok := w.senderLocks[key]
+	if !ok {
+		lock = &senderLock{}
+		w.senderLocks[key] = lock
+	}
+	lock.refs++
+	w.senderMu.Unlock()
+
+	lock.mu.Lock()
+	return func() {
+		lock.mu.Unlock()
+		w.senderMu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(w.senderLocks, key)
+		}
+		w.senderMu.Unlock()
+	}
+}
+
+// validateParentMessage は parentID のメッセージに返信できるかを確認する
+func (w *WebsocketUseCase) validateParentMessage(ctx context.Context, roomID entity.RoomID, parentID entity.MessageID) error {
+	parent, err := w.msgRepo.GetMessageByID(ctx, parentID)
+	if errors.Is(err, repository.ErrMessageNotFound) {
+		return ErrInvalidParentMessage
+	}
+	if err != nil {
 		return err
 	}
+	if parent.GetRoomID() != roomID || parent.IsReply() || parent.IsDeleted() {
+		return ErrInvalidParentMessage
+	}
+	return nil
+}
+
+// getAttachableAttachments は添付するファイルを req.AttachmentIDs の順に返す
+// 送信者がこの部屋にアップロードし、まだどのメッセージにも添付していないファイルのみ添付できる
+func (w *WebsocketUseCase) getAttachableAttachments(ctx context.Context, req SendMessageRequest) ([]*entity.Attachment, error) {
+	if len(req.AttachmentIDs) == 0 {
+		return nil, nil
+	}
+	if len(req.AttachmentIDs) > entity.MaxMessageAttachments {
+		return nil, ErrInvalidAttachment
+	}
+
+	found, err := w.attachmentRepo.GetAttachmentsByIDs(ctx, req.AttachmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[entity.AttachmentID]*entity.Attachment, len(found))
+	for _, attachment := range found {
+		byID[attachment.GetID()] = attachment
+	}
+	// 同じIDが重複している場合も不正とする
+	if len(byID) != len(req.AttachmentIDs) {
+		return nil, ErrInvalidAttachment
+	}
 
-	err = w.websocketManager.BroadcastToRoom(ctx, req.RoomID, msg)
+	attachments := make([]*entity.Attachment, len(req.AttachmentIDs))
+	for i, id := range req.AttachmentIDs {
+		attachment, ok := byID[id]
+		if !ok || attachment.GetRoomID() != req.RoomID || attachment.GetUploaderID() != req.Sender || attachment.IsAttached() {
+			return nil, ErrInvalidAttachment
+		}
+		attachments[i] = attachment
+	}
+	return attachments, nil
+}
+
+// checkRateLimit は部屋のスローモードとユーザーごとの連投の制限を確認します。
+// 管理者以上はスローモードの対象外ですが、連投の制限は受けます。
+// 両方の制限を超えている場合は、再送できる日時が遅い方を返します。
+func (w *WebsocketUseCase) checkRateLimit(ctx context.Context, room *entity.Room, sender entity.UserID, role entity.RoomRole, now time.Time) error {
+	slowMode := room.GetSlowMode()
+	if role.AtLeast(entity.RoomRoleAdmin) {
+		slowMode = 0
+	}
+	if slowMode <= 0 && w.burstLimit <= 0 {
+		return nil
+	}
+
+	// 両方の制限を確認できる範囲の送信日時をまとめて取得する
+	lookback := max(slowMode, w.burstWindow)
+	sentTimes, err := w.msgRepo.GetUserSentTimesSince(ctx, room.GetID(), sender, now.Add(-lookback), max(w.burstLimit, 1))
 	if err != nil {
 		return err
 	}
 
+	var limitErr *RateLimitError
+	if slowMode > 0 && len(sentTimes) > 0 {
+		if retryAfter := sentTimes[0].Add(slowMode); retryAfter.After(now) {
+			limitErr = &RateLimitError{Reason: RateLimitReasonSlowMode, RetryAfter: retryAfter}
+		}
+	}
+	if w.burstLimit > 0 && len(sentTimes) >= w.burstLimit {
+		// 期間内の最も古い送信が期間外になれば、もう1件送信できる
+		oldest := sentTimes[w.burstLimit-1]
+		if retryAfter := oldest.Add(w.burstWindow); retryAfter.After(now) && (limitErr == nil || retryAfter.After(limitErr.RetryAfter)) {
+			limitErr = &RateLimitError{Reason: RateLimitReasonBurst, RetryAfter: retryAfter}
+		}
+	}
+	if limitErr != nil {
+		return limitErr
+	}
 	return nil
 }
@@ -2,11 +2,15 @@ package websocketcase_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/usecase/websocketcase"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -22,8 +26,11 @@ func TestSendMessage(t *testing.T) {
 		content := "Hello, World!"
 		messageID := entity.MessageID("msg123")
 
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(context.Background(), roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
 		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(messageID, nil)
-		mocks.MsgRepo.EXPECT().CreateMessage(context.Background(), gomock.Any()).Return(nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 		mocks.MsgCache.EXPECT().AddMessage(context.Background(), roomID, gomock.Any()).Return(nil)
 		mocks.WebsocketManager.EXPECT().BroadcastToRoom(context.Background(), roomID, gomock.Any()).Return(nil)
 
@@ -32,9 +39,11 @@ func TestSendMessage(t *testing.T) {
 			Sender:  senderID,
 			Content: content,
 		}
-		err := useCase.SendMessage(context.Background(), request)
+		res, err := useCase.SendMessage(context.Background(), request)
 
 		assert.NoError(t, err)
+		assert.Equal(t, messageID, res.Message.GetID())
+		assert.Equal(t, content, res.Message.GetContent())
 	})
 
 	t.Run("異常系：メッセージID生成失敗", func(t *testing.T) {
@@ -42,6 +51,9 @@ func TestSendMessage(t *testing.T) {
 		senderID := entity.UserID("user123")
 		content := "Hello, World!"
 
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(context.Background(), roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
 		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID(""), assert.AnError)
 
 		request := websocketcase.SendMessageRequest{
@@ -49,7 +61,7 @@ func TestSendMessage(t *testing.T) {
 			Sender:  senderID,
 			Content: content,
 		}
-		err := useCase.SendMessage(context.Background(), request)
+		_, err := useCase.SendMessage(context.Background(), request)
 
 		assert.Error(t, err)
 	})
@@ -60,15 +72,18 @@ func TestSendMessage(t *testing.T) {
 		content := "Hello, World!"
 		messageID := entity.MessageID("msg123")
 
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(context.Background(), roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
 		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(messageID, nil)
-		mocks.MsgRepo.EXPECT().CreateMessage(context.Background(), gomock.Any()).Return(assert.AnError)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(context.Background(), gomock.Any(), gomock.Any()).Return(assert.AnError)
 
 		request := websocketcase.SendMessageRequest{
 			RoomID:  roomID,
 			Sender:  senderID,
 			Content: content,
 		}
-		err := useCase.SendMessage(context.Background(), request)
+		_, err := useCase.SendMessage(context.Background(), request)
 
 		assert.Error(t, err)
 	})
@@ -79,8 +94,11 @@ func TestSendMessage(t *testing.T) {
 		content := "Hello, World!"
 		messageID := entity.MessageID("msg123")
 
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(context.Background(), roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
 		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(messageID, nil)
-		mocks.MsgRepo.EXPECT().CreateMessage(context.Background(), gomock.Any()).Return(nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 		mocks.MsgCache.EXPECT().AddMessage(context.Background(), roomID, gomock.Any()).Return(assert.AnError)
 
 		request := websocketcase.SendMessageRequest{
@@ -88,7 +106,7 @@ func TestSendMessage(t *testing.T) {
 			Sender:  senderID,
 			Content: content,
 		}
-		err := useCase.SendMessage(context.Background(), request)
+		_, err := useCase.SendMessage(context.Background(), request)
 
 		assert.Error(t, err)
 	})
@@ -99,8 +117,11 @@ func TestSendMessage(t *testing.T) {
 		content := "Hello, World!"
 		messageID := entity.MessageID("msg123")
 
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(context.Background(), roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
 		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(messageID, nil)
-		mocks.MsgRepo.EXPECT().CreateMessage(context.Background(), gomock.Any()).Return(nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
 		mocks.MsgCache.EXPECT().AddMessage(context.Background(), roomID, gomock.Any()).Return(nil)
 		mocks.WebsocketManager.EXPECT().BroadcastToRoom(context.Background(), roomID, gomock.Any()).Return(assert.AnError)
 
@@ -109,8 +130,264 @@ func TestSendMessage(t *testing.T) {
 			Sender:  senderID,
 			Content: content,
 		}
-		err := useCase.SendMessage(context.Background(), request)
+		_, err := useCase.SendMessage(context.Background(), request)
 
 		assert.Error(t, err)
 	})
+
+	t.Run("異常系：部屋のメンバーでない", func(t *testing.T) {
+		roomID := entity.RoomID("room123")
+		senderID := entity.UserID("outsider")
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, senderID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		request := websocketcase.SendMessageRequest{
+			RoomID:  roomID,
+			Sender:  senderID,
+			Content: "Hello, World!",
+		}
+		_, err := useCase.SendMessage(context.Background(), request)
+
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
+
+	t.Run("異常系：アーカイブされた部屋", func(t *testing.T) {
+		roomID := entity.RoomID("room123")
+		senderID := entity.UserID("user123")
+		archivedAt := time.Now()
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(context.Background(), roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID, ArchivedAt: &archivedAt}), nil)
+
+		request := websocketcase.SendMessageRequest{
+			RoomID:  roomID,
+			Sender:  senderID,
+			Content: "Hello, World!",
+		}
+		_, err := useCase.SendMessage(context.Background(), request)
+
+		assert.ErrorIs(t, err, entity.ErrRoomArchived)
+	})
+
+	t.Run("異常系：発言を禁止されている", func(t *testing.T) {
+		roomID := entity.RoomID("room123")
+		senderID := entity.UserID("user123")
+		mutedUntil := time.Now().Add(time.Hour)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(context.Background(), roomID, senderID).Return(&mutedUntil, nil)
+
+		request := websocketcase.SendMessageRequest{
+			RoomID:  roomID,
+			Sender:  senderID,
+			Content: "Hello, World!",
+		}
+		_, err := useCase.SendMessage(context.Background(), request)
+
+		assert.ErrorIs(t, err, entity.ErrUserMuted)
+		assert.Contains(t, err.Error(), mutedUntil.UTC().Format(time.RFC3339))
+	})
+
+	t.Run("正常系：期限切れの発言禁止は無視する", func(t *testing.T) {
+		roomID := entity.RoomID("room123")
+		senderID := entity.UserID("user123")
+		mutedUntil := time.Now().Add(-time.Minute)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(context.Background(), roomID, senderID).Return(&mutedUntil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID("msg456"), nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
+		mocks.MsgCache.EXPECT().AddMessage(context.Background(), roomID, gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(context.Background(), roomID, gomock.Any()).Return(nil)
+
+		request := websocketcase.SendMessageRequest{
+			RoomID:  roomID,
+			Sender:  senderID,
+			Content: "Hello, World!",
+		}
+		_, err := useCase.SendMessage(context.Background(), request)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestSendMessage_Reply(t *testing.T) {
+	ctx := context.Background()
+	roomID := entity.RoomID("room123")
+	parent := entity.NewMessage(entity.MessageParams{
+		ID:     "parent",
+		RoomID: roomID,
+		UserID: "user456",
+	})
+	request := websocketcase.SendMessageRequest{
+		RoomID:   roomID,
+		Sender:   "user123",
+		Content:  "reply",
+		ParentID: "parent",
+	}
+
+	t.Run("正常系：返信はキャッシュせずに配信する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, request.Sender).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, request.Sender).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+
+		mocks.MsgRepo.EXPECT().GetMessageByID(ctx, entity.MessageID("parent")).Return(parent, nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID("reply1"), nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).Return(nil)
+
+		res, err := useCase.SendMessage(ctx, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.MessageID("parent"), res.Message.GetParentID())
+	})
+
+	t.Run("異常系：返信先が存在しない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, request.Sender).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, request.Sender).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+
+		mocks.MsgRepo.EXPECT().GetMessageByID(ctx, entity.MessageID("parent")).Return(nil, repository.ErrMessageNotFound)
+
+		_, err := useCase.SendMessage(ctx, request)
+
+		assert.ErrorIs(t, err, websocketcase.ErrInvalidParentMessage)
+	})
+
+	t.Run("異常系：返信への返信", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, request.Sender).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, request.Sender).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+
+		reply := entity.NewMessage(entity.MessageParams{ID: "parent", RoomID: roomID, ParentID: "root"})
+		mocks.MsgRepo.EXPECT().GetMessageByID(ctx, entity.MessageID("parent")).Return(reply, nil)
+
+		_, err := useCase.SendMessage(ctx, request)
+
+		assert.ErrorIs(t, err, websocketcase.ErrInvalidParentMessage)
+	})
+}
+
+func TestSendMessage_Attachments(t *testing.T) {
+	ctx := context.Background()
+	roomID := entity.RoomID("room123")
+	senderID := entity.UserID("user123")
+	newAttachment := func(id entity.AttachmentID, roomID entity.RoomID, uploaderID entity.UserID, messageID entity.MessageID) *entity.Attachment {
+		return entity.NewAttachment(entity.AttachmentParams{
+			ID:         id,
+			RoomID:     roomID,
+			UploaderID: uploaderID,
+			MessageID:  messageID,
+			FileName:   string(id) + ".png",
+			MimeType:   "image/png",
+		})
+	}
+
+	t.Run("正常系：添付ファイルを添付済みにして指定した順に配信する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		ids := []entity.AttachmentID{"att2", "att1"}
+		mocks.AttachmentRepo.EXPECT().GetAttachmentsByIDs(ctx, ids).Return([]*entity.Attachment{
+			newAttachment("att1", roomID, senderID, ""),
+			newAttachment("att2", roomID, senderID, ""),
+		}, nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID("msg1"), nil)
+		// 添付ファイルはメッセージと一緒に添付済みにする
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, msg *entity.Message, _ []*entity.Mention) error {
+				require.Len(t, msg.GetAttachments(), 2)
+				assert.Equal(t, entity.AttachmentID("att2"), msg.GetAttachments()[0].GetID())
+				assert.Equal(t, entity.AttachmentID("att1"), msg.GetAttachments()[1].GetID())
+				return nil
+			})
+		mocks.MsgCache.EXPECT().AddMessage(ctx, roomID, gomock.Any()).Return(nil)
+		mocks.WebsocketManager.EXPECT().BroadcastToRoom(ctx, roomID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entity.RoomID, event *entity.WebsocketEvent) error {
+				msg := event.GetPayload().(*entity.Message)
+				assert.Len(t, msg.GetAttachments(), 2)
+				return nil
+			})
+
+		res, err := useCase.SendMessage(ctx, websocketcase.SendMessageRequest{RoomID: roomID, Sender: senderID, AttachmentIDs: ids})
+
+		require.NoError(t, err)
+		attachments := res.Message.GetAttachments()
+		require.Len(t, attachments, 2)
+		assert.Equal(t, entity.AttachmentID("att2"), attachments[0].GetID())
+		assert.Equal(t, entity.AttachmentID("att1"), attachments[1].GetID())
+		assert.Equal(t, entity.MessageID("msg1"), attachments[0].GetMessageID())
+	})
+
+	invalidCases := []struct {
+		name       string
+		ids        []entity.AttachmentID
+		attachment *entity.Attachment
+	}{
+		{"異常系：存在しない", []entity.AttachmentID{"att1"}, nil},
+		{"異常系：別の部屋にアップロードした", []entity.AttachmentID{"att1"}, newAttachment("att1", "room456", senderID, "")},
+		{"異常系：別のユーザーがアップロードした", []entity.AttachmentID{"att1"}, newAttachment("att1", roomID, "user456", "")},
+		{"異常系：添付済み", []entity.AttachmentID{"att1"}, newAttachment("att1", roomID, senderID, "msg0")},
+		{"異常系：同じIDを重複して指定した", []entity.AttachmentID{"att1", "att1"}, newAttachment("att1", roomID, senderID, "")},
+	}
+	for _, tt := range invalidCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+			mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+			mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+			mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+			var found []*entity.Attachment
+			if tt.attachment != nil {
+				found = append(found, tt.attachment)
+			}
+			mocks.AttachmentRepo.EXPECT().GetAttachmentsByIDs(ctx, tt.ids).Return(found, nil)
+
+			_, err := useCase.SendMessage(ctx, websocketcase.SendMessageRequest{RoomID: roomID, Sender: senderID, AttachmentIDs: tt.ids})
+
+			assert.ErrorIs(t, err, websocketcase.ErrInvalidAttachment)
+		})
+	}
+
+	t.Run("異常系：添付ファイルが多すぎる", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		ids := make([]entity.AttachmentID, entity.MaxMessageAttachments+1)
+		for i := range ids {
+			ids[i] = entity.AttachmentID(fmt.Sprintf("att%d", i))
+		}
+
+		_, err := useCase.SendMessage(ctx, websocketcase.SendMessageRequest{RoomID: roomID, Sender: senderID, AttachmentIDs: ids})
+
+		assert.ErrorIs(t, err, websocketcase.ErrInvalidAttachment)
+	})
+
+	t.Run("異常系：確認後に別のメッセージに添付された", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+		mocks.RoomRepo.EXPECT().GetMemberRole(ctx, roomID, senderID).Return(entity.RoomRoleMember, nil)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(ctx, roomID, senderID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(ctx, roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		ids := []entity.AttachmentID{"att1"}
+		mocks.AttachmentRepo.EXPECT().GetAttachmentsByIDs(ctx, ids).Return([]*entity.Attachment{newAttachment("att1", roomID, senderID, "")}, nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID("msg1"), nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(ctx, gomock.Any(), gomock.Any()).Return(repository.ErrAttachmentAlreadyAttached)
+
+		_, err := useCase.SendMessage(ctx, websocketcase.SendMessageRequest{RoomID: roomID, Sender: senderID, AttachmentIDs: ids})
+
+		assert.ErrorIs(t, err, websocketcase.ErrInvalidAttachment)
+	})
 }
@@ -0,0 +1,90 @@
+package websocketcase
+
+import (
+	"context"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+)
+
+// typingKey は入力中の状態を管理するキー（部屋とユーザーの組）
+type typingKey struct {
+	roomID entity.RoomID
+	userID entity.UserID
+}
+
+// typingEntry は入力中のユーザーと、入力を終了させるタイマー
+type typingEntry struct {
+	timer *time.Timer
+}
+
+// TypingRequest構造体: 入力の開始・終了リクエスト
+type TypingRequest struct {
+	RoomID entity.RoomID
+	UserID entity.UserID
+}
+
+// StartTyping 入力開始
+// 入力中でなかった場合だけ typing.updated を部屋に配信します（入力したユーザー自身のコネクションには配信されません）。
+// 入力中のクライアントは TypingTimeout より短い間隔で送り直し、送り直しがなければ入力を終了したものとして扱います。
+// 入力状態は永続化せず、このプロセスのメモリだけで管理します。
+func (w *WebsocketUseCase) StartTyping(ctx context.Context, req TypingRequest) error {
+	// 接続後に部屋を退出した場合に備えて、メンバーであることを確認する
+	if _, err := w.roomRepo.GetMemberRole(ctx, req.RoomID, req.UserID); err != nil {
+		return err
+	}
+
+	key := typingKey{roomID: req.RoomID, userID: req.UserID}
+
+	entry := &typingEntry{}
+	w.typingMu.Lock()
+	entry.timer = time.AfterFunc(w.typingTimeout, func() {
+		w.expireTyping(key, entry)
+	})
+	prev, typing := w.typingTimers[key]
+	w.typingTimers[key] = entry
+	w.typingMu.Unlock()
+
+	// 送り直しの場合は期限を延ばすだけで、配信はしない
+	if typing {
+		prev.timer.Stop()
+		return nil
+	}
+
+	return w.websocketManager.BroadcastToRoomExcept(ctx, req.RoomID, req.UserID, entity.NewTypingUpdatedEvent(req.UserID, true))
+}
+
+// StopTyping 入力終了
+// 入力中だった場合だけ typing.updated を部屋に配信します。
+func (w *WebsocketUseCase) StopTyping(ctx context.Context, req TypingRequest) error {
+	return w.stopTyping(ctx, typingKey{roomID: req.RoomID, userID: req.UserID})
+}
+
+func (w *WebsocketUseCase) stopTyping(ctx context.Context, key typingKey) error {
+	w.typingMu.Lock()
+	entry, typing := w.typingTimers[key]
+	delete(w.typingTimers, key)
+	w.typingMu.Unlock()
+
+	if !typing {
+		return nil
+	}
+	entry.timer.Stop()
+
+	return w.websocketManager.BroadcastToRoomExcept(ctx, key.roomID, key.userID, entity.NewTypingUpdatedEvent(key.userID, false))
+}
+
+// expireTyping は送り直しがないまま期限を過ぎた入力を終了させる
+// クライアントが異常終了して typing.stop が届かない場合もここで終了する
+func (w *WebsocketUseCase) expireTyping(key typingKey, entry *typingEntry) {
+	w.typingMu.Lock()
+	// 期限の直前に送り直された・終了した場合は何もしない
+	if w.typingTimers[key] != entry {
+		w.typingMu.Unlock()
+		return
+	}
+	delete(w.typingTimers, key)
+	w.typingMu.Unlock()
+
+	_ = w.websocketManager.BroadcastToRoomExcept(context.Background(), key.roomID, key.userID, entity.NewTypingUpdatedEvent(key.userID, false))
+}
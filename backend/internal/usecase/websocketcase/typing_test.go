@@ -0,0 +1,125 @@
+package websocketcase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"example.com/infrahandson/internal/domain/entity"
+	"example.com/infrahandson/internal/domain/repository"
+	"example.com/infrahandson/internal/usecase/websocketcase"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 1. 入力の開始・終了は状態が変わったときだけ配信する
+// 2. メンバーでない
+// 3. 送り直しがなければ期限切れで終了する
+// 4. 切断したら終了する
+// 5. メッセージを送信したら終了する
+func TestTyping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	roomID := entity.RoomID("room123")
+	userID := entity.UserID("user123")
+	req := websocketcase.TypingRequest{RoomID: roomID, UserID: userID}
+
+	t.Run("入力の開始・終了は状態が変わったときだけ配信する", func(t *testing.T) {
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil).Times(2)
+		gomock.InOrder(
+			mocks.WebsocketManager.EXPECT().BroadcastToRoomExcept(context.Background(), roomID, userID, entity.NewTypingUpdatedEvent(userID, true)).Return(nil),
+			mocks.WebsocketManager.EXPECT().BroadcastToRoomExcept(context.Background(), roomID, userID, entity.NewTypingUpdatedEvent(userID, false)).Return(nil),
+		)
+
+		assert.NoError(t, useCase.StartTyping(context.Background(), req))
+		// 送り直しは配信しない
+		assert.NoError(t, useCase.StartTyping(context.Background(), req))
+		assert.NoError(t, useCase.StopTyping(context.Background(), req))
+		// 入力中でなければ配信しない
+		assert.NoError(t, useCase.StopTyping(context.Background(), req))
+	})
+
+	t.Run("メンバーでない", func(t *testing.T) {
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRole(""), repository.ErrNotRoomMember)
+
+		err := useCase.StartTyping(context.Background(), req)
+		assert.ErrorIs(t, err, repository.ErrNotRoomMember)
+	})
+
+	t.Run("送り直しがなければ期限切れで終了する", func(t *testing.T) {
+		useCase, mocks := websocketcase.NewTestWebsocketUseCaseWithTypingTimeout(ctrl, 20*time.Millisecond)
+
+		expired := make(chan struct{})
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil)
+		gomock.InOrder(
+			mocks.WebsocketManager.EXPECT().BroadcastToRoomExcept(context.Background(), roomID, userID, entity.NewTypingUpdatedEvent(userID, true)).Return(nil),
+			mocks.WebsocketManager.EXPECT().BroadcastToRoomExcept(gomock.Any(), roomID, userID, entity.NewTypingUpdatedEvent(userID, false)).DoAndReturn(
+				func(_ context.Context, _ entity.RoomID, _ entity.UserID, _ *entity.WebsocketEvent) error {
+					close(expired)
+					return nil
+				}),
+		)
+
+		assert.NoError(t, useCase.StartTyping(context.Background(), req))
+
+		select {
+		case <-expired:
+			// 期限切れの後は入力中ではないため、終了しても配信しない
+			assert.NoError(t, useCase.StopTyping(context.Background(), req))
+		case <-time.After(1 * time.Second):
+			t.Fatal("typing did not expire")
+		}
+	})
+
+	t.Run("切断したら終了する", func(t *testing.T) {
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		clientID := entity.WsClientID("client123")
+		client := entity.NewWebsocketClient(entity.WebsocketClientParams{
+			ID:     clientID,
+			UserID: userID,
+			RoomID: roomID,
+		})
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil)
+		mocks.WsClientRepo.EXPECT().GetClientByID(context.Background(), clientID).Return(client, nil)
+		mocks.WebsocketManager.EXPECT().Unregister(context.Background(), clientID).Return(nil)
+		mocks.WsClientRepo.EXPECT().DeleteClient(context.Background(), clientID).Return(nil)
+		gomock.InOrder(
+			mocks.WebsocketManager.EXPECT().BroadcastToRoomExcept(context.Background(), roomID, userID, entity.NewTypingUpdatedEvent(userID, true)).Return(nil),
+			mocks.WebsocketManager.EXPECT().BroadcastToRoomExcept(context.Background(), roomID, userID, entity.NewTypingUpdatedEvent(userID, false)).Return(nil),
+		)
+
+		assert.NoError(t, useCase.StartTyping(context.Background(), req))
+		assert.NoError(t, useCase.DisconnectUser(context.Background(), websocketcase.DisconnectUserRequest{ClientID: clientID}))
+	})
+
+	t.Run("メッセージを送信したら終了する", func(t *testing.T) {
+		useCase, mocks := websocketcase.NewTestWebsocketUseCase(ctrl)
+
+		mocks.RoomRepo.EXPECT().GetMemberRole(context.Background(), roomID, userID).Return(entity.RoomRoleMember, nil).Times(2)
+		mocks.ModerationRepo.EXPECT().GetMutedUntil(context.Background(), roomID, userID).Return(nil, nil)
+		mocks.RoomRepo.EXPECT().GetRoomByID(context.Background(), roomID).Return(entity.NewRoom(entity.RoomParams{ID: roomID}), nil)
+		mocks.MsgIDFactory.EXPECT().NewMessageID().Return(entity.MessageID("msg123"), nil)
+		mocks.MsgRepo.EXPECT().CreateMessageWithMentions(context.Background(), gomock.Any(), gomock.Any()).Return(nil)
+		mocks.MsgCache.EXPECT().AddMessage(context.Background(), roomID, gomock.Any()).Return(nil)
+		gomock.InOrder(
+			mocks.WebsocketManager.EXPECT().BroadcastToRoomExcept(context.Background(), roomID, userID, entity.NewTypingUpdatedEvent(userID, true)).Return(nil),
+			mocks.WebsocketManager.EXPECT().BroadcastToRoom(context.Background(), roomID, gomock.Any()).Return(nil),
+			mocks.WebsocketManager.EXPECT().BroadcastToRoomExcept(context.Background(), roomID, userID, entity.NewTypingUpdatedEvent(userID, false)).Return(nil),
+		)
+
+		assert.NoError(t, useCase.StartTyping(context.Background(), req))
+		_, err := useCase.SendMessage(context.Background(), websocketcase.SendMessageRequest{
+			RoomID:  roomID,
+			Sender:  userID,
+			Content: "hello",
+		})
+		assert.NoError(t, err)
+	})
+}
@@ -1,6 +1,8 @@
 package websocketcase
 
 import (
+	"time"
+
 	mock_repository "example.com/infrahandson/test/mocks/domain/repository"
 	mock_service "example.com/infrahandson/test/mocks/domain/service"
 	mock_factory "example.com/infrahandson/test/mocks/interface/factory"
@@ -10,7 +12,9 @@ import (
 type mockDeps struct {
 	UserRepo         *mock_repository.MockUserRepository
 	RoomRepo         *mock_repository.MockRoomRepository
+	ModerationRepo   *mock_repository.MockRoomModerationRepository
 	MsgRepo          *mock_repository.MockMessageRepository
+	AttachmentRepo   *mock_repository.MockAttachmentRepository
 	MsgCache         *mock_service.MockMessageCacheService
 	WsClientRepo     *mock_repository.MockWebsocketClientRepository
 	WebsocketManager *mock_service.MockWebsocketManager
@@ -20,11 +24,43 @@ type mockDeps struct {
 
 func NewTestWebsocketUseCase(
 	ctrl *gomock.Controller,
+) (WebsocketUseCaseInterface, mockDeps) {
+	// 連投の制限は無効にする
+	return NewTestWebsocketUseCaseWithBurstLimit(ctrl, 0, 0)
+}
+
+// NewTestWebsocketUseCaseWithBurstLimit は連投の制限を設定したユースケースを生成します。
+func NewTestWebsocketUseCaseWithBurstLimit(
+	ctrl *gomock.Controller,
+	burstLimit int,
+	burstWindow time.Duration,
+) (WebsocketUseCaseInterface, mockDeps) {
+	return newTestWebsocketUseCase(ctrl, func(p *NewWebsocketUseCaseParams) {
+		p.BurstLimit = burstLimit
+		p.BurstWindow = burstWindow
+	})
+}
+
+// NewTestWebsocketUseCaseWithTypingTimeout は入力中の期限を設定したユースケースを生成します。
+func NewTestWebsocketUseCaseWithTypingTimeout(
+	ctrl *gomock.Controller,
+	typingTimeout time.Duration,
+) (WebsocketUseCaseInterface, mockDeps) {
+	return newTestWebsocketUseCase(ctrl, func(p *NewWebsocketUseCaseParams) {
+		p.TypingTimeout = typingTimeout
+	})
+}
+
+func newTestWebsocketUseCase(
+	ctrl *gomock.Controller,
+	configure func(*NewWebsocketUseCaseParams),
 ) (WebsocketUseCaseInterface, mockDeps) {
 	// モックの作成
 	mockUserRepo := mock_repository.NewMockUserRepository(ctrl)
 	mockRoomRepo := mock_repository.NewMockRoomRepository(ctrl)
+	mockModerationRepo := mock_repository.NewMockRoomModerationRepository(ctrl)
 	mockMsgRepo := mock_repository.NewMockMessageRepository(ctrl)
+	mockAttachmentRepo := mock_repository.NewMockAttachmentRepository(ctrl)
 	mockMsgCache := mock_service.NewMockMessageCacheService(ctrl)
 	mockWsClientRepo := mock_repository.NewMockWebsocketClientRepository(ctrl)
 	mockWebsocketManager := mock_service.NewMockWebsocketManager(ctrl)
@@ -34,20 +70,26 @@ func NewTestWebsocketUseCase(
 	params := NewWebsocketUseCaseParams{
 		UserRepo:         mockUserRepo,
 		RoomRepo:         mockRoomRepo,
+		ModerationRepo:   mockModerationRepo,
 		MsgRepo:          mockMsgRepo,
+		AttachmentRepo:   mockAttachmentRepo,
 		MsgCache:         mockMsgCache,
 		WsClientRepo:     mockWsClientRepo,
 		WebsocketManager: mockWebsocketManager,
 		MsgIDFactory:     mockMsgIDFactory,
 		ClientIDFactory:  mockClientIDFactory,
+		TypingTimeout:    5 * time.Second,
 	}
+	configure(&params)
 	useCase := NewWebsocketUseCase(params)
 
 	// モックをテスト内で使いたいため構造体で返す
 	return useCase, mockDeps{
 		UserRepo:         mockUserRepo,
 		RoomRepo:         mockRoomRepo,
+		ModerationRepo:   mockModerationRepo,
 		MsgRepo:          mockMsgRepo,
+		AttachmentRepo:   mockAttachmentRepo,
 		MsgCache:         mockMsgCache,
 		WsClientRepo:     mockWsClientRepo,
 		WebsocketManager: mockWebsocketManager,
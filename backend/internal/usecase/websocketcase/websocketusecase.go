@@ -1,6 +1,9 @@
 package websocketcase
 
 import (
+	"sync"
+	"time"
+
 	"example.com/infrahandson/internal/domain/repository"
 	"example.com/infrahandson/internal/domain/service"
 	"example.com/infrahandson/internal/interface/factory"
@@ -9,10 +12,19 @@ import (
 type WebsocketUseCase struct {
 	userRepo         repository.UserRepository
 	roomRepo         repository.RoomRepository
+	moderationRepo   repository.RoomModerationRepository
 	msgRepo          repository.MessageRepository
+	attachmentRepo   repository.AttachmentRepository
 	msgCache         service.MessageCacheService
 	wsClientRepo     repository.WebsocketClientRepository
 	websocketManager service.WebsocketManager
 	msgIDFactory     factory.MessageIDFactory
 	clientIDFactory  factory.WsClientIDFactory
+	burstLimit       int
+	burstWindow      time.Duration
+	typingTimeout    time.Duration
+	typingMu         sync.Mutex
+	typingTimers     map[typingKey]*typingEntry // 入力中のユーザー
+	senderMu         sync.Mutex
+	senderLocks      map[senderKey]*senderLock // 送信中の部屋とユーザーの組
 }
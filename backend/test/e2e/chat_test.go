@@ -9,8 +9,11 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-type MessageDTO struct {
-	Content string `json:"content"`
+type EventDTO struct {
+	Type    string `json:"type"`
+	Payload struct {
+		Content string `json:"content"`
+	} `json:"payload"`
 }
 
 func TestChatWebSocketConnection(t *testing.T) {
@@ -48,15 +51,21 @@ func TestChatWebSocketConnection(t *testing.T) {
 	defer connB.Close()
 
 	// === ユーザーAがメッセージ送信 ===
-	message := map[string]string{"content": "Hello, World!"}
+	message := map[string]any{
+		"v":       1,
+		"type":    "message.send",
+		"id":      "a-1",
+		"payload": map[string]string{"content": "Hello, World!"},
+	}
 	err = connA.WriteJSON(message)
 	assert.NoError(t, err)
 	fmt.Println("User A sent message:", message)
 
 	// === ユーザーBがメッセージ受信 ===
-	var receivedMessage MessageDTO
+	var receivedMessage EventDTO
 	err = connB.ReadJSON(&receivedMessage)
 	fmt.Println("User B received message:", receivedMessage)
 	assert.NoError(t, err)
-	assert.Equal(t, "Hello, World!", receivedMessage.Content)
+	assert.Equal(t, "message.created", receivedMessage.Type)
+	assert.Equal(t, "Hello, World!", receivedMessage.Payload.Content)
 }
@@ -41,13 +41,8 @@ func StartTestServer(t *testing.T) *TestServer {
 	cfg := config.LoadConfig()
 	cfg.Port = fmt.Sprintf("%d", port)
 
-	e, db, memcacheClient := server.ServerStart(cfg)
-	t.Cleanup(func() {
-		db.Close()
-		if memcacheClient != nil {
-			memcacheClient.Close()
-		}
-	})
+	srv := server.ServerStart(cfg)
+	e := srv.Echo
 
 	// Echoサーバー起動
 
@@ -60,11 +55,11 @@ func StartTestServer(t *testing.T) *TestServer {
 	// 起動待機
 	time.Sleep(100 * time.Millisecond)
 
-	// テスト終了時にサーバー停止とDB削除
+	// テスト終了時にサーバー停止（DB・Memcachedのクローズを含む）とDB削除
 	t.Cleanup(func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
-		_ = e.Shutdown(ctx)
+		_ = srv.Shutdown(ctx)
 
 		if err := os.Remove("./database.db"); err != nil && !os.IsNotExist(err) {
 			t.Logf("failed to remove database.db: %v", err)
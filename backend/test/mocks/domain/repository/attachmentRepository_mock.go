@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/attachmentRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/attachmentRepository.go -destination=test/mocks/domain/repository/attachmentRepository_mock.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentRepository is a mock of AttachmentRepository interface.
+type MockAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentRepositoryMockRecorder is the mock recorder for MockAttachmentRepository.
+type MockAttachmentRepositoryMockRecorder struct {
+	mock *MockAttachmentRepository
+}
+
+// NewMockAttachmentRepository creates a new mock instance.
+func NewMockAttachmentRepository(ctrl *gomock.Controller) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRepository) EXPECT() *MockAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAttachment mocks base method.
+func (m *MockAttachmentRepository) CreateAttachment(ctx context.Context, attachment *entity.Attachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockAttachmentRepositoryMockRecorder) CreateAttachment(ctx, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockAttachmentRepository)(nil).CreateAttachment), ctx, attachment)
+}
+
+// GetAttachmentByID mocks base method.
+func (m *MockAttachmentRepository) GetAttachmentByID(ctx context.Context, id entity.AttachmentID) (*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentByID indicates an expected call of GetAttachmentByID.
+func (mr *MockAttachmentRepositoryMockRecorder) GetAttachmentByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentByID", reflect.TypeOf((*MockAttachmentRepository)(nil).GetAttachmentByID), ctx, id)
+}
+
+// GetAttachmentIDsByRoomID mocks base method.
+func (m *MockAttachmentRepository) GetAttachmentIDsByRoomID(ctx context.Context, roomID entity.RoomID) ([]entity.AttachmentID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentIDsByRoomID", ctx, roomID)
+	ret0, _ := ret[0].([]entity.AttachmentID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentIDsByRoomID indicates an expected call of GetAttachmentIDsByRoomID.
+func (mr *MockAttachmentRepositoryMockRecorder) GetAttachmentIDsByRoomID(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentIDsByRoomID", reflect.TypeOf((*MockAttachmentRepository)(nil).GetAttachmentIDsByRoomID), ctx, roomID)
+}
+
+// GetAttachmentsByIDs mocks base method.
+func (m *MockAttachmentRepository) GetAttachmentsByIDs(ctx context.Context, ids []entity.AttachmentID) ([]*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByIDs indicates an expected call of GetAttachmentsByIDs.
+func (mr *MockAttachmentRepositoryMockRecorder) GetAttachmentsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByIDs", reflect.TypeOf((*MockAttachmentRepository)(nil).GetAttachmentsByIDs), ctx, ids)
+}
+
+// GetAttachmentsByMessageIDs mocks base method.
+func (m *MockAttachmentRepository) GetAttachmentsByMessageIDs(ctx context.Context, messageIDs []entity.MessageID) (map[entity.MessageID][]*entity.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByMessageIDs", ctx, messageIDs)
+	ret0, _ := ret[0].(map[entity.MessageID][]*entity.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByMessageIDs indicates an expected call of GetAttachmentsByMessageIDs.
+func (mr *MockAttachmentRepositoryMockRecorder) GetAttachmentsByMessageIDs(ctx, messageIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByMessageIDs", reflect.TypeOf((*MockAttachmentRepository)(nil).GetAttachmentsByMessageIDs), ctx, messageIDs)
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/mentionRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/mentionRepository.go -destination=test/mocks/domain/repository/mentionRepository_mock.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	repository "example.com/infrahandson/internal/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMentionRepository is a mock of MentionRepository interface.
+type MockMentionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMentionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMentionRepositoryMockRecorder is the mock recorder for MockMentionRepository.
+type MockMentionRepositoryMockRecorder struct {
+	mock *MockMentionRepository
+}
+
+// NewMockMentionRepository creates a new mock instance.
+func NewMockMentionRepository(ctrl *gomock.Controller) *MockMentionRepository {
+	mock := &MockMentionRepository{ctrl: ctrl}
+	mock.recorder = &MockMentionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMentionRepository) EXPECT() *MockMentionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListMentions mocks base method.
+func (m *MockMentionRepository) ListMentions(ctx context.Context, query repository.MentionListQuery) ([]*entity.MentionedMessage, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMentions", ctx, query)
+	ret0, _ := ret[0].([]*entity.MentionedMessage)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMentions indicates an expected call of ListMentions.
+func (mr *MockMentionRepositoryMockRecorder) ListMentions(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMentions", reflect.TypeOf((*MockMentionRepository)(nil).ListMentions), ctx, query)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/repository/messageRepository.go
+// Source: internal/domain/repository/messageRepository.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/repository/messageRepository.go -destination=test/mocks/domain/repository/messageRepository_mock.go
+//	mockgen -source=internal/domain/repository/messageRepository.go -destination=test/mocks/domain/repository/messageRepository_mock.go
 //
 
 // Package mock_repository is a generated GoMock package.
@@ -43,17 +43,60 @@ func (m *MockMessageRepository) EXPECT() *MockMessageRepositoryMockRecorder {
 }
 
 // CreateMessage mocks base method.
-func (m *MockMessageRepository) CreateMessage(arg0 context.Context, arg1 *entity.Message) error {
+func (m *MockMessageRepository) CreateMessage(ctx context.Context, msg *entity.Message) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMessage", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateMessage", ctx, msg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateMessage indicates an expected call of CreateMessage.
-func (mr *MockMessageRepositoryMockRecorder) CreateMessage(arg0, arg1 any) *gomock.Call {
+func (mr *MockMessageRepositoryMockRecorder) CreateMessage(ctx, msg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessage", reflect.TypeOf((*MockMessageRepository)(nil).CreateMessage), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessage", reflect.TypeOf((*MockMessageRepository)(nil).CreateMessage), ctx, msg)
+}
+
+// CreateMessageWithMentions mocks base method.
+func (m *MockMessageRepository) CreateMessageWithMentions(ctx context.Context, msg *entity.Message, mentions []*entity.Mention) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMessageWithMentions", ctx, msg, mentions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMessageWithMentions indicates an expected call of CreateMessageWithMentions.
+func (mr *MockMessageRepositoryMockRecorder) CreateMessageWithMentions(ctx, msg, mentions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessageWithMentions", reflect.TypeOf((*MockMessageRepository)(nil).CreateMessageWithMentions), ctx, msg, mentions)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockMessageRepository) DeleteMessage(ctx context.Context, id entity.MessageID, deletedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, id, deletedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockMessageRepositoryMockRecorder) DeleteMessage(ctx, id, deletedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageRepository)(nil).DeleteMessage), ctx, id, deletedAt)
+}
+
+// GetMessageByID mocks base method.
+func (m *MockMessageRepository) GetMessageByID(ctx context.Context, id entity.MessageID) (*entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByID indicates an expected call of GetMessageByID.
+func (mr *MockMessageRepositoryMockRecorder) GetMessageByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockMessageRepository)(nil).GetMessageByID), ctx, id)
 }
 
 // GetMessageHistoryInRoom mocks base method.
@@ -72,3 +115,96 @@ func (mr *MockMessageRepositoryMockRecorder) GetMessageHistoryInRoom(ctx, roomID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageHistoryInRoom", reflect.TypeOf((*MockMessageRepository)(nil).GetMessageHistoryInRoom), ctx, roomID, limit, beforeSentAt)
 }
+
+// GetMessageRevisions mocks base method.
+func (m *MockMessageRepository) GetMessageRevisions(ctx context.Context, id entity.MessageID) ([]*entity.MessageRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageRevisions", ctx, id)
+	ret0, _ := ret[0].([]*entity.MessageRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageRevisions indicates an expected call of GetMessageRevisions.
+func (mr *MockMessageRepositoryMockRecorder) GetMessageRevisions(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageRevisions", reflect.TypeOf((*MockMessageRepository)(nil).GetMessageRevisions), ctx, id)
+}
+
+// GetThread mocks base method.
+func (m *MockMessageRepository) GetThread(ctx context.Context, parentID entity.MessageID, limit int, afterSentAt time.Time) ([]*entity.Message, time.Time, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThread", ctx, parentID, limit, afterSentAt)
+	ret0, _ := ret[0].([]*entity.Message)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetThread indicates an expected call of GetThread.
+func (mr *MockMessageRepositoryMockRecorder) GetThread(ctx, parentID, limit, afterSentAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThread", reflect.TypeOf((*MockMessageRepository)(nil).GetThread), ctx, parentID, limit, afterSentAt)
+}
+
+// GetThreadSummaries mocks base method.
+func (m *MockMessageRepository) GetThreadSummaries(ctx context.Context, parentIDs []entity.MessageID) (map[entity.MessageID]*entity.ThreadSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThreadSummaries", ctx, parentIDs)
+	ret0, _ := ret[0].(map[entity.MessageID]*entity.ThreadSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThreadSummaries indicates an expected call of GetThreadSummaries.
+func (mr *MockMessageRepositoryMockRecorder) GetThreadSummaries(ctx, parentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreadSummaries", reflect.TypeOf((*MockMessageRepository)(nil).GetThreadSummaries), ctx, parentIDs)
+}
+
+// GetUserSentTimesSince mocks base method.
+func (m *MockMessageRepository) GetUserSentTimesSince(ctx context.Context, roomID entity.RoomID, userID entity.UserID, since time.Time, limit int) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSentTimesSince", ctx, roomID, userID, since, limit)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSentTimesSince indicates an expected call of GetUserSentTimesSince.
+func (mr *MockMessageRepositoryMockRecorder) GetUserSentTimesSince(ctx, roomID, userID, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSentTimesSince", reflect.TypeOf((*MockMessageRepository)(nil).GetUserSentTimesSince), ctx, roomID, userID, since, limit)
+}
+
+// SearchMessages mocks base method.
+func (m *MockMessageRepository) SearchMessages(ctx context.Context, userID entity.UserID, query string, roomID entity.RoomID, limit int, beforeSentAt time.Time) ([]*entity.Message, time.Time, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, userID, query, roomID, limit, beforeSentAt)
+	ret0, _ := ret[0].([]*entity.Message)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockMessageRepositoryMockRecorder) SearchMessages(ctx, userID, query, roomID, limit, beforeSentAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockMessageRepository)(nil).SearchMessages), ctx, userID, query, roomID, limit, beforeSentAt)
+}
+
+// UpdateMessage mocks base method.
+func (m *MockMessageRepository) UpdateMessage(ctx context.Context, msg *entity.Message, revision *entity.MessageRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", ctx, msg, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockMessageRepositoryMockRecorder) UpdateMessage(ctx, msg, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockMessageRepository)(nil).UpdateMessage), ctx, msg, revision)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/pinRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/pinRepository.go -destination=test/mocks/domain/repository/pinRepository_mock.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPinRepository is a mock of PinRepository interface.
+type MockPinRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPinRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPinRepositoryMockRecorder is the mock recorder for MockPinRepository.
+type MockPinRepositoryMockRecorder struct {
+	mock *MockPinRepository
+}
+
+// NewMockPinRepository creates a new mock instance.
+func NewMockPinRepository(ctrl *gomock.Controller) *MockPinRepository {
+	mock := &MockPinRepository{ctrl: ctrl}
+	mock.recorder = &MockPinRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPinRepository) EXPECT() *MockPinRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountPins mocks base method.
+func (m *MockPinRepository) CountPins(ctx context.Context, roomID entity.RoomID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPins", ctx, roomID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPins indicates an expected call of CountPins.
+func (mr *MockPinRepositoryMockRecorder) CountPins(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPins", reflect.TypeOf((*MockPinRepository)(nil).CountPins), ctx, roomID)
+}
+
+// DeletePin mocks base method.
+func (m *MockPinRepository) DeletePin(ctx context.Context, roomID entity.RoomID, messageID entity.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePin", ctx, roomID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePin indicates an expected call of DeletePin.
+func (mr *MockPinRepositoryMockRecorder) DeletePin(ctx, roomID, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePin", reflect.TypeOf((*MockPinRepository)(nil).DeletePin), ctx, roomID, messageID)
+}
+
+// ListPinnedMessages mocks base method.
+func (m *MockPinRepository) ListPinnedMessages(ctx context.Context, roomID entity.RoomID) ([]*entity.PinnedMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPinnedMessages", ctx, roomID)
+	ret0, _ := ret[0].([]*entity.PinnedMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPinnedMessages indicates an expected call of ListPinnedMessages.
+func (mr *MockPinRepositoryMockRecorder) ListPinnedMessages(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPinnedMessages", reflect.TypeOf((*MockPinRepository)(nil).ListPinnedMessages), ctx, roomID)
+}
+
+// SavePin mocks base method.
+func (m *MockPinRepository) SavePin(ctx context.Context, pin *entity.Pin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePin", ctx, pin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePin indicates an expected call of SavePin.
+func (mr *MockPinRepositoryMockRecorder) SavePin(ctx, pin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePin", reflect.TypeOf((*MockPinRepository)(nil).SavePin), ctx, pin)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/reactionRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/reactionRepository.go -destination=test/mocks/domain/repository/reactionRepository_mock.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReactionRepository is a mock of ReactionRepository interface.
+type MockReactionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReactionRepositoryMockRecorder is the mock recorder for MockReactionRepository.
+type MockReactionRepositoryMockRecorder struct {
+	mock *MockReactionRepository
+}
+
+// NewMockReactionRepository creates a new mock instance.
+func NewMockReactionRepository(ctrl *gomock.Controller) *MockReactionRepository {
+	mock := &MockReactionRepository{ctrl: ctrl}
+	mock.recorder = &MockReactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactionRepository) EXPECT() *MockReactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReaction mocks base method.
+func (m *MockReactionRepository) AddReaction(ctx context.Context, reaction *entity.Reaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", ctx, reaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockReactionRepositoryMockRecorder) AddReaction(ctx, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockReactionRepository)(nil).AddReaction), ctx, reaction)
+}
+
+// CountReactions mocks base method.
+func (m *MockReactionRepository) CountReactions(ctx context.Context, messageID entity.MessageID, emoji string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReactions", ctx, messageID, emoji)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReactions indicates an expected call of CountReactions.
+func (mr *MockReactionRepositoryMockRecorder) CountReactions(ctx, messageID, emoji any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReactions", reflect.TypeOf((*MockReactionRepository)(nil).CountReactions), ctx, messageID, emoji)
+}
+
+// GetReactionSummaries mocks base method.
+func (m *MockReactionRepository) GetReactionSummaries(ctx context.Context, messageIDs []entity.MessageID, viewerID entity.UserID) (map[entity.MessageID][]*entity.ReactionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactionSummaries", ctx, messageIDs, viewerID)
+	ret0, _ := ret[0].(map[entity.MessageID][]*entity.ReactionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactionSummaries indicates an expected call of GetReactionSummaries.
+func (mr *MockReactionRepositoryMockRecorder) GetReactionSummaries(ctx, messageIDs, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactionSummaries", reflect.TypeOf((*MockReactionRepository)(nil).GetReactionSummaries), ctx, messageIDs, viewerID)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockReactionRepository) RemoveReaction(ctx context.Context, messageID entity.MessageID, userID entity.UserID, emoji string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", ctx, messageID, userID, emoji)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockReactionRepositoryMockRecorder) RemoveReaction(ctx, messageID, userID, emoji any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockReactionRepository)(nil).RemoveReaction), ctx, messageID, userID, emoji)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/roomInviteRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/roomInviteRepository.go -destination=test/mocks/domain/repository/roomInviteRepository_mock.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoomInviteRepository is a mock of RoomInviteRepository interface.
+type MockRoomInviteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomInviteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRoomInviteRepositoryMockRecorder is the mock recorder for MockRoomInviteRepository.
+type MockRoomInviteRepositoryMockRecorder struct {
+	mock *MockRoomInviteRepository
+}
+
+// NewMockRoomInviteRepository creates a new mock instance.
+func NewMockRoomInviteRepository(ctrl *gomock.Controller) *MockRoomInviteRepository {
+	mock := &MockRoomInviteRepository{ctrl: ctrl}
+	mock.recorder = &MockRoomInviteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomInviteRepository) EXPECT() *MockRoomInviteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetInviteByToken mocks base method.
+func (m *MockRoomInviteRepository) GetInviteByToken(ctx context.Context, token entity.InviteToken) (*entity.RoomInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInviteByToken", ctx, token)
+	ret0, _ := ret[0].(*entity.RoomInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInviteByToken indicates an expected call of GetInviteByToken.
+func (mr *MockRoomInviteRepositoryMockRecorder) GetInviteByToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInviteByToken", reflect.TypeOf((*MockRoomInviteRepository)(nil).GetInviteByToken), ctx, token)
+}
+
+// RedeemInvite mocks base method.
+func (m *MockRoomInviteRepository) RedeemInvite(ctx context.Context, token entity.InviteToken, userID entity.UserID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemInvite", ctx, token, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedeemInvite indicates an expected call of RedeemInvite.
+func (mr *MockRoomInviteRepositoryMockRecorder) RedeemInvite(ctx, token, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemInvite", reflect.TypeOf((*MockRoomInviteRepository)(nil).RedeemInvite), ctx, token, userID, now)
+}
+
+// SaveInvite mocks base method.
+func (m *MockRoomInviteRepository) SaveInvite(ctx context.Context, invite *entity.RoomInvite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveInvite", ctx, invite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveInvite indicates an expected call of SaveInvite.
+func (mr *MockRoomInviteRepositoryMockRecorder) SaveInvite(ctx, invite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveInvite", reflect.TypeOf((*MockRoomInviteRepository)(nil).SaveInvite), ctx, invite)
+}
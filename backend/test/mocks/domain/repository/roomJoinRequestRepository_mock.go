@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/roomJoinRequestRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/roomJoinRequestRepository.go -destination=test/mocks/domain/repository/roomJoinRequestRepository_mock.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoomJoinRequestRepository is a mock of RoomJoinRequestRepository interface.
+type MockRoomJoinRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomJoinRequestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRoomJoinRequestRepositoryMockRecorder is the mock recorder for MockRoomJoinRequestRepository.
+type MockRoomJoinRequestRepositoryMockRecorder struct {
+	mock *MockRoomJoinRequestRepository
+}
+
+// NewMockRoomJoinRequestRepository creates a new mock instance.
+func NewMockRoomJoinRequestRepository(ctrl *gomock.Controller) *MockRoomJoinRequestRepository {
+	mock := &MockRoomJoinRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockRoomJoinRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomJoinRequestRepository) EXPECT() *MockRoomJoinRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DecideJoinRequest mocks base method.
+func (m *MockRoomJoinRequestRepository) DecideJoinRequest(ctx context.Context, roomID entity.RoomID, userID entity.UserID, status entity.RoomJoinRequestStatus, decidedBy entity.UserID, decidedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideJoinRequest", ctx, roomID, userID, status, decidedBy, decidedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecideJoinRequest indicates an expected call of DecideJoinRequest.
+func (mr *MockRoomJoinRequestRepositoryMockRecorder) DecideJoinRequest(ctx, roomID, userID, status, decidedBy, decidedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideJoinRequest", reflect.TypeOf((*MockRoomJoinRequestRepository)(nil).DecideJoinRequest), ctx, roomID, userID, status, decidedBy, decidedAt)
+}
+
+// ListPendingJoinRequests mocks base method.
+func (m *MockRoomJoinRequestRepository) ListPendingJoinRequests(ctx context.Context, roomID entity.RoomID) ([]*entity.RoomJoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingJoinRequests", ctx, roomID)
+	ret0, _ := ret[0].([]*entity.RoomJoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingJoinRequests indicates an expected call of ListPendingJoinRequests.
+func (mr *MockRoomJoinRequestRepositoryMockRecorder) ListPendingJoinRequests(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingJoinRequests", reflect.TypeOf((*MockRoomJoinRequestRepository)(nil).ListPendingJoinRequests), ctx, roomID)
+}
+
+// ReopenJoinRequest mocks base method.
+func (m *MockRoomJoinRequestRepository) ReopenJoinRequest(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenJoinRequest", ctx, roomID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReopenJoinRequest indicates an expected call of ReopenJoinRequest.
+func (mr *MockRoomJoinRequestRepositoryMockRecorder) ReopenJoinRequest(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenJoinRequest", reflect.TypeOf((*MockRoomJoinRequestRepository)(nil).ReopenJoinRequest), ctx, roomID, userID)
+}
+
+// SaveJoinRequest mocks base method.
+func (m *MockRoomJoinRequestRepository) SaveJoinRequest(ctx context.Context, req *entity.RoomJoinRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveJoinRequest", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveJoinRequest indicates an expected call of SaveJoinRequest.
+func (mr *MockRoomJoinRequestRepositoryMockRecorder) SaveJoinRequest(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveJoinRequest", reflect.TypeOf((*MockRoomJoinRequestRepository)(nil).SaveJoinRequest), ctx, req)
+}
@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/roomModerationRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/roomModerationRepository.go -destination=test/mocks/domain/repository/roomModerationRepository_mock.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoomModerationRepository is a mock of RoomModerationRepository interface.
+type MockRoomModerationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomModerationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRoomModerationRepositoryMockRecorder is the mock recorder for MockRoomModerationRepository.
+type MockRoomModerationRepositoryMockRecorder struct {
+	mock *MockRoomModerationRepository
+}
+
+// NewMockRoomModerationRepository creates a new mock instance.
+func NewMockRoomModerationRepository(ctrl *gomock.Controller) *MockRoomModerationRepository {
+	mock := &MockRoomModerationRepository{ctrl: ctrl}
+	mock.recorder = &MockRoomModerationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomModerationRepository) EXPECT() *MockRoomModerationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteBan mocks base method.
+func (m *MockRoomModerationRepository) DeleteBan(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBan", ctx, roomID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBan indicates an expected call of DeleteBan.
+func (mr *MockRoomModerationRepositoryMockRecorder) DeleteBan(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBan", reflect.TypeOf((*MockRoomModerationRepository)(nil).DeleteBan), ctx, roomID, userID)
+}
+
+// DeleteMute mocks base method.
+func (m *MockRoomModerationRepository) DeleteMute(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMute", ctx, roomID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMute indicates an expected call of DeleteMute.
+func (mr *MockRoomModerationRepositoryMockRecorder) DeleteMute(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMute", reflect.TypeOf((*MockRoomModerationRepository)(nil).DeleteMute), ctx, roomID, userID)
+}
+
+// GetMutedUntil mocks base method.
+func (m *MockRoomModerationRepository) GetMutedUntil(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMutedUntil", ctx, roomID, userID)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMutedUntil indicates an expected call of GetMutedUntil.
+func (mr *MockRoomModerationRepositoryMockRecorder) GetMutedUntil(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutedUntil", reflect.TypeOf((*MockRoomModerationRepository)(nil).GetMutedUntil), ctx, roomID, userID)
+}
+
+// IsBanned mocks base method.
+func (m *MockRoomModerationRepository) IsBanned(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBanned", ctx, roomID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBanned indicates an expected call of IsBanned.
+func (mr *MockRoomModerationRepositoryMockRecorder) IsBanned(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBanned", reflect.TypeOf((*MockRoomModerationRepository)(nil).IsBanned), ctx, roomID, userID)
+}
+
+// SaveAction mocks base method.
+func (m *MockRoomModerationRepository) SaveAction(ctx context.Context, action *entity.RoomModerationAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAction", ctx, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAction indicates an expected call of SaveAction.
+func (mr *MockRoomModerationRepositoryMockRecorder) SaveAction(ctx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAction", reflect.TypeOf((*MockRoomModerationRepository)(nil).SaveAction), ctx, action)
+}
+
+// SaveBan mocks base method.
+func (m *MockRoomModerationRepository) SaveBan(ctx context.Context, roomID entity.RoomID, userID entity.UserID, bannedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBan", ctx, roomID, userID, bannedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBan indicates an expected call of SaveBan.
+func (mr *MockRoomModerationRepositoryMockRecorder) SaveBan(ctx, roomID, userID, bannedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBan", reflect.TypeOf((*MockRoomModerationRepository)(nil).SaveBan), ctx, roomID, userID, bannedAt)
+}
+
+// SaveMute mocks base method.
+func (m *MockRoomModerationRepository) SaveMute(ctx context.Context, roomID entity.RoomID, userID entity.UserID, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMute", ctx, roomID, userID, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMute indicates an expected call of SaveMute.
+func (mr *MockRoomModerationRepositoryMockRecorder) SaveMute(ctx, roomID, userID, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMute", reflect.TypeOf((*MockRoomModerationRepository)(nil).SaveMute), ctx, roomID, userID, until)
+}
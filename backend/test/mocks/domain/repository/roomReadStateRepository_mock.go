@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/roomReadStateRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/roomReadStateRepository.go -destination=test/mocks/domain/repository/roomReadStateRepository_mock.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoomReadStateRepository is a mock of RoomReadStateRepository interface.
+type MockRoomReadStateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomReadStateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRoomReadStateRepositoryMockRecorder is the mock recorder for MockRoomReadStateRepository.
+type MockRoomReadStateRepositoryMockRecorder struct {
+	mock *MockRoomReadStateRepository
+}
+
+// NewMockRoomReadStateRepository creates a new mock instance.
+func NewMockRoomReadStateRepository(ctrl *gomock.Controller) *MockRoomReadStateRepository {
+	mock := &MockRoomReadStateRepository{ctrl: ctrl}
+	mock.recorder = &MockRoomReadStateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomReadStateRepository) EXPECT() *MockRoomReadStateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListReadStates mocks base method.
+func (m *MockRoomReadStateRepository) ListReadStates(ctx context.Context, roomID entity.RoomID) ([]*entity.RoomReadState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReadStates", ctx, roomID)
+	ret0, _ := ret[0].([]*entity.RoomReadState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReadStates indicates an expected call of ListReadStates.
+func (mr *MockRoomReadStateRepositoryMockRecorder) ListReadStates(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReadStates", reflect.TypeOf((*MockRoomReadStateRepository)(nil).ListReadStates), ctx, roomID)
+}
+
+// SaveReadState mocks base method.
+func (m *MockRoomReadStateRepository) SaveReadState(ctx context.Context, state *entity.RoomReadState) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReadState", ctx, state)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveReadState indicates an expected call of SaveReadState.
+func (mr *MockRoomReadStateRepositoryMockRecorder) SaveReadState(ctx, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReadState", reflect.TypeOf((*MockRoomReadStateRepository)(nil).SaveReadState), ctx, state)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/repository/roomRepository.go
+// Source: internal/domain/repository/roomRepository.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/repository/roomRepository.go -destination=test/mocks/domain/repository/roomRepository_mock.go
+//	mockgen -source=internal/domain/repository/roomRepository.go -destination=test/mocks/domain/repository/roomRepository_mock.go
 //
 
 // Package mock_repository is a generated GoMock package.
@@ -12,8 +12,10 @@ package mock_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "example.com/infrahandson/internal/domain/entity"
+	repository "example.com/infrahandson/internal/domain/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,46 +44,121 @@ func (m *MockRoomRepository) EXPECT() *MockRoomRepositoryMockRecorder {
 }
 
 // AddMemberToRoom mocks base method.
-func (m *MockRoomRepository) AddMemberToRoom(arg0 context.Context, arg1 entity.RoomID, arg2 entity.UserID) error {
+func (m *MockRoomRepository) AddMemberToRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID, role entity.RoomRole) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddMemberToRoom", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "AddMemberToRoom", ctx, roomID, userID, role)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddMemberToRoom indicates an expected call of AddMemberToRoom.
-func (mr *MockRoomRepositoryMockRecorder) AddMemberToRoom(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) AddMemberToRoom(ctx, roomID, userID, role any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMemberToRoom", reflect.TypeOf((*MockRoomRepository)(nil).AddMemberToRoom), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMemberToRoom", reflect.TypeOf((*MockRoomRepository)(nil).AddMemberToRoom), ctx, roomID, userID, role)
 }
 
 // DeleteRoom mocks base method.
-func (m *MockRoomRepository) DeleteRoom(arg0 context.Context, arg1 entity.RoomID) error {
+func (m *MockRoomRepository) DeleteRoom(ctx context.Context, roomID entity.RoomID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRoom", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteRoom", ctx, roomID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteRoom indicates an expected call of DeleteRoom.
-func (mr *MockRoomRepositoryMockRecorder) DeleteRoom(arg0, arg1 any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) DeleteRoom(ctx, roomID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoom", reflect.TypeOf((*MockRoomRepository)(nil).DeleteRoom), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoom", reflect.TypeOf((*MockRoomRepository)(nil).DeleteRoom), ctx, roomID)
 }
 
 // GetAllRooms mocks base method.
-func (m *MockRoomRepository) GetAllRooms(arg0 context.Context) ([]*entity.Room, error) {
+func (m *MockRoomRepository) GetAllRooms(ctx context.Context, viewerID entity.UserID) ([]*entity.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllRooms", arg0)
+	ret := m.ctrl.Call(m, "GetAllRooms", ctx, viewerID)
 	ret0, _ := ret[0].([]*entity.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllRooms indicates an expected call of GetAllRooms.
-func (mr *MockRoomRepositoryMockRecorder) GetAllRooms(arg0 any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) GetAllRooms(ctx, viewerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRooms", reflect.TypeOf((*MockRoomRepository)(nil).GetAllRooms), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRooms", reflect.TypeOf((*MockRoomRepository)(nil).GetAllRooms), ctx, viewerID)
+}
+
+// GetDirectRoom mocks base method.
+func (m *MockRoomRepository) GetDirectRoom(ctx context.Context, userA, userB entity.UserID) (*entity.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDirectRoom", ctx, userA, userB)
+	ret0, _ := ret[0].(*entity.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDirectRoom indicates an expected call of GetDirectRoom.
+func (mr *MockRoomRepositoryMockRecorder) GetDirectRoom(ctx, userA, userB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectRoom", reflect.TypeOf((*MockRoomRepository)(nil).GetDirectRoom), ctx, userA, userB)
+}
+
+// GetDirectRooms mocks base method.
+func (m *MockRoomRepository) GetDirectRooms(ctx context.Context, userID entity.UserID) ([]*entity.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDirectRooms", ctx, userID)
+	ret0, _ := ret[0].([]*entity.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDirectRooms indicates an expected call of GetDirectRooms.
+func (mr *MockRoomRepositoryMockRecorder) GetDirectRooms(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectRooms", reflect.TypeOf((*MockRoomRepository)(nil).GetDirectRooms), ctx, userID)
+}
+
+// GetMemberIDs mocks base method.
+func (m *MockRoomRepository) GetMemberIDs(ctx context.Context, roomID entity.RoomID) ([]entity.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberIDs", ctx, roomID)
+	ret0, _ := ret[0].([]entity.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberIDs indicates an expected call of GetMemberIDs.
+func (mr *MockRoomRepositoryMockRecorder) GetMemberIDs(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberIDs", reflect.TypeOf((*MockRoomRepository)(nil).GetMemberIDs), ctx, roomID)
+}
+
+// GetMemberIDsByNames mocks base method.
+func (m *MockRoomRepository) GetMemberIDsByNames(ctx context.Context, roomID entity.RoomID, names []string) ([]entity.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberIDsByNames", ctx, roomID, names)
+	ret0, _ := ret[0].([]entity.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberIDsByNames indicates an expected call of GetMemberIDsByNames.
+func (mr *MockRoomRepositoryMockRecorder) GetMemberIDsByNames(ctx, roomID, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberIDsByNames", reflect.TypeOf((*MockRoomRepository)(nil).GetMemberIDsByNames), ctx, roomID, names)
+}
+
+// GetMemberRole mocks base method.
+func (m *MockRoomRepository) GetMemberRole(ctx context.Context, roomID entity.RoomID, userID entity.UserID) (entity.RoomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberRole", ctx, roomID, userID)
+	ret0, _ := ret[0].(entity.RoomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberRole indicates an expected call of GetMemberRole.
+func (mr *MockRoomRepositoryMockRecorder) GetMemberRole(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberRole", reflect.TypeOf((*MockRoomRepository)(nil).GetMemberRole), ctx, roomID, userID)
 }
 
 // GetRoomByID mocks base method.
@@ -99,75 +176,193 @@ func (mr *MockRoomRepositoryMockRecorder) GetRoomByID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomByID", reflect.TypeOf((*MockRoomRepository)(nil).GetRoomByID), ctx, id)
 }
 
-// GetRoomByNameLike mocks base method.
-func (m *MockRoomRepository) GetRoomByNameLike(ctx context.Context, name string) ([]*entity.Room, error) {
+// GetRoomIDsByMember mocks base method.
+func (m *MockRoomRepository) GetRoomIDsByMember(ctx context.Context, userID entity.UserID) ([]entity.RoomID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRoomByNameLike", ctx, name)
-	ret0, _ := ret[0].([]*entity.Room)
+	ret := m.ctrl.Call(m, "GetRoomIDsByMember", ctx, userID)
+	ret0, _ := ret[0].([]entity.RoomID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRoomByNameLike indicates an expected call of GetRoomByNameLike.
-func (mr *MockRoomRepositoryMockRecorder) GetRoomByNameLike(ctx, name any) *gomock.Call {
+// GetRoomIDsByMember indicates an expected call of GetRoomIDsByMember.
+func (mr *MockRoomRepositoryMockRecorder) GetRoomIDsByMember(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomByNameLike", reflect.TypeOf((*MockRoomRepository)(nil).GetRoomByNameLike), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomIDsByMember", reflect.TypeOf((*MockRoomRepository)(nil).GetRoomIDsByMember), ctx, userID)
 }
 
 // GetUsersInRoom mocks base method.
-func (m *MockRoomRepository) GetUsersInRoom(arg0 context.Context, arg1 entity.RoomID) ([]*entity.User, error) {
+func (m *MockRoomRepository) GetUsersInRoom(ctx context.Context, roomID entity.RoomID) ([]*entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersInRoom", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetUsersInRoom", ctx, roomID)
 	ret0, _ := ret[0].([]*entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUsersInRoom indicates an expected call of GetUsersInRoom.
-func (mr *MockRoomRepositoryMockRecorder) GetUsersInRoom(arg0, arg1 any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) GetUsersInRoom(ctx, roomID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersInRoom", reflect.TypeOf((*MockRoomRepository)(nil).GetUsersInRoom), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersInRoom", reflect.TypeOf((*MockRoomRepository)(nil).GetUsersInRoom), ctx, roomID)
+}
+
+// ListRoomMembers mocks base method.
+func (m *MockRoomRepository) ListRoomMembers(ctx context.Context, query repository.RoomMemberListQuery) ([]*entity.RoomMember, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomMembers", ctx, query)
+	ret0, _ := ret[0].([]*entity.RoomMember)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRoomMembers indicates an expected call of ListRoomMembers.
+func (mr *MockRoomRepositoryMockRecorder) ListRoomMembers(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomMembers", reflect.TypeOf((*MockRoomRepository)(nil).ListRoomMembers), ctx, query)
+}
+
+// ListRoomSummaries mocks base method.
+func (m *MockRoomRepository) ListRoomSummaries(ctx context.Context, query repository.RoomListQuery) ([]*entity.RoomSummary, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomSummaries", ctx, query)
+	ret0, _ := ret[0].([]*entity.RoomSummary)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRoomSummaries indicates an expected call of ListRoomSummaries.
+func (mr *MockRoomRepositoryMockRecorder) ListRoomSummaries(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomSummaries", reflect.TypeOf((*MockRoomRepository)(nil).ListRoomSummaries), ctx, query)
 }
 
 // RemoveMemberFromRoom mocks base method.
-func (m *MockRoomRepository) RemoveMemberFromRoom(arg0 context.Context, arg1 entity.RoomID, arg2 entity.UserID) error {
+func (m *MockRoomRepository) RemoveMemberFromRoom(ctx context.Context, roomID entity.RoomID, userID entity.UserID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveMemberFromRoom", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "RemoveMemberFromRoom", ctx, roomID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveMemberFromRoom indicates an expected call of RemoveMemberFromRoom.
-func (mr *MockRoomRepositoryMockRecorder) RemoveMemberFromRoom(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) RemoveMemberFromRoom(ctx, roomID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMemberFromRoom", reflect.TypeOf((*MockRoomRepository)(nil).RemoveMemberFromRoom), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMemberFromRoom", reflect.TypeOf((*MockRoomRepository)(nil).RemoveMemberFromRoom), ctx, roomID, userID)
+}
+
+// SaveDirectRoom mocks base method.
+func (m *MockRoomRepository) SaveDirectRoom(ctx context.Context, room *entity.Room, userA, userB entity.UserID) (entity.RoomID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDirectRoom", ctx, room, userA, userB)
+	ret0, _ := ret[0].(entity.RoomID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDirectRoom indicates an expected call of SaveDirectRoom.
+func (mr *MockRoomRepositoryMockRecorder) SaveDirectRoom(ctx, room, userA, userB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDirectRoom", reflect.TypeOf((*MockRoomRepository)(nil).SaveDirectRoom), ctx, room, userA, userB)
 }
 
 // SaveRoom mocks base method.
-func (m *MockRoomRepository) SaveRoom(arg0 context.Context, arg1 *entity.Room) (entity.RoomID, error) {
+func (m *MockRoomRepository) SaveRoom(ctx context.Context, room *entity.Room) (entity.RoomID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveRoom", arg0, arg1)
+	ret := m.ctrl.Call(m, "SaveRoom", ctx, room)
 	ret0, _ := ret[0].(entity.RoomID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SaveRoom indicates an expected call of SaveRoom.
-func (mr *MockRoomRepositoryMockRecorder) SaveRoom(arg0, arg1 any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) SaveRoom(ctx, room any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRoom", reflect.TypeOf((*MockRoomRepository)(nil).SaveRoom), ctx, room)
+}
+
+// SaveRoomWithOwner mocks base method.
+func (m *MockRoomRepository) SaveRoomWithOwner(ctx context.Context, room *entity.Room, ownerID entity.UserID) (entity.RoomID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRoomWithOwner", ctx, room, ownerID)
+	ret0, _ := ret[0].(entity.RoomID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveRoomWithOwner indicates an expected call of SaveRoomWithOwner.
+func (mr *MockRoomRepositoryMockRecorder) SaveRoomWithOwner(ctx, room, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRoomWithOwner", reflect.TypeOf((*MockRoomRepository)(nil).SaveRoomWithOwner), ctx, room, ownerID)
+}
+
+// SetRoomArchived mocks base method.
+func (m *MockRoomRepository) SetRoomArchived(ctx context.Context, roomID entity.RoomID, archivedAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoomArchived", ctx, roomID, archivedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRoomArchived indicates an expected call of SetRoomArchived.
+func (mr *MockRoomRepositoryMockRecorder) SetRoomArchived(ctx, roomID, archivedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRoom", reflect.TypeOf((*MockRoomRepository)(nil).SaveRoom), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoomArchived", reflect.TypeOf((*MockRoomRepository)(nil).SetRoomArchived), ctx, roomID, archivedAt)
+}
+
+// UpdateRoomDescription mocks base method.
+func (m *MockRoomRepository) UpdateRoomDescription(ctx context.Context, roomID entity.RoomID, description string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoomDescription", ctx, roomID, description)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoomDescription indicates an expected call of UpdateRoomDescription.
+func (mr *MockRoomRepositoryMockRecorder) UpdateRoomDescription(ctx, roomID, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomDescription", reflect.TypeOf((*MockRoomRepository)(nil).UpdateRoomDescription), ctx, roomID, description)
 }
 
 // UpdateRoomName mocks base method.
-func (m *MockRoomRepository) UpdateRoomName(arg0 context.Context, arg1 entity.RoomID, arg2 string) error {
+func (m *MockRoomRepository) UpdateRoomName(ctx context.Context, roomID entity.RoomID, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRoomName", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateRoomName", ctx, roomID, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateRoomName indicates an expected call of UpdateRoomName.
-func (mr *MockRoomRepositoryMockRecorder) UpdateRoomName(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockRoomRepositoryMockRecorder) UpdateRoomName(ctx, roomID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomName", reflect.TypeOf((*MockRoomRepository)(nil).UpdateRoomName), ctx, roomID, name)
+}
+
+// UpdateRoomPinLimit mocks base method.
+func (m *MockRoomRepository) UpdateRoomPinLimit(ctx context.Context, roomID entity.RoomID, limit int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoomPinLimit", ctx, roomID, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoomPinLimit indicates an expected call of UpdateRoomPinLimit.
+func (mr *MockRoomRepositoryMockRecorder) UpdateRoomPinLimit(ctx, roomID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomPinLimit", reflect.TypeOf((*MockRoomRepository)(nil).UpdateRoomPinLimit), ctx, roomID, limit)
+}
+
+// UpdateRoomSlowMode mocks base method.
+func (m *MockRoomRepository) UpdateRoomSlowMode(ctx context.Context, roomID entity.RoomID, interval time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoomSlowMode", ctx, roomID, interval)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoomSlowMode indicates an expected call of UpdateRoomSlowMode.
+func (mr *MockRoomRepositoryMockRecorder) UpdateRoomSlowMode(ctx, roomID, interval any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomName", reflect.TypeOf((*MockRoomRepository)(nil).UpdateRoomName), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomSlowMode", reflect.TypeOf((*MockRoomRepository)(nil).UpdateRoomSlowMode), ctx, roomID, interval)
 }
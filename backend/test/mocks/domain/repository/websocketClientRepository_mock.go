@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/repository/websocketClientRepository.go
+// Source: internal/domain/repository/websocketClientRepository.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/repository/websocketClientRepository.go -destination=test/mocks/domain/repository/websocketClientRepository_mock.go
+//	mockgen -source=internal/domain/repository/websocketClientRepository.go -destination=test/mocks/domain/repository/websocketClientRepository_mock.go
 //
 
 // Package mock_repository is a generated GoMock package.
@@ -100,10 +100,10 @@ func (mr *MockWebsocketClientRepositoryMockRecorder) GetClientsByRoomID(ctx, roo
 }
 
 // GetClientsByUserID mocks base method.
-func (m *MockWebsocketClientRepository) GetClientsByUserID(ctx context.Context, userID entity.UserID) (*entity.WebsocketClient, error) {
+func (m *MockWebsocketClientRepository) GetClientsByUserID(ctx context.Context, userID entity.UserID) ([]*entity.WebsocketClient, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetClientsByUserID", ctx, userID)
-	ret0, _ := ret[0].(*entity.WebsocketClient)
+	ret0, _ := ret[0].([]*entity.WebsocketClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
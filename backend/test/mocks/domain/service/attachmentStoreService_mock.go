@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/service/attachmentStoreService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/service/attachmentStoreService.go -destination=test/mocks/domain/service/attachmentStoreService_mock.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	service "example.com/infrahandson/internal/domain/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentStoreService is a mock of AttachmentStoreService interface.
+type MockAttachmentStoreService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentStoreServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentStoreServiceMockRecorder is the mock recorder for MockAttachmentStoreService.
+type MockAttachmentStoreServiceMockRecorder struct {
+	mock *MockAttachmentStoreService
+}
+
+// NewMockAttachmentStoreService creates a new mock instance.
+func NewMockAttachmentStoreService(ctrl *gomock.Controller) *MockAttachmentStoreService {
+	mock := &MockAttachmentStoreService{ctrl: ctrl}
+	mock.recorder = &MockAttachmentStoreServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentStoreService) EXPECT() *MockAttachmentStoreServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockAttachmentStoreService) DeleteAttachment(ctx context.Context, id entity.AttachmentID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockAttachmentStoreServiceMockRecorder) DeleteAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockAttachmentStoreService)(nil).DeleteAttachment), ctx, id)
+}
+
+// OpenAttachment mocks base method.
+func (m *MockAttachmentStoreService) OpenAttachment(ctx context.Context, id entity.AttachmentID, thumbnail bool) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAttachment", ctx, id, thumbnail)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenAttachment indicates an expected call of OpenAttachment.
+func (mr *MockAttachmentStoreServiceMockRecorder) OpenAttachment(ctx, id, thumbnail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAttachment", reflect.TypeOf((*MockAttachmentStoreService)(nil).OpenAttachment), ctx, id, thumbnail)
+}
+
+// SaveAttachment mocks base method.
+func (m *MockAttachmentStoreService) SaveAttachment(ctx context.Context, id entity.AttachmentID, data *service.AttachmentData) (*service.StoredAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttachment", ctx, id, data)
+	ret0, _ := ret[0].(*service.StoredAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAttachment indicates an expected call of SaveAttachment.
+func (mr *MockAttachmentStoreServiceMockRecorder) SaveAttachment(ctx, id, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttachment", reflect.TypeOf((*MockAttachmentStoreService)(nil).SaveAttachment), ctx, id, data)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/service/iconStoreService.go
+// Source: internal/domain/service/iconStoreService.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/service/iconStoreService.go -destination=test/mocks/domain/service/iconStoreService_mock.go
+//	mockgen -source=internal/domain/service/iconStoreService.go -destination=test/mocks/domain/service/iconStoreService_mock.go
 //
 
 // Package mock_service is a generated GoMock package.
@@ -57,6 +57,21 @@ func (mr *MockIconStoreServiceMockRecorder) GetIconPath(ctx, userID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIconPath", reflect.TypeOf((*MockIconStoreService)(nil).GetIconPath), ctx, userID)
 }
 
+// GetRoomIconPath mocks base method.
+func (m *MockIconStoreService) GetRoomIconPath(ctx context.Context, roomID entity.RoomID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomIconPath", ctx, roomID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomIconPath indicates an expected call of GetRoomIconPath.
+func (mr *MockIconStoreServiceMockRecorder) GetRoomIconPath(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomIconPath", reflect.TypeOf((*MockIconStoreService)(nil).GetRoomIconPath), ctx, roomID)
+}
+
 // SaveIcon mocks base method.
 func (m *MockIconStoreService) SaveIcon(ctx context.Context, iconData *service.IconData, userID entity.UserID) error {
 	m.ctrl.T.Helper()
@@ -70,3 +85,17 @@ func (mr *MockIconStoreServiceMockRecorder) SaveIcon(ctx, iconData, userID any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIcon", reflect.TypeOf((*MockIconStoreService)(nil).SaveIcon), ctx, iconData, userID)
 }
+
+// SaveRoomIcon mocks base method.
+func (m *MockIconStoreService) SaveRoomIcon(ctx context.Context, iconData *service.IconData, roomID entity.RoomID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRoomIcon", ctx, iconData, roomID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRoomIcon indicates an expected call of SaveRoomIcon.
+func (mr *MockIconStoreServiceMockRecorder) SaveRoomIcon(ctx, iconData, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRoomIcon", reflect.TypeOf((*MockIconStoreService)(nil).SaveRoomIcon), ctx, iconData, roomID)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/service/messageCacheService.go
+// Source: internal/domain/service/messageCacheService.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/service/messageCacheService.go -destination=test/mocks/domain/service/messageCacheService_mock.go
+//	mockgen -source=internal/domain/service/messageCacheService.go -destination=test/mocks/domain/service/messageCacheService_mock.go
 //
 
 // Package mock_service is a generated GoMock package.
@@ -11,7 +11,6 @@ package mock_service
 
 import (
 	context "context"
-
 	reflect "reflect"
 
 	entity "example.com/infrahandson/internal/domain/entity"
@@ -46,7 +45,6 @@ func (m *MockMessageCacheService) EXPECT() *MockMessageCacheServiceMockRecorder
 func (m *MockMessageCacheService) AddMessage(ctx context.Context, roomID entity.RoomID, message *entity.Message) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddMessage", ctx, roomID, message)
-
 	ret0, _ := ret[0].(error)
 	return ret0
 }
@@ -61,7 +59,6 @@ func (mr *MockMessageCacheServiceMockRecorder) AddMessage(ctx, roomID, message a
 func (m *MockMessageCacheService) GetRecentMessages(ctx context.Context, roomID entity.RoomID) ([]*entity.Message, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRecentMessages", ctx, roomID)
-
 	ret0, _ := ret[0].([]*entity.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
@@ -72,3 +69,17 @@ func (mr *MockMessageCacheServiceMockRecorder) GetRecentMessages(ctx, roomID any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentMessages", reflect.TypeOf((*MockMessageCacheService)(nil).GetRecentMessages), ctx, roomID)
 }
+
+// InvalidateRoom mocks base method.
+func (m *MockMessageCacheService) InvalidateRoom(ctx context.Context, roomID entity.RoomID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateRoom", ctx, roomID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateRoom indicates an expected call of InvalidateRoom.
+func (mr *MockMessageCacheServiceMockRecorder) InvalidateRoom(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateRoom", reflect.TypeOf((*MockMessageCacheService)(nil).InvalidateRoom), ctx, roomID)
+}
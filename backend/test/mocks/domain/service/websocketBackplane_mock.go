@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/service/websocketBackplane.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/service/websocketBackplane.go -destination=test/mocks/domain/service/websocketBackplane_mock.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	service "example.com/infrahandson/internal/domain/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebsocketBackplane is a mock of WebsocketBackplane interface.
+type MockWebsocketBackplane struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebsocketBackplaneMockRecorder
+	isgomock struct{}
+}
+
+// MockWebsocketBackplaneMockRecorder is the mock recorder for MockWebsocketBackplane.
+type MockWebsocketBackplaneMockRecorder struct {
+	mock *MockWebsocketBackplane
+}
+
+// NewMockWebsocketBackplane creates a new mock instance.
+func NewMockWebsocketBackplane(ctrl *gomock.Controller) *MockWebsocketBackplane {
+	mock := &MockWebsocketBackplane{ctrl: ctrl}
+	mock.recorder = &MockWebsocketBackplaneMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebsocketBackplane) EXPECT() *MockWebsocketBackplaneMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockWebsocketBackplane) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockWebsocketBackplaneMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWebsocketBackplane)(nil).Close))
+}
+
+// Publish mocks base method.
+func (m *MockWebsocketBackplane) Publish(ctx context.Context, roomID entity.RoomID, event *entity.WebsocketEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, roomID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockWebsocketBackplaneMockRecorder) Publish(ctx, roomID, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockWebsocketBackplane)(nil).Publish), ctx, roomID, event)
+}
+
+// Subscribe mocks base method.
+func (m *MockWebsocketBackplane) Subscribe(handler service.WebsocketBackplaneHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", handler)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockWebsocketBackplaneMockRecorder) Subscribe(handler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockWebsocketBackplane)(nil).Subscribe), handler)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/service/websocketManager.go
+// Source: internal/domain/service/websocketManager.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/domain/service/websocketManager.go -destination=test/mocks/domain/service/websocketManager_mock.go
+//	mockgen -source=internal/domain/service/websocketManager.go -destination=test/mocks/domain/service/websocketManager_mock.go
 //
 
 // Package mock_service is a generated GoMock package.
@@ -12,6 +12,7 @@ package mock_service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "example.com/infrahandson/internal/domain/entity"
 	service "example.com/infrahandson/internal/domain/service"
@@ -56,33 +57,47 @@ func (mr *MockWebSocketConnectionMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWebSocketConnection)(nil).Close))
 }
 
-// ReadMessage mocks base method.
-func (m *MockWebSocketConnection) ReadMessage() (*entity.Message, error) {
+// CloseWithReason mocks base method.
+func (m *MockWebSocketConnection) CloseWithReason(code service.WebsocketCloseCode, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadMessage")
-	ret0, _ := ret[0].(*entity.Message)
+	ret := m.ctrl.Call(m, "CloseWithReason", code, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseWithReason indicates an expected call of CloseWithReason.
+func (mr *MockWebSocketConnectionMockRecorder) CloseWithReason(code, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseWithReason", reflect.TypeOf((*MockWebSocketConnection)(nil).CloseWithReason), code, reason)
+}
+
+// ReadEvent mocks base method.
+func (m *MockWebSocketConnection) ReadEvent() (*entity.WebsocketEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEvent")
+	ret0, _ := ret[0].(*entity.WebsocketEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadMessage indicates an expected call of ReadMessage.
-func (mr *MockWebSocketConnectionMockRecorder) ReadMessage() *gomock.Call {
+// ReadEvent indicates an expected call of ReadEvent.
+func (mr *MockWebSocketConnectionMockRecorder) ReadEvent() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMessage", reflect.TypeOf((*MockWebSocketConnection)(nil).ReadMessage))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEvent", reflect.TypeOf((*MockWebSocketConnection)(nil).ReadEvent))
 }
 
-// WriteMessage mocks base method.
-func (m *MockWebSocketConnection) WriteMessage(arg0 *entity.Message) error {
+// WriteEvent mocks base method.
+func (m *MockWebSocketConnection) WriteEvent(arg0 *entity.WebsocketEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteMessage", arg0)
+	ret := m.ctrl.Call(m, "WriteEvent", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// WriteMessage indicates an expected call of WriteMessage.
-func (mr *MockWebSocketConnectionMockRecorder) WriteMessage(arg0 any) *gomock.Call {
+// WriteEvent indicates an expected call of WriteEvent.
+func (mr *MockWebSocketConnectionMockRecorder) WriteEvent(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteMessage", reflect.TypeOf((*MockWebSocketConnection)(nil).WriteMessage), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEvent", reflect.TypeOf((*MockWebSocketConnection)(nil).WriteEvent), arg0)
 }
 
 // MockWebsocketManager is a mock of WebsocketManager interface.
@@ -110,58 +125,172 @@ func (m *MockWebsocketManager) EXPECT() *MockWebsocketManagerMockRecorder {
 }
 
 // BroadcastToRoom mocks base method.
-func (m *MockWebsocketManager) BroadcastToRoom(ctx context.Context, roomID entity.RoomID, msg *entity.Message) error {
+func (m *MockWebsocketManager) BroadcastToRoom(ctx context.Context, roomID entity.RoomID, event *entity.WebsocketEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BroadcastToRoom", ctx, roomID, msg)
+	ret := m.ctrl.Call(m, "BroadcastToRoom", ctx, roomID, event)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // BroadcastToRoom indicates an expected call of BroadcastToRoom.
-func (mr *MockWebsocketManagerMockRecorder) BroadcastToRoom(ctx, roomID, msg any) *gomock.Call {
+func (mr *MockWebsocketManagerMockRecorder) BroadcastToRoom(ctx, roomID, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastToRoom", reflect.TypeOf((*MockWebsocketManager)(nil).BroadcastToRoom), ctx, roomID, event)
+}
+
+// BroadcastToRoomExcept mocks base method.
+func (m *MockWebsocketManager) BroadcastToRoomExcept(ctx context.Context, roomID entity.RoomID, exclude entity.UserID, event *entity.WebsocketEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BroadcastToRoomExcept", ctx, roomID, exclude, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BroadcastToRoomExcept indicates an expected call of BroadcastToRoomExcept.
+func (mr *MockWebsocketManagerMockRecorder) BroadcastToRoomExcept(ctx, roomID, exclude, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastToRoomExcept", reflect.TypeOf((*MockWebsocketManager)(nil).BroadcastToRoomExcept), ctx, roomID, exclude, event)
+}
+
+// CloseRoom mocks base method.
+func (m *MockWebsocketManager) CloseRoom(ctx context.Context, roomID entity.RoomID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseRoom", ctx, roomID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseRoom indicates an expected call of CloseRoom.
+func (mr *MockWebsocketManagerMockRecorder) CloseRoom(ctx, roomID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseRoom", reflect.TypeOf((*MockWebsocketManager)(nil).CloseRoom), ctx, roomID, reason)
+}
+
+// DisconnectUser mocks base method.
+func (m *MockWebsocketManager) DisconnectUser(ctx context.Context, roomID entity.RoomID, userID entity.UserID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisconnectUser", ctx, roomID, userID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisconnectUser indicates an expected call of DisconnectUser.
+func (mr *MockWebsocketManagerMockRecorder) DisconnectUser(ctx, roomID, userID, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastToRoom", reflect.TypeOf((*MockWebsocketManager)(nil).BroadcastToRoom), ctx, roomID, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisconnectUser", reflect.TypeOf((*MockWebsocketManager)(nil).DisconnectUser), ctx, roomID, userID, reason)
 }
 
-// GetConnectionByUserID mocks base method.
-func (m *MockWebsocketManager) GetConnectionByUserID(ctx context.Context, userID entity.UserID) (service.WebSocketConnection, error) {
+// GetConnectionByClientID mocks base method.
+func (m *MockWebsocketManager) GetConnectionByClientID(ctx context.Context, clientID entity.WsClientID) (service.WebSocketConnection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConnectionByUserID", ctx, userID)
+	ret := m.ctrl.Call(m, "GetConnectionByClientID", ctx, clientID)
 	ret0, _ := ret[0].(service.WebSocketConnection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetConnectionByUserID indicates an expected call of GetConnectionByUserID.
-func (mr *MockWebsocketManagerMockRecorder) GetConnectionByUserID(ctx, userID any) *gomock.Call {
+// GetConnectionByClientID indicates an expected call of GetConnectionByClientID.
+func (mr *MockWebsocketManagerMockRecorder) GetConnectionByClientID(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionByClientID", reflect.TypeOf((*MockWebsocketManager)(nil).GetConnectionByClientID), ctx, clientID)
+}
+
+// GetConnectionsByUserID mocks base method.
+func (m *MockWebsocketManager) GetConnectionsByUserID(ctx context.Context, userID entity.UserID) ([]service.WebSocketConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnectionsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]service.WebSocketConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnectionsByUserID indicates an expected call of GetConnectionsByUserID.
+func (mr *MockWebsocketManagerMockRecorder) GetConnectionsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionsByUserID", reflect.TypeOf((*MockWebsocketManager)(nil).GetConnectionsByUserID), ctx, userID)
+}
+
+// GetUserActivity mocks base method.
+func (m *MockWebsocketManager) GetUserActivity(ctx context.Context, userID entity.UserID) (entity.UserActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserActivity", ctx, userID)
+	ret0, _ := ret[0].(entity.UserActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserActivity indicates an expected call of GetUserActivity.
+func (mr *MockWebsocketManagerMockRecorder) GetUserActivity(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionByUserID", reflect.TypeOf((*MockWebsocketManager)(nil).GetConnectionByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserActivity", reflect.TypeOf((*MockWebsocketManager)(nil).GetUserActivity), ctx, userID)
 }
 
 // Register mocks base method.
-func (m *MockWebsocketManager) Register(ctx context.Context, conn service.WebSocketConnection, userID entity.UserID, roomID entity.RoomID) error {
+func (m *MockWebsocketManager) Register(ctx context.Context, client *entity.WebsocketClient, conn service.WebSocketConnection) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", ctx, conn, userID, roomID)
+	ret := m.ctrl.Call(m, "Register", ctx, client, conn)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Register indicates an expected call of Register.
-func (mr *MockWebsocketManagerMockRecorder) Register(ctx, conn, userID, roomID any) *gomock.Call {
+func (mr *MockWebsocketManagerMockRecorder) Register(ctx, client, conn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockWebsocketManager)(nil).Register), ctx, client, conn)
+}
+
+// SendToUser mocks base method.
+func (m *MockWebsocketManager) SendToUser(ctx context.Context, userID entity.UserID, event *entity.WebsocketEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendToUser", ctx, userID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendToUser indicates an expected call of SendToUser.
+func (mr *MockWebsocketManagerMockRecorder) SendToUser(ctx, userID, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendToUser", reflect.TypeOf((*MockWebsocketManager)(nil).SendToUser), ctx, userID, event)
+}
+
+// Shutdown mocks base method.
+func (m *MockWebsocketManager) Shutdown(ctx context.Context, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shutdown", ctx, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Shutdown indicates an expected call of Shutdown.
+func (mr *MockWebsocketManagerMockRecorder) Shutdown(ctx, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockWebsocketManager)(nil).Shutdown), ctx, reason)
+}
+
+// TouchUser mocks base method.
+func (m *MockWebsocketManager) TouchUser(ctx context.Context, userID entity.UserID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchUser", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchUser indicates an expected call of TouchUser.
+func (mr *MockWebsocketManagerMockRecorder) TouchUser(ctx, userID, at any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockWebsocketManager)(nil).Register), ctx, conn, userID, roomID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchUser", reflect.TypeOf((*MockWebsocketManager)(nil).TouchUser), ctx, userID, at)
 }
 
 // Unregister mocks base method.
-func (m *MockWebsocketManager) Unregister(ctx context.Context, conn service.WebSocketConnection) error {
+func (m *MockWebsocketManager) Unregister(ctx context.Context, clientID entity.WsClientID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Unregister", ctx, conn)
+	ret := m.ctrl.Call(m, "Unregister", ctx, clientID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Unregister indicates an expected call of Unregister.
-func (mr *MockWebsocketManagerMockRecorder) Unregister(ctx, conn any) *gomock.Call {
+func (mr *MockWebsocketManagerMockRecorder) Unregister(ctx, clientID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unregister", reflect.TypeOf((*MockWebsocketManager)(nil).Unregister), ctx, conn)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unregister", reflect.TypeOf((*MockWebsocketManager)(nil).Unregister), ctx, clientID)
 }
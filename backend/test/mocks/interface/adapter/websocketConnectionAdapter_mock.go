@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamuraitsuki/program/Maximum/Infra/Maximum-Infra-hands-on/backend/internal/interface/adapter/websocketConnectionAdapter.go
+// Source: internal/interface/adapter/websocketConnectionAdapter.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamuraitsuki/program/Maximum/Infra/Maximum-Infra-hands-on/backend/internal/interface/adapter/websocketConnectionAdapter.go -destination=test/mocks/interface/adapter/websocketConnectionAdapter_mock.go
+//	mockgen -source=internal/interface/adapter/websocketConnectionAdapter.go -destination=test/mocks/interface/adapter/websocketConnectionAdapter_mock.go
 //
 
 // Package mock_adapter is a generated GoMock package.
@@ -11,6 +11,7 @@ package mock_adapter
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -83,6 +84,60 @@ func (mr *MockConnAdapterMockRecorder) ReadMessageFunc() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMessageFunc", reflect.TypeOf((*MockConnAdapter)(nil).ReadMessageFunc))
 }
 
+// SetPongHandler mocks base method.
+func (m *MockConnAdapter) SetPongHandler(h func(string) error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPongHandler", h)
+}
+
+// SetPongHandler indicates an expected call of SetPongHandler.
+func (mr *MockConnAdapterMockRecorder) SetPongHandler(h any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPongHandler", reflect.TypeOf((*MockConnAdapter)(nil).SetPongHandler), h)
+}
+
+// SetReadDeadline mocks base method.
+func (m *MockConnAdapter) SetReadDeadline(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadDeadline", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReadDeadline indicates an expected call of SetReadDeadline.
+func (mr *MockConnAdapterMockRecorder) SetReadDeadline(t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadDeadline", reflect.TypeOf((*MockConnAdapter)(nil).SetReadDeadline), t)
+}
+
+// SetWriteDeadline mocks base method.
+func (m *MockConnAdapter) SetWriteDeadline(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteDeadline", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWriteDeadline indicates an expected call of SetWriteDeadline.
+func (mr *MockConnAdapterMockRecorder) SetWriteDeadline(t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteDeadline", reflect.TypeOf((*MockConnAdapter)(nil).SetWriteDeadline), t)
+}
+
+// WriteControl mocks base method.
+func (m *MockConnAdapter) WriteControl(messageType int, data []byte, deadline time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteControl", messageType, data, deadline)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteControl indicates an expected call of WriteControl.
+func (mr *MockConnAdapterMockRecorder) WriteControl(messageType, data, deadline any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteControl", reflect.TypeOf((*MockConnAdapter)(nil).WriteControl), messageType, data, deadline)
+}
+
 // WriteJSON mocks base method.
 func (m *MockConnAdapter) WriteJSON(arg0 any) error {
 	m.ctrl.T.Helper()
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamuraitsuki/program/Maximum/Infra/Maximum-Infra-hands-on/backend/internal/interface/factory/idFactory.go
+// Source: internal/interface/factory/idFactory.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamuraitsuki/program/Maximum/Infra/Maximum-Infra-hands-on/backend/internal/interface/factory/idFactory.go -destination=test/mocks/interface/factory/idFactory_mock.go
+//	mockgen -source=internal/interface/factory/idFactory.go -destination=test/mocks/interface/factory/idFactory_mock.go
 //
 
 // Package mock_factory is a generated GoMock package.
@@ -133,6 +133,45 @@ func (mr *MockMessageIDFactoryMockRecorder) NewMessageID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMessageID", reflect.TypeOf((*MockMessageIDFactory)(nil).NewMessageID))
 }
 
+// MockAttachmentIDFactory is a mock of AttachmentIDFactory interface.
+type MockAttachmentIDFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentIDFactoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentIDFactoryMockRecorder is the mock recorder for MockAttachmentIDFactory.
+type MockAttachmentIDFactoryMockRecorder struct {
+	mock *MockAttachmentIDFactory
+}
+
+// NewMockAttachmentIDFactory creates a new mock instance.
+func NewMockAttachmentIDFactory(ctrl *gomock.Controller) *MockAttachmentIDFactory {
+	mock := &MockAttachmentIDFactory{ctrl: ctrl}
+	mock.recorder = &MockAttachmentIDFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentIDFactory) EXPECT() *MockAttachmentIDFactoryMockRecorder {
+	return m.recorder
+}
+
+// NewAttachmentID mocks base method.
+func (m *MockAttachmentIDFactory) NewAttachmentID() (entity.AttachmentID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAttachmentID")
+	ret0, _ := ret[0].(entity.AttachmentID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAttachmentID indicates an expected call of NewAttachmentID.
+func (mr *MockAttachmentIDFactoryMockRecorder) NewAttachmentID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAttachmentID", reflect.TypeOf((*MockAttachmentIDFactory)(nil).NewAttachmentID))
+}
+
 // MockWsClientIDFactory is a mock of WsClientIDFactory interface.
 type MockWsClientIDFactory struct {
 	ctrl     *gomock.Controller
@@ -171,3 +210,42 @@ func (mr *MockWsClientIDFactoryMockRecorder) NewWsClientID() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWsClientID", reflect.TypeOf((*MockWsClientIDFactory)(nil).NewWsClientID))
 }
+
+// MockInviteTokenFactory is a mock of InviteTokenFactory interface.
+type MockInviteTokenFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteTokenFactoryMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteTokenFactoryMockRecorder is the mock recorder for MockInviteTokenFactory.
+type MockInviteTokenFactoryMockRecorder struct {
+	mock *MockInviteTokenFactory
+}
+
+// NewMockInviteTokenFactory creates a new mock instance.
+func NewMockInviteTokenFactory(ctrl *gomock.Controller) *MockInviteTokenFactory {
+	mock := &MockInviteTokenFactory{ctrl: ctrl}
+	mock.recorder = &MockInviteTokenFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteTokenFactory) EXPECT() *MockInviteTokenFactoryMockRecorder {
+	return m.recorder
+}
+
+// NewInviteToken mocks base method.
+func (m *MockInviteTokenFactory) NewInviteToken() (entity.InviteToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewInviteToken")
+	ret0, _ := ret[0].(entity.InviteToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewInviteToken indicates an expected call of NewInviteToken.
+func (mr *MockInviteTokenFactoryMockRecorder) NewInviteToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewInviteToken", reflect.TypeOf((*MockInviteTokenFactory)(nil).NewInviteToken))
+}
@@ -0,0 +1,279 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interface/handler/messagehandler/interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/interface/handler/messagehandler/interface.go -destination=test/mocks/interface/handler/messagehandler/interface_mock.go
+//
+
+// Package mock_messagehandler is a generated GoMock package.
+package mock_messagehandler
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMessageHandlerInterface is a mock of MessageHandlerInterface interface.
+type MockMessageHandlerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageHandlerInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockMessageHandlerInterfaceMockRecorder is the mock recorder for MockMessageHandlerInterface.
+type MockMessageHandlerInterfaceMockRecorder struct {
+	mock *MockMessageHandlerInterface
+}
+
+// NewMockMessageHandlerInterface creates a new mock instance.
+func NewMockMessageHandlerInterface(ctrl *gomock.Controller) *MockMessageHandlerInterface {
+	mock := &MockMessageHandlerInterface{ctrl: ctrl}
+	mock.recorder = &MockMessageHandlerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageHandlerInterface) EXPECT() *MockMessageHandlerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddReaction mocks base method.
+func (m *MockMessageHandlerInterface) AddReaction(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockMessageHandlerInterfaceMockRecorder) AddReaction(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockMessageHandlerInterface)(nil).AddReaction), c)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockMessageHandlerInterface) DeleteMessage(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockMessageHandlerInterfaceMockRecorder) DeleteMessage(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageHandlerInterface)(nil).DeleteMessage), c)
+}
+
+// EditMessage mocks base method.
+func (m *MockMessageHandlerInterface) EditMessage(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMessage", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditMessage indicates an expected call of EditMessage.
+func (mr *MockMessageHandlerInterfaceMockRecorder) EditMessage(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockMessageHandlerInterface)(nil).EditMessage), c)
+}
+
+// GetAttachment mocks base method.
+func (m *MockMessageHandlerInterface) GetAttachment(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockMessageHandlerInterfaceMockRecorder) GetAttachment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockMessageHandlerInterface)(nil).GetAttachment), c)
+}
+
+// GetAttachmentThumbnail mocks base method.
+func (m *MockMessageHandlerInterface) GetAttachmentThumbnail(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentThumbnail", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetAttachmentThumbnail indicates an expected call of GetAttachmentThumbnail.
+func (mr *MockMessageHandlerInterfaceMockRecorder) GetAttachmentThumbnail(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentThumbnail", reflect.TypeOf((*MockMessageHandlerInterface)(nil).GetAttachmentThumbnail), c)
+}
+
+// GetMentions mocks base method.
+func (m *MockMessageHandlerInterface) GetMentions(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMentions", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetMentions indicates an expected call of GetMentions.
+func (mr *MockMessageHandlerInterfaceMockRecorder) GetMentions(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMentions", reflect.TypeOf((*MockMessageHandlerInterface)(nil).GetMentions), c)
+}
+
+// GetMessageRevisions mocks base method.
+func (m *MockMessageHandlerInterface) GetMessageRevisions(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageRevisions", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetMessageRevisions indicates an expected call of GetMessageRevisions.
+func (mr *MockMessageHandlerInterfaceMockRecorder) GetMessageRevisions(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageRevisions", reflect.TypeOf((*MockMessageHandlerInterface)(nil).GetMessageRevisions), c)
+}
+
+// GetPinnedMessages mocks base method.
+func (m *MockMessageHandlerInterface) GetPinnedMessages(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinnedMessages", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetPinnedMessages indicates an expected call of GetPinnedMessages.
+func (mr *MockMessageHandlerInterfaceMockRecorder) GetPinnedMessages(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinnedMessages", reflect.TypeOf((*MockMessageHandlerInterface)(nil).GetPinnedMessages), c)
+}
+
+// GetReadReceipts mocks base method.
+func (m *MockMessageHandlerInterface) GetReadReceipts(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadReceipts", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetReadReceipts indicates an expected call of GetReadReceipts.
+func (mr *MockMessageHandlerInterfaceMockRecorder) GetReadReceipts(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadReceipts", reflect.TypeOf((*MockMessageHandlerInterface)(nil).GetReadReceipts), c)
+}
+
+// GetRoomMessage mocks base method.
+func (m *MockMessageHandlerInterface) GetRoomMessage(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomMessage", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetRoomMessage indicates an expected call of GetRoomMessage.
+func (mr *MockMessageHandlerInterfaceMockRecorder) GetRoomMessage(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomMessage", reflect.TypeOf((*MockMessageHandlerInterface)(nil).GetRoomMessage), c)
+}
+
+// GetThread mocks base method.
+func (m *MockMessageHandlerInterface) GetThread(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThread", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetThread indicates an expected call of GetThread.
+func (mr *MockMessageHandlerInterfaceMockRecorder) GetThread(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThread", reflect.TypeOf((*MockMessageHandlerInterface)(nil).GetThread), c)
+}
+
+// MarkAsRead mocks base method.
+func (m *MockMessageHandlerInterface) MarkAsRead(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsRead", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsRead indicates an expected call of MarkAsRead.
+func (mr *MockMessageHandlerInterfaceMockRecorder) MarkAsRead(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockMessageHandlerInterface)(nil).MarkAsRead), c)
+}
+
+// PinMessage mocks base method.
+func (m *MockMessageHandlerInterface) PinMessage(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinMessage", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinMessage indicates an expected call of PinMessage.
+func (mr *MockMessageHandlerInterfaceMockRecorder) PinMessage(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinMessage", reflect.TypeOf((*MockMessageHandlerInterface)(nil).PinMessage), c)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockMessageHandlerInterface) RemoveReaction(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockMessageHandlerInterfaceMockRecorder) RemoveReaction(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockMessageHandlerInterface)(nil).RemoveReaction), c)
+}
+
+// SearchMessages mocks base method.
+func (m *MockMessageHandlerInterface) SearchMessages(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockMessageHandlerInterfaceMockRecorder) SearchMessages(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockMessageHandlerInterface)(nil).SearchMessages), c)
+}
+
+// UnpinMessage mocks base method.
+func (m *MockMessageHandlerInterface) UnpinMessage(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinMessage", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinMessage indicates an expected call of UnpinMessage.
+func (mr *MockMessageHandlerInterfaceMockRecorder) UnpinMessage(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinMessage", reflect.TypeOf((*MockMessageHandlerInterface)(nil).UnpinMessage), c)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockMessageHandlerInterface) UploadAttachment(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockMessageHandlerInterfaceMockRecorder) UploadAttachment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockMessageHandlerInterface)(nil).UploadAttachment), c)
+}
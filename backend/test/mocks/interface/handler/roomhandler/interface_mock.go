@@ -0,0 +1,377 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interface/handler/roomhandler/interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/interface/handler/roomhandler/interface.go -destination=test/mocks/interface/handler/roomhandler/interface_mock.go
+//
+
+// Package mock_roomhandler is a generated GoMock package.
+package mock_roomhandler
+
+import (
+	reflect "reflect"
+
+	echo "github.com/labstack/echo/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoomHandlerInterface is a mock of RoomHandlerInterface interface.
+type MockRoomHandlerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomHandlerInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRoomHandlerInterfaceMockRecorder is the mock recorder for MockRoomHandlerInterface.
+type MockRoomHandlerInterfaceMockRecorder struct {
+	mock *MockRoomHandlerInterface
+}
+
+// NewMockRoomHandlerInterface creates a new mock instance.
+func NewMockRoomHandlerInterface(ctrl *gomock.Controller) *MockRoomHandlerInterface {
+	mock := &MockRoomHandlerInterface{ctrl: ctrl}
+	mock.recorder = &MockRoomHandlerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomHandlerInterface) EXPECT() *MockRoomHandlerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ApproveJoinRequest mocks base method.
+func (m *MockRoomHandlerInterface) ApproveJoinRequest(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJoinRequest", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveJoinRequest indicates an expected call of ApproveJoinRequest.
+func (mr *MockRoomHandlerInterfaceMockRecorder) ApproveJoinRequest(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJoinRequest", reflect.TypeOf((*MockRoomHandlerInterface)(nil).ApproveJoinRequest), c)
+}
+
+// ArchiveRoom mocks base method.
+func (m *MockRoomHandlerInterface) ArchiveRoom(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveRoom", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveRoom indicates an expected call of ArchiveRoom.
+func (mr *MockRoomHandlerInterfaceMockRecorder) ArchiveRoom(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveRoom", reflect.TypeOf((*MockRoomHandlerInterface)(nil).ArchiveRoom), c)
+}
+
+// BanMember mocks base method.
+func (m *MockRoomHandlerInterface) BanMember(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanMember", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanMember indicates an expected call of BanMember.
+func (mr *MockRoomHandlerInterfaceMockRecorder) BanMember(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanMember", reflect.TypeOf((*MockRoomHandlerInterface)(nil).BanMember), c)
+}
+
+// CreateInvite mocks base method.
+func (m *MockRoomHandlerInterface) CreateInvite(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockRoomHandlerInterfaceMockRecorder) CreateInvite(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockRoomHandlerInterface)(nil).CreateInvite), c)
+}
+
+// CreateRoom mocks base method.
+func (m *MockRoomHandlerInterface) CreateRoom(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoom", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRoom indicates an expected call of CreateRoom.
+func (mr *MockRoomHandlerInterfaceMockRecorder) CreateRoom(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoom", reflect.TypeOf((*MockRoomHandlerInterface)(nil).CreateRoom), c)
+}
+
+// DeleteRoom mocks base method.
+func (m *MockRoomHandlerInterface) DeleteRoom(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoom", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoom indicates an expected call of DeleteRoom.
+func (mr *MockRoomHandlerInterfaceMockRecorder) DeleteRoom(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoom", reflect.TypeOf((*MockRoomHandlerInterface)(nil).DeleteRoom), c)
+}
+
+// DenyJoinRequest mocks base method.
+func (m *MockRoomHandlerInterface) DenyJoinRequest(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyJoinRequest", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DenyJoinRequest indicates an expected call of DenyJoinRequest.
+func (mr *MockRoomHandlerInterfaceMockRecorder) DenyJoinRequest(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyJoinRequest", reflect.TypeOf((*MockRoomHandlerInterface)(nil).DenyJoinRequest), c)
+}
+
+// GetDirectRooms mocks base method.
+func (m *MockRoomHandlerInterface) GetDirectRooms(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDirectRooms", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetDirectRooms indicates an expected call of GetDirectRooms.
+func (mr *MockRoomHandlerInterfaceMockRecorder) GetDirectRooms(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectRooms", reflect.TypeOf((*MockRoomHandlerInterface)(nil).GetDirectRooms), c)
+}
+
+// GetJoinRequests mocks base method.
+func (m *MockRoomHandlerInterface) GetJoinRequests(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinRequests", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetJoinRequests indicates an expected call of GetJoinRequests.
+func (mr *MockRoomHandlerInterfaceMockRecorder) GetJoinRequests(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinRequests", reflect.TypeOf((*MockRoomHandlerInterface)(nil).GetJoinRequests), c)
+}
+
+// GetRoomByID mocks base method.
+func (m *MockRoomHandlerInterface) GetRoomByID(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomByID", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetRoomByID indicates an expected call of GetRoomByID.
+func (mr *MockRoomHandlerInterfaceMockRecorder) GetRoomByID(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomByID", reflect.TypeOf((*MockRoomHandlerInterface)(nil).GetRoomByID), c)
+}
+
+// GetRoomIcon mocks base method.
+func (m *MockRoomHandlerInterface) GetRoomIcon(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomIcon", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetRoomIcon indicates an expected call of GetRoomIcon.
+func (mr *MockRoomHandlerInterfaceMockRecorder) GetRoomIcon(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomIcon", reflect.TypeOf((*MockRoomHandlerInterface)(nil).GetRoomIcon), c)
+}
+
+// GetRoomMembers mocks base method.
+func (m *MockRoomHandlerInterface) GetRoomMembers(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomMembers", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetRoomMembers indicates an expected call of GetRoomMembers.
+func (mr *MockRoomHandlerInterfaceMockRecorder) GetRoomMembers(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomMembers", reflect.TypeOf((*MockRoomHandlerInterface)(nil).GetRoomMembers), c)
+}
+
+// GetRooms mocks base method.
+func (m *MockRoomHandlerInterface) GetRooms(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRooms", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetRooms indicates an expected call of GetRooms.
+func (mr *MockRoomHandlerInterfaceMockRecorder) GetRooms(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRooms", reflect.TypeOf((*MockRoomHandlerInterface)(nil).GetRooms), c)
+}
+
+// JoinRoom mocks base method.
+func (m *MockRoomHandlerInterface) JoinRoom(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinRoom", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinRoom indicates an expected call of JoinRoom.
+func (mr *MockRoomHandlerInterfaceMockRecorder) JoinRoom(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinRoom", reflect.TypeOf((*MockRoomHandlerInterface)(nil).JoinRoom), c)
+}
+
+// KickMember mocks base method.
+func (m *MockRoomHandlerInterface) KickMember(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KickMember", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KickMember indicates an expected call of KickMember.
+func (mr *MockRoomHandlerInterfaceMockRecorder) KickMember(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KickMember", reflect.TypeOf((*MockRoomHandlerInterface)(nil).KickMember), c)
+}
+
+// LeaveRoom mocks base method.
+func (m *MockRoomHandlerInterface) LeaveRoom(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveRoom", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveRoom indicates an expected call of LeaveRoom.
+func (mr *MockRoomHandlerInterfaceMockRecorder) LeaveRoom(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveRoom", reflect.TypeOf((*MockRoomHandlerInterface)(nil).LeaveRoom), c)
+}
+
+// MuteMember mocks base method.
+func (m *MockRoomHandlerInterface) MuteMember(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MuteMember", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MuteMember indicates an expected call of MuteMember.
+func (mr *MockRoomHandlerInterfaceMockRecorder) MuteMember(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MuteMember", reflect.TypeOf((*MockRoomHandlerInterface)(nil).MuteMember), c)
+}
+
+// OpenDirectRoom mocks base method.
+func (m *MockRoomHandlerInterface) OpenDirectRoom(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDirectRoom", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenDirectRoom indicates an expected call of OpenDirectRoom.
+func (mr *MockRoomHandlerInterfaceMockRecorder) OpenDirectRoom(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDirectRoom", reflect.TypeOf((*MockRoomHandlerInterface)(nil).OpenDirectRoom), c)
+}
+
+// RedeemInvite mocks base method.
+func (m *MockRoomHandlerInterface) RedeemInvite(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemInvite", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedeemInvite indicates an expected call of RedeemInvite.
+func (mr *MockRoomHandlerInterfaceMockRecorder) RedeemInvite(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemInvite", reflect.TypeOf((*MockRoomHandlerInterface)(nil).RedeemInvite), c)
+}
+
+// RequestToJoin mocks base method.
+func (m *MockRoomHandlerInterface) RequestToJoin(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestToJoin", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestToJoin indicates an expected call of RequestToJoin.
+func (mr *MockRoomHandlerInterfaceMockRecorder) RequestToJoin(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestToJoin", reflect.TypeOf((*MockRoomHandlerInterface)(nil).RequestToJoin), c)
+}
+
+// UnarchiveRoom mocks base method.
+func (m *MockRoomHandlerInterface) UnarchiveRoom(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveRoom", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveRoom indicates an expected call of UnarchiveRoom.
+func (mr *MockRoomHandlerInterfaceMockRecorder) UnarchiveRoom(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveRoom", reflect.TypeOf((*MockRoomHandlerInterface)(nil).UnarchiveRoom), c)
+}
+
+// UnbanMember mocks base method.
+func (m *MockRoomHandlerInterface) UnbanMember(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanMember", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbanMember indicates an expected call of UnbanMember.
+func (mr *MockRoomHandlerInterfaceMockRecorder) UnbanMember(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanMember", reflect.TypeOf((*MockRoomHandlerInterface)(nil).UnbanMember), c)
+}
+
+// UnmuteMember mocks base method.
+func (m *MockRoomHandlerInterface) UnmuteMember(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmuteMember", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnmuteMember indicates an expected call of UnmuteMember.
+func (mr *MockRoomHandlerInterfaceMockRecorder) UnmuteMember(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmuteMember", reflect.TypeOf((*MockRoomHandlerInterface)(nil).UnmuteMember), c)
+}
+
+// UpdateRoom mocks base method.
+func (m *MockRoomHandlerInterface) UpdateRoom(c echo.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoom", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoom indicates an expected call of UpdateRoom.
+func (mr *MockRoomHandlerInterfaceMockRecorder) UpdateRoom(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoom", reflect.TypeOf((*MockRoomHandlerInterface)(nil).UpdateRoom), c)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/usecase/messagecase/interface.go
+// Source: internal/usecase/messagecase/interface.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/usecase/messagecase/interface.go -destination=test/mocks/usecase/messagecase/interface_mock.go
+//	mockgen -source=internal/usecase/messagecase/interface.go -destination=test/mocks/usecase/messagecase/interface_mock.go
 //
 
 // Package mock_messagecase is a generated GoMock package.
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entity "example.com/infrahandson/internal/domain/entity"
 	messagecase "example.com/infrahandson/internal/usecase/messagecase"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,50 @@ func (m *MockMessageUseCaseInterface) EXPECT() *MockMessageUseCaseInterfaceMockR
 	return m.recorder
 }
 
+// AddReaction mocks base method.
+func (m *MockMessageUseCaseInterface) AddReaction(ctx context.Context, req messagecase.ReactionRequest) (messagecase.ReactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", ctx, req)
+	ret0, _ := ret[0].(messagecase.ReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) AddReaction(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).AddReaction), ctx, req)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockMessageUseCaseInterface) DeleteMessage(ctx context.Context, req messagecase.DeleteMessageRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) DeleteMessage(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).DeleteMessage), ctx, req)
+}
+
+// EditMessage mocks base method.
+func (m *MockMessageUseCaseInterface) EditMessage(ctx context.Context, req messagecase.EditMessageRequest) (messagecase.EditMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMessage", ctx, req)
+	ret0, _ := ret[0].(messagecase.EditMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditMessage indicates an expected call of EditMessage.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) EditMessage(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).EditMessage), ctx, req)
+}
+
 // GetMessageHistoryInRoom mocks base method.
 func (m *MockMessageUseCaseInterface) GetMessageHistoryInRoom(ctx context.Context, req messagecase.GetMessageHistoryInRoomRequest) (messagecase.GetMessageHistoryInRoomResponse, error) {
 	m.ctrl.T.Helper()
@@ -55,3 +100,181 @@ func (mr *MockMessageUseCaseInterfaceMockRecorder) GetMessageHistoryInRoom(ctx,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageHistoryInRoom", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).GetMessageHistoryInRoom), ctx, req)
 }
+
+// GetMessageRevisions mocks base method.
+func (m *MockMessageUseCaseInterface) GetMessageRevisions(ctx context.Context, req messagecase.GetMessageRevisionsRequest) (messagecase.GetMessageRevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageRevisions", ctx, req)
+	ret0, _ := ret[0].(messagecase.GetMessageRevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageRevisions indicates an expected call of GetMessageRevisions.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) GetMessageRevisions(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageRevisions", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).GetMessageRevisions), ctx, req)
+}
+
+// GetPinnedMessages mocks base method.
+func (m *MockMessageUseCaseInterface) GetPinnedMessages(ctx context.Context, req messagecase.GetPinnedMessagesRequest) (messagecase.GetPinnedMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinnedMessages", ctx, req)
+	ret0, _ := ret[0].(messagecase.GetPinnedMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinnedMessages indicates an expected call of GetPinnedMessages.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) GetPinnedMessages(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinnedMessages", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).GetPinnedMessages), ctx, req)
+}
+
+// GetReadReceipts mocks base method.
+func (m *MockMessageUseCaseInterface) GetReadReceipts(ctx context.Context, req messagecase.GetReadReceiptsRequest) (messagecase.GetReadReceiptsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadReceipts", ctx, req)
+	ret0, _ := ret[0].(messagecase.GetReadReceiptsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadReceipts indicates an expected call of GetReadReceipts.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) GetReadReceipts(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadReceipts", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).GetReadReceipts), ctx, req)
+}
+
+// GetThread mocks base method.
+func (m *MockMessageUseCaseInterface) GetThread(ctx context.Context, req messagecase.GetThreadRequest) (messagecase.GetThreadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThread", ctx, req)
+	ret0, _ := ret[0].(messagecase.GetThreadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThread indicates an expected call of GetThread.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) GetThread(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThread", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).GetThread), ctx, req)
+}
+
+// ListMentions mocks base method.
+func (m *MockMessageUseCaseInterface) ListMentions(ctx context.Context, req messagecase.ListMentionsRequest) (messagecase.ListMentionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMentions", ctx, req)
+	ret0, _ := ret[0].(messagecase.ListMentionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMentions indicates an expected call of ListMentions.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) ListMentions(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMentions", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).ListMentions), ctx, req)
+}
+
+// MarkAsRead mocks base method.
+func (m *MockMessageUseCaseInterface) MarkAsRead(ctx context.Context, req messagecase.MarkAsReadRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsRead", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsRead indicates an expected call of MarkAsRead.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) MarkAsRead(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).MarkAsRead), ctx, req)
+}
+
+// OpenAttachment mocks base method.
+func (m *MockMessageUseCaseInterface) OpenAttachment(ctx context.Context, req messagecase.OpenAttachmentRequest) (messagecase.OpenAttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAttachment", ctx, req)
+	ret0, _ := ret[0].(messagecase.OpenAttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenAttachment indicates an expected call of OpenAttachment.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) OpenAttachment(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAttachment", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).OpenAttachment), ctx, req)
+}
+
+// PinMessage mocks base method.
+func (m *MockMessageUseCaseInterface) PinMessage(ctx context.Context, req messagecase.PinRequest) (*entity.PinnedMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinMessage", ctx, req)
+	ret0, _ := ret[0].(*entity.PinnedMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinMessage indicates an expected call of PinMessage.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) PinMessage(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinMessage", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).PinMessage), ctx, req)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockMessageUseCaseInterface) RemoveReaction(ctx context.Context, req messagecase.ReactionRequest) (messagecase.ReactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", ctx, req)
+	ret0, _ := ret[0].(messagecase.ReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) RemoveReaction(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).RemoveReaction), ctx, req)
+}
+
+// SearchMessages mocks base method.
+func (m *MockMessageUseCaseInterface) SearchMessages(ctx context.Context, req messagecase.SearchMessagesRequest) (messagecase.SearchMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, req)
+	ret0, _ := ret[0].(messagecase.SearchMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) SearchMessages(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).SearchMessages), ctx, req)
+}
+
+// UnpinMessage mocks base method.
+func (m *MockMessageUseCaseInterface) UnpinMessage(ctx context.Context, req messagecase.PinRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinMessage", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinMessage indicates an expected call of UnpinMessage.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) UnpinMessage(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinMessage", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).UnpinMessage), ctx, req)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockMessageUseCaseInterface) UploadAttachment(ctx context.Context, req messagecase.UploadAttachmentRequest) (messagecase.UploadAttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, req)
+	ret0, _ := ret[0].(messagecase.UploadAttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockMessageUseCaseInterfaceMockRecorder) UploadAttachment(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockMessageUseCaseInterface)(nil).UploadAttachment), ctx, req)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/presencecase/interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/presencecase/interface.go -destination=test/mocks/usecase/presencecase/interface_mock.go
+//
+
+// Package mock_presencecase is a generated GoMock package.
+package mock_presencecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "example.com/infrahandson/internal/domain/entity"
+	presencecase "example.com/infrahandson/internal/usecase/presencecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPresenceUseCaseInterface is a mock of PresenceUseCaseInterface interface.
+type MockPresenceUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPresenceUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPresenceUseCaseInterfaceMockRecorder is the mock recorder for MockPresenceUseCaseInterface.
+type MockPresenceUseCaseInterfaceMockRecorder struct {
+	mock *MockPresenceUseCaseInterface
+}
+
+// NewMockPresenceUseCaseInterface creates a new mock instance.
+func NewMockPresenceUseCaseInterface(ctrl *gomock.Controller) *MockPresenceUseCaseInterface {
+	mock := &MockPresenceUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockPresenceUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPresenceUseCaseInterface) EXPECT() *MockPresenceUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Connect mocks base method.
+func (m *MockPresenceUseCaseInterface) Connect(ctx context.Context, req presencecase.PresenceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connect", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockPresenceUseCaseInterfaceMockRecorder) Connect(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockPresenceUseCaseInterface)(nil).Connect), ctx, req)
+}
+
+// Disconnect mocks base method.
+func (m *MockPresenceUseCaseInterface) Disconnect(ctx context.Context, req presencecase.PresenceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disconnect", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disconnect indicates an expected call of Disconnect.
+func (mr *MockPresenceUseCaseInterfaceMockRecorder) Disconnect(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockPresenceUseCaseInterface)(nil).Disconnect), ctx, req)
+}
+
+// GetPresence mocks base method.
+func (m *MockPresenceUseCaseInterface) GetPresence(ctx context.Context, id entity.UserID) (*entity.UserPresence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresence", ctx, id)
+	ret0, _ := ret[0].(*entity.UserPresence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresence indicates an expected call of GetPresence.
+func (mr *MockPresenceUseCaseInterfaceMockRecorder) GetPresence(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresence", reflect.TypeOf((*MockPresenceUseCaseInterface)(nil).GetPresence), ctx, id)
+}
+
+// GetPresences mocks base method.
+func (m *MockPresenceUseCaseInterface) GetPresences(ctx context.Context, req presencecase.GetPresencesRequest) ([]*entity.UserPresence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresences", ctx, req)
+	ret0, _ := ret[0].([]*entity.UserPresence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresences indicates an expected call of GetPresences.
+func (mr *MockPresenceUseCaseInterfaceMockRecorder) GetPresences(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresences", reflect.TypeOf((*MockPresenceUseCaseInterface)(nil).GetPresences), ctx, req)
+}
+
+// Heartbeat mocks base method.
+func (m *MockPresenceUseCaseInterface) Heartbeat(ctx context.Context, req presencecase.PresenceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Heartbeat", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Heartbeat indicates an expected call of Heartbeat.
+func (mr *MockPresenceUseCaseInterfaceMockRecorder) Heartbeat(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockPresenceUseCaseInterface)(nil).Heartbeat), ctx, req)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/usecase/roomcase/interface.go
+// Source: internal/usecase/roomcase/interface.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/usecase/roomcase/interface.go -destination=test/mocks/usecase/roomcase/interface_mock.go
+//	mockgen -source=internal/usecase/roomcase/interface.go -destination=test/mocks/usecase/roomcase/interface_mock.go
 //
 
 // Package mock_roomcase is a generated GoMock package.
@@ -42,6 +42,63 @@ func (m *MockRoomUseCaseInterface) EXPECT() *MockRoomUseCaseInterfaceMockRecorde
 	return m.recorder
 }
 
+// ApproveJoinRequest mocks base method.
+func (m *MockRoomUseCaseInterface) ApproveJoinRequest(ctx context.Context, req roomcase.DecideJoinRequestRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJoinRequest", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveJoinRequest indicates an expected call of ApproveJoinRequest.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) ApproveJoinRequest(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJoinRequest", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).ApproveJoinRequest), ctx, req)
+}
+
+// ArchiveRoom mocks base method.
+func (m *MockRoomUseCaseInterface) ArchiveRoom(ctx context.Context, req roomcase.ArchiveRoomRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveRoom", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveRoom indicates an expected call of ArchiveRoom.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) ArchiveRoom(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveRoom", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).ArchiveRoom), ctx, req)
+}
+
+// BanMember mocks base method.
+func (m *MockRoomUseCaseInterface) BanMember(ctx context.Context, req roomcase.ModerateMemberRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanMember", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanMember indicates an expected call of BanMember.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) BanMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanMember", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).BanMember), ctx, req)
+}
+
+// CreateInvite mocks base method.
+func (m *MockRoomUseCaseInterface) CreateInvite(ctx context.Context, req roomcase.CreateInviteRequest) (roomcase.CreateInviteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", ctx, req)
+	ret0, _ := ret[0].(roomcase.CreateInviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) CreateInvite(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).CreateInvite), ctx, req)
+}
+
 // CreateRoom mocks base method.
 func (m *MockRoomUseCaseInterface) CreateRoom(ctx context.Context, req roomcase.CreateRoomRequest) (roomcase.CreateRoomResponse, error) {
 	m.ctrl.T.Helper()
@@ -71,19 +128,48 @@ func (mr *MockRoomUseCaseInterfaceMockRecorder) DeleteRoom(ctx, req any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoom", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).DeleteRoom), ctx, req)
 }
 
+// DenyJoinRequest mocks base method.
+func (m *MockRoomUseCaseInterface) DenyJoinRequest(ctx context.Context, req roomcase.DecideJoinRequestRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyJoinRequest", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DenyJoinRequest indicates an expected call of DenyJoinRequest.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) DenyJoinRequest(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyJoinRequest", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).DenyJoinRequest), ctx, req)
+}
+
 // GetAllRooms mocks base method.
-func (m *MockRoomUseCaseInterface) GetAllRooms(ctx context.Context) ([]*entity.Room, error) {
+func (m *MockRoomUseCaseInterface) GetAllRooms(ctx context.Context, req roomcase.GetAllRoomsRequest) ([]*entity.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllRooms", ctx)
+	ret := m.ctrl.Call(m, "GetAllRooms", ctx, req)
 	ret0, _ := ret[0].([]*entity.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllRooms indicates an expected call of GetAllRooms.
-func (mr *MockRoomUseCaseInterfaceMockRecorder) GetAllRooms(ctx any) *gomock.Call {
+func (mr *MockRoomUseCaseInterfaceMockRecorder) GetAllRooms(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRooms", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).GetAllRooms), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRooms", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).GetAllRooms), ctx, req)
+}
+
+// GetDirectRooms mocks base method.
+func (m *MockRoomUseCaseInterface) GetDirectRooms(ctx context.Context, req roomcase.GetDirectRoomsRequest) ([]*entity.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDirectRooms", ctx, req)
+	ret0, _ := ret[0].([]*entity.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDirectRooms indicates an expected call of GetDirectRooms.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) GetDirectRooms(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectRooms", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).GetDirectRooms), ctx, req)
 }
 
 // GetRoomByID mocks base method.
@@ -101,6 +187,21 @@ func (mr *MockRoomUseCaseInterfaceMockRecorder) GetRoomByID(ctx, params any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomByID", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).GetRoomByID), ctx, params)
 }
 
+// GetRoomIconPath mocks base method.
+func (m *MockRoomUseCaseInterface) GetRoomIconPath(ctx context.Context, req roomcase.GetRoomIconPathRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomIconPath", ctx, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomIconPath indicates an expected call of GetRoomIconPath.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) GetRoomIconPath(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomIconPath", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).GetRoomIconPath), ctx, req)
+}
+
 // GetUsersInRoom mocks base method.
 func (m *MockRoomUseCaseInterface) GetUsersInRoom(ctx context.Context, req roomcase.GetUsersInRoomRequest) (roomcase.GetUsersInRoomResponse, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +231,20 @@ func (mr *MockRoomUseCaseInterfaceMockRecorder) JoinRoom(ctx, req any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinRoom", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).JoinRoom), ctx, req)
 }
 
+// KickMember mocks base method.
+func (m *MockRoomUseCaseInterface) KickMember(ctx context.Context, req roomcase.ModerateMemberRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KickMember", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KickMember indicates an expected call of KickMember.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) KickMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KickMember", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).KickMember), ctx, req)
+}
+
 // LeaveRoom mocks base method.
 func (m *MockRoomUseCaseInterface) LeaveRoom(ctx context.Context, req roomcase.LeaveRoomRequest) error {
 	m.ctrl.T.Helper()
@@ -143,3 +258,177 @@ func (mr *MockRoomUseCaseInterfaceMockRecorder) LeaveRoom(ctx, req any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveRoom", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).LeaveRoom), ctx, req)
 }
+
+// ListJoinRequests mocks base method.
+func (m *MockRoomUseCaseInterface) ListJoinRequests(ctx context.Context, req roomcase.ListJoinRequestsRequest) ([]*entity.RoomJoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJoinRequests", ctx, req)
+	ret0, _ := ret[0].([]*entity.RoomJoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJoinRequests indicates an expected call of ListJoinRequests.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) ListJoinRequests(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJoinRequests", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).ListJoinRequests), ctx, req)
+}
+
+// ListRoomMembers mocks base method.
+func (m *MockRoomUseCaseInterface) ListRoomMembers(ctx context.Context, req roomcase.ListRoomMembersRequest) (roomcase.ListRoomMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoomMembers", ctx, req)
+	ret0, _ := ret[0].(roomcase.ListRoomMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoomMembers indicates an expected call of ListRoomMembers.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) ListRoomMembers(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoomMembers", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).ListRoomMembers), ctx, req)
+}
+
+// ListRooms mocks base method.
+func (m *MockRoomUseCaseInterface) ListRooms(ctx context.Context, req roomcase.ListRoomsRequest) (roomcase.ListRoomsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRooms", ctx, req)
+	ret0, _ := ret[0].(roomcase.ListRoomsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRooms indicates an expected call of ListRooms.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) ListRooms(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRooms", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).ListRooms), ctx, req)
+}
+
+// MuteMember mocks base method.
+func (m *MockRoomUseCaseInterface) MuteMember(ctx context.Context, req roomcase.ModerateMemberRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MuteMember", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MuteMember indicates an expected call of MuteMember.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) MuteMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MuteMember", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).MuteMember), ctx, req)
+}
+
+// OpenDirectRoom mocks base method.
+func (m *MockRoomUseCaseInterface) OpenDirectRoom(ctx context.Context, req roomcase.OpenDirectRoomRequest) (roomcase.OpenDirectRoomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDirectRoom", ctx, req)
+	ret0, _ := ret[0].(roomcase.OpenDirectRoomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenDirectRoom indicates an expected call of OpenDirectRoom.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) OpenDirectRoom(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDirectRoom", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).OpenDirectRoom), ctx, req)
+}
+
+// RedeemInvite mocks base method.
+func (m *MockRoomUseCaseInterface) RedeemInvite(ctx context.Context, req roomcase.RedeemInviteRequest) (roomcase.RedeemInviteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemInvite", ctx, req)
+	ret0, _ := ret[0].(roomcase.RedeemInviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemInvite indicates an expected call of RedeemInvite.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) RedeemInvite(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemInvite", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).RedeemInvite), ctx, req)
+}
+
+// RequestToJoin mocks base method.
+func (m *MockRoomUseCaseInterface) RequestToJoin(ctx context.Context, req roomcase.RequestToJoinRequest) (*entity.RoomJoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestToJoin", ctx, req)
+	ret0, _ := ret[0].(*entity.RoomJoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestToJoin indicates an expected call of RequestToJoin.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) RequestToJoin(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestToJoin", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).RequestToJoin), ctx, req)
+}
+
+// UnarchiveRoom mocks base method.
+func (m *MockRoomUseCaseInterface) UnarchiveRoom(ctx context.Context, req roomcase.ArchiveRoomRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveRoom", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveRoom indicates an expected call of UnarchiveRoom.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) UnarchiveRoom(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveRoom", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).UnarchiveRoom), ctx, req)
+}
+
+// UnbanMember mocks base method.
+func (m *MockRoomUseCaseInterface) UnbanMember(ctx context.Context, req roomcase.ModerateMemberRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanMember", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbanMember indicates an expected call of UnbanMember.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) UnbanMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanMember", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).UnbanMember), ctx, req)
+}
+
+// UnmuteMember mocks base method.
+func (m *MockRoomUseCaseInterface) UnmuteMember(ctx context.Context, req roomcase.ModerateMemberRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmuteMember", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnmuteMember indicates an expected call of UnmuteMember.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) UnmuteMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmuteMember", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).UnmuteMember), ctx, req)
+}
+
+// UpdateRoomName mocks base method.
+func (m *MockRoomUseCaseInterface) UpdateRoomName(ctx context.Context, req roomcase.UpdateRoomNameRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoomName", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoomName indicates an expected call of UpdateRoomName.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) UpdateRoomName(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomName", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).UpdateRoomName), ctx, req)
+}
+
+// UpdateRoomSettings mocks base method.
+func (m *MockRoomUseCaseInterface) UpdateRoomSettings(ctx context.Context, req roomcase.UpdateRoomSettingsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoomSettings", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoomSettings indicates an expected call of UpdateRoomSettings.
+func (mr *MockRoomUseCaseInterfaceMockRecorder) UpdateRoomSettings(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomSettings", reflect.TypeOf((*MockRoomUseCaseInterface)(nil).UpdateRoomSettings), ctx, req)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: /home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/usecase/websocketcase/interface.go
+// Source: internal/usecase/websocketcase/interface.go
 //
 // Generated by this command:
 //
-//	mockgen -source=/home/nakamura/program/Maximum/infra/Maximum-Infra-hands-on/backend/internal/usecase/websocketcase/interface.go -destination=test/mocks/usecase/websocketcase/interface_mock.go
+//	mockgen -source=internal/usecase/websocketcase/interface.go -destination=test/mocks/usecase/websocketcase/interface_mock.go
 //
 
 // Package mock_websocketcase is a generated GoMock package.
@@ -41,14 +41,29 @@ func (m *MockWebsocketUseCaseInterface) EXPECT() *MockWebsocketUseCaseInterfaceM
 	return m.recorder
 }
 
-// ConnectUserToRoom mocks base method.
-func (m *MockWebsocketUseCaseInterface) ConnectUserToRoom(ctx context.Context, req websocketcase.ConnectUserToRoomRequest) error {
+// AuthorizeConnect mocks base method.
+func (m *MockWebsocketUseCaseInterface) AuthorizeConnect(ctx context.Context, req websocketcase.AuthorizeConnectRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConnectUserToRoom", ctx, req)
+	ret := m.ctrl.Call(m, "AuthorizeConnect", ctx, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// AuthorizeConnect indicates an expected call of AuthorizeConnect.
+func (mr *MockWebsocketUseCaseInterfaceMockRecorder) AuthorizeConnect(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeConnect", reflect.TypeOf((*MockWebsocketUseCaseInterface)(nil).AuthorizeConnect), ctx, req)
+}
+
+// ConnectUserToRoom mocks base method.
+func (m *MockWebsocketUseCaseInterface) ConnectUserToRoom(ctx context.Context, req websocketcase.ConnectUserToRoomRequest) (websocketcase.ConnectUserToRoomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectUserToRoom", ctx, req)
+	ret0, _ := ret[0].(websocketcase.ConnectUserToRoomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // ConnectUserToRoom indicates an expected call of ConnectUserToRoom.
 func (mr *MockWebsocketUseCaseInterfaceMockRecorder) ConnectUserToRoom(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -70,11 +85,12 @@ func (mr *MockWebsocketUseCaseInterfaceMockRecorder) DisconnectUser(ctx, req any
 }
 
 // SendMessage mocks base method.
-func (m *MockWebsocketUseCaseInterface) SendMessage(ctx context.Context, req websocketcase.SendMessageRequest) error {
+func (m *MockWebsocketUseCaseInterface) SendMessage(ctx context.Context, req websocketcase.SendMessageRequest) (websocketcase.SendMessageResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendMessage", ctx, req)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(websocketcase.SendMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SendMessage indicates an expected call of SendMessage.
@@ -82,3 +98,31 @@ func (mr *MockWebsocketUseCaseInterfaceMockRecorder) SendMessage(ctx, req any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockWebsocketUseCaseInterface)(nil).SendMessage), ctx, req)
 }
+
+// StartTyping mocks base method.
+func (m *MockWebsocketUseCaseInterface) StartTyping(ctx context.Context, req websocketcase.TypingRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTyping", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartTyping indicates an expected call of StartTyping.
+func (mr *MockWebsocketUseCaseInterfaceMockRecorder) StartTyping(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTyping", reflect.TypeOf((*MockWebsocketUseCaseInterface)(nil).StartTyping), ctx, req)
+}
+
+// StopTyping mocks base method.
+func (m *MockWebsocketUseCaseInterface) StopTyping(ctx context.Context, req websocketcase.TypingRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTyping", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopTyping indicates an expected call of StopTyping.
+func (mr *MockWebsocketUseCaseInterfaceMockRecorder) StopTyping(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTyping", reflect.TypeOf((*MockWebsocketUseCaseInterface)(nil).StopTyping), ctx, req)
+}
@@ -1,10 +1,17 @@
+let eventSeq = 0;
+
 export const sendRoomMessage = (
   socket: WebSocket,
   message: string
 ): void => {
   if (socket.readyState === WebSocket.OPEN) {
     socket.send(JSON.stringify({
-      content: message,
+      v: 1,
+      type: 'message.send',
+      id: `c-${++eventSeq}`, // ack / error と対応付けるためのID
+      payload: {
+        content: message,
+      },
     }));
   } else {
     console.warn('WebSocket is not open. Message not sent:', message);
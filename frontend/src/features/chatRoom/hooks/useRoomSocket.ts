@@ -16,15 +16,68 @@ export const useRoomSocket = (roomId: string) => {
 
     socket.onmessage = (event) => {
       const data = parseRoomMessage(event);
-      console.log('Received message:', data);
-      // MessageResonse型に変換
-      const msg: MessageResponse = {
-        id: data.ID as string,
-        user_id: data.UserID as string,
-        sent_at: data.SentAt as string,
-        content: data.Content as string,
+      console.log('Received event:', data);
+      switch (data?.type) {
+        case 'message.created': {
+          // スレッドの返信は部屋のメッセージ一覧には表示しない
+          if (data.payload.parent_id) {
+            break;
+          }
+          // MessageResonse型に変換
+          const msg: MessageResponse = {
+            id: data.payload.id as string,
+            user_id: data.payload.user_id as string,
+            sent_at: data.payload.sent_at as string,
+            content: data.payload.content as string,
+          }
+          setMessages((prev) => [...prev, msg]);
+          break;
+        }
+        case 'message.updated':
+        case 'message.deleted': {
+          // 表示中のメッセージを編集・削除後の内容に置き換える
+          const id = data.payload.id as string;
+          setMessages((prev) =>
+            prev.map((m) =>
+              m.id === id
+                ? {
+                    ...m,
+                    content: data.payload.content as string,
+                    edited_at: data.payload.edited_at as string | undefined,
+                    deleted_at: data.payload.deleted_at as string | undefined,
+                  }
+                : m,
+            ),
+          );
+          break;
+        }
+        case 'reaction.added':
+        case 'reaction.removed': {
+          // 絵文字ごとのリアクション数を最新の値に置き換える（0件になったものは消す）
+          const messageId = data.payload.message_id as string;
+          const emoji = data.payload.emoji as string;
+          const count = data.payload.count as number;
+          setMessages((prev) =>
+            prev.map((m) => {
+              if (m.id !== messageId) {
+                return m;
+              }
+              const reactions = m.reactions ?? [];
+              const updated = reactions.some((r) => r.emoji === emoji)
+                ? reactions.map((r) => (r.emoji === emoji ? { ...r, count } : r))
+                : [...reactions, { emoji, count, reacted: false }];
+              return { ...m, reactions: updated.filter((r) => r.count > 0) };
+            }),
+          );
+          break;
+        }
+        case 'error':
+          console.error('Server error:', data.payload);
+          break;
+        default:
+          // 未知のイベントは無視する
+          break;
       }
-      setMessages((prev) => [...prev, msg]);
     };
 
     socket.onerror = (err) => console.error('WebSocket error:', err);
@@ -3,4 +3,18 @@ export type MessageResponse = {
   user_id: string;
   sent_at: string; // RFC3339
   content: string;
+  parent_id?: string; // スレッドの返信の場合のみ
+  edited_at?: string; // RFC3339（編集された場合のみ）
+  deleted_at?: string; // RFC3339（削除された場合のみ、content は空）
+  thread?: {
+    reply_count: number;
+    last_reply_at: string; // RFC3339
+  }; // 返信がある場合のみ
+  reactions?: ReactionResponse[]; // リアクションがある場合のみ
+};
+
+export type ReactionResponse = {
+  emoji: string;
+  count: number;
+  reacted: boolean; // 自分がリアクションしているか
 };
@@ -1,12 +1,13 @@
 import apiClient from "../../utils/apiClient"
 
 export const getAllRoomsApi = async () => {
-  const response = await apiClient.get("/api/room");
+  // 一覧はページ単位で返るため、上限件数の先頭ページを取得する
+  const response = await apiClient.get("/api/room?limit=100");
   if (!response.ok) {
     const error = await response.json();
     throw new Error(error.message || "ルームの取得に失敗しました");
   }
 
   const data = await response.json();
-  return data;
+  return data.rooms;
 }
\ No newline at end of file
@@ -6,7 +6,7 @@ set -e
 # 同時実行をバックグラウンドで開始し、PIDを記録
 echo "Starting backend..."
 cd backend
-go run cmd/main.go &
+go run -tags sqlite_fts5 cmd/main.go &
 BACK_PID=$!
 
 echo "Starting frontend..."